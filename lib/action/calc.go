@@ -77,6 +77,8 @@ func formatCalcResult(p value.Primary) string {
 		s = p.(value.Ternary).String()
 	case value.Datetime:
 		s = p.(value.Datetime).Format(time.RFC3339Nano)
+	case value.Array:
+		s = p.(value.Array).String()
 	case value.Null:
 		s = "null"
 	}
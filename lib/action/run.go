@@ -21,6 +21,7 @@ func Run(input string, sourceFile string) error {
 
 	defer func() {
 		query.ReleaseResources()
+		showWarnings()
 		showStats(start)
 	}()
 
@@ -41,9 +42,7 @@ func Run(input string, sourceFile string) error {
 		return err
 	}
 
-	createSelectLog()
-
-	return nil
+	return createSelectLog()
 }
 
 func LaunchInteractiveShell() error {
@@ -126,6 +125,7 @@ func LaunchInteractiveShell() error {
 		}
 
 		flow, e := proc.Execute(statements)
+		showWarnings()
 		if e != nil {
 			if ex, ok := e.(*query.Exit); ok {
 				err = ex
@@ -153,7 +153,10 @@ func LaunchInteractiveShell() error {
 
 func createSelectLog() error {
 	flags := cmd.GetFlags()
-	selectLog := query.ReadSelectLog()
+	selectLog, err := query.ReadSelectLog()
+	if err != nil {
+		return err
+	}
 	if 0 < len(flags.OutFile) && 0 < len(selectLog) {
 		if err := cmd.CreateFile(flags.OutFile, selectLog); err != nil {
 			return err
@@ -162,6 +165,19 @@ func createSelectLog() error {
 	return nil
 }
 
+func showWarnings() {
+	flags := cmd.GetFlags()
+	if flags.Warnings {
+		for _, w := range query.Warnings.List() {
+			cmd.ToStderr(w.String() + "\n")
+		}
+		if dropped := query.Warnings.Dropped(); 0 < dropped {
+			cmd.ToStderr(query.NewWarning(parser.NewNullValue(), fmt.Sprintf(query.WARNING_OMITTED, dropped)).String() + "\n")
+		}
+	}
+	query.Warnings.Clear()
+}
+
 func showStats(start time.Time) {
 	flags := cmd.GetFlags()
 	if !flags.Stats {
@@ -38,6 +38,16 @@ var executeTests = []struct {
 		Input: "select from",
 		Error: "[L:1 C:8] syntax error: unexpected FROM",
 	},
+	{
+		Name:  "Field Not Exist Error Position",
+		Input: "var @a := 1;\nselect 1,\n  notexist;",
+		Error: "[L:3 C:3] field notexist does not exist",
+	},
+	{
+		Name:  "Function Invalid Argument Error Position",
+		Input: "print 1;\nprint 'a' || format(null);",
+		Error: "[L:2 C:14] the first argument must be a string for function format",
+	},
 	{
 		Name:  "Show Statistics",
 		Input: "select 1",
@@ -19,14 +19,32 @@ const UNDEF = -1
 type Encoding string
 
 const (
-	UTF8 Encoding = "UTF8"
-	SJIS Encoding = "SJIS"
+	AUTO    Encoding = "AUTO"
+	UTF8    Encoding = "UTF8"
+	UTF16LE Encoding = "UTF16LE"
+	UTF16BE Encoding = "UTF16BE"
+	SJIS    Encoding = "SJIS"
+	LATIN1  Encoding = "LATIN1"
 )
 
 func (e Encoding) String() string {
 	return reflect.ValueOf(e).String()
 }
 
+type Locale string
+
+const (
+	EN Locale = "en"
+	JA Locale = "ja"
+	DE Locale = "de"
+	FR Locale = "fr"
+	ES Locale = "es"
+)
+
+func (l Locale) String() string {
+	return reflect.ValueOf(l).String()
+}
+
 type LineBreak string
 
 const (
@@ -69,23 +87,120 @@ func (f Format) String() string {
 	return formatLiterals[f]
 }
 
+type NumberNotation int
+
+const (
+	FIXED NumberNotation = iota
+	SCIENTIFIC
+	AUTO_NOTATION
+)
+
+var numberNotationLiterals = map[NumberNotation]string{
+	FIXED:         "FIXED",
+	SCIENTIFIC:    "SCIENTIFIC",
+	AUTO_NOTATION: "AUTO",
+}
+
+func (n NumberNotation) String() string {
+	return numberNotationLiterals[n]
+}
+
+type TsvStyle int
+
+const (
+	QUOTE TsvStyle = iota
+	ESCAPE
+)
+
+var tsvStyleLiterals = map[TsvStyle]string{
+	QUOTE:  "QUOTE",
+	ESCAPE: "ESCAPE",
+}
+
+func (s TsvStyle) String() string {
+	return tsvStyleLiterals[s]
+}
+
+type InferTypes int
+
+const (
+	INFER_OFF InferTypes = iota
+	INFER_PER_CELL
+	INFER_PER_COLUMN
+)
+
+var inferTypesLiterals = map[InferTypes]string{
+	INFER_OFF:        "OFF",
+	INFER_PER_CELL:   "PER-CELL",
+	INFER_PER_COLUMN: "PER-COLUMN",
+}
+
+func (t InferTypes) String() string {
+	return inferTypesLiterals[t]
+}
+
+type EmptyResult int
+
+const (
+	EMPTY_AUTO EmptyResult = iota
+	EMPTY_HEADER
+	EMPTY_NONE
+	EMPTY_MESSAGE
+)
+
+var emptyResultLiterals = map[EmptyResult]string{
+	EMPTY_AUTO:    "AUTO",
+	EMPTY_HEADER:  "HEADER",
+	EMPTY_NONE:    "NONE",
+	EMPTY_MESSAGE: "MESSAGE",
+}
+
+func (e EmptyResult) String() string {
+	return emptyResultLiterals[e]
+}
+
 const (
-	CSV_EXT = ".csv"
-	TSV_EXT = ".tsv"
+	CSV_EXT  = ".csv"
+	TSV_EXT  = ".tsv"
+	XLSX_EXT = ".xlsx"
+)
+
+const (
+	DEFAULT_APPROX_PRECISION = 14
+	MIN_APPROX_PRECISION     = 4
+	MAX_APPROX_PRECISION     = 18
 )
 
 type Flags struct {
 	// Global Options
-	Delimiter      rune
-	Encoding       Encoding
-	LineBreak      LineBreak
-	Location       string
-	Repository     string
-	Source         string
-	DatetimeFormat string
-	WaitTimeout    float64
-	NoHeader       bool
-	WithoutNull    bool
+	Delimiter             rune
+	Encoding              Encoding
+	EncodingFallback      bool
+	LineBreak             LineBreak
+	Location              string
+	Repository            string
+	Source                string
+	DatetimeFormat        string
+	WaitTimeout           float64
+	NoHeader              bool
+	WithoutNull           bool
+	TrimTrailingDelimiter bool
+	SkipLines             int
+	Head                  int
+	CommentPrefix         string
+	TsvStyle              TsvStyle
+	PreserveQuoting       bool
+	PreserveLineBreak     bool
+	Locale                Locale
+	BooleanLiterals       string
+	InferTypes            InferTypes
+	SchemaNullOnError     bool
+	MaxErrors             int
+	MaxIterations         int
+	ApproxPrecision       int
+	MaxResultRows         int
+	StrictUnionTypes      bool
+	StrictSelectInto      bool
 
 	// For Output
 	WriteEncoding  Encoding
@@ -93,11 +208,21 @@ type Flags struct {
 	Format         Format
 	WriteDelimiter rune
 	WithoutHeader  bool
+	NumberNotation NumberNotation
+	EmptyResult    EmptyResult
 
 	// System Use
-	Quiet bool
-	CPU   int
-	Stats bool
+	Quiet        bool
+	CPU          int
+	Seed         int64
+	Stats        bool
+	Profile      bool
+	ErrorFormat  Format
+	Summary      Format
+	Warnings     bool
+	ReadOnly     bool
+	Backup       bool
+	BackupSuffix string
 
 	// Fixed Value
 	RetryInterval time.Duration
@@ -109,6 +234,8 @@ type Flags struct {
 var (
 	flags    *Flags
 	getFlags sync.Once
+
+	booleanLiterals map[string]bool
 )
 
 func GetFlags() *Flags {
@@ -124,26 +251,54 @@ func GetFlags() *Flags {
 
 	getFlags.Do(func() {
 		flags = &Flags{
-			Delimiter:      UNDEF,
-			Encoding:       UTF8,
-			LineBreak:      LF,
-			Location:       "Local",
-			Repository:     pwd,
-			Source:         "",
-			DatetimeFormat: "",
-			WaitTimeout:    10,
-			NoHeader:       false,
-			WithoutNull:    false,
-			WriteEncoding:  UTF8,
-			OutFile:        "",
-			Format:         TEXT,
-			WriteDelimiter: ',',
-			WithoutHeader:  false,
-			Quiet:          false,
-			CPU:            cpu,
-			Stats:          false,
-			RetryInterval:  10 * time.Millisecond,
-			Now:            "",
+			Delimiter:             UNDEF,
+			Encoding:              UTF8,
+			EncodingFallback:      true,
+			LineBreak:             LF,
+			Location:              "Local",
+			Repository:            pwd,
+			Source:                "",
+			DatetimeFormat:        "",
+			WaitTimeout:           10,
+			NoHeader:              false,
+			WithoutNull:           false,
+			TrimTrailingDelimiter: false,
+			SkipLines:             0,
+			Head:                  0,
+			CommentPrefix:         "",
+			TsvStyle:              QUOTE,
+			PreserveQuoting:       false,
+			PreserveLineBreak:     true,
+			Locale:                EN,
+			BooleanLiterals:       "",
+			InferTypes:            INFER_OFF,
+			SchemaNullOnError:     false,
+			MaxErrors:             0,
+			MaxIterations:         0,
+			ApproxPrecision:       DEFAULT_APPROX_PRECISION,
+			MaxResultRows:         0,
+			StrictUnionTypes:      false,
+			StrictSelectInto:      false,
+			WriteEncoding:         UTF8,
+			OutFile:               "",
+			Format:                TEXT,
+			WriteDelimiter:        ',',
+			WithoutHeader:         false,
+			NumberNotation:        FIXED,
+			EmptyResult:           EMPTY_AUTO,
+			Quiet:                 false,
+			CPU:                   cpu,
+			Seed:                  0,
+			Stats:                 false,
+			Profile:               false,
+			ErrorFormat:           TEXT,
+			Summary:               TEXT,
+			Warnings:              false,
+			ReadOnly:              false,
+			Backup:                false,
+			BackupSuffix:          ".bak",
+			RetryInterval:         10 * time.Millisecond,
+			Now:                   "",
 		}
 	})
 	return flags
@@ -170,9 +325,15 @@ func SetDelimiter(s string) error {
 }
 
 func SetEncoding(s string) error {
-	encoding, err := ParseEncoding(s)
-	if err != nil {
-		return err
+	var encoding Encoding
+
+	if strings.EqualFold(s, AUTO.String()) {
+		encoding = AUTO
+	} else {
+		var err error
+		if encoding, err = ParseEncoding(s); err != nil {
+			return errors.New("encoding must be one of auto|utf8|utf16le|utf16be|sjis|latin1")
+		}
 	}
 
 	f := GetFlags()
@@ -180,21 +341,20 @@ func SetEncoding(s string) error {
 	return nil
 }
 
+func SetEncodingFallback(b bool) {
+	f := GetFlags()
+	f.EncodingFallback = b
+	return
+}
+
 func SetLineBreak(s string) error {
 	if len(s) < 1 {
 		return nil
 	}
 
-	var lb LineBreak
-	switch strings.ToUpper(s) {
-	case "CRLF":
-		lb = CRLF
-	case "CR":
-		lb = CR
-	case "LF":
-		lb = LF
-	default:
-		return errors.New("line-break must be one of crlf|lf|cr")
+	lb, err := ParseLineBreak(s)
+	if err != nil {
+		return err
 	}
 
 	f := GetFlags()
@@ -285,6 +445,204 @@ func SetWithoutNull(b bool) {
 	return
 }
 
+func SetTrimTrailingDelimiter(b bool) {
+	f := GetFlags()
+	f.TrimTrailingDelimiter = b
+	return
+}
+
+func SetSkipLines(i int) {
+	if i < 0 {
+		i = 0
+	}
+
+	f := GetFlags()
+	f.SkipLines = i
+	return
+}
+
+func SetHead(i int) {
+	if i < 0 {
+		i = 0
+	}
+
+	f := GetFlags()
+	f.Head = i
+	return
+}
+
+func SetCommentPrefix(s string) {
+	f := GetFlags()
+	f.CommentPrefix = s
+	return
+}
+
+func SetTsvStyle(s string) error {
+	var style TsvStyle
+
+	switch strings.ToUpper(s) {
+	case "", "QUOTE":
+		style = QUOTE
+	case "ESCAPE":
+		style = ESCAPE
+	default:
+		return errors.New("tsv-style must be one of quote|escape")
+	}
+
+	f := GetFlags()
+	f.TsvStyle = style
+	return nil
+}
+
+func SetPreserveQuoting(b bool) {
+	f := GetFlags()
+	f.PreserveQuoting = b
+	return
+}
+
+func SetPreserveLineBreak(b bool) {
+	f := GetFlags()
+	f.PreserveLineBreak = b
+	return
+}
+
+func SetLocale(s string) error {
+	if len(s) < 1 {
+		return nil
+	}
+
+	var locale Locale
+	switch strings.ToLower(s) {
+	case "en":
+		locale = EN
+	case "ja":
+		locale = JA
+	case "de":
+		locale = DE
+	case "fr":
+		locale = FR
+	case "es":
+		locale = ES
+	default:
+		return errors.New("locale must be one of en|ja|de|fr|es")
+	}
+
+	f := GetFlags()
+	f.Locale = locale
+	return nil
+}
+
+func SetBooleanLiterals(s string) error {
+	literals := make(map[string]bool)
+	if 0 < len(s) {
+		for _, pair := range strings.Split(s, ",") {
+			words := strings.Split(pair, ":")
+			if len(words) != 2 {
+				return errors.New("boolean-literals must be pairs of a true literal and a false literal separated by a colon")
+			}
+			t := strings.ToUpper(strings.TrimSpace(words[0]))
+			f := strings.ToUpper(strings.TrimSpace(words[1]))
+			if len(t) < 1 || len(f) < 1 || t == f {
+				return errors.New("boolean-literals must be pairs of a true literal and a false literal separated by a colon")
+			}
+			literals[t] = true
+			literals[f] = false
+		}
+	}
+
+	f := GetFlags()
+	f.BooleanLiterals = s
+	booleanLiterals = literals
+	return nil
+}
+
+func ParseBooleanLiteral(s string) (bool, bool) {
+	if len(booleanLiterals) < 1 {
+		return false, false
+	}
+	b, ok := booleanLiterals[strings.ToUpper(s)]
+	return b, ok
+}
+
+func SetInferTypes(s string) error {
+	var policy InferTypes
+
+	switch strings.ToUpper(s) {
+	case "", "OFF":
+		policy = INFER_OFF
+	case "PER-CELL":
+		policy = INFER_PER_CELL
+	case "PER-COLUMN":
+		policy = INFER_PER_COLUMN
+	default:
+		return errors.New("infer-types must be one of off|per-cell|per-column")
+	}
+
+	f := GetFlags()
+	f.InferTypes = policy
+	return nil
+}
+
+func SetSchemaNullOnError(b bool) {
+	f := GetFlags()
+	f.SchemaNullOnError = b
+	return
+}
+
+func SetMaxErrors(i int) {
+	if i < 0 {
+		i = 0
+	}
+
+	f := GetFlags()
+	f.MaxErrors = i
+	return
+}
+
+func SetMaxIterations(i int) {
+	if i < 0 {
+		i = 0
+	}
+
+	f := GetFlags()
+	f.MaxIterations = i
+	return
+}
+
+func SetApproxPrecision(i int) {
+	if i < MIN_APPROX_PRECISION {
+		i = MIN_APPROX_PRECISION
+	} else if MAX_APPROX_PRECISION < i {
+		i = MAX_APPROX_PRECISION
+	}
+
+	f := GetFlags()
+	f.ApproxPrecision = i
+	return
+}
+
+func SetMaxResultRows(i int) {
+	if i < 0 {
+		i = 0
+	}
+
+	f := GetFlags()
+	f.MaxResultRows = i
+	return
+}
+
+func SetStrictUnionTypes(b bool) {
+	f := GetFlags()
+	f.StrictUnionTypes = b
+	return
+}
+
+func SetStrictSelectInto(b bool) {
+	f := GetFlags()
+	f.StrictSelectInto = b
+	return
+}
+
 func SetWriteEncoding(s string) error {
 	encoding, err := ParseEncoding(s)
 	if err != nil {
@@ -325,16 +683,11 @@ func SetFormat(s string) error {
 		default:
 			return nil
 		}
-	case "CSV":
-		fm = CSV
-	case "TSV":
-		fm = TSV
-	case "JSON":
-		fm = JSON
-	case "TEXT":
-		fm = TEXT
 	default:
-		return errors.New("format must be one of csv|tsv|json|text")
+		var err error
+		if fm, err = ParseFormat(s); err != nil {
+			return err
+		}
 	}
 
 	f.Format = fm
@@ -371,6 +724,78 @@ func SetWithoutHeader(b bool) {
 	return
 }
 
+func SetNumberNotation(s string) error {
+	var n NumberNotation
+
+	switch strings.ToUpper(s) {
+	case "", "FIXED":
+		n = FIXED
+	case "SCIENTIFIC":
+		n = SCIENTIFIC
+	case "AUTO":
+		n = AUTO_NOTATION
+	default:
+		return errors.New("number-notation must be one of fixed|scientific|auto")
+	}
+
+	f := GetFlags()
+	f.NumberNotation = n
+	return nil
+}
+
+func SetEmptyResult(s string) error {
+	var e EmptyResult
+
+	switch strings.ToUpper(s) {
+	case "", "AUTO":
+		e = EMPTY_AUTO
+	case "HEADER":
+		e = EMPTY_HEADER
+	case "NONE":
+		e = EMPTY_NONE
+	case "MESSAGE":
+		e = EMPTY_MESSAGE
+	default:
+		return errors.New("empty-result must be one of auto|header|none|message")
+	}
+
+	f := GetFlags()
+	f.EmptyResult = e
+	return nil
+}
+
+func ParseLineBreak(s string) (LineBreak, error) {
+	var lb LineBreak
+	switch strings.ToUpper(s) {
+	case "CRLF":
+		lb = CRLF
+	case "CR":
+		lb = CR
+	case "LF":
+		lb = LF
+	default:
+		return LF, errors.New("line-break must be one of crlf|lf|cr")
+	}
+	return lb, nil
+}
+
+func ParseFormat(s string) (Format, error) {
+	var fm Format
+	switch strings.ToUpper(s) {
+	case "CSV":
+		fm = CSV
+	case "TSV":
+		fm = TSV
+	case "JSON":
+		fm = JSON
+	case "TEXT":
+		fm = TEXT
+	default:
+		return TEXT, errors.New("format must be one of csv|tsv|json|text")
+	}
+	return fm, nil
+}
+
 func ParseEncoding(s string) (Encoding, error) {
 	if len(s) < 1 {
 		return UTF8, nil
@@ -380,10 +805,16 @@ func ParseEncoding(s string) (Encoding, error) {
 	switch strings.ToUpper(s) {
 	case "UTF8":
 		encoding = UTF8
+	case "UTF16LE":
+		encoding = UTF16LE
+	case "UTF16BE":
+		encoding = UTF16BE
 	case "SJIS":
 		encoding = SJIS
+	case "LATIN1":
+		encoding = LATIN1
 	default:
-		return UTF8, errors.New("encoding must be one of utf8|sjis")
+		return UTF8, errors.New("encoding must be one of utf8|utf16le|utf16be|sjis|latin1")
 	}
 	return encoding, nil
 }
@@ -408,8 +839,87 @@ func SetCPU(i int) {
 	return
 }
 
+func SetSeed(seed int64) {
+	if seed == 0 {
+		return
+	}
+
+	f := GetFlags()
+	f.Seed = seed
+	GetRand().Seed(seed)
+	return
+}
+
 func SetStats(b bool) {
 	f := GetFlags()
 	f.Stats = b
 	return
 }
+
+func SetProfile(b bool) {
+	f := GetFlags()
+	f.Profile = b
+	return
+}
+
+func SetWarnings(b bool) {
+	f := GetFlags()
+	f.Warnings = b
+	return
+}
+
+func SetReadOnly(b bool) {
+	f := GetFlags()
+	f.ReadOnly = b
+	return
+}
+
+func SetBackup(b bool) {
+	f := GetFlags()
+	f.Backup = b
+	return
+}
+
+func SetBackupSuffix(s string) error {
+	if len(s) < 1 {
+		return errors.New("backup-suffix must not be empty")
+	}
+
+	f := GetFlags()
+	f.BackupSuffix = s
+	return nil
+}
+
+func SetErrorFormat(s string) error {
+	var fm Format
+
+	switch strings.ToUpper(s) {
+	case "", "TEXT":
+		fm = TEXT
+	case "JSON":
+		fm = JSON
+	default:
+		return errors.New("error-format must be one of text|json")
+	}
+
+	f := GetFlags()
+	f.ErrorFormat = fm
+	return nil
+}
+
+func SetSummary(s string) error {
+	var fm Format
+
+	switch strings.ToUpper(s) {
+	case "", "TEXT":
+		fm = TEXT
+	case "JSON":
+		fm = JSON
+	default:
+		return errors.New("summary must be one of text|json")
+	}
+
+	f := GetFlags()
+	f.Summary = fm
+	return nil
+}
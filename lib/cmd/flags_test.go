@@ -66,7 +66,12 @@ func TestSetEncoding(t *testing.T) {
 		t.Errorf("encoding = %s, expect to set %s for %s", flags.Encoding, SJIS, "sjis")
 	}
 
-	expectErr := "encoding must be one of utf8|sjis"
+	SetEncoding("auto")
+	if flags.Encoding != AUTO {
+		t.Errorf("encoding = %s, expect to set %s for %s", flags.Encoding, AUTO, "auto")
+	}
+
+	expectErr := "encoding must be one of auto|utf8|utf16le|utf16be|sjis|latin1"
 	err := SetEncoding("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -75,6 +80,16 @@ func TestSetEncoding(t *testing.T) {
 	}
 }
 
+func TestSetEncodingFallback(t *testing.T) {
+	flags := GetFlags()
+
+	SetEncodingFallback(false)
+	if flags.EncodingFallback {
+		t.Errorf("encoding-fallback = %t, expect to set %t", flags.EncodingFallback, false)
+	}
+	SetEncodingFallback(true)
+}
+
 func TestSetLineBreak(t *testing.T) {
 	flags := GetFlags()
 
@@ -246,15 +261,273 @@ func TestSetWithoutNull(t *testing.T) {
 	}
 }
 
+func TestSetTrimTrailingDelimiter(t *testing.T) {
+	flags := GetFlags()
+
+	SetTrimTrailingDelimiter(true)
+	if !flags.TrimTrailingDelimiter {
+		t.Errorf("trim-trailing-delimiter = %t, expect to set %t", flags.TrimTrailingDelimiter, true)
+	}
+}
+
+func TestSetSkipLines(t *testing.T) {
+	flags := GetFlags()
+
+	SetSkipLines(3)
+	if flags.SkipLines != 3 {
+		t.Errorf("skip-lines = %d, expect to set %d", flags.SkipLines, 3)
+	}
+
+	SetSkipLines(-1)
+	if flags.SkipLines != 0 {
+		t.Errorf("skip-lines = %d, expect to set %d", flags.SkipLines, 0)
+	}
+}
+
+func TestSetHead(t *testing.T) {
+	flags := GetFlags()
+
+	SetHead(100)
+	if flags.Head != 100 {
+		t.Errorf("head = %d, expect to set %d", flags.Head, 100)
+	}
+
+	SetHead(-1)
+	if flags.Head != 0 {
+		t.Errorf("head = %d, expect to set %d", flags.Head, 0)
+	}
+}
+
+func TestSetCommentPrefix(t *testing.T) {
+	flags := GetFlags()
+
+	SetCommentPrefix("#")
+	if flags.CommentPrefix != "#" {
+		t.Errorf("comment = %q, expect to set %q", flags.CommentPrefix, "#")
+	}
+}
+
+func TestSetTsvStyle(t *testing.T) {
+	flags := GetFlags()
+
+	SetTsvStyle("escape")
+	if flags.TsvStyle != ESCAPE {
+		t.Errorf("tsv-style = %s, expect to set %s for %s", flags.TsvStyle, ESCAPE, "escape")
+	}
+
+	SetTsvStyle("")
+	if flags.TsvStyle != QUOTE {
+		t.Errorf("tsv-style = %s, expect to set %s for empty string", flags.TsvStyle, QUOTE)
+	}
+
+	expectErr := "tsv-style must be one of quote|escape"
+	err := SetTsvStyle("error")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "error")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "error")
+	}
+}
+
+func TestSetPreserveQuoting(t *testing.T) {
+	flags := GetFlags()
+
+	SetPreserveQuoting(true)
+	if !flags.PreserveQuoting {
+		t.Errorf("preserve-quoting = %t, expect to set %t", flags.PreserveQuoting, true)
+	}
+}
+
+func TestSetPreserveLineBreak(t *testing.T) {
+	flags := GetFlags()
+
+	SetPreserveLineBreak(false)
+	if flags.PreserveLineBreak {
+		t.Errorf("preserve-linebreak = %t, expect to set %t", flags.PreserveLineBreak, false)
+	}
+	SetPreserveLineBreak(true)
+}
+
+func TestSetLocale(t *testing.T) {
+	flags := GetFlags()
+
+	SetLocale("")
+	if flags.Locale != EN {
+		t.Errorf("locale = %s, expect to set %s for %q", flags.Locale, EN, "")
+	}
+
+	SetLocale("JA")
+	if flags.Locale != JA {
+		t.Errorf("locale = %s, expect to set %s for %s", flags.Locale, JA, "JA")
+	}
+
+	expectErr := "locale must be one of en|ja|de|fr|es"
+	err := SetLocale("error")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "error")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "error")
+	}
+
+	flags.Locale = EN
+}
+
+func TestSetBooleanLiterals(t *testing.T) {
+	flags := GetFlags()
+
+	SetBooleanLiterals("yes:no, On:Off")
+	if flags.BooleanLiterals != "yes:no, On:Off" {
+		t.Errorf("boolean-literals = %q, expect to set %q", flags.BooleanLiterals, "yes:no, On:Off")
+	}
+	if b, ok := ParseBooleanLiteral("YES"); !ok || !b {
+		t.Errorf("boolean literal = %t, %t, want %t, %t for %q", b, ok, true, true, "YES")
+	}
+	if b, ok := ParseBooleanLiteral("off"); !ok || b {
+		t.Errorf("boolean literal = %t, %t, want %t, %t for %q", b, ok, false, true, "off")
+	}
+	if _, ok := ParseBooleanLiteral("maybe"); ok {
+		t.Errorf("boolean literal is recognized, want not to be recognized for %q", "maybe")
+	}
+
+	expectErr := "boolean-literals must be pairs of a true literal and a false literal separated by a colon"
+	err := SetBooleanLiterals("yes,no")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "yes,no")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "yes,no")
+	}
+
+	SetBooleanLiterals("")
+	if _, ok := ParseBooleanLiteral("yes"); ok {
+		t.Errorf("boolean literal is recognized, want not to be recognized for %q", "yes")
+	}
+}
+
+func TestSetInferTypes(t *testing.T) {
+	flags := GetFlags()
+
+	SetInferTypes("per-column")
+	if flags.InferTypes != INFER_PER_COLUMN {
+		t.Errorf("infer-types = %s, expect to set %s for %s", flags.InferTypes, INFER_PER_COLUMN, "per-column")
+	}
+
+	SetInferTypes("")
+	if flags.InferTypes != INFER_OFF {
+		t.Errorf("infer-types = %s, expect to set %s for empty string", flags.InferTypes, INFER_OFF)
+	}
+
+	expectErr := "infer-types must be one of off|per-cell|per-column"
+	err := SetInferTypes("error")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "error")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "error")
+	}
+}
+
+func TestSetSchemaNullOnError(t *testing.T) {
+	flags := GetFlags()
+
+	SetSchemaNullOnError(true)
+	if !flags.SchemaNullOnError {
+		t.Errorf("schema-null-on-error = %t, expect to set %t", flags.SchemaNullOnError, true)
+	}
+}
+
+func TestSetMaxErrors(t *testing.T) {
+	flags := GetFlags()
+
+	SetMaxErrors(3)
+	if flags.MaxErrors != 3 {
+		t.Errorf("max-errors = %d, expect to set %d", flags.MaxErrors, 3)
+	}
+
+	SetMaxErrors(-1)
+	if flags.MaxErrors != 0 {
+		t.Errorf("max-errors = %d, expect to set %d", flags.MaxErrors, 0)
+	}
+}
+
+func TestSetMaxIterations(t *testing.T) {
+	flags := GetFlags()
+
+	SetMaxIterations(100)
+	if flags.MaxIterations != 100 {
+		t.Errorf("max-iterations = %d, expect to set %d", flags.MaxIterations, 100)
+	}
+
+	SetMaxIterations(-1)
+	if flags.MaxIterations != 0 {
+		t.Errorf("max-iterations = %d, expect to set %d", flags.MaxIterations, 0)
+	}
+}
+
+func TestSetApproxPrecision(t *testing.T) {
+	flags := GetFlags()
+
+	SetApproxPrecision(10)
+	if flags.ApproxPrecision != 10 {
+		t.Errorf("approx-precision = %d, expect to set %d", flags.ApproxPrecision, 10)
+	}
+
+	SetApproxPrecision(1)
+	if flags.ApproxPrecision != MIN_APPROX_PRECISION {
+		t.Errorf("approx-precision = %d, expect to set %d", flags.ApproxPrecision, MIN_APPROX_PRECISION)
+	}
+
+	SetApproxPrecision(30)
+	if flags.ApproxPrecision != MAX_APPROX_PRECISION {
+		t.Errorf("approx-precision = %d, expect to set %d", flags.ApproxPrecision, MAX_APPROX_PRECISION)
+	}
+
+	SetApproxPrecision(DEFAULT_APPROX_PRECISION)
+}
+
+func TestSetMaxResultRows(t *testing.T) {
+	flags := GetFlags()
+
+	SetMaxResultRows(1000)
+	if flags.MaxResultRows != 1000 {
+		t.Errorf("max-result-rows = %d, expect to set %d", flags.MaxResultRows, 1000)
+	}
+
+	SetMaxResultRows(-1)
+	if flags.MaxResultRows != 0 {
+		t.Errorf("max-result-rows = %d, expect to set %d", flags.MaxResultRows, 0)
+	}
+}
+
+func TestSetStrictUnionTypes(t *testing.T) {
+	flags := GetFlags()
+
+	SetStrictUnionTypes(true)
+	if !flags.StrictUnionTypes {
+		t.Errorf("strict-union-types = %t, expect to set %t", flags.StrictUnionTypes, true)
+	}
+
+	SetStrictUnionTypes(false)
+}
+
+func TestSetStrictSelectInto(t *testing.T) {
+	flags := GetFlags()
+
+	SetStrictSelectInto(true)
+	if !flags.StrictSelectInto {
+		t.Errorf("strict-select-into = %t, expect to set %t", flags.StrictSelectInto, true)
+	}
+
+	SetStrictSelectInto(false)
+}
+
 func TestSetWriteEncoding(t *testing.T) {
 	flags := GetFlags()
 
 	SetWriteEncoding("sjis")
-	if flags.Encoding != SJIS {
+	if flags.WriteEncoding != SJIS {
 		t.Errorf("encoding = %s, expect to set %s for %s", flags.WriteEncoding, SJIS, "sjis")
 	}
 
-	expectErr := "encoding must be one of utf8|sjis"
+	expectErr := "encoding must be one of utf8|utf16le|utf16be|sjis|latin1"
 	err := SetWriteEncoding("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -380,6 +653,65 @@ func TestSetWithoutHeader(t *testing.T) {
 	}
 }
 
+func TestSetNumberNotation(t *testing.T) {
+	flags := GetFlags()
+
+	SetNumberNotation("scientific")
+	if flags.NumberNotation != SCIENTIFIC {
+		t.Errorf("number-notation = %s, expect to set %s for %s", flags.NumberNotation, SCIENTIFIC, "scientific")
+	}
+
+	SetNumberNotation("auto")
+	if flags.NumberNotation != AUTO_NOTATION {
+		t.Errorf("number-notation = %s, expect to set %s for %s", flags.NumberNotation, AUTO_NOTATION, "auto")
+	}
+
+	SetNumberNotation("")
+	if flags.NumberNotation != FIXED {
+		t.Errorf("number-notation = %s, expect to set %s for empty string", flags.NumberNotation, FIXED)
+	}
+
+	expectErr := "number-notation must be one of fixed|scientific|auto"
+	err := SetNumberNotation("error")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "error")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "error")
+	}
+}
+
+func TestSetEmptyResult(t *testing.T) {
+	flags := GetFlags()
+
+	SetEmptyResult("header")
+	if flags.EmptyResult != EMPTY_HEADER {
+		t.Errorf("empty-result = %s, expect to set %s for %s", flags.EmptyResult, EMPTY_HEADER, "header")
+	}
+
+	SetEmptyResult("none")
+	if flags.EmptyResult != EMPTY_NONE {
+		t.Errorf("empty-result = %s, expect to set %s for %s", flags.EmptyResult, EMPTY_NONE, "none")
+	}
+
+	SetEmptyResult("message")
+	if flags.EmptyResult != EMPTY_MESSAGE {
+		t.Errorf("empty-result = %s, expect to set %s for %s", flags.EmptyResult, EMPTY_MESSAGE, "message")
+	}
+
+	SetEmptyResult("")
+	if flags.EmptyResult != EMPTY_AUTO {
+		t.Errorf("empty-result = %s, expect to set %s for empty string", flags.EmptyResult, EMPTY_AUTO)
+	}
+
+	expectErr := "empty-result must be one of auto|header|none|message"
+	err := SetEmptyResult("error")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "error")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "error")
+	}
+}
+
 func TestSetQuiet(t *testing.T) {
 	flags := GetFlags()
 
@@ -407,6 +739,30 @@ func TestSetCPU(t *testing.T) {
 	}
 }
 
+func TestSetSeed(t *testing.T) {
+	flags := GetFlags()
+	defer func() {
+		flags.Seed = 0
+	}()
+
+	SetSeed(0)
+	if flags.Seed != 0 {
+		t.Errorf("seed = %d, expect to set %d", flags.Seed, 0)
+	}
+
+	SetSeed(42)
+	if flags.Seed != 42 {
+		t.Errorf("seed = %d, expect to set %d", flags.Seed, 42)
+	}
+	f1 := GetRand().Float64()
+
+	SetSeed(42)
+	f2 := GetRand().Float64()
+	if f1 != f2 {
+		t.Errorf("random numbers with the same seed = %f and %f, expect to be the same", f1, f2)
+	}
+}
+
 func TestSetStats(t *testing.T) {
 	flags := GetFlags()
 
@@ -416,6 +772,59 @@ func TestSetStats(t *testing.T) {
 	}
 }
 
+func TestSetProfile(t *testing.T) {
+	flags := GetFlags()
+
+	SetProfile(true)
+	if !flags.Profile {
+		t.Errorf("profile = %t, expect to set %t", flags.Profile, true)
+	}
+}
+
+func TestSetErrorFormat(t *testing.T) {
+	flags := GetFlags()
+
+	SetErrorFormat("json")
+	if flags.ErrorFormat != JSON {
+		t.Errorf("error format = %s, expect to set %s for %s", flags.ErrorFormat, JSON, "json")
+	}
+
+	SetErrorFormat("")
+	if flags.ErrorFormat != TEXT {
+		t.Errorf("error format = %s, expect to set %s for empty string", flags.ErrorFormat, TEXT)
+	}
+
+	expectErr := "error-format must be one of text|json"
+	err := SetErrorFormat("csv")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "csv")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "csv")
+	}
+}
+
+func TestSetSummary(t *testing.T) {
+	flags := GetFlags()
+
+	SetSummary("json")
+	if flags.Summary != JSON {
+		t.Errorf("summary = %s, expect to set %s for %s", flags.Summary, JSON, "json")
+	}
+
+	SetSummary("")
+	if flags.Summary != TEXT {
+		t.Errorf("summary = %s, expect to set %s for empty string", flags.Summary, TEXT)
+	}
+
+	expectErr := "summary must be one of text|json"
+	err := SetSummary("csv")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "csv")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "csv")
+	}
+}
+
 func TestParseEncoding(t *testing.T) {
 	e, err := ParseEncoding("")
 	if err != nil {
@@ -441,7 +850,31 @@ func TestParseEncoding(t *testing.T) {
 		t.Errorf("encoding = %s, expect to set %s for %s", e, SJIS, "sjis")
 	}
 
-	expectErr := "encoding must be one of utf8|sjis"
+	e, err = ParseEncoding("utf16le")
+	if err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if e != UTF16LE {
+		t.Errorf("encoding = %s, expect to set %s for %s", e, UTF16LE, "utf16le")
+	}
+
+	e, err = ParseEncoding("utf16be")
+	if err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if e != UTF16BE {
+		t.Errorf("encoding = %s, expect to set %s for %s", e, UTF16BE, "utf16be")
+	}
+
+	e, err = ParseEncoding("latin1")
+	if err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if e != LATIN1 {
+		t.Errorf("encoding = %s, expect to set %s for %s", e, LATIN1, "latin1")
+	}
+
+	expectErr := "encoding must be one of utf8|utf16le|utf16be|sjis|latin1"
 	_, err = ParseEncoding("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -449,3 +882,88 @@ func TestParseEncoding(t *testing.T) {
 		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "error")
 	}
 }
+
+func TestParseLineBreak(t *testing.T) {
+	lb, err := ParseLineBreak("crlf")
+	if err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if lb != CRLF {
+		t.Errorf("line-break = %s, expect to set %s for %s", lb, CRLF, "crlf")
+	}
+
+	expectErr := "line-break must be one of crlf|lf|cr"
+	_, err = ParseLineBreak("error")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "error")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "error")
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	fm, err := ParseFormat("json")
+	if err != nil {
+		t.Errorf("unexpected error: %q", err.Error())
+	}
+	if fm != JSON {
+		t.Errorf("format = %d, expect to set %d for %s", fm, JSON, "json")
+	}
+
+	expectErr := "format must be one of csv|tsv|json|text"
+	_, err = ParseFormat("error")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "error")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "error")
+	}
+}
+
+func TestSetWarnings(t *testing.T) {
+	flags := GetFlags()
+
+	SetWarnings(true)
+	if !flags.Warnings {
+		t.Errorf("warnings = %t, expect to set %t", flags.Warnings, true)
+	}
+	SetWarnings(false)
+}
+
+func TestSetReadOnly(t *testing.T) {
+	flags := GetFlags()
+
+	SetReadOnly(true)
+	if !flags.ReadOnly {
+		t.Errorf("read-only = %t, expect to set %t", flags.ReadOnly, true)
+	}
+	SetReadOnly(false)
+}
+
+func TestSetBackup(t *testing.T) {
+	flags := GetFlags()
+
+	SetBackup(true)
+	if !flags.Backup {
+		t.Errorf("backup = %t, expect to set %t", flags.Backup, true)
+	}
+	SetBackup(false)
+}
+
+func TestSetBackupSuffix(t *testing.T) {
+	flags := GetFlags()
+
+	SetBackupSuffix(".orig")
+	if flags.BackupSuffix != ".orig" {
+		t.Errorf("backup-suffix = %q, expect to set %q", flags.BackupSuffix, ".orig")
+	}
+
+	expectErr := "backup-suffix must not be empty"
+	err := SetBackupSuffix("")
+	if err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q", err.Error(), expectErr)
+	}
+
+	SetBackupSuffix(".bak")
+}
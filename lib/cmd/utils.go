@@ -6,18 +6,55 @@ import (
 	"io"
 	"os"
 	"strings"
+	"unicode/utf8"
 
+	"golang.org/x/text/encoding/charmap"
 	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/unicode"
 	"golang.org/x/text/transform"
 )
 
 func GetReader(r io.Reader, enc Encoding) io.Reader {
-	if enc == SJIS {
+	switch enc {
+	case UTF16LE:
+		return transform.NewReader(r, unicode.UTF16(unicode.LittleEndian, unicode.UseBOM).NewDecoder())
+	case UTF16BE:
+		return transform.NewReader(r, unicode.UTF16(unicode.BigEndian, unicode.UseBOM).NewDecoder())
+	case SJIS:
 		return transform.NewReader(r, japanese.ShiftJIS.NewDecoder())
+	case LATIN1:
+		return transform.NewReader(r, charmap.ISO8859_1.NewDecoder())
 	}
 	return bufio.NewReader(r)
 }
 
+func GetWriter(w io.Writer, enc Encoding) io.Writer {
+	switch enc {
+	case UTF16LE:
+		return transform.NewWriter(w, unicode.UTF16(unicode.LittleEndian, unicode.UseBOM).NewEncoder())
+	case UTF16BE:
+		return transform.NewWriter(w, unicode.UTF16(unicode.BigEndian, unicode.UseBOM).NewEncoder())
+	case SJIS:
+		return transform.NewWriter(w, japanese.ShiftJIS.NewEncoder())
+	case LATIN1:
+		return transform.NewWriter(w, charmap.ISO8859_1.NewEncoder())
+	}
+	return w
+}
+
+func DetectEncoding(b []byte) Encoding {
+	if bytes.HasPrefix(b, []byte{0xff, 0xfe}) {
+		return UTF16LE
+	}
+	if bytes.HasPrefix(b, []byte{0xfe, 0xff}) {
+		return UTF16BE
+	}
+	if utf8.Valid(b) {
+		return UTF8
+	}
+	return LATIN1
+}
+
 func EscapeString(s string) string {
 	runes := []rune(s)
 	var buf bytes.Buffer
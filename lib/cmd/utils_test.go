@@ -19,6 +19,75 @@ func TestGetReader(t *testing.T) {
 	if reflect.TypeOf(r).String() != "*transform.Reader" {
 		t.Errorf("reader = %q, want %q", reflect.TypeOf(r).String(), "*transform.Reader")
 	}
+
+	r = GetReader(fp, UTF16LE)
+	if reflect.TypeOf(r).String() != "*transform.Reader" {
+		t.Errorf("reader = %q, want %q", reflect.TypeOf(r).String(), "*transform.Reader")
+	}
+
+	r = GetReader(fp, LATIN1)
+	if reflect.TypeOf(r).String() != "*transform.Reader" {
+		t.Errorf("reader = %q, want %q", reflect.TypeOf(r).String(), "*transform.Reader")
+	}
+}
+
+func TestGetWriter(t *testing.T) {
+	fp := os.Stdout
+
+	w := GetWriter(fp, UTF8)
+	if reflect.TypeOf(w).String() != "*os.File" {
+		t.Errorf("writer = %q, want %q", reflect.TypeOf(w).String(), "*os.File")
+	}
+
+	w = GetWriter(fp, SJIS)
+	if reflect.TypeOf(w).String() != "*transform.Writer" {
+		t.Errorf("writer = %q, want %q", reflect.TypeOf(w).String(), "*transform.Writer")
+	}
+
+	w = GetWriter(fp, UTF16BE)
+	if reflect.TypeOf(w).String() != "*transform.Writer" {
+		t.Errorf("writer = %q, want %q", reflect.TypeOf(w).String(), "*transform.Writer")
+	}
+
+	w = GetWriter(fp, LATIN1)
+	if reflect.TypeOf(w).String() != "*transform.Writer" {
+		t.Errorf("writer = %q, want %q", reflect.TypeOf(w).String(), "*transform.Writer")
+	}
+}
+
+var detectEncodingTests = []struct {
+	Input  []byte
+	Expect Encoding
+}{
+	{
+		Input:  []byte("abc,def"),
+		Expect: UTF8,
+	},
+	{
+		Input:  []byte("caf\xc3\xa9,\xe6\x97\xa5\xe6\x9c\xac"),
+		Expect: UTF8,
+	},
+	{
+		Input:  []byte("caf\xe9,abc"),
+		Expect: LATIN1,
+	},
+	{
+		Input:  []byte("\xff\xfea\x00,\x00b\x00"),
+		Expect: UTF16LE,
+	},
+	{
+		Input:  []byte("\xfe\xff\x00a\x00,\x00b"),
+		Expect: UTF16BE,
+	},
+}
+
+func TestDetectEncoding(t *testing.T) {
+	for _, v := range detectEncodingTests {
+		result := DetectEncoding(v.Input)
+		if result != v.Expect {
+			t.Errorf("encoding = %s, want %s for %q", result, v.Expect, v.Input)
+		}
+	}
 }
 
 func TestEscapeString(t *testing.T) {
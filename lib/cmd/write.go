@@ -2,7 +2,9 @@ package cmd
 
 import (
 	"bufio"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	"github.com/mithrandie/csvq/lib/file"
 )
@@ -16,6 +18,18 @@ func ToStdout(s string) error {
 	return CreateFile("", s)
 }
 
+func ToStderr(s string) error {
+	if Terminal != nil {
+		return Terminal.Write(s)
+	}
+
+	w := bufio.NewWriter(os.Stderr)
+	if _, err := w.WriteString(s); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
 func CreateFile(filename string, s string) error {
 	var fp *os.File
 	var err error
@@ -64,3 +78,19 @@ func TryCreateFile(filename string) error {
 
 	return nil
 }
+
+// MoveAside renames the file to a temporary name in the same directory, and returns the new path.
+func MoveAside(filename string) (string, error) {
+	fp, err := ioutil.TempFile(filepath.Dir(filename), "."+filepath.Base(filename)+".")
+	if err != nil {
+		return "", err
+	}
+	tmp := fp.Name()
+	fp.Close()
+
+	if err = os.Rename(filename, tmp); err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+	return tmp, nil
+}
@@ -142,3 +142,30 @@ func TestTryCreateFile(t *testing.T) {
 		}
 	}
 }
+
+func TestMoveAside(t *testing.T) {
+	fpath := GetTestFilePath("move_aside.txt")
+	if err := ioutil.WriteFile(fpath, []byte("content"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tmp, err := MoveAside(fpath)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer os.Remove(tmp)
+
+	if filepath.Dir(tmp) != filepath.Dir(fpath) {
+		t.Errorf("directory = %q, want %q", filepath.Dir(tmp), filepath.Dir(fpath))
+	}
+	if _, err := os.Stat(fpath); err == nil {
+		t.Errorf("file %q is not moved", fpath)
+	}
+	if b, _ := ioutil.ReadFile(tmp); string(b) != "content" {
+		t.Errorf("content = %q, want %q", string(b), "content")
+	}
+
+	if _, err := MoveAside(GetTestFilePath("notexist.txt")); err == nil {
+		t.Errorf("no error, want error for a file that does not exist")
+	}
+}
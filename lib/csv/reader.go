@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"unicode/utf8"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
@@ -28,8 +29,12 @@ func (f Field) ToPrimary() value.Primary {
 }
 
 type Reader struct {
-	Delimiter   rune
-	WithoutNull bool
+	Delimiter        rune
+	WithoutNull      bool
+	EncodingFallback bool
+	SkipLines        int
+	CommentPrefix    string
+	BackslashEscape  bool
 
 	reader *bufio.Reader
 	line   int
@@ -41,7 +46,12 @@ type Reader struct {
 
 	FieldsPerRecord int
 
-	LineBreak cmd.LineBreak
+	LineBreak         cmd.LineBreak
+	InvalidSequences  int
+	TrailingDelimiter bool
+	SkippedLines      int
+
+	recordCount int
 }
 
 func NewReader(r io.Reader) *Reader {
@@ -80,6 +90,13 @@ func (r *Reader) Read() ([]Field, error) {
 	return record, nil
 }
 
+// FieldsQuoted returns whether each field of the last read record was enclosed in quotes.
+func (r *Reader) FieldsQuoted() []bool {
+	quoted := make([]bool, len(r.fieldQuoted))
+	copy(quoted, r.fieldQuoted)
+	return quoted
+}
+
 func (r *Reader) ReadAll() ([][]Field, error) {
 	records := [][]Field{}
 
@@ -102,9 +119,22 @@ func (r *Reader) parseRecord(withoutNull bool) ([]Field, error) {
 	r.fieldStartPos = r.fieldStartPos[:0]
 	r.fieldQuoted = r.fieldQuoted[:0]
 
+	for r.line <= r.SkipLines {
+		if err := r.skipLine(); err != nil {
+			return nil, err
+		}
+	}
+
 	fieldIndex := 0
 	fieldPosition := 0
 	for {
+		if fieldIndex < 1 && r.recordBuf.Len() < 1 && r.isCommentLine() {
+			if err := r.skipLine(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		if 0 < r.FieldsPerRecord && r.FieldsPerRecord <= fieldIndex {
 			return nil, r.newError("wrong number of fields in line")
 		}
@@ -142,6 +172,15 @@ func (r *Reader) parseRecord(withoutNull bool) ([]Field, error) {
 		return nil, r.newError("wrong number of fields in line")
 	}
 
+	lastIdx := len(r.fieldStartPos) - 1
+	trailingDelimiter := 0 < lastIdx && r.fieldStartPos[lastIdx] == r.recordBuf.Len() && !r.fieldQuoted[lastIdx]
+	if r.recordCount < 1 {
+		r.TrailingDelimiter = trailingDelimiter
+	} else {
+		r.TrailingDelimiter = r.TrailingDelimiter && trailingDelimiter
+	}
+	r.recordCount++
+
 	record := make([]Field, 0, r.FieldsPerRecord)
 	recordStr := make([]byte, r.recordBuf.Len())
 	copy(recordStr, r.recordBuf.Bytes())
@@ -177,7 +216,7 @@ Read:
 	for {
 		lineBreak = ""
 
-		r1, _, err := r.reader.ReadRune()
+		r1, size, err := r.reader.ReadRune()
 		r.column++
 
 		if err != nil {
@@ -190,6 +229,13 @@ Read:
 			return quoted, eol, err
 		}
 
+		if r1 == utf8.RuneError && size == 1 {
+			if !r.EncodingFallback {
+				return quoted, eol, r.newError("invalid byte sequence")
+			}
+			r.InvalidSequences++
+		}
+
 		switch r1 {
 		case '\r':
 			r2, _, _ := r.reader.ReadRune()
@@ -240,6 +286,37 @@ Read:
 			continue
 		}
 
+		if r.BackslashEscape {
+			switch r1 {
+			case '\\':
+				r2, _, err := r.reader.ReadRune()
+				if err != nil {
+					r.recordBuf.WriteRune(r1)
+					continue
+				}
+				r.column++
+
+				switch r2 {
+				case 't':
+					r.recordBuf.WriteRune('\t')
+				case 'n':
+					r.recordBuf.WriteRune('\n')
+				case 'r':
+					r.recordBuf.WriteRune('\r')
+				case '\\':
+					r.recordBuf.WriteRune('\\')
+				default:
+					r.reader.UnreadRune()
+					r.column--
+					r.recordBuf.WriteRune(r1)
+				}
+				continue
+			case '"':
+				r.recordBuf.WriteRune(r1)
+				continue
+			}
+		}
+
 		switch r1 {
 		case '\n':
 			if r.LineBreak == "" {
@@ -262,3 +339,36 @@ Read:
 
 	return quoted, eol, eof
 }
+
+func (r *Reader) isCommentLine() bool {
+	if len(r.CommentPrefix) < 1 {
+		return false
+	}
+	b, _ := r.reader.Peek(len(r.CommentPrefix))
+	return string(b) == r.CommentPrefix
+}
+
+func (r *Reader) skipLine() error {
+	for i := 0; ; i++ {
+		r1, _, err := r.reader.ReadRune()
+		if err != nil {
+			if 0 < i {
+				r.SkippedLines++
+			}
+			return err
+		}
+
+		switch r1 {
+		case '\r':
+			if r2, _, _ := r.reader.ReadRune(); r2 != '\n' {
+				r.reader.UnreadRune()
+			}
+			fallthrough
+		case '\n':
+			r.line++
+			r.column = 0
+			r.SkippedLines++
+			return nil
+		}
+	}
+}
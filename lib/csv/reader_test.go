@@ -24,13 +24,31 @@ func TestField_ToPrimary(t *testing.T) {
 }
 
 var readAllTests = []struct {
-	Name      string
-	Delimiter rune
-	Input     string
-	Output    [][]Field
-	LineBreak cmd.LineBreak
-	Error     string
+	Name              string
+	Delimiter         rune
+	EncodingFallback  bool
+	SkipLines         int
+	CommentPrefix     string
+	BackslashEscape   bool
+	Input             string
+	Output            [][]Field
+	LineBreak         cmd.LineBreak
+	InvalidSequences  int
+	TrailingDelimiter bool
+	SkippedLines      int
+	Error             string
 }{
+	{
+		Name:            "Backslash Escape",
+		Delimiter:       '\t',
+		BackslashEscape: true,
+		Input:           "a\tb\n\"c\\t1\\n2\"\td\\\\e\\x\n",
+		Output: [][]Field{
+			{NewField("a"), NewField("b")},
+			{NewField("\"c\t1\n2\""), NewField("d\\e\\x")},
+		},
+		LineBreak: cmd.LF,
+	},
 	{
 		Name:  "NewLineLF",
 		Input: "a,b,c\nd,e,f",
@@ -121,6 +139,63 @@ var readAllTests = []struct {
 		},
 		LineBreak: cmd.LF,
 	},
+	{
+		Name:  "Line Breaks In Quoted Fields",
+		Input: "a,\"b\nc\",d\r\n\"e\r\n\r\n\",f,\"g\rh\"\r\ni,j,k",
+		Output: [][]Field{
+			{NewField("a"), NewField("b\nc"), NewField("d")},
+			{NewField("e\r\n\r\n"), NewField("f"), NewField("g\rh")},
+			{NewField("i"), NewField("j"), NewField("k")},
+		},
+		LineBreak: cmd.CRLF,
+	},
+	{
+		Name:      "SkipLines",
+		SkipLines: 3,
+		Input:     "preamble\r\n\"x,\ny\"\na,b,c\nd,e,f",
+		Output: [][]Field{
+			{NewField("a"), NewField("b"), NewField("c")},
+			{NewField("d"), NewField("e"), NewField("f")},
+		},
+		LineBreak:    cmd.LF,
+		SkippedLines: 3,
+	},
+	{
+		Name:         "SkipLines Exceeding Number of Lines",
+		SkipLines:    3,
+		Input:        "a,b,c\nd,e,f",
+		Output:       [][]Field{},
+		SkippedLines: 2,
+	},
+	{
+		Name:          "CommentPrefix",
+		CommentPrefix: "#",
+		Input:         "# comment,\"\na,b,c\n#d,e,f\ng,\"h\n#i\",j\n#",
+		Output: [][]Field{
+			{NewField("a"), NewField("b"), NewField("c")},
+			{NewField("g"), NewField("h\n#i"), NewField("j")},
+		},
+		LineBreak:    cmd.LF,
+		SkippedLines: 3,
+	},
+	{
+		Name:          "SkipLines and CommentPrefix",
+		SkipLines:     1,
+		CommentPrefix: "//",
+		Input:         "preamble\n// comment\na,b,c\n/d,e,f",
+		Output: [][]Field{
+			{NewField("a"), NewField("b"), NewField("c")},
+			{NewField("/d"), NewField("e"), NewField("f")},
+		},
+		LineBreak:    cmd.LF,
+		SkippedLines: 2,
+	},
+	{
+		Name:          "CommentPrefix Error Line Number",
+		CommentPrefix: "#",
+		Input:         "#comment\na,b,c\nd,e\nf,g,h",
+		Error:         "line 3, column 0: wrong number of fields in line",
+	},
 	{
 		Name:  "ExtraneousQuote",
 		Input: "a,\"b\",\"ccc\ncc\nd,e,",
@@ -141,6 +216,50 @@ var readAllTests = []struct {
 		Input: "a,b,c\nd,e,f,g\nh,i,j",
 		Error: "line 2, column 6: wrong number of fields in line",
 	},
+	{
+		Name:  "TrailingDelimiter",
+		Input: "a,b,\nd,e,\n",
+		Output: [][]Field{
+			{NewField("a"), NewField("b"), nil},
+			{NewField("d"), NewField("e"), nil},
+		},
+		LineBreak:         cmd.LF,
+		TrailingDelimiter: true,
+	},
+	{
+		Name:  "TrailingDelimiter Not In All Records",
+		Input: "a,b,\nd,e,f\n",
+		Output: [][]Field{
+			{NewField("a"), NewField("b"), nil},
+			{NewField("d"), NewField("e"), NewField("f")},
+		},
+		LineBreak: cmd.LF,
+	},
+	{
+		Name:  "TrailingDelimiter Quoted Empty Field",
+		Input: "a,b,\nd,e,\"\"\n",
+		Output: [][]Field{
+			{NewField("a"), NewField("b"), nil},
+			{NewField("d"), NewField("e"), NewField("")},
+		},
+		LineBreak: cmd.LF,
+	},
+	{
+		Name:  "InvalidByteSequence",
+		Input: "a,b,c\nd,\xffe,f",
+		Error: "line 2, column 3: invalid byte sequence",
+	},
+	{
+		Name:             "InvalidByteSequence With Encoding Fallback",
+		EncodingFallback: true,
+		Input:            "a,b,c\nd,\xffe,\"f\xfe\"",
+		Output: [][]Field{
+			{NewField("a"), NewField("b"), NewField("c")},
+			{NewField("d"), NewField("\ufffde"), NewField("f\ufffd")},
+		},
+		LineBreak:        cmd.LF,
+		InvalidSequences: 2,
+	},
 }
 
 func TestReader_ReadAll(t *testing.T) {
@@ -150,6 +269,10 @@ func TestReader_ReadAll(t *testing.T) {
 		if v.Delimiter != 0 {
 			r.Delimiter = v.Delimiter
 		}
+		r.EncodingFallback = v.EncodingFallback
+		r.SkipLines = v.SkipLines
+		r.CommentPrefix = v.CommentPrefix
+		r.BackslashEscape = v.BackslashEscape
 
 		records, err := r.ReadAll()
 
@@ -169,6 +292,18 @@ func TestReader_ReadAll(t *testing.T) {
 		if r.LineBreak != v.LineBreak {
 			t.Errorf("%s: line break = %q, want %q", v.Name, r.LineBreak, v.LineBreak)
 		}
+
+		if r.InvalidSequences != v.InvalidSequences {
+			t.Errorf("%s: invalid sequences = %d, want %d", v.Name, r.InvalidSequences, v.InvalidSequences)
+		}
+
+		if r.TrailingDelimiter != v.TrailingDelimiter {
+			t.Errorf("%s: trailing delimiter = %t, want %t", v.Name, r.TrailingDelimiter, v.TrailingDelimiter)
+		}
+
+		if r.SkippedLines != v.SkippedLines {
+			t.Errorf("%s: skipped lines = %d, want %d", v.Name, r.SkippedLines, v.SkippedLines)
+		}
 	}
 }
 
@@ -209,6 +344,29 @@ func TestReader_ReadHeader(t *testing.T) {
 	}
 }
 
+func TestReader_FieldsQuoted(t *testing.T) {
+	input := "h1,\"h2\",h3\n\"a\",b,\"\"\n"
+	expect := [][]bool{
+		{false, true, false},
+		{true, false, true},
+	}
+
+	r := NewReader(strings.NewReader(input))
+	if _, err := r.ReadHeader(); err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+	if quoted := r.FieldsQuoted(); !reflect.DeepEqual(quoted, expect[0]) {
+		t.Errorf("header fields quoted = %v, want %v", quoted, expect[0])
+	}
+
+	if _, err := r.Read(); err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+	if quoted := r.FieldsQuoted(); !reflect.DeepEqual(quoted, expect[1]) {
+		t.Errorf("fields quoted = %v, want %v", quoted, expect[1])
+	}
+}
+
 var readerReadAllBenchmarkText = strings.Repeat("aaaaaa,\"bbbbbb\",cccccc\n", 10000)
 
 func BenchmarkReader_ReadAll(b *testing.B) {
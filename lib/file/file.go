@@ -2,6 +2,7 @@ package file
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -62,6 +63,42 @@ func Create(path string) (*os.File, error) {
 	return fp, nil
 }
 
+// Backup copies the content of the file to the path.
+// If overwrite is false and a file already exists at the path, an error is returned.
+func Backup(fp *os.File, path string, overwrite bool) error {
+	info, err := fp.Stat()
+	if err != nil {
+		return NewIOError(err.Error())
+	}
+
+	flag := os.O_CREATE | os.O_WRONLY
+	if overwrite {
+		flag = flag | os.O_TRUNC
+	} else {
+		flag = flag | os.O_EXCL
+	}
+
+	bfp, err := os.OpenFile(path, flag, info.Mode())
+	if err != nil {
+		if os.IsExist(err) {
+			return NewIOError(fmt.Sprintf("backup file %s already exists", path))
+		}
+		return NewIOError(err.Error())
+	}
+
+	if _, err = io.Copy(bfp, fp); err == nil {
+		_, err = fp.Seek(0, io.SeekStart)
+	}
+	if cerr := bfp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(path)
+		return NewIOError(err.Error())
+	}
+	return nil
+}
+
 func Close(fp *os.File) error {
 	var err error
 	if fp != nil {
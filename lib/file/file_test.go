@@ -2,6 +2,8 @@ package file
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"testing"
 )
 
@@ -134,3 +136,47 @@ func TestLockFilePath(t *testing.T) {
 		t.Errorf("result = %q, want %q", result, expect)
 	}
 }
+
+func TestBackup(t *testing.T) {
+	fp, _ := os.Create(GetTestFilePath("backup.txt"))
+	fp.WriteString("backup content")
+	fp.Seek(0, 0)
+	defer fp.Close()
+
+	backupPath := GetTestFilePath("backup.txt.bak")
+	if err := Backup(fp, backupPath, false); err != nil {
+		t.Fatalf("error = %q, want no error", err)
+	}
+
+	buf, _ := ioutil.ReadFile(backupPath)
+	if string(buf) != "backup content" {
+		t.Errorf("backup content = %q, want %q", string(buf), "backup content")
+	}
+
+	buf, _ = ioutil.ReadAll(fp)
+	if string(buf) != "backup content" {
+		t.Errorf("content after backup = %q, want %q", string(buf), "backup content")
+	}
+
+	expectErr := fmt.Sprintf("backup file %s already exists", backupPath)
+	err := Backup(fp, backupPath, false)
+	if err == nil {
+		t.Fatalf("no error, want error %q", expectErr)
+	}
+	if err.Error() != expectErr {
+		t.Fatalf("error = %q, want error %q", err.Error(), expectErr)
+	}
+
+	fp.Truncate(0)
+	fp.Seek(0, 0)
+	fp.WriteString("updated content")
+	fp.Seek(0, 0)
+	if err := Backup(fp, backupPath, true); err != nil {
+		t.Fatalf("error = %q, want no error", err)
+	}
+
+	buf, _ = ioutil.ReadFile(backupPath)
+	if string(buf) != "updated content" {
+		t.Errorf("overwritten backup content = %q, want %q", string(buf), "updated content")
+	}
+}
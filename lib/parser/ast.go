@@ -243,6 +243,8 @@ type SelectQuery struct {
 	OrderByClause QueryExpression
 	LimitClause   QueryExpression
 	OffsetClause  QueryExpression
+	ForUpdate     bool
+	ForUpdateLit  string
 }
 
 func (e SelectQuery) String() string {
@@ -260,6 +262,9 @@ func (e SelectQuery) String() string {
 	if e.OffsetClause != nil {
 		s = append(s, e.OffsetClause.String())
 	}
+	if e.ForUpdate {
+		s = append(s, e.ForUpdateLit)
+	}
 	return joinWithSpace(s)
 }
 
@@ -514,24 +519,33 @@ func (i Is) String() string {
 
 type Between struct {
 	*BaseExpr
-	Between  string
-	And      string
-	LHS      QueryExpression
-	Low      QueryExpression
-	High     QueryExpression
-	Negation Token
+	Between   string
+	And       string
+	LHS       QueryExpression
+	Low       QueryExpression
+	High      QueryExpression
+	Negation  Token
+	Symmetric Token
 }
 
 func (b Between) IsNegated() bool {
 	return !b.Negation.IsEmpty()
 }
 
+func (b Between) IsSymmetric() bool {
+	return !b.Symmetric.IsEmpty()
+}
+
 func (b Between) String() string {
 	s := []string{b.LHS.String()}
 	if b.IsNegated() {
 		s = append(s, b.Negation.Literal)
 	}
-	s = append(s, b.Between, b.Low.String(), b.And, b.High.String())
+	s = append(s, b.Between)
+	if b.IsSymmetric() {
+		s = append(s, b.Symmetric.Literal)
+	}
+	s = append(s, b.Low.String(), b.And, b.High.String())
 	return joinWithSpace(s)
 }
 
@@ -584,10 +598,12 @@ func (a Any) String() string {
 
 type Like struct {
 	*BaseExpr
-	Like     string
-	LHS      QueryExpression
-	Pattern  QueryExpression
-	Negation Token
+	Like      string
+	LHS       QueryExpression
+	Pattern   QueryExpression
+	Negation  Token
+	EscapeLit string
+	Escape    QueryExpression
 }
 
 func (l Like) IsNegated() bool {
@@ -600,6 +616,55 @@ func (l Like) String() string {
 		s = append(s, l.Negation.Literal)
 	}
 	s = append(s, l.Like, l.Pattern.String())
+	if l.Escape != nil {
+		s = append(s, l.EscapeLit, l.Escape.String())
+	}
+	return joinWithSpace(s)
+}
+
+type SimilarTo struct {
+	*BaseExpr
+	SimilarTo string
+	LHS       QueryExpression
+	Pattern   QueryExpression
+	Negation  Token
+	EscapeLit string
+	Escape    QueryExpression
+}
+
+func (st SimilarTo) IsNegated() bool {
+	return !st.Negation.IsEmpty()
+}
+
+func (st SimilarTo) String() string {
+	s := []string{st.LHS.String()}
+	if st.IsNegated() {
+		s = append(s, st.Negation.Literal)
+	}
+	s = append(s, st.SimilarTo, st.Pattern.String())
+	if st.Escape != nil {
+		s = append(s, st.EscapeLit, st.Escape.String())
+	}
+	return joinWithSpace(s)
+}
+
+type RegExpMatch struct {
+	*BaseExpr
+	LHS      QueryExpression
+	Operator string
+	Pattern  QueryExpression
+}
+
+func (r RegExpMatch) IsNegated() bool {
+	return strings.HasPrefix(r.Operator, "!")
+}
+
+func (r RegExpMatch) IsCaseInsensitive() bool {
+	return strings.HasSuffix(r.Operator, "*")
+}
+
+func (r RegExpMatch) String() string {
+	s := []string{r.LHS.String(), r.Operator, r.Pattern.String()}
 	return joinWithSpace(s)
 }
 
@@ -675,6 +740,17 @@ func (c Concat) String() string {
 	return strings.Join(s, " || ")
 }
 
+type AtTimeZone struct {
+	*BaseExpr
+	AtTimeZone string
+	Value      QueryExpression
+	TimeZone   QueryExpression
+}
+
+func (e AtTimeZone) String() string {
+	return joinWithSpace([]string{e.Value.String(), e.AtTimeZone, e.TimeZone.String()})
+}
+
 type Function struct {
 	*BaseExpr
 	Name string
@@ -708,18 +784,31 @@ func (e AggregateFunction) IsDistinct() bool {
 
 type Table struct {
 	*BaseExpr
-	Object QueryExpression
-	As     string
-	Alias  QueryExpression
+	Object  QueryExpression
+	Options []TableOption
+	As      string
+	Alias   QueryExpression
+	Fields  []QueryExpression
 }
 
 func (t Table) String() string {
 	s := []string{t.Object.String()}
+	if 0 < len(t.Options) {
+		opts := make([]string, len(t.Options))
+		for i, o := range t.Options {
+			opts[i] = o.String()
+		}
+		s = append(s, putParentheses(strings.Join(opts, ", ")))
+	}
 	if 0 < len(t.As) {
 		s = append(s, t.As)
 	}
 	if t.Alias != nil {
-		s = append(s, t.Alias.String())
+		alias := t.Alias.String()
+		if 0 < len(t.Fields) {
+			alias = alias + putParentheses(listQueryExpressions(t.Fields))
+		}
+		s = append(s, alias)
 	}
 	return joinWithSpace(s)
 }
@@ -743,14 +832,40 @@ func (t Table) Name() Identifier {
 
 }
 
+type TableOption struct {
+	Name  Identifier
+	Value QueryExpression
+}
+
+func (o TableOption) String() string {
+	if o.Value == nil {
+		return o.Name.String()
+	}
+	return joinWithSpace([]string{o.Name.String(), o.Value.String()})
+}
+
+type ComputedColumn struct {
+	*BaseExpr
+	Column Identifier
+	Name   Identifier
+	As     string
+	Value  QueryExpression
+}
+
+func (e ComputedColumn) String() string {
+	return joinWithSpace([]string{e.Column.String(), e.Name.String(), e.As, e.Value.String()})
+}
+
 type Join struct {
 	*BaseExpr
 	Join      string
 	Table     QueryExpression
 	JoinTable QueryExpression
 	Natural   Token
+	AsOf      Token
 	JoinType  Token
 	Direction Token
+	Partition QueryExpression
 	Condition QueryExpression
 }
 
@@ -759,6 +874,9 @@ func (j Join) String() string {
 	if !j.Natural.IsEmpty() {
 		s = append(s, j.Natural.Literal)
 	}
+	if !j.AsOf.IsEmpty() {
+		s = append(s, j.AsOf.Literal)
+	}
 	if !j.Direction.IsEmpty() {
 		s = append(s, j.Direction.Literal)
 	}
@@ -766,6 +884,9 @@ func (j Join) String() string {
 		s = append(s, j.JoinType.Literal)
 	}
 	s = append(s, j.Join, j.JoinTable.String())
+	if j.Partition != nil {
+		s = append(s, j.Partition.String())
+	}
 	if j.Condition != nil {
 		s = append(s, j.Condition.String())
 	}
@@ -822,10 +943,25 @@ func (f Field) Name() string {
 
 type AllColumns struct {
 	*BaseExpr
+	View       Identifier
+	ExceptLit  string
+	Except     []QueryExpression
+	ReplaceLit string
+	Replace    []QueryExpression
 }
 
 func (ac AllColumns) String() string {
-	return "*"
+	s := []string{"*"}
+	if 0 < len(ac.View.Literal) {
+		s[0] = ac.View.String() + ".*"
+	}
+	if ac.Except != nil {
+		s = append(s, ac.ExceptLit, putParentheses(listQueryExpressions(ac.Except)))
+	}
+	if ac.Replace != nil {
+		s = append(s, ac.ReplaceLit, putParentheses(listQueryExpressions(ac.Replace)))
+	}
+	return joinWithSpace(s)
 }
 
 type Dual struct {
@@ -846,6 +982,36 @@ func (si Stdin) String() string {
 	return si.Stdin
 }
 
+type ValuesTable struct {
+	*BaseExpr
+	Values    string
+	RowValues []QueryExpression
+}
+
+func (e ValuesTable) String() string {
+	return putParentheses(e.Values + " " + listQueryExpressions(e.RowValues))
+}
+
+type UnnestTable struct {
+	*BaseExpr
+	Unnest     string
+	Value      QueryExpression
+	With       string
+	Ordinality string
+}
+
+func (e UnnestTable) WithOrdinality() bool {
+	return 0 < len(e.Ordinality)
+}
+
+func (e UnnestTable) String() string {
+	s := []string{e.Unnest + putParentheses(e.Value.String())}
+	if e.WithOrdinality() {
+		s = append(s, e.With, e.Ordinality)
+	}
+	return joinWithSpace(s)
+}
+
 type OrderItem struct {
 	*BaseExpr
 	Value     QueryExpression
@@ -1119,9 +1285,22 @@ type DeleteQuery struct {
 
 type CreateTable struct {
 	*BaseExpr
-	Table  Identifier
-	Fields []QueryExpression
-	Query  QueryExpression
+	Table       Identifier
+	Fields      []QueryExpression
+	Query       QueryExpression
+	IfNotExists bool
+}
+
+type DropTable struct {
+	*BaseExpr
+	Table QueryExpression
+}
+
+type RenameTable struct {
+	*BaseExpr
+	Table     QueryExpression
+	NewName   Identifier
+	Overwrite bool
 }
 
 type AddColumns struct {
@@ -1156,11 +1335,21 @@ type RenameColumn struct {
 	New   Identifier
 }
 
+type Deduplicate struct {
+	*BaseExpr
+	Table QueryExpression
+}
+
 type FunctionDeclaration struct {
 	*BaseExpr
-	Name       Identifier
-	Parameters []VariableAssignment
-	Statements []Statement
+	Name          Identifier
+	Parameters    []VariableAssignment
+	Deterministic Token
+	Statements    []Statement
+}
+
+func (e FunctionDeclaration) IsDeterministic() bool {
+	return !e.Deterministic.IsEmpty()
 }
 
 type AggregateDeclaration struct {
@@ -1197,6 +1386,41 @@ type Source struct {
 	FilePath QueryExpression
 }
 
+type UseRepository struct {
+	*BaseExpr
+	Repository QueryExpression
+}
+
+type Export struct {
+	*BaseExpr
+	Table    QueryExpression
+	FilePath QueryExpression
+	Options  []ExportOption
+}
+
+type ExportOption struct {
+	Name  Identifier
+	Value QueryExpression
+}
+
+type Diff struct {
+	*BaseExpr
+	Table   QueryExpression
+	Against QueryExpression
+	Keys    []QueryExpression
+}
+
+type Explain struct {
+	*BaseExpr
+	Query SelectQuery
+}
+
+type SelectInto struct {
+	*BaseExpr
+	Query     SelectQuery
+	Variables []Variable
+}
+
 type SetFlag struct {
 	*BaseExpr
 	Name  string
@@ -1210,7 +1434,8 @@ type ShowFlag struct {
 
 type ShowObjects struct {
 	*BaseExpr
-	Type int
+	Type    int
+	Pattern QueryExpression
 }
 
 type ShowFields struct {
@@ -1257,22 +1482,54 @@ type CaseElse struct {
 
 type While struct {
 	*BaseExpr
+	Label      Identifier
 	Condition  QueryExpression
 	Statements []Statement
 }
 
 type WhileInCursor struct {
 	*BaseExpr
+	Label           Identifier
 	WithDeclaration bool
 	Variables       []Variable
 	Cursor          Identifier
 	Statements      []Statement
 }
 
+type Loop struct {
+	*BaseExpr
+	Label      Identifier
+	Statements []Statement
+}
+
+func labelLoop(stmt Statement, label Identifier) Statement {
+	switch loop := stmt.(type) {
+	case While:
+		loop.Label = label
+		return loop
+	case WhileInCursor:
+		loop.Label = label
+		return loop
+	case Loop:
+		loop.Label = label
+		return loop
+	}
+	return stmt
+}
+
 type CursorDeclaration struct {
 	*BaseExpr
-	Cursor Identifier
-	Query  SelectQuery
+	Cursor   Identifier
+	Negation Token
+	Scroll   Token
+	Query    SelectQuery
+}
+
+func (e CursorDeclaration) IsScrollable() ternary.Value {
+	if e.Scroll.IsEmpty() {
+		return ternary.UNKNOWN
+	}
+	return ternary.ConvertFromBool(e.Negation.IsEmpty())
 }
 
 type OpenCursor struct {
@@ -1346,14 +1603,28 @@ type DisposeView struct {
 	View Identifier
 }
 
+type SchemaDeclaration struct {
+	*BaseExpr
+	Table  Identifier
+	Fields []SchemaField
+}
+
+type SchemaField struct {
+	*BaseExpr
+	Column Identifier
+	Type   Identifier
+}
+
 type TransactionControl struct {
 	*BaseExpr
-	Token int
+	Token     int
+	Savepoint Identifier
 }
 
 type FlowControl struct {
 	*BaseExpr
 	Token int
+	Label Identifier
 }
 
 type Trigger struct {
@@ -262,6 +262,25 @@ func TestSelectQuery_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = SelectQuery{
+		SelectEntity: SelectEntity{
+			SelectClause: SelectClause{
+				Select: "select",
+				Fields: []QueryExpression{Field{Object: Identifier{Literal: "column"}}},
+			},
+			FromClause: FromClause{
+				From:   "from",
+				Tables: []QueryExpression{Table{Object: Identifier{Literal: "table"}}},
+			},
+		},
+		ForUpdate:    true,
+		ForUpdateLit: "for update",
+	}
+	expect = "select column from table for update"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestSelectSet_String(t *testing.T) {
@@ -670,6 +689,18 @@ func TestBetween_IsNegated(t *testing.T) {
 	}
 }
 
+func TestBetween_IsSymmetric(t *testing.T) {
+	e := Between{}
+	if e.IsSymmetric() == true {
+		t.Errorf("symmetric = %t, want %t for %#v", e.IsSymmetric(), false, e)
+	}
+
+	e = Between{Symmetric: Token{Token: SYMMETRIC, Literal: "symmetric"}}
+	if e.IsSymmetric() == false {
+		t.Errorf("symmetric = %t, want %t for %#v", e.IsSymmetric(), true, e)
+	}
+}
+
 func TestBetween_String(t *testing.T) {
 	e := Between{
 		Between:  "between",
@@ -683,6 +714,12 @@ func TestBetween_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e.Symmetric = Token{Token: SYMMETRIC, Literal: "symmetric"}
+	expect = "column not between symmetric -10 and 10"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestIn_IsNegated(t *testing.T) {
@@ -814,6 +851,81 @@ func TestLike_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = Like{
+		Like:      "ilike",
+		LHS:       Identifier{Literal: "column"},
+		Pattern:   NewStringValue("a!%"),
+		EscapeLit: "escape",
+		Escape:    NewStringValue("!"),
+	}
+	expect = "column ilike 'a!%' escape '!'"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestSimilarTo_IsNegated(t *testing.T) {
+	e := SimilarTo{}
+	if e.IsNegated() == true {
+		t.Errorf("negation = %t, want %t for %#v", e.IsNegated(), false, e)
+	}
+
+	e = SimilarTo{Negation: Token{Token: NOT, Literal: "not"}}
+	if e.IsNegated() == false {
+		t.Errorf("negation = %t, want %t for %#v", e.IsNegated(), true, e)
+	}
+}
+
+func TestSimilarTo_String(t *testing.T) {
+	e := SimilarTo{
+		SimilarTo: "similar to",
+		LHS:       Identifier{Literal: "column"},
+		Pattern:   NewStringValue("a(b|c)!%"),
+		Negation:  Token{Token: NOT, Literal: "not"},
+		EscapeLit: "escape",
+		Escape:    NewStringValue("!"),
+	}
+	expect := "column not similar to 'a(b|c)!%' escape '!'"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestRegExpMatch_IsNegated(t *testing.T) {
+	e := RegExpMatch{Operator: "~*"}
+	if e.IsNegated() == true {
+		t.Errorf("negation = %t, want %t for %#v", e.IsNegated(), false, e)
+	}
+
+	e = RegExpMatch{Operator: "!~"}
+	if e.IsNegated() == false {
+		t.Errorf("negation = %t, want %t for %#v", e.IsNegated(), true, e)
+	}
+}
+
+func TestRegExpMatch_IsCaseInsensitive(t *testing.T) {
+	e := RegExpMatch{Operator: "!~"}
+	if e.IsCaseInsensitive() == true {
+		t.Errorf("case insensitive = %t, want %t for %#v", e.IsCaseInsensitive(), false, e)
+	}
+
+	e = RegExpMatch{Operator: "~*"}
+	if e.IsCaseInsensitive() == false {
+		t.Errorf("case insensitive = %t, want %t for %#v", e.IsCaseInsensitive(), true, e)
+	}
+}
+
+func TestRegExpMatch_String(t *testing.T) {
+	e := RegExpMatch{
+		LHS:      Identifier{Literal: "column"},
+		Operator: "!~*",
+		Pattern:  NewStringValue("^a"),
+	}
+	expect := "column !~* '^a'"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestExists_String(t *testing.T) {
@@ -912,6 +1024,18 @@ func TestConcat_String(t *testing.T) {
 	}
 }
 
+func TestAtTimeZone_String(t *testing.T) {
+	e := AtTimeZone{
+		AtTimeZone: "at time zone",
+		Value:      Identifier{Literal: "column"},
+		TimeZone:   NewStringValue("UTC"),
+	}
+	expect := "column at time zone 'UTC'"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestFunction_String(t *testing.T) {
 	e := Function{
 		Name: "sum",
@@ -951,6 +1075,18 @@ func TestAggregateFunction_IsDistinct(t *testing.T) {
 	}
 }
 
+func TestFunctionDeclaration_IsDeterministic(t *testing.T) {
+	e := FunctionDeclaration{}
+	if e.IsDeterministic() == true {
+		t.Errorf("deterministic = %t, want %t for %#v", e.IsDeterministic(), false, e)
+	}
+
+	e = FunctionDeclaration{Deterministic: Token{Token: DETERMINISTIC, Literal: "deterministic"}}
+	if e.IsDeterministic() == false {
+		t.Errorf("deterministic = %t, want %t for %#v", e.IsDeterministic(), true, e)
+	}
+}
+
 func TestTable_String(t *testing.T) {
 	e := Table{
 		Object: Identifier{Literal: "table"},
@@ -969,6 +1105,35 @@ func TestTable_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = Table{
+		Object: Identifier{Literal: "table"},
+		Options: []TableOption{
+			{Name: Identifier{Literal: "no"}, Value: Identifier{Literal: "header"}},
+			{Name: Identifier{Literal: "delimiter"}, Value: NewStringValue(";")},
+		},
+		Alias: Identifier{Literal: "alias"},
+	}
+	expect = "table (no header, delimiter ';') alias"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = Table{
+		Object: ValuesTable{
+			Values: "values",
+			RowValues: []QueryExpression{
+				RowValue{Value: ValueList{Values: []QueryExpression{NewIntegerValueFromString("1"), NewStringValue("a")}}},
+			},
+		},
+		As:     "as",
+		Alias:  Identifier{Literal: "alias"},
+		Fields: []QueryExpression{Identifier{Literal: "id"}, Identifier{Literal: "name"}},
+	}
+	expect = "(values (1, 'a')) as alias(id, name)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestTable_Name(t *testing.T) {
@@ -1045,6 +1210,32 @@ func TestJoin_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = Join{
+		Join:      "join",
+		Table:     Table{Object: Identifier{Literal: "table1"}},
+		JoinTable: Table{Object: Identifier{Literal: "table2"}},
+		AsOf:      Token{Token: ASOF, Literal: "asof"},
+		Direction: Token{Token: LEFT, Literal: "left"},
+		Partition: PartitionClause{
+			PartitionBy: "partition by",
+			Values: []QueryExpression{
+				FieldReference{Column: Identifier{Literal: "column1"}},
+			},
+		},
+		Condition: JoinCondition{
+			Literal: "on",
+			On: Comparison{
+				LHS:      FieldReference{View: Identifier{Literal: "table1"}, Column: Identifier{Literal: "column2"}},
+				Operator: ">=",
+				RHS:      FieldReference{View: Identifier{Literal: "table2"}, Column: Identifier{Literal: "column2"}},
+			},
+		},
+	}
+	expect = "table1 asof left join table2 partition by column1 on table1.column2 >= table2.column2"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestJoinCondition_String(t *testing.T) {
@@ -1146,6 +1337,41 @@ func TestAllColumns_String(t *testing.T) {
 	if e.String() != "*" {
 		t.Errorf("string = %q, want %q for %#v", e.String(), "*", e)
 	}
+
+	e = AllColumns{
+		ExceptLit: "except",
+		Except: []QueryExpression{
+			FieldReference{Column: Identifier{Literal: "column1"}},
+			FieldReference{Column: Identifier{Literal: "column2"}},
+		},
+	}
+	expect := "* except (column1, column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = AllColumns{
+		ExceptLit: "except",
+		Except: []QueryExpression{
+			FieldReference{Column: Identifier{Literal: "column1"}},
+		},
+		ReplaceLit: "replace",
+		Replace: []QueryExpression{
+			Field{Object: Function{Name: "upper", Args: []QueryExpression{FieldReference{Column: Identifier{Literal: "column2"}}}}, As: "as", Alias: Identifier{Literal: "column2"}},
+		},
+	}
+	expect = "* except (column1) replace (upper(column2) as column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = AllColumns{
+		View: Identifier{Literal: "t1"},
+	}
+	expect = "t1.*"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestDual_String(t *testing.T) {
@@ -1164,6 +1390,62 @@ func TestStdin_String(t *testing.T) {
 	}
 }
 
+func TestComputedColumn_String(t *testing.T) {
+	e := TableOption{
+		Name: Identifier{Literal: "add"},
+		Value: ComputedColumn{
+			Column: Identifier{Literal: "column"},
+			Name:   Identifier{Literal: "total"},
+			As:     "as",
+			Value: Arithmetic{
+				LHS:      Identifier{Literal: "qty"},
+				Operator: '*',
+				RHS:      Identifier{Literal: "price"},
+			},
+		},
+	}
+	expect := "add column total as qty * price"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestValuesTable_String(t *testing.T) {
+	e := ValuesTable{
+		Values: "values",
+		RowValues: []QueryExpression{
+			RowValue{Value: ValueList{Values: []QueryExpression{NewIntegerValueFromString("1"), NewStringValue("a")}}},
+			RowValue{Value: ValueList{Values: []QueryExpression{NewIntegerValueFromString("2"), NewStringValue("b")}}},
+		},
+	}
+	expect := "(values (1, 'a'), (2, 'b'))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestUnnestTable_String(t *testing.T) {
+	e := UnnestTable{
+		Unnest: "unnest",
+		Value:  Variable{Name: "@arr"},
+	}
+	expect := "unnest(@arr)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = UnnestTable{
+		Unnest:     "unnest",
+		Value:      Variable{Name: "@arr"},
+		With:       "with",
+		Ordinality: "ordinality",
+	}
+	expect = "unnest(@arr) with ordinality"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestOrderItem_String(t *testing.T) {
 	e := OrderItem{
 		Value:     Identifier{Literal: "column"},
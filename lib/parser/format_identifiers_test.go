@@ -2,7 +2,9 @@ package parser
 
 import (
 	"testing"
+	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
@@ -15,7 +17,7 @@ func TestFormatFieldIdentifier(t *testing.T) {
 	}
 
 	e = NewDatetimeValueFromString("2006-01-02 15:04:05 -08:00")
-	expect = "2006-01-02T15:04:05-08:00"
+	expect = time.Date(2006, 1, 2, 23, 4, 5, 0, time.UTC).In(cmd.GetLocation()).Format(time.RFC3339Nano)
 	result = FormatFieldIdentifier(e)
 	if result != expect {
 		t.Errorf("field identifier = %q, want %q for %#v", result, expect, e)
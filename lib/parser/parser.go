@@ -4,6 +4,7 @@ package parser
 import __yyfmt__ "fmt"
 
 //line parser.y:2
+
 import (
 	"strconv"
 
@@ -12,29 +13,35 @@ import (
 
 //line parser.y:11
 type yySymType struct {
-	yys         int
-	program     []Statement
-	statement   Statement
-	queryexpr   QueryExpression
-	queryexprs  []QueryExpression
-	expression  Expression
-	expressions []Expression
-	identifier  Identifier
-	table       Table
-	variable    Variable
-	variables   []Variable
-	varassign   VariableAssignment
-	varassigns  []VariableAssignment
-	updateset   UpdateSet
-	updatesets  []UpdateSet
-	columndef   ColumnDefault
-	columndefs  []ColumnDefault
-	elseif      []ElseIf
-	elseexpr    Else
-	casewhen    []CaseWhen
-	caseelse    CaseElse
-	fetchpos    FetchPosition
-	token       Token
+	yys          int
+	program      []Statement
+	statement    Statement
+	queryexpr    QueryExpression
+	queryexprs   []QueryExpression
+	expression   Expression
+	expressions  []Expression
+	identifier   Identifier
+	table        Table
+	variable     Variable
+	variables    []Variable
+	varassign    VariableAssignment
+	varassigns   []VariableAssignment
+	updateset    UpdateSet
+	updatesets   []UpdateSet
+	columndef    ColumnDefault
+	columndefs   []ColumnDefault
+	exportopt    ExportOption
+	exportopts   []ExportOption
+	tableopt     TableOption
+	tableopts    []TableOption
+	elseif       []ElseIf
+	elseexpr     Else
+	casewhen     []CaseWhen
+	caseelse     CaseElse
+	fetchpos     FetchPosition
+	schemafield  SchemaField
+	schemafields []SchemaField
+	token        Token
 }
 
 const IDENTIFIER = 57346
@@ -58,121 +65,151 @@ const VALUES = 57363
 const AS = 57364
 const DUAL = 57365
 const STDIN = 57366
-const RECURSIVE = 57367
-const CREATE = 57368
-const ADD = 57369
-const DROP = 57370
-const ALTER = 57371
-const TABLE = 57372
-const FIRST = 57373
-const LAST = 57374
-const AFTER = 57375
-const BEFORE = 57376
-const DEFAULT = 57377
-const RENAME = 57378
-const TO = 57379
-const VIEW = 57380
-const ORDER = 57381
-const GROUP = 57382
-const HAVING = 57383
-const BY = 57384
-const ASC = 57385
-const DESC = 57386
-const LIMIT = 57387
-const OFFSET = 57388
-const PERCENT = 57389
-const JOIN = 57390
-const INNER = 57391
-const OUTER = 57392
-const LEFT = 57393
-const RIGHT = 57394
-const FULL = 57395
-const CROSS = 57396
-const ON = 57397
-const USING = 57398
-const NATURAL = 57399
-const UNION = 57400
-const INTERSECT = 57401
-const EXCEPT = 57402
-const ALL = 57403
-const ANY = 57404
-const EXISTS = 57405
-const IN = 57406
-const AND = 57407
-const OR = 57408
-const NOT = 57409
-const BETWEEN = 57410
-const LIKE = 57411
-const IS = 57412
-const NULL = 57413
-const DISTINCT = 57414
-const WITH = 57415
-const RANGE = 57416
-const UNBOUNDED = 57417
-const PRECEDING = 57418
-const FOLLOWING = 57419
-const CURRENT = 57420
-const ROW = 57421
-const CASE = 57422
-const IF = 57423
-const ELSEIF = 57424
-const WHILE = 57425
-const WHEN = 57426
-const THEN = 57427
-const ELSE = 57428
-const DO = 57429
-const END = 57430
-const DECLARE = 57431
-const CURSOR = 57432
-const FOR = 57433
-const FETCH = 57434
-const OPEN = 57435
-const CLOSE = 57436
-const DISPOSE = 57437
-const NEXT = 57438
-const PRIOR = 57439
-const ABSOLUTE = 57440
-const RELATIVE = 57441
-const SEPARATOR = 57442
-const PARTITION = 57443
-const OVER = 57444
-const COMMIT = 57445
-const ROLLBACK = 57446
-const CONTINUE = 57447
-const BREAK = 57448
-const EXIT = 57449
-const PRINT = 57450
-const PRINTF = 57451
-const SOURCE = 57452
-const TRIGGER = 57453
-const FUNCTION = 57454
-const AGGREGATE = 57455
-const BEGIN = 57456
-const RETURN = 57457
-const IGNORE = 57458
-const WITHIN = 57459
-const VAR = 57460
-const SHOW = 57461
-const TIES = 57462
-const NULLS = 57463
-const TABLES = 57464
-const VIEWS = 57465
-const FIELDS = 57466
-const CURSORS = 57467
-const FUNCTIONS = 57468
-const ROWS = 57469
-const ERROR = 57470
-const COUNT = 57471
-const LISTAGG = 57472
-const AGGREGATE_FUNCTION = 57473
-const ANALYTIC_FUNCTION = 57474
-const FUNCTION_NTH = 57475
-const FUNCTION_WITH_INS = 57476
-const COMPARISON_OP = 57477
-const STRING_OP = 57478
-const SUBSTITUTION_OP = 57479
-const UMINUS = 57480
-const UPLUS = 57481
+const UNNEST = 57367
+const RECURSIVE = 57368
+const CREATE = 57369
+const ADD = 57370
+const DROP = 57371
+const ALTER = 57372
+const TABLE = 57373
+const FIRST = 57374
+const LAST = 57375
+const AFTER = 57376
+const BEFORE = 57377
+const DEFAULT = 57378
+const RENAME = 57379
+const TO = 57380
+const VIEW = 57381
+const DEDUPLICATE = 57382
+const EXPORT = 57383
+const DIFF = 57384
+const EXPLAIN = 57385
+const ORDER = 57386
+const GROUP = 57387
+const HAVING = 57388
+const BY = 57389
+const ASC = 57390
+const DESC = 57391
+const LIMIT = 57392
+const OFFSET = 57393
+const PERCENT = 57394
+const JOIN = 57395
+const INNER = 57396
+const OUTER = 57397
+const LEFT = 57398
+const RIGHT = 57399
+const FULL = 57400
+const CROSS = 57401
+const ON = 57402
+const USING = 57403
+const NATURAL = 57404
+const ASOF = 57405
+const UNION = 57406
+const INTERSECT = 57407
+const EXCEPT = 57408
+const ALL = 57409
+const ANY = 57410
+const EXISTS = 57411
+const IN = 57412
+const AND = 57413
+const OR = 57414
+const NOT = 57415
+const BETWEEN = 57416
+const LIKE = 57417
+const IS = 57418
+const NULL = 57419
+const SYMMETRIC = 57420
+const ILIKE = 57421
+const ESCAPE = 57422
+const SIMILAR = 57423
+const DISTINCT = 57424
+const WITH = 57425
+const RANGE = 57426
+const UNBOUNDED = 57427
+const PRECEDING = 57428
+const FOLLOWING = 57429
+const CURRENT = 57430
+const ROW = 57431
+const CASE = 57432
+const IF = 57433
+const ELSEIF = 57434
+const WHILE = 57435
+const LOOP = 57436
+const WHEN = 57437
+const THEN = 57438
+const ELSE = 57439
+const DO = 57440
+const END = 57441
+const DECLARE = 57442
+const CURSOR = 57443
+const FOR = 57444
+const FETCH = 57445
+const OPEN = 57446
+const CLOSE = 57447
+const DISPOSE = 57448
+const NEXT = 57449
+const PRIOR = 57450
+const ABSOLUTE = 57451
+const RELATIVE = 57452
+const SEPARATOR = 57453
+const PARTITION = 57454
+const OVER = 57455
+const COMMIT = 57456
+const ROLLBACK = 57457
+const SAVEPOINT = 57458
+const CONTINUE = 57459
+const BREAK = 57460
+const EXIT = 57461
+const PRINT = 57462
+const PRINTF = 57463
+const SOURCE = 57464
+const TRIGGER = 57465
+const RAISE = 57466
+const FUNCTION = 57467
+const AGGREGATE = 57468
+const BEGIN = 57469
+const RETURN = 57470
+const IGNORE = 57471
+const WITHIN = 57472
+const AT = 57473
+const TIME = 57474
+const ZONE = 57475
+const SCHEMA = 57476
+const USE = 57477
+const REPOSITORY = 57478
+const NO = 57479
+const SCROLL = 57480
+const VAR = 57481
+const SHOW = 57482
+const TIES = 57483
+const NULLS = 57484
+const TABLES = 57485
+const VIEWS = 57486
+const FIELDS = 57487
+const COLUMNS = 57488
+const CURSORS = 57489
+const FUNCTIONS = 57490
+const FLAGS = 57491
+const ROWS = 57492
+const AGAINST = 57493
+const KEY = 57494
+const DETERMINISTIC = 57495
+const ORDINALITY = 57496
+const REPLACE = 57497
+const OVERWRITE = 57498
+const ERROR = 57499
+const COUNT = 57500
+const LISTAGG = 57501
+const AGGREGATE_FUNCTION = 57502
+const ANALYTIC_FUNCTION = 57503
+const FUNCTION_NTH = 57504
+const FUNCTION_WITH_INS = 57505
+const COMPARISON_OP = 57506
+const STRING_OP = 57507
+const REGEXP_OP = 57508
+const SUBSTITUTION_OP = 57509
+const UMINUS = 57510
+const UPLUS = 57511
 
 var yyToknames = [...]string{
 	"$end",
@@ -199,6 +236,7 @@ var yyToknames = [...]string{
 	"AS",
 	"DUAL",
 	"STDIN",
+	"UNNEST",
 	"RECURSIVE",
 	"CREATE",
 	"ADD",
@@ -213,6 +251,10 @@ var yyToknames = [...]string{
 	"RENAME",
 	"TO",
 	"VIEW",
+	"DEDUPLICATE",
+	"EXPORT",
+	"DIFF",
+	"EXPLAIN",
 	"ORDER",
 	"GROUP",
 	"HAVING",
@@ -232,6 +274,7 @@ var yyToknames = [...]string{
 	"ON",
 	"USING",
 	"NATURAL",
+	"ASOF",
 	"UNION",
 	"INTERSECT",
 	"EXCEPT",
@@ -246,6 +289,10 @@ var yyToknames = [...]string{
 	"LIKE",
 	"IS",
 	"NULL",
+	"SYMMETRIC",
+	"ILIKE",
+	"ESCAPE",
+	"SIMILAR",
 	"DISTINCT",
 	"WITH",
 	"RANGE",
@@ -258,6 +305,7 @@ var yyToknames = [...]string{
 	"IF",
 	"ELSEIF",
 	"WHILE",
+	"LOOP",
 	"WHEN",
 	"THEN",
 	"ELSE",
@@ -279,6 +327,7 @@ var yyToknames = [...]string{
 	"OVER",
 	"COMMIT",
 	"ROLLBACK",
+	"SAVEPOINT",
 	"CONTINUE",
 	"BREAK",
 	"EXIT",
@@ -286,12 +335,21 @@ var yyToknames = [...]string{
 	"PRINTF",
 	"SOURCE",
 	"TRIGGER",
+	"RAISE",
 	"FUNCTION",
 	"AGGREGATE",
 	"BEGIN",
 	"RETURN",
 	"IGNORE",
 	"WITHIN",
+	"AT",
+	"TIME",
+	"ZONE",
+	"SCHEMA",
+	"USE",
+	"REPOSITORY",
+	"NO",
+	"SCROLL",
 	"VAR",
 	"SHOW",
 	"TIES",
@@ -299,9 +357,17 @@ var yyToknames = [...]string{
 	"TABLES",
 	"VIEWS",
 	"FIELDS",
+	"COLUMNS",
 	"CURSORS",
 	"FUNCTIONS",
+	"FLAGS",
 	"ROWS",
+	"AGAINST",
+	"KEY",
+	"DETERMINISTIC",
+	"ORDINALITY",
+	"REPLACE",
+	"OVERWRITE",
 	"ERROR",
 	"COUNT",
 	"LISTAGG",
@@ -311,10 +377,12 @@ var yyToknames = [...]string{
 	"FUNCTION_WITH_INS",
 	"COMPARISON_OP",
 	"STRING_OP",
+	"REGEXP_OP",
 	"SUBSTITUTION_OP",
 	"UMINUS",
 	"UPLUS",
 	"';'",
+	"':'",
 	"'*'",
 	"'='",
 	"'-'",
@@ -327,13 +395,14 @@ var yyToknames = [...]string{
 	"','",
 	"'.'",
 }
+
 var yyStatenames = [...]string{}
 
 const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line parser.y:2207
+//line parser.y:2715
 
 func SetDebugLevel(level int, verbose bool) {
 	yyDebug = level
@@ -351,974 +420,1374 @@ func Parse(s string, sourceFile string) ([]Statement, error) {
 var yyExca = [...]int{
 	-1, 0,
 	1, 1,
-	-2, 164,
+	-2, 207,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 62,
-	13, 164,
-	15, 164,
-	17, 164,
-	19, 164,
-	146, 164,
+	-1, 87,
+	99, 4,
+	-2, 207,
+	-1, 89,
+	13, 207,
+	15, 207,
+	17, 207,
+	19, 207,
+	177, 207,
 	-2, 1,
-	-1, 64,
-	147, 248,
-	-2, 164,
-	-1, 104,
-	58, 144,
-	59, 144,
-	60, 144,
-	-2, 155,
-	-1, 158,
-	82, 1,
-	86, 1,
-	88, 1,
-	-2, 164,
-	-1, 240,
-	88, 4,
-	-2, 164,
-	-1, 251,
-	64, 0,
-	68, 0,
-	69, 0,
+	-1, 91,
+	178, 309,
+	-2, 207,
+	-1, 132,
+	64, 187,
+	65, 187,
+	66, 187,
+	-2, 198,
+	-1, 221,
+	92, 1,
+	97, 1,
+	99, 1,
+	-2, 207,
+	-1, 332,
+	99, 4,
+	-2, 207,
+	-1, 339,
+	92, 4,
+	95, 4,
+	97, 4,
+	99, 4,
+	-2, 207,
+	-1, 347,
+	70, 0,
+	74, 0,
+	75, 0,
+	76, 0,
+	79, 0,
+	81, 0,
+	164, 0,
+	166, 0,
+	173, 0,
+	-2, 265,
+	-1, 348,
+	70, 0,
+	74, 0,
+	75, 0,
+	76, 0,
+	79, 0,
+	81, 0,
+	164, 0,
+	166, 0,
+	173, 0,
+	-2, 267,
+	-1, 360,
 	70, 0,
-	135, 0,
-	142, 0,
-	-2, 215,
-	-1, 252,
-	64, 0,
-	68, 0,
-	69, 0,
+	74, 0,
+	75, 0,
+	76, 0,
+	79, 0,
+	81, 0,
+	164, 0,
+	166, 0,
+	173, 0,
+	-2, 278,
+	-1, 361,
 	70, 0,
-	135, 0,
-	142, 0,
-	-2, 217,
-	-1, 261,
-	64, 0,
-	68, 0,
-	69, 0,
+	74, 0,
+	75, 0,
+	76, 0,
+	79, 0,
+	81, 0,
+	164, 0,
+	166, 0,
+	173, 0,
+	-2, 282,
+	-1, 363,
 	70, 0,
-	135, 0,
-	142, 0,
-	-2, 228,
-	-1, 295,
-	88, 1,
-	-2, 164,
-	-1, 305,
-	48, 402,
-	-2, 324,
-	-1, 375,
-	88, 1,
-	-2, 164,
-	-1, 382,
-	64, 0,
-	68, 0,
-	69, 0,
+	74, 0,
+	75, 0,
+	76, 0,
+	79, 0,
+	81, 0,
+	164, 0,
+	166, 0,
+	173, 0,
+	-2, 290,
+	-1, 397,
+	99, 1,
+	-2, 207,
+	-1, 420,
+	53, 505,
+	-2, 405,
+	-1, 488,
+	92, 4,
+	97, 4,
+	99, 4,
+	-2, 207,
+	-1, 493,
+	99, 1,
+	-2, 207,
+	-1, 501,
 	70, 0,
-	135, 0,
-	142, 0,
-	-2, 229,
-	-1, 404,
-	84, 1,
-	86, 1,
-	88, 1,
-	-2, 164,
-	-1, 475,
-	82, 4,
-	84, 4,
-	86, 4,
-	88, 4,
-	-2, 164,
-	-1, 478,
-	88, 4,
-	-2, 164,
-	-1, 479,
-	88, 4,
-	-2, 164,
-	-1, 546,
-	13, 412,
-	73, 412,
-	146, 412,
-	-2, 75,
-	-1, 568,
-	82, 4,
-	86, 4,
-	88, 4,
-	-2, 164,
-	-1, 573,
-	88, 4,
-	-2, 164,
-	-1, 574,
-	88, 4,
-	-2, 164,
-	-1, 579,
-	82, 1,
-	86, 1,
-	88, 1,
-	-2, 164,
-	-1, 626,
-	88, 6,
-	-2, 164,
-	-1, 637,
-	88, 4,
-	-2, 164,
-	-1, 688,
-	88, 6,
-	-2, 164,
-	-1, 689,
-	88, 6,
-	-2, 164,
-	-1, 693,
-	88, 4,
-	-2, 164,
-	-1, 697,
-	84, 4,
-	86, 4,
-	88, 4,
-	-2, 164,
-	-1, 723,
-	82, 6,
-	84, 6,
-	86, 6,
-	88, 6,
-	-2, 164,
-	-1, 761,
-	82, 6,
-	86, 6,
-	88, 6,
-	-2, 164,
-	-1, 764,
-	88, 8,
-	-2, 164,
-	-1, 769,
-	88, 6,
-	-2, 164,
-	-1, 772,
-	82, 4,
-	86, 4,
-	88, 4,
-	-2, 164,
-	-1, 793,
-	88, 6,
-	-2, 164,
-	-1, 820,
-	88, 6,
-	-2, 164,
-	-1, 824,
-	84, 6,
-	86, 6,
-	88, 6,
-	-2, 164,
-	-1, 826,
-	82, 8,
-	84, 8,
-	86, 8,
-	88, 8,
-	-2, 164,
-	-1, 829,
-	88, 8,
-	-2, 164,
-	-1, 830,
-	88, 8,
-	-2, 164,
-	-1, 844,
-	82, 8,
-	86, 8,
-	88, 8,
-	-2, 164,
-	-1, 853,
-	82, 6,
-	86, 6,
-	88, 6,
-	-2, 164,
-	-1, 857,
-	88, 8,
-	-2, 164,
-	-1, 870,
-	88, 8,
-	-2, 164,
-	-1, 874,
-	84, 8,
-	86, 8,
-	88, 8,
-	-2, 164,
-	-1, 899,
-	82, 8,
-	86, 8,
-	88, 8,
-	-2, 164,
+	74, 0,
+	75, 0,
+	76, 0,
+	79, 0,
+	81, 0,
+	164, 0,
+	166, 0,
+	173, 0,
+	-2, 279,
+	-1, 502,
+	70, 0,
+	74, 0,
+	75, 0,
+	76, 0,
+	79, 0,
+	81, 0,
+	164, 0,
+	166, 0,
+	173, 0,
+	-2, 283,
+	-1, 506,
+	70, 0,
+	74, 0,
+	75, 0,
+	76, 0,
+	79, 0,
+	81, 0,
+	164, 0,
+	166, 0,
+	173, 0,
+	-2, 286,
+	-1, 528,
+	95, 1,
+	97, 1,
+	99, 1,
+	-2, 207,
+	-1, 622,
+	99, 4,
+	-2, 207,
+	-1, 623,
+	99, 4,
+	-2, 207,
+	-1, 628,
+	99, 4,
+	-2, 207,
+	-1, 639,
+	70, 0,
+	74, 0,
+	75, 0,
+	76, 0,
+	79, 0,
+	81, 0,
+	164, 0,
+	166, 0,
+	173, 0,
+	-2, 287,
+	-1, 714,
+	13, 515,
+	83, 515,
+	177, 515,
+	-2, 88,
+	-1, 752,
+	99, 4,
+	-2, 207,
+	-1, 753,
+	99, 4,
+	-2, 207,
+	-1, 756,
+	99, 4,
+	-2, 207,
+	-1, 760,
+	95, 4,
+	97, 4,
+	99, 4,
+	-2, 207,
+	-1, 763,
+	92, 1,
+	97, 1,
+	99, 1,
+	-2, 207,
+	-1, 894,
+	60, 338,
+	-2, 505,
+	-1, 912,
+	99, 6,
+	-2, 207,
+	-1, 914,
+	99, 6,
+	-2, 207,
+	-1, 925,
+	92, 4,
+	97, 4,
+	99, 4,
+	-2, 207,
+	-1, 949,
+	60, 338,
+	-2, 505,
+	-1, 955,
+	13, 515,
+	83, 515,
+	177, 515,
+	-2, 91,
+	-1, 968,
+	99, 8,
+	-2, 207,
+	-1, 969,
+	99, 6,
+	-2, 207,
+	-1, 1002,
+	92, 6,
+	95, 6,
+	97, 6,
+	99, 6,
+	-2, 207,
+	-1, 1021,
+	99, 6,
+	-2, 207,
+	-1, 1046,
+	92, 6,
+	97, 6,
+	99, 6,
+	-2, 207,
+	-1, 1050,
+	99, 8,
+	-2, 207,
+	-1, 1054,
+	92, 8,
+	95, 8,
+	97, 8,
+	99, 8,
+	-2, 207,
+	-1, 1071,
+	99, 6,
+	-2, 207,
+	-1, 1078,
+	92, 8,
+	97, 8,
+	99, 8,
+	-2, 207,
+	-1, 1088,
+	99, 6,
+	-2, 207,
+	-1, 1092,
+	95, 6,
+	97, 6,
+	99, 6,
+	-2, 207,
+	-1, 1094,
+	99, 8,
+	-2, 207,
+	-1, 1095,
+	99, 8,
+	-2, 207,
+	-1, 1098,
+	99, 8,
+	-2, 207,
+	-1, 1113,
+	99, 8,
+	-2, 207,
+	-1, 1117,
+	95, 8,
+	97, 8,
+	99, 8,
+	-2, 207,
+	-1, 1125,
+	92, 6,
+	97, 6,
+	99, 6,
+	-2, 207,
+	-1, 1149,
+	92, 8,
+	97, 8,
+	99, 8,
+	-2, 207,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 3601
+const yyLast = 5775
 
 var yyAct = [...]int{
-
-	78, 23, 869, 879, 868, 845, 762, 685, 819, 818,
-	692, 691, 101, 408, 747, 569, 305, 453, 147, 553,
-	746, 778, 656, 498, 548, 219, 678, 374, 120, 516,
-	93, 125, 126, 466, 360, 21, 469, 336, 468, 65,
-	508, 283, 418, 323, 524, 304, 320, 199, 152, 412,
-	745, 373, 22, 426, 425, 211, 313, 116, 554, 191,
-	205, 216, 353, 23, 109, 85, 83, 316, 306, 66,
-	301, 441, 359, 20, 180, 430, 1, 431, 432, 427,
-	424, 446, 182, 428, 765, 119, 180, 241, 159, 368,
-	176, 75, 60, 170, 104, 169, 168, 21, 197, 181,
-	171, 172, 170, 564, 180, 188, 565, 207, 207, 171,
-	172, 61, 621, 595, 584, 221, 207, 179, 118, 118,
-	562, 121, 561, 229, 230, 231, 202, 547, 232, 201,
-	684, 520, 511, 146, 242, 20, 444, 303, 430, 157,
-	431, 432, 427, 424, 246, 170, 428, 169, 168, 223,
-	835, 413, 171, 172, 60, 247, 179, 156, 834, 23,
-	156, 815, 814, 813, 812, 179, 811, 245, 210, 789,
-	242, 787, 43, 242, 786, 242, 429, 206, 206, 777,
-	776, 276, 775, 279, 774, 690, 222, 668, 110, 667,
-	106, 666, 107, 21, 105, 665, 258, 664, 643, 623,
-	620, 615, 361, 43, 614, 207, 613, 607, 594, 586,
-	207, 795, 585, 207, 583, 576, 560, 327, 558, 546,
-	285, 286, 253, 504, 493, 492, 491, 490, 371, 334,
-	345, 20, 110, 337, 273, 249, 531, 350, 275, 274,
-	244, 23, 364, 278, 367, 259, 521, 465, 281, 282,
-	60, 104, 790, 788, 753, 752, 751, 750, 315, 749,
-	293, 365, 720, 351, 718, 717, 325, 711, 707, 259,
-	704, 702, 482, 300, 452, 451, 414, 450, 449, 448,
-	447, 341, 318, 319, 398, 201, 396, 381, 394, 347,
-	346, 198, 112, 383, 384, 370, 23, 187, 385, 186,
-	327, 185, 416, 421, 207, 349, 113, 193, 433, 235,
-	826, 207, 179, 207, 723, 118, 475, 62, 393, 378,
-	377, 112, 224, 156, 144, 850, 389, 435, 721, 291,
-	21, 719, 60, 593, 366, 591, 454, 588, 769, 458,
-	421, 421, 420, 689, 716, 454, 672, 688, 472, 626,
-	759, 400, 372, 179, 344, 757, 715, 335, 436, 588,
-	673, 423, 463, 179, 422, 112, 714, 473, 20, 480,
-	481, 477, 403, 454, 206, 189, 23, 61, 670, 459,
-	461, 440, 190, 442, 443, 713, 712, 60, 292, 179,
-	483, 135, 671, 669, 663, 748, 179, 456, 179, 503,
-	343, 167, 898, 886, 123, 23, 872, 76, 28, 860,
-	21, 859, 852, 226, 836, 421, 831, 825, 518, 822,
-	500, 771, 501, 70, 9, 499, 485, 499, 502, 499,
-	207, 768, 767, 733, 722, 530, 701, 515, 471, 21,
-	366, 700, 695, 640, 499, 327, 537, 179, 20, 179,
-	639, 179, 486, 578, 517, 494, 122, 484, 458, 474,
-	402, 421, 871, 830, 829, 225, 870, 60, 574, 821,
-	28, 573, 526, 820, 519, 529, 23, 20, 124, 23,
-	23, 506, 528, 527, 479, 694, 9, 227, 228, 693,
-	556, 536, 478, 532, 325, 192, 60, 870, 857, 820,
-	517, 136, 137, 140, 138, 139, 793, 693, 539, 540,
-	541, 542, 327, 376, 637, 375, 391, 375, 295, 846,
-	763, 421, 570, 207, 207, 592, 200, 284, 582, 876,
-	875, 842, 740, 739, 699, 179, 698, 566, 567, 599,
-	600, 571, 572, 871, 130, 131, 821, 694, 454, 376,
-	590, 904, 421, 421, 589, 897, 866, 851, 624, 807,
-	420, 606, 770, 597, 645, 617, 28, 60, 577, 23,
-	60, 60, 890, 604, 23, 23, 596, 864, 840, 737,
-	23, 505, 9, 896, 616, 611, 880, 884, 894, 895,
-	880, 618, 619, 906, 893, 883, 421, 882, 628, 634,
-	629, 630, 207, 207, 207, 587, 43, 510, 648, 128,
-	129, 132, 133, 217, 21, 499, 653, 193, 659, 660,
-	661, 99, 458, 647, 256, 655, 288, 23, 255, 257,
-	287, 635, 355, 3, 892, 517, 641, 642, 23, 862,
-	497, 676, 766, 369, 317, 675, 863, 179, 28, 865,
-	243, 214, 20, 471, 631, 902, 646, 471, 881, 878,
-	60, 662, 881, 207, 9, 60, 60, 525, 43, 179,
-	603, 60, 290, 289, 703, 263, 262, 705, 179, 710,
-	602, 601, 708, 100, 499, 213, 214, 215, 523, 23,
-	23, 522, 406, 298, 23, 3, 725, 809, 23, 430,
-	696, 431, 432, 28, 734, 728, 513, 514, 454, 80,
-	81, 82, 780, 99, 84, 730, 731, 535, 60, 9,
-	299, 534, 649, 438, 23, 743, 742, 203, 779, 60,
-	338, 339, 755, 557, 563, 755, 555, 115, 754, 340,
-	430, 758, 431, 432, 427, 424, 657, 658, 428, 114,
-	760, 651, 652, 155, 773, 179, 735, 732, 644, 633,
-	738, 627, 23, 625, 337, 23, 804, 805, 755, 756,
-	23, 559, 802, 23, 785, 100, 445, 348, 727, 204,
-	60, 60, 314, 28, 302, 60, 212, 312, 791, 60,
-	236, 3, 134, 61, 23, 151, 806, 154, 117, 9,
-	781, 782, 783, 784, 856, 755, 810, 792, 636, 294,
-	327, 817, 28, 828, 8, 60, 419, 7, 6, 832,
-	823, 23, 390, 833, 72, 23, 321, 23, 9, 837,
-	23, 23, 322, 308, 802, 808, 178, 802, 802, 816,
-	549, 550, 551, 552, 307, 23, 901, 838, 854, 877,
-	220, 841, 802, 60, 23, 861, 60, 849, 23, 91,
-	71, 60, 74, 67, 60, 802, 73, 68, 650, 63,
-	102, 23, 512, 410, 887, 23, 885, 409, 802, 153,
-	867, 405, 802, 28, 297, 60, 28, 28, 141, 142,
-	143, 533, 145, 437, 108, 801, 903, 900, 17, 9,
-	23, 16, 9, 9, 77, 127, 14, 802, 907, 470,
-	467, 13, 60, 12, 10, 175, 60, 15, 60, 11,
-	798, 60, 60, 681, 796, 679, 356, 430, 3, 431,
-	432, 427, 424, 709, 354, 428, 60, 183, 184, 4,
-	148, 2, 0, 102, 0, 60, 195, 196, 0, 60,
-	218, 0, 0, 0, 175, 0, 0, 801, 0, 0,
-	801, 801, 60, 0, 0, 0, 60, 803, 0, 0,
-	0, 0, 0, 0, 0, 801, 28, 0, 0, 0,
-	0, 28, 28, 233, 234, 0, 0, 28, 801, 0,
-	0, 60, 9, 0, 0, 238, 0, 9, 9, 0,
-	0, 801, 0, 9, 0, 801, 0, 248, 3, 0,
-	250, 251, 252, 0, 254, 0, 0, 261, 218, 264,
-	265, 266, 267, 268, 269, 270, 0, 0, 0, 803,
-	801, 0, 803, 803, 28, 0, 0, 3, 843, 0,
-	0, 847, 848, 0, 5, 28, 0, 803, 0, 0,
-	9, 296, 0, 0, 0, 0, 855, 0, 0, 0,
-	803, 9, 0, 0, 0, 0, 0, 324, 0, 873,
-	0, 0, 0, 803, 0, 342, 0, 803, 0, 0,
-	0, 0, 888, 0, 0, 0, 891, 0, 0, 0,
-	352, 0, 0, 0, 0, 0, 28, 28, 0, 0,
-	0, 28, 803, 0, 0, 28, 380, 0, 382, 177,
-	0, 905, 9, 9, 0, 386, 0, 9, 387, 388,
-	165, 9, 0, 164, 163, 166, 162, 0, 0, 0,
-	401, 28, 0, 0, 0, 392, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 9, 177, 407,
-	411, 0, 0, 0, 0, 0, 0, 177, 0, 0,
-	0, 0, 0, 0, 0, 439, 0, 0, 0, 28,
-	0, 0, 28, 0, 0, 0, 0, 28, 0, 0,
-	28, 0, 0, 0, 0, 9, 0, 0, 9, 0,
-	0, 160, 159, 9, 0, 0, 9, 170, 161, 169,
-	168, 28, 0, 0, 171, 172, 0, 0, 476, 102,
-	0, 0, 3, 0, 0, 0, 0, 9, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 487, 28, 0,
-	488, 0, 28, 0, 28, 0, 0, 28, 28, 0,
-	0, 0, 495, 0, 9, 0, 0, 0, 9, 0,
-	9, 0, 28, 9, 9, 0, 0, 507, 0, 680,
-	0, 28, 0, 0, 0, 28, 0, 0, 9, 0,
-	0, 0, 0, 0, 0, 0, 0, 9, 28, 0,
-	0, 9, 28, 538, 0, 69, 0, 543, 544, 545,
-	0, 0, 0, 0, 9, 324, 0, 0, 9, 0,
-	0, 0, 0, 0, 177, 0, 0, 28, 111, 0,
+	105, 25, 1112, 1137, 1087, 1047, 1111, 407, 1079, 103,
+	36, 529, 1086, 532, 399, 707, 755, 965, 129, 887,
+	447, 285, 979, 209, 576, 1027, 980, 711, 492, 205,
+	404, 420, 489, 651, 981, 739, 155, 718, 723, 164,
+	165, 697, 587, 681, 174, 385, 963, 604, 607, 754,
+	93, 189, 189, 731, 553, 606, 277, 662, 430, 614,
+	284, 560, 419, 724, 216, 23, 561, 265, 402, 273,
+	138, 491, 257, 689, 198, 350, 151, 433, 422, 112,
+	580, 110, 421, 222, 737, 215, 22, 738, 25, 1,
+	25, 541, 1051, 541, 333, 246, 246, 36, 441, 36,
+	270, 247, 247, 442, 248, 132, 441, 246, 845, 92,
+	484, 829, 154, 243, 566, 820, 567, 568, 562, 559,
+	808, 795, 563, 564, 237, 263, 236, 235, 773, 188,
+	191, 238, 239, 88, 735, 734, 189, 189, 254, 222,
+	962, 715, 217, 289, 685, 665, 334, 294, 189, 189,
+	189, 189, 1010, 267, 23, 539, 418, 329, 268, 120,
+	300, 768, 309, 310, 311, 1085, 1084, 312, 566, 1065,
+	567, 568, 562, 559, 315, 22, 563, 564, 1064, 220,
+	237, 548, 1063, 642, 1062, 1061, 325, 238, 239, 202,
+	1043, 202, 1039, 229, 241, 240, 228, 227, 230, 226,
+	1038, 330, 231, 334, 232, 334, 276, 133, 1037, 135,
+	1035, 136, 222, 134, 274, 274, 1034, 1026, 448, 342,
+	343, 334, 25, 1024, 1022, 955, 296, 297, 298, 299,
+	952, 36, 1005, 341, 222, 917, 337, 915, 142, 956,
+	900, 565, 878, 57, 877, 876, 223, 378, 289, 381,
+	875, 874, 849, 237, 222, 236, 235, 847, 844, 477,
+	238, 239, 831, 828, 819, 818, 814, 807, 223, 794,
+	793, 408, 792, 189, 132, 237, 189, 236, 235, 189,
+	791, 57, 238, 239, 638, 785, 23, 224, 223, 233,
+	775, 774, 704, 772, 748, 237, 225, 236, 235, 349,
+	603, 373, 238, 239, 374, 733, 730, 22, 714, 463,
+	657, 344, 646, 645, 644, 643, 460, 466, 445, 444,
+	469, 470, 443, 438, 437, 189, 375, 377, 376, 448,
+	1036, 405, 141, 25, 480, 222, 483, 549, 142, 986,
+	25, 432, 36, 471, 985, 984, 983, 982, 380, 36,
+	140, 416, 954, 383, 384, 415, 479, 943, 435, 436,
+	358, 939, 937, 487, 481, 395, 936, 930, 467, 223,
+	928, 140, 918, 824, 813, 358, 237, 454, 236, 235,
+	267, 686, 637, 238, 239, 289, 746, 701, 619, 357,
+	612, 222, 611, 584, 583, 574, 509, 547, 25, 546,
+	545, 544, 537, 473, 476, 543, 542, 36, 522, 536,
+	520, 518, 462, 461, 478, 387, 388, 551, 556, 189,
+	264, 140, 253, 551, 570, 223, 474, 189, 499, 497,
+	189, 496, 237, 222, 236, 235, 252, 259, 251, 238,
+	239, 145, 378, 381, 144, 513, 143, 588, 317, 1054,
+	1002, 591, 594, 556, 556, 339, 89, 301, 573, 202,
+	588, 194, 23, 610, 393, 517, 524, 223, 717, 903,
+	439, 459, 953, 616, 237, 408, 236, 235, 558, 588,
+	732, 238, 239, 22, 446, 624, 625, 527, 557, 25,
+	617, 324, 575, 1101, 25, 613, 940, 274, 36, 938,
+	783, 781, 601, 36, 579, 458, 581, 582, 211, 3,
+	187, 55, 626, 500, 495, 592, 345, 222, 882, 935,
+	777, 620, 1021, 880, 507, 508, 969, 555, 255, 25,
+	914, 992, 990, 934, 883, 405, 777, 256, 36, 881,
+	408, 671, 289, 618, 912, 933, 394, 932, 931, 234,
+	556, 879, 873, 683, 653, 948, 654, 729, 23, 440,
+	88, 656, 595, 597, 631, 660, 189, 599, 669, 505,
+	699, 456, 702, 308, 600, 457, 1148, 1129, 176, 22,
+	169, 170, 680, 632, 556, 537, 1128, 1127, 162, 1124,
+	1095, 716, 1115, 23, 594, 655, 1102, 556, 3, 1093,
+	405, 1090, 1081, 1057, 1053, 1020, 703, 1001, 670, 924,
+	684, 922, 741, 741, 22, 713, 616, 744, 659, 921,
+	222, 855, 852, 25, 25, 692, 694, 710, 696, 25,
+	693, 851, 36, 36, 706, 762, 691, 758, 36, 647,
+	726, 630, 673, 674, 675, 676, 750, 751, 742, 621,
+	161, 526, 759, 282, 223, 167, 168, 171, 172, 682,
+	338, 237, 1094, 236, 235, 753, 537, 745, 238, 239,
+	258, 752, 623, 536, 163, 743, 289, 650, 652, 782,
+	652, 622, 652, 1113, 1088, 1114, 556, 1089, 189, 189,
+	1113, 757, 1088, 682, 494, 1098, 756, 1071, 756, 493,
+	809, 811, 588, 493, 628, 515, 682, 397, 652, 177,
+	178, 182, 183, 179, 180, 181, 588, 1080, 1048, 799,
+	800, 780, 556, 556, 967, 968, 784, 778, 832, 490,
+	3, 817, 652, 266, 386, 822, 789, 825, 86, 87,
+	797, 1076, 846, 796, 812, 486, 1146, 588, 1145, 1108,
+	810, 974, 973, 25, 25, 920, 919, 25, 823, 749,
+	504, 25, 36, 36, 25, 1114, 36, 804, 1089, 806,
+	36, 757, 1157, 36, 494, 1147, 853, 854, 305, 1143,
+	857, 1123, 975, 837, 860, 923, 843, 836, 861, 848,
+	838, 839, 761, 1121, 1133, 555, 556, 1106, 859, 658,
+	1138, 1138, 189, 189, 189, 856, 189, 867, 896, 699,
+	1155, 222, 899, 1142, 588, 566, 865, 567, 568, 562,
+	559, 537, 905, 563, 564, 588, 1153, 1154, 23, 1163,
+	594, 826, 827, 890, 891, 892, 1152, 894, 1141, 886,
+	302, 1140, 884, 872, 776, 223, 741, 57, 904, 22,
+	898, 713, 237, 862, 236, 235, 901, 902, 664, 238,
+	239, 1119, 271, 351, 306, 307, 909, 907, 910, 1151,
+	390, 318, 1120, 948, 389, 1122, 304, 303, 259, 1160,
+	1136, 916, 1139, 1139, 127, 102, 85, 449, 771, 1052,
+	485, 927, 335, 926, 189, 590, 189, 929, 950, 392,
+	391, 365, 364, 353, 57, 682, 3, 352, 354, 434,
+	944, 280, 355, 964, 356, 964, 1042, 995, 941, 690,
+	153, 153, 36, 160, 36, 946, 25, 949, 566, 947,
+	695, 866, 895, 652, 893, 36, 805, 107, 108, 109,
+	803, 127, 111, 287, 588, 978, 24, 530, 566, 976,
+	567, 568, 128, 802, 977, 537, 279, 280, 281, 801,
+	989, 688, 687, 987, 90, 130, 991, 400, 1060, 964,
+	964, 988, 204, 85, 988, 85, 1000, 996, 36, 36,
+	1004, 709, 999, 401, 997, 713, 993, 667, 668, 708,
+	184, 185, 186, 1023, 577, 868, 269, 1009, 195, 193,
+	1028, 727, 3, 964, 1025, 1029, 1030, 1031, 1032, 128,
+	451, 452, 36, 1033, 503, 1017, 450, 362, 1041, 453,
+	736, 988, 964, 1049, 719, 720, 721, 722, 323, 173,
+	203, 36, 725, 149, 1056, 242, 148, 3, 193, 147,
+	1059, 870, 871, 146, 652, 201, 448, 964, 971, 951,
+	913, 964, 1074, 1075, 850, 964, 36, 249, 250, 1066,
+	36, 537, 842, 130, 36, 1067, 261, 262, 536, 835,
+	834, 1082, 964, 988, 1083, 242, 821, 540, 193, 964,
+	538, 36, 97, 10, 465, 272, 431, 193, 36, 964,
+	417, 779, 336, 964, 278, 964, 964, 1017, 36, 964,
+	1103, 1017, 36, 429, 36, 36, 320, 85, 36, 1016,
+	319, 1018, 150, 1126, 964, 313, 314, 175, 964, 1130,
+	88, 197, 200, 36, 152, 1017, 964, 36, 1097, 1070,
+	627, 322, 396, 9, 554, 36, 8, 7, 326, 514,
+	328, 1017, 1017, 1150, 99, 1017, 331, 712, 403, 1156,
+	964, 424, 423, 1159, 970, 1161, 1135, 340, 130, 36,
+	1017, 1162, 1118, 1100, 1017, 118, 98, 346, 347, 348,
+	10, 101, 10, 94, 360, 361, 100, 363, 95, 366,
+	367, 368, 369, 370, 371, 372, 153, 869, 666, 534,
+	533, 1016, 286, 1018, 199, 1016, 1017, 1018, 137, 6,
+	19, 18, 104, 1073, 166, 16, 608, 1077, 605, 1019,
+	398, 740, 15, 14, 406, 13, 615, 698, 85, 1016,
+	482, 1018, 11, 17, 566, 85, 567, 568, 562, 559,
+	945, 1096, 563, 564, 12, 1016, 1016, 1018, 1018, 1016,
+	1013, 1018, 1045, 959, 1011, 455, 957, 1109, 1110, 212,
+	210, 1116, 4, 206, 1016, 2, 1018, 0, 1016, 0,
+	1018, 1058, 468, 0, 0, 0, 1131, 472, 0, 0,
+	1134, 475, 3, 0, 0, 0, 5, 0, 0, 0,
+	0, 0, 0, 85, 0, 0, 1069, 0, 0, 0,
+	1016, 0, 1018, 0, 498, 0, 0, 0, 501, 502,
+	0, 0, 1158, 0, 10, 193, 506, 0, 0, 0,
+	566, 1091, 567, 568, 562, 559, 888, 889, 563, 564,
+	0, 0, 0, 0, 0, 0, 0, 0, 1104, 192,
+	516, 0, 1107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 531, 535, 0, 609, 0, 0,
+	0, 482, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 0, 0, 1144, 0, 0, 244, 0,
+	0, 0, 0, 193, 85, 578, 0, 0, 0, 85,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 229, 193, 0, 228, 227, 230, 226,
+	0, 0, 231, 193, 232, 0, 245, 193, 244, 0,
+	0, 0, 0, 0, 85, 10, 0, 244, 406, 0,
+	0, 958, 10, 958, 482, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 629, 0, 0, 0, 633, 634,
+	0, 0, 0, 636, 0, 0, 0, 639, 640, 641,
+	0, 0, 0, 0, 222, 0, 0, 0, 0, 648,
+	0, 0, 0, 0, 0, 193, 0, 193, 0, 193,
+	0, 0, 0, 0, 661, 0, 0, 1012, 958, 0,
+	10, 0, 0, 406, 0, 0, 0, 224, 223, 233,
+	0, 96, 0, 0, 0, 237, 225, 236, 235, 0,
+	0, 0, 238, 239, 0, 0, 0, 0, 85, 85,
+	0, 958, 0, 0, 85, 0, 139, 0, 705, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 406, 0,
+	958, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 193, 0, 0, 283,
+	291, 292, 293, 0, 0, 958, 0, 0, 0, 1012,
+	0, 0, 0, 1012, 0, 0, 193, 0, 0, 0,
+	0, 10, 0, 0, 0, 0, 10, 0, 0, 764,
+	958, 766, 767, 0, 0, 0, 769, 1012, 0, 0,
+	0, 0, 0, 770, 0, 0, 0, 958, 0, 0,
+	0, 958, 0, 1012, 1012, 0, 0, 1012, 0, 535,
+	0, 10, 0, 260, 0, 0, 0, 0, 0, 0,
+	609, 840, 1012, 0, 609, 0, 1012, 0, 0, 0,
+	798, 0, 0, 0, 958, 244, 0, 0, 85, 85,
+	0, 0, 85, 0, 0, 0, 85, 0, 0, 85,
+	0, 0, 816, 0, 283, 0, 0, 0, 1012, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 830,
+	0, 0, 0, 0, 0, 0, 193, 0, 0, 0,
+	841, 0, 0, 0, 0, 139, 0, 0, 0, 0,
+	0, 0, 550, 0, 0, 0, 663, 0, 0, 0,
+	0, 858, 0, 244, 0, 10, 10, 0, 0, 863,
+	0, 10, 864, 0, 229, 241, 240, 228, 227, 230,
+	226, 359, 0, 231, 589, 232, 0, 664, 0, 0,
+	0, 0, 0, 598, 0, 0, 0, 602, 0, 0,
+	0, 193, 0, 0, 0, 0, 0, 359, 359, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 406, 0, 0, 427, 0, 193,
+	427, 0, 193, 0, 0, 222, 0, 0, 0, 0,
+	0, 193, 0, 0, 0, 0, 0, 510, 0, 0,
+	511, 512, 0, 0, 0, 244, 0, 244, 85, 244,
+	85, 0, 525, 0, 0, 0, 0, 0, 224, 223,
+	233, 85, 0, 0, 0, 0, 237, 225, 236, 235,
+	0, 0, 0, 238, 239, 0, 0, 0, 0, 0,
+	0, 0, 942, 0, 0, 10, 10, 0, 0, 10,
+	0, 0, 0, 10, 0, 359, 10, 0, 0, 0,
+	0, 0, 0, 1008, 85, 85, 359, 359, 0, 0,
+	0, 0, 0, 0, 0, 193, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 728, 0, 0, 0,
+	0, 0, 519, 521, 523, 0, 0, 0, 85, 994,
+	0, 0, 0, 0, 0, 998, 747, 0, 406, 0,
+	0, 0, 0, 0, 1003, 130, 0, 85, 0, 0,
+	1006, 1007, 0, 0, 0, 0, 0, 0, 427, 0,
+	0, 427, 0, 0, 0, 139, 0, 139, 139, 0,
+	0, 0, 85, 0, 0, 0, 85, 0, 0, 1040,
+	85, 0, 0, 0, 0, 0, 0, 193, 672, 0,
+	0, 0, 677, 678, 679, 0, 0, 85, 1055, 130,
+	0, 0, 0, 0, 85, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 85, 0, 0, 0, 85, 0,
+	85, 85, 0, 0, 85, 0, 1068, 0, 0, 0,
+	0, 0, 1072, 0, 0, 10, 0, 10, 0, 85,
+	0, 0, 0, 85, 535, 0, 833, 0, 10, 359,
+	359, 85, 359, 0, 359, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1099, 0, 0, 0, 0, 0,
+	0, 0, 0, 1105, 0, 85, 0, 0, 0, 0,
+	359, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 10, 10, 0, 0, 0, 0, 427, 1132, 0,
+	0, 0, 0, 0, 359, 0, 0, 0, 0, 0,
+	0, 885, 0, 0, 0, 0, 0, 0, 0, 0,
+	786, 787, 788, 790, 0, 10, 0, 0, 0, 229,
+	241, 240, 228, 227, 230, 226, 0, 0, 231, 906,
+	232, 0, 908, 0, 10, 0, 0, 0, 0, 0,
+	0, 911, 0, 0, 386, 229, 241, 240, 228, 227,
+	230, 226, 0, 0, 231, 0, 232, 0, 0, 10,
+	0, 0, 0, 10, 0, 0, 0, 10, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	222, 0, 0, 0, 10, 0, 0, 0, 0, 0,
+	0, 10, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 10, 58, 0, 0, 10, 222, 10, 10, 427,
+	427, 10, 0, 224, 223, 233, 0, 0, 0, 0,
+	0, 237, 225, 236, 235, 972, 10, 0, 238, 239,
+	10, 0, 0, 0, 0, 0, 0, 0, 10, 224,
+	223, 233, 0, 0, 0, 0, 0, 237, 225, 236,
+	235, 0, 0, 0, 238, 239, 374, 0, 0, 0,
+	0, 0, 10, 0, 0, 0, 0, 0, 229, 241,
+	240, 228, 227, 230, 226, 0, 0, 231, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 58, 359, 0, 359, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1044, 0, 0,
+	0, 425, 190, 428, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 427, 427, 427, 0, 427, 0, 222,
+	71, 72, 73, 125, 74, 75, 76, 0, 0, 59,
+	60, 61, 62, 77, 78, 63, 64, 65, 66, 67,
+	68, 69, 70, 79, 80, 84, 81, 82, 83, 157,
+	158, 159, 224, 223, 233, 0, 0, 0, 0, 0,
+	237, 225, 236, 235, 58, 0, 0, 238, 239, 0,
+	0, 88, 0, 0, 0, 0, 44, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 29, 0, 30,
+	32, 0, 0, 0, 0, 0, 359, 31, 0, 0,
+	33, 50, 51, 52, 0, 427, 0, 427, 0, 0,
+	71, 72, 73, 125, 74, 75, 76, 0, 0, 59,
+	60, 61, 62, 77, 78, 63, 64, 65, 66, 67,
+	68, 69, 70, 79, 80, 84, 81, 82, 83, 157,
+	158, 159, 0, 57, 0, 0, 0, 0, 0, 0,
+	1015, 1014, 0, 967, 968, 426, 0, 0, 0, 0,
+	35, 0, 0, 40, 38, 39, 37, 0, 0, 0,
+	0, 0, 0, 0, 41, 42, 43, 218, 219, 0,
+	46, 47, 48, 53, 54, 0, 0, 0, 966, 0,
+	0, 0, 71, 72, 73, 49, 74, 75, 76, 34,
+	45, 59, 60, 61, 62, 77, 78, 63, 64, 65,
+	66, 67, 68, 69, 70, 79, 80, 84, 81, 82,
+	83, 26, 27, 28, 58, 0, 0, 0, 0, 0,
+	0, 88, 0, 0, 0, 0, 44, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 29, 0, 30,
+	32, 0, 0, 0, 0, 0, 0, 31, 0, 0,
+	33, 50, 51, 52, 0, 0, 0, 0, 58, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 572, 0, 425, 190, 428,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 57, 0, 0, 0, 0, 0, 0,
+	214, 213, 0, 86, 87, 0, 0, 0, 0, 0,
+	35, 0, 0, 40, 38, 39, 37, 0, 0, 0,
+	0, 0, 0, 0, 41, 42, 43, 218, 219, 56,
+	46, 47, 48, 53, 54, 0, 0, 57, 0, 0,
+	0, 0, 71, 72, 73, 49, 74, 75, 76, 34,
+	45, 59, 60, 61, 62, 77, 78, 63, 64, 65,
+	66, 67, 68, 69, 70, 79, 80, 84, 81, 82,
+	83, 26, 27, 28, 58, 107, 108, 109, 0, 127,
+	111, 88, 0, 0, 0, 0, 71, 72, 73, 125,
+	74, 75, 76, 0, 290, 59, 60, 61, 62, 77,
+	78, 63, 64, 65, 66, 67, 68, 69, 70, 79,
+	80, 84, 81, 82, 83, 157, 158, 159, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 426, 0, 0, 0, 0, 0, 0, 0, 121,
+	0, 0, 0, 122, 0, 0, 0, 128, 0, 0,
+	0, 0, 271, 0, 0, 0, 0, 0, 0, 0,
+	119, 115, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 124, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 58,
+	107, 108, 109, 0, 127, 111, 88, 0, 0, 0,
+	0, 0, 71, 72, 73, 125, 74, 75, 76, 106,
+	0, 59, 60, 61, 62, 77, 78, 63, 64, 65,
+	66, 67, 68, 69, 70, 79, 80, 84, 117, 126,
+	116, 26, 27, 28, 0, 0, 0, 0, 0, 0,
+	0, 0, 288, 0, 113, 114, 123, 131, 0, 0,
+	0, 0, 0, 0, 121, 0, 0, 0, 122, 0,
+	0, 0, 128, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 119, 115, 0, 0, 0,
+	0, 0, 0, 0, 0, 208, 124, 0, 0, 0,
+	0, 229, 241, 240, 228, 227, 230, 226, 0, 0,
+	231, 0, 232, 0, 0, 58, 107, 108, 109, 0,
+	127, 111, 88, 0, 0, 0, 0, 71, 72, 73,
+	125, 74, 75, 76, 207, 290, 59, 60, 61, 62,
+	77, 78, 63, 64, 65, 66, 67, 68, 69, 70,
+	79, 80, 84, 117, 126, 116, 26, 27, 28, 0,
+	0, 0, 222, 0, 0, 0, 0, 0, 0, 113,
+	114, 123, 131, 0, 0, 0, 0, 0, 0, 0,
+	121, 0, 0, 0, 122, 0, 0, 0, 128, 0,
+	0, 0, 0, 0, 0, 224, 223, 233, 0, 0,
+	0, 119, 115, 237, 225, 236, 235, 0, 0, 815,
+	238, 239, 124, 0, 0, 0, 229, 241, 240, 228,
+	227, 230, 226, 0, 0, 231, 0, 232, 0, 0,
+	58, 107, 108, 109, 0, 127, 111, 88, 0, 0,
+	0, 0, 1149, 71, 72, 73, 125, 74, 75, 76,
+	290, 0, 59, 60, 61, 62, 77, 78, 63, 64,
+	65, 66, 67, 68, 69, 70, 79, 80, 84, 117,
+	126, 116, 26, 27, 28, 0, 0, 222, 0, 0,
+	0, 0, 0, 288, 0, 113, 114, 123, 131, 0,
+	0, 0, 0, 0, 0, 121, 0, 0, 0, 122,
+	0, 0, 0, 128, 0, 0, 0, 0, 0, 0,
+	224, 223, 233, 0, 0, 0, 119, 115, 237, 225,
+	236, 235, 0, 0, 0, 238, 239, 124, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 58, 107, 108, 109, 0,
+	127, 111, 88, 0, 0, 0, 0, 0, 71, 72,
+	73, 125, 74, 75, 76, 106, 0, 59, 60, 61,
+	62, 77, 78, 63, 64, 65, 66, 67, 68, 69,
+	70, 79, 80, 84, 410, 411, 409, 412, 413, 414,
+	0, 0, 0, 0, 0, 0, 0, 0, 288, 0,
+	113, 114, 123, 131, 0, 0, 0, 0, 0, 0,
+	121, 0, 0, 0, 122, 0, 0, 0, 128, 0,
+	0, 0, 0, 0, 57, 0, 0, 0, 0, 0,
+	0, 119, 115, 58, 0, 0, 0, 0, 0, 0,
+	88, 0, 124, 0, 0, 44, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 29, 0, 30, 32,
+	0, 0, 0, 0, 0, 0, 31, 0, 0, 33,
+	50, 51, 52, 71, 72, 73, 125, 74, 75, 76,
+	0, 0, 59, 60, 61, 62, 77, 78, 63, 64,
+	65, 66, 67, 68, 69, 70, 79, 80, 84, 117,
+	126, 116, 26, 27, 28, 0, 0, 0, 0, 0,
+	0, 0, 57, 0, 0, 113, 114, 123, 131, 961,
+	960, 0, 967, 968, 0, 0, 0, 0, 0, 35,
+	0, 0, 40, 38, 39, 37, 0, 0, 0, 0,
+	0, 0, 0, 41, 42, 43, 0, 0, 0, 46,
+	47, 48, 53, 54, 0, 0, 0, 966, 0, 0,
+	0, 71, 72, 73, 49, 74, 75, 76, 34, 45,
+	59, 60, 61, 62, 77, 78, 63, 64, 65, 66,
+	67, 68, 69, 70, 79, 80, 84, 81, 82, 83,
+	26, 27, 28, 58, 107, 108, 109, 0, 127, 111,
+	88, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 106, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 121, 0,
+	0, 0, 122, 0, 0, 0, 128, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
+	115, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	124, 0, 0, 0, 229, 241, 240, 228, 227, 230,
+	226, 0, 0, 231, 0, 232, 0, 0, 58, 107,
+	108, 109, 0, 127, 111, 88, 0, 0, 0, 0,
+	1125, 71, 72, 73, 125, 74, 75, 76, 106, 0,
+	59, 60, 61, 62, 77, 78, 63, 64, 65, 66,
+	67, 68, 69, 70, 79, 80, 84, 117, 126, 116,
+	26, 27, 28, 0, 0, 222, 0, 0, 0, 0,
+	0, 0, 0, 113, 114, 123, 131, 0, 0, 0,
+	0, 0, 0, 121, 0, 0, 0, 122, 0, 0,
+	0, 128, 0, 0, 0, 0, 0, 0, 224, 223,
+	233, 0, 0, 0, 119, 115, 237, 225, 236, 235,
+	0, 0, 0, 238, 239, 124, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 58, 107, 108, 109, 0, 127, 111,
+	88, 0, 0, 0, 0, 0, 71, 72, 73, 125,
+	74, 75, 76, 106, 0, 59, 60, 61, 62, 77,
+	78, 63, 64, 65, 66, 67, 68, 69, 70, 79,
+	80, 84, 410, 411, 409, 412, 413, 414, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 113, 114,
+	123, 131, 0, 0, 0, 0, 0, 0, 121, 0,
+	0, 0, 122, 0, 0, 0, 128, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
+	115, 58, 0, 0, 0, 0, 0, 0, 88, 0,
+	124, 0, 0, 44, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 29, 0, 30, 32, 0, 0,
+	0, 0, 0, 0, 31, 0, 0, 33, 50, 51,
+	52, 71, 72, 73, 125, 74, 75, 76, 0, 0,
+	59, 60, 61, 62, 77, 78, 63, 64, 65, 66,
+	67, 68, 69, 70, 79, 80, 84, 117, 126, 116,
+	26, 27, 28, 0, 0, 0, 0, 0, 0, 0,
+	57, 0, 0, 113, 114, 123, 91, 21, 20, 0,
+	86, 87, 0, 0, 0, 0, 0, 35, 0, 0,
+	40, 38, 39, 37, 0, 0, 0, 0, 0, 0,
+	0, 41, 42, 43, 0, 0, 56, 46, 47, 48,
+	53, 54, 0, 0, 0, 0, 0, 0, 0, 71,
+	72, 73, 49, 74, 75, 76, 34, 45, 59, 60,
+	61, 62, 77, 78, 63, 64, 65, 66, 67, 68,
+	69, 70, 79, 80, 84, 81, 82, 83, 26, 27,
+	28, 58, 107, 327, 109, 0, 127, 111, 88, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 106, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 121, 0, 0, 0,
+	122, 0, 0, 0, 128, 0, 0, 0, 58, 0,
+	0, 0, 0, 0, 0, 0, 0, 119, 115, 0,
+	0, 0, 0, 0, 0, 0, 552, 0, 124, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 58, 107, 196, 109,
+	0, 127, 111, 88, 0, 0, 0, 0, 0, 71,
+	72, 73, 125, 74, 75, 76, 106, 0, 59, 60,
+	61, 62, 77, 78, 63, 64, 65, 66, 67, 68,
+	69, 70, 79, 80, 84, 117, 126, 116, 26, 27,
+	28, 0, 58, 0, 0, 0, 0, 0, 0, 0,
+	0, 113, 114, 123, 131, 0, 0, 0, 0, 0,
+	0, 121, 106, 0, 0, 122, 0, 0, 0, 128,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 58,
+	0, 0, 119, 115, 0, 0, 71, 72, 73, 125,
+	74, 75, 76, 124, 0, 59, 60, 61, 62, 77,
+	78, 63, 64, 65, 66, 67, 68, 69, 70, 79,
+	80, 84, 81, 82, 83, 157, 158, 159, 0, 0,
+	0, 0, 0, 0, 71, 72, 73, 125, 74, 75,
+	76, 569, 0, 59, 60, 61, 62, 77, 78, 63,
+	64, 65, 66, 67, 68, 69, 70, 79, 80, 84,
+	117, 126, 116, 26, 27, 28, 58, 0, 382, 0,
+	0, 0, 0, 0, 0, 0, 113, 114, 123, 131,
+	71, 72, 73, 125, 74, 75, 76, 0, 0, 59,
+	60, 61, 62, 77, 78, 63, 64, 65, 66, 67,
+	68, 69, 70, 79, 80, 84, 81, 82, 83, 157,
+	158, 159, 58, 0, 379, 0, 0, 71, 72, 73,
+	125, 74, 75, 76, 0, 596, 59, 60, 61, 62,
+	77, 78, 63, 64, 65, 66, 67, 68, 69, 70,
+	79, 80, 84, 81, 82, 83, 157, 158, 159, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 593, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 71, 72, 73, 125, 74, 75,
+	76, 0, 0, 59, 60, 61, 62, 77, 78, 63,
+	64, 65, 66, 67, 68, 69, 70, 79, 80, 84,
+	81, 82, 83, 157, 158, 159, 229, 241, 240, 228,
+	227, 230, 226, 0, 586, 231, 0, 232, 0, 0,
+	71, 72, 73, 125, 74, 75, 76, 0, 0, 59,
+	60, 61, 62, 77, 78, 63, 64, 65, 66, 67,
+	68, 69, 70, 79, 80, 84, 81, 82, 83, 157,
+	158, 159, 229, 241, 240, 228, 227, 230, 226, 0,
+	585, 231, 0, 232, 0, 0, 0, 222, 0, 0,
+	229, 241, 240, 228, 227, 230, 226, 0, 1117, 231,
+	0, 232, 0, 0, 0, 0, 0, 0, 229, 241,
+	240, 228, 227, 230, 226, 0, 1092, 231, 0, 232,
+	224, 223, 233, 0, 0, 0, 0, 0, 237, 225,
+	236, 235, 0, 222, 1078, 238, 239, 321, 0, 0,
+	0, 0, 0, 0, 229, 241, 240, 228, 227, 230,
+	226, 222, 0, 231, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 224, 223, 233, 222,
+	0, 0, 1050, 0, 237, 225, 236, 235, 0, 0,
+	0, 238, 239, 0, 224, 223, 233, 0, 0, 0,
+	0, 0, 237, 225, 236, 235, 0, 0, 0, 238,
+	239, 0, 224, 223, 233, 222, 0, 0, 0, 0,
+	237, 225, 236, 235, 0, 0, 0, 238, 239, 229,
+	241, 240, 228, 227, 230, 226, 0, 0, 231, 0,
+	232, 0, 0, 0, 0, 0, 0, 0, 224, 223,
+	233, 0, 0, 0, 0, 1046, 237, 225, 236, 235,
+	0, 0, 0, 238, 239, 229, 241, 240, 228, 227,
+	230, 226, 0, 0, 231, 0, 232, 58, 107, 108,
+	109, 0, 127, 111, 0, 0, 0, 0, 0, 0,
+	222, 925, 229, 241, 240, 228, 227, 230, 226, 0,
+	0, 231, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 763, 0,
+	0, 0, 0, 224, 223, 233, 222, 0, 0, 0,
+	0, 237, 225, 236, 235, 0, 0, 0, 238, 239,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	128, 0, 0, 222, 0, 0, 0, 0, 0, 224,
+	223, 233, 0, 0, 0, 0, 0, 237, 225, 236,
+	235, 0, 0, 0, 238, 239, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 224, 223, 233, 0,
+	0, 0, 0, 0, 237, 225, 236, 235, 0, 0,
+	0, 238, 239, 0, 0, 71, 72, 73, 125, 74,
+	75, 76, 0, 0, 59, 60, 61, 62, 77, 78,
+	63, 64, 65, 66, 67, 68, 69, 70, 79, 80,
+	84, 81, 82, 83, 157, 158, 159, 229, 241, 240,
+	228, 227, 230, 226, 0, 0, 231, 0, 232, 229,
+	241, 240, 228, 227, 230, 226, 0, 0, 231, 0,
+	232, 0, 0, 760, 0, 0, 0, 229, 241, 240,
+	228, 227, 230, 226, 0, 649, 231, 0, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 528, 0, 0, 0, 0, 222, 0,
+	0, 0, 0, 229, 241, 240, 228, 227, 230, 226,
+	222, 0, 231, 0, 232, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 222, 488,
+	0, 224, 223, 233, 0, 0, 0, 0, 0, 237,
+	225, 236, 235, 224, 223, 233, 238, 239, 0, 0,
+	0, 237, 225, 236, 235, 0, 0, 0, 238, 239,
+	0, 224, 223, 233, 222, 0, 0, 0, 0, 237,
+	225, 236, 235, 0, 0, 0, 238, 239, 0, 0,
+	0, 229, 241, 240, 228, 227, 230, 226, 0, 0,
+	231, 0, 232, 0, 0, 0, 0, 224, 223, 233,
+	0, 0, 0, 0, 0, 237, 225, 236, 235, 332,
+	0, 0, 238, 239, 0, 0, 0, 229, 241, 240,
+	228, 227, 230, 226, 0, 0, 231, 0, 232, 0,
+	0, 0, 0, 0, 0, 229, 241, 240, 228, 227,
+	230, 226, 222, 221, 231, 0, 232, 229, 765, 240,
+	228, 227, 230, 226, 0, 0, 231, 0, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 224, 223, 233, 222, 0,
+	0, 0, 0, 237, 225, 236, 235, 0, 0, 0,
+	238, 239, 0, 0, 0, 0, 222, 0, 229, 635,
+	240, 228, 227, 230, 226, 0, 0, 231, 222, 232,
+	0, 224, 223, 233, 0, 0, 0, 0, 0, 237,
+	225, 236, 235, 0, 0, 0, 238, 239, 0, 224,
+	223, 233, 0, 0, 0, 0, 0, 237, 225, 236,
+	235, 224, 223, 233, 238, 239, 0, 0, 58, 237,
+	225, 236, 235, 0, 0, 0, 238, 239, 275, 222,
+	229, 241, 0, 228, 227, 230, 226, 0, 190, 231,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	58, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 224, 223, 233, 0, 0, 0, 0, 0,
+	237, 225, 236, 235, 700, 0, 0, 238, 239, 0,
+	0, 0, 58, 0, 0, 0, 0, 0, 0, 0,
+	0, 222, 0, 0, 0, 0, 0, 0, 0, 0,
+	897, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 58, 0, 0, 0,
+	0, 0, 0, 0, 224, 223, 233, 0, 0, 0,
+	0, 0, 237, 225, 236, 235, 106, 0, 0, 238,
+	239, 0, 0, 0, 0, 0, 71, 72, 73, 125,
+	74, 75, 76, 0, 0, 59, 60, 61, 62, 77,
+	78, 63, 64, 65, 66, 67, 68, 69, 70, 79,
+	80, 84, 81, 82, 83, 157, 158, 159, 71, 72,
+	73, 125, 74, 75, 76, 0, 0, 59, 60, 61,
+	62, 77, 78, 63, 64, 65, 66, 67, 68, 69,
+	70, 79, 80, 84, 81, 82, 83, 157, 158, 159,
+	71, 72, 73, 125, 74, 75, 76, 0, 0, 59,
+	60, 61, 62, 77, 78, 63, 64, 65, 66, 67,
+	68, 69, 70, 79, 80, 84, 81, 82, 83, 157,
+	158, 159, 58, 464, 71, 72, 73, 125, 74, 75,
+	76, 0, 0, 59, 60, 61, 62, 77, 78, 63,
+	64, 65, 66, 67, 68, 69, 70, 79, 80, 84,
+	81, 82, 83, 157, 158, 159, 58, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 571, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 58, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 680, 680, 9, 0, 0, 0, 0, 0, 0,
-	0, 0, 165, 174, 173, 164, 163, 166, 162, 0,
-	580, 0, 0, 0, 0, 415, 0, 581, 0, 0,
-	0, 0, 0, 0, 0, 177, 680, 0, 0, 0,
-	0, 0, 411, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 598, 0, 0, 0, 608, 609, 610, 612,
-	194, 455, 0, 0, 0, 605, 0, 0, 462, 0,
-	464, 0, 0, 0, 680, 0, 0, 797, 0, 0,
-	0, 0, 680, 160, 159, 0, 622, 0, 0, 170,
-	161, 169, 168, 0, 632, 271, 171, 172, 272, 0,
-	0, 638, 0, 0, 0, 0, 680, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
-	0, 177, 0, 177, 0, 0, 0, 0, 0, 0,
-	0, 260, 0, 680, 0, 0, 0, 680, 0, 797,
-	0, 0, 797, 797, 0, 111, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 260, 260, 797, 0, 165,
-	174, 173, 164, 163, 166, 162, 680, 0, 0, 0,
-	797, 0, 509, 0, 0, 311, 0, 0, 311, 0,
-	0, 0, 0, 797, 0, 0, 0, 797, 706, 165,
-	174, 173, 164, 163, 166, 162, 0, 0, 510, 0,
-	0, 0, 0, 0, 0, 0, 0, 575, 0, 0,
-	0, 0, 797, 724, 102, 0, 0, 726, 729, 0,
-	0, 0, 260, 0, 0, 736, 0, 0, 260, 260,
-	160, 159, 0, 0, 0, 0, 170, 161, 169, 168,
-	744, 0, 0, 171, 172, 272, 0, 0, 0, 0,
-	0, 0, 0, 260, 395, 397, 399, 0, 0, 0,
-	160, 159, 0, 0, 0, 0, 170, 161, 169, 168,
-	0, 0, 0, 171, 172, 0, 311, 0, 311, 0,
-	0, 0, 111, 0, 111, 111, 0, 0, 0, 0,
-	44, 80, 81, 82, 794, 99, 84, 61, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 190, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	79, 0, 0, 0, 0, 0, 0, 0, 0, 654,
+	58, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 552, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	827, 102, 0, 0, 0, 0, 0, 0, 0, 0,
-	411, 674, 0, 0, 0, 0, 0, 0, 0, 94,
-	677, 0, 839, 95, 0, 0, 0, 100, 0, 0,
-	260, 0, 260, 0, 260, 0, 92, 88, 0, 0,
-	0, 0, 0, 0, 0, 150, 97, 858, 0, 260,
-	44, 80, 81, 82, 0, 99, 84, 61, 0, 0,
-	0, 0, 0, 0, 0, 311, 0, 0, 0, 0,
-	79, 0, 889, 0, 149, 0, 45, 46, 47, 48,
-	52, 49, 50, 51, 59, 90, 98, 89, 56, 57,
-	58, 0, 0, 0, 0, 0, 0, 741, 0, 86,
-	87, 96, 103, 0, 0, 0, 0, 0, 0, 94,
-	0, 0, 0, 95, 0, 0, 0, 100, 0, 0,
-	0, 0, 0, 0, 0, 0, 92, 88, 0, 0,
-	0, 0, 0, 260, 0, 0, 97, 44, 80, 81,
-	82, 0, 99, 84, 61, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 79, 311, 311,
-	0, 0, 0, 0, 0, 0, 45, 46, 47, 48,
-	52, 49, 50, 51, 59, 90, 98, 89, 56, 57,
-	58, 0, 0, 0, 0, 0, 0, 326, 0, 86,
-	87, 96, 103, 0, 0, 0, 94, 0, 0, 0,
-	95, 0, 0, 0, 100, 217, 0, 0, 0, 0,
-	0, 0, 0, 92, 88, 0, 0, 0, 0, 0,
-	260, 0, 0, 97, 44, 80, 81, 82, 0, 99,
-	84, 61, 0, 0, 0, 0, 0, 311, 311, 311,
-	0, 0, 0, 0, 79, 0, 0, 0, 0, 0,
-	0, 0, 0, 45, 46, 47, 48, 52, 49, 50,
-	51, 59, 90, 98, 89, 56, 57, 58, 0, 0,
-	0, 0, 0, 0, 0, 0, 86, 87, 96, 103,
-	0, 0, 0, 94, 0, 0, 0, 95, 0, 260,
-	0, 100, 0, 0, 0, 0, 0, 0, 311, 0,
-	92, 88, 0, 0, 0, 0, 0, 0, 0, 0,
-	97, 44, 80, 81, 82, 0, 99, 84, 61, 0,
+	71, 72, 73, 125, 74, 75, 76, 0, 0, 59,
+	60, 61, 62, 77, 78, 63, 64, 65, 66, 67,
+	68, 69, 70, 79, 80, 84, 81, 82, 83, 157,
+	158, 159, 0, 0, 71, 72, 73, 125, 74, 75,
+	76, 58, 0, 59, 60, 61, 62, 77, 78, 63,
+	64, 65, 66, 67, 68, 69, 70, 79, 80, 84,
+	81, 82, 83, 157, 158, 159, 71, 72, 73, 125,
+	74, 75, 76, 58, 0, 59, 60, 61, 62, 77,
+	78, 63, 64, 65, 66, 67, 68, 69, 70, 79,
+	80, 84, 81, 82, 83, 157, 158, 159, 71, 72,
+	73, 125, 74, 75, 76, 0, 0, 59, 60, 61,
+	62, 77, 78, 63, 64, 65, 66, 67, 68, 69,
+	70, 79, 80, 84, 81, 82, 83, 157, 158, 159,
+	58, 0, 382, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 79, 0, 0, 0, 0, 0, 0, 0, 0,
-	45, 46, 47, 48, 52, 49, 50, 51, 59, 329,
-	330, 328, 331, 332, 333, 0, 0, 0, 0, 0,
-	0, 326, 0, 86, 87, 96, 103, 0, 0, 0,
-	94, 0, 0, 0, 95, 0, 0, 0, 100, 0,
-	43, 0, 0, 0, 0, 0, 0, 92, 88, 0,
-	0, 0, 0, 0, 0, 0, 0, 97, 44, 80,
-	81, 82, 0, 99, 84, 61, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 79, 0,
-	0, 0, 0, 0, 0, 0, 0, 45, 46, 47,
-	48, 52, 49, 50, 51, 59, 90, 98, 89, 56,
-	57, 58, 0, 0, 0, 0, 0, 0, 0, 0,
-	86, 87, 96, 103, 0, 0, 0, 94, 0, 0,
-	0, 95, 0, 0, 0, 100, 0, 0, 0, 0,
-	0, 0, 0, 0, 92, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 97, 44, 80, 81, 82, 0,
-	99, 84, 61, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 79, 0, 0, 0, 0,
-	0, 0, 0, 0, 45, 46, 47, 48, 52, 49,
-	50, 51, 59, 90, 98, 89, 56, 57, 58, 0,
-	0, 0, 0, 0, 0, 0, 0, 86, 87, 96,
-	103, 0, 0, 0, 94, 0, 0, 0, 95, 0,
-	0, 0, 100, 0, 0, 0, 0, 0, 0, 0,
-	0, 92, 88, 0, 0, 0, 0, 0, 0, 0,
-	0, 97, 44, 80, 81, 82, 0, 99, 84, 61,
+	0, 0, 0, 316, 0, 0, 0, 0, 0, 0,
+	295, 0, 58, 0, 379, 0, 0, 0, 0, 71,
+	72, 73, 125, 74, 75, 76, 0, 0, 59, 60,
+	61, 62, 77, 78, 63, 64, 65, 66, 67, 68,
+	69, 70, 79, 80, 84, 81, 82, 83, 157, 158,
+	159, 71, 72, 73, 125, 74, 75, 76, 0, 0,
+	59, 60, 61, 62, 77, 78, 63, 64, 65, 66,
+	67, 68, 69, 70, 79, 80, 84, 81, 82, 83,
+	157, 158, 159, 58, 0, 0, 0, 0, 0, 0,
+	88, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 71, 72,
+	73, 125, 74, 75, 76, 58, 0, 59, 60, 61,
+	62, 77, 78, 63, 64, 65, 66, 67, 68, 69,
+	70, 79, 80, 84, 81, 82, 83, 157, 158, 159,
+	71, 72, 73, 125, 74, 75, 76, 0, 0, 59,
+	60, 61, 62, 77, 78, 63, 64, 65, 66, 67,
+	68, 69, 70, 79, 80, 84, 81, 82, 83, 157,
+	158, 159, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 79, 0, 0, 0, 0, 0, 0, 0,
-	0, 45, 46, 47, 48, 52, 49, 50, 51, 59,
-	329, 330, 328, 331, 332, 333, 0, 0, 0, 0,
-	0, 0, 0, 0, 86, 87, 96, 103, 0, 0,
-	0, 94, 0, 0, 0, 95, 0, 44, 0, 100,
-	0, 0, 0, 0, 0, 0, 0, 209, 92, 88,
-	0, 0, 0, 0, 0, 0, 0, 208, 97, 44,
-	80, 239, 82, 0, 99, 84, 61, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 79,
-	0, 0, 0, 0, 0, 0, 0, 0, 45, 46,
-	47, 48, 52, 49, 50, 51, 59, 90, 98, 89,
-	56, 57, 58, 0, 0, 0, 0, 0, 0, 0,
-	0, 86, 87, 96, 64, 0, 0, 0, 94, 0,
-	0, 0, 95, 0, 0, 0, 100, 44, 0, 0,
-	0, 0, 0, 0, 61, 92, 88, 0, 0, 35,
-	0, 0, 0, 0, 0, 97, 0, 0, 0, 24,
-	0, 0, 25, 45, 46, 47, 48, 52, 49, 50,
-	51, 59, 53, 54, 55, 56, 57, 58, 0, 0,
-	0, 44, 0, 0, 0, 45, 46, 47, 48, 52,
-	49, 50, 51, 59, 90, 98, 89, 56, 57, 58,
-	309, 208, 0, 0, 0, 0, 43, 0, 86, 87,
-	96, 103, 0, 800, 799, 0, 686, 0, 0, 0,
-	0, 0, 27, 0, 0, 32, 30, 31, 29, 0,
-	0, 0, 0, 0, 0, 0, 33, 34, 362, 363,
-	0, 37, 38, 39, 40, 0, 0, 0, 687, 0,
-	43, 26, 36, 45, 46, 47, 48, 52, 49, 50,
-	51, 59, 53, 54, 55, 56, 57, 58, 44, 0,
-	0, 0, 0, 0, 0, 61, 0, 0, 0, 0,
-	35, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	24, 0, 0, 25, 0, 0, 0, 45, 46, 47,
-	48, 52, 49, 50, 51, 59, 53, 54, 55, 56,
-	57, 58, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 310, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 43, 0, 44,
-	0, 0, 0, 0, 358, 357, 61, 41, 0, 0,
-	0, 35, 0, 27, 0, 0, 32, 30, 31, 29,
-	0, 24, 0, 0, 25, 0, 0, 33, 34, 362,
-	363, 42, 37, 38, 39, 40, 0, 0, 0, 0,
-	0, 0, 26, 36, 45, 46, 47, 48, 52, 49,
-	50, 51, 59, 53, 54, 55, 56, 57, 58, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 43, 0,
-	44, 0, 0, 0, 0, 683, 682, 61, 686, 0,
-	0, 0, 35, 0, 27, 0, 0, 32, 30, 31,
-	29, 0, 24, 0, 0, 25, 0, 0, 33, 34,
-	0, 0, 0, 37, 38, 39, 40, 0, 0, 0,
-	687, 0, 0, 26, 36, 45, 46, 47, 48, 52,
-	49, 50, 51, 59, 53, 54, 55, 56, 57, 58,
-	0, 165, 174, 173, 164, 163, 166, 162, 0, 43,
-	0, 0, 0, 0, 0, 0, 19, 18, 0, 41,
-	0, 0, 0, 0, 0, 27, 0, 0, 32, 30,
-	31, 29, 165, 174, 173, 164, 163, 166, 162, 33,
-	34, 0, 0, 42, 37, 38, 39, 40, 0, 0,
-	0, 0, 0, 899, 26, 36, 45, 46, 47, 48,
-	52, 49, 50, 51, 59, 53, 54, 55, 56, 57,
-	58, 0, 160, 159, 0, 0, 0, 0, 170, 161,
-	169, 168, 0, 0, 0, 171, 172, 237, 0, 0,
-	0, 0, 165, 174, 173, 164, 163, 166, 162, 0,
-	0, 0, 0, 160, 159, 0, 0, 0, 0, 170,
-	161, 169, 168, 874, 0, 0, 171, 172, 165, 174,
-	173, 164, 163, 166, 162, 0, 0, 0, 165, 174,
-	173, 164, 163, 166, 162, 0, 0, 0, 0, 853,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 844,
-	0, 0, 165, 174, 173, 164, 163, 166, 162, 0,
-	0, 0, 0, 160, 159, 0, 0, 0, 0, 170,
-	161, 169, 168, 824, 0, 0, 171, 172, 0, 0,
-	165, 174, 173, 164, 163, 166, 162, 0, 0, 160,
-	159, 0, 0, 0, 0, 170, 161, 169, 168, 160,
-	159, 772, 171, 172, 0, 170, 161, 169, 168, 0,
-	0, 0, 171, 172, 44, 165, 174, 173, 164, 163,
-	166, 162, 0, 160, 159, 0, 0, 0, 0, 170,
-	161, 169, 168, 309, 208, 0, 171, 172, 764, 0,
-	165, 174, 173, 164, 163, 166, 162, 0, 0, 0,
-	0, 160, 159, 0, 0, 0, 0, 170, 161, 169,
-	168, 761, 0, 0, 171, 172, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 165, 174, 173, 164, 163,
-	166, 162, 0, 0, 0, 0, 160, 159, 0, 0,
-	0, 0, 170, 161, 169, 168, 697, 0, 0, 171,
-	172, 165, 174, 173, 164, 163, 166, 162, 0, 0,
-	0, 160, 159, 0, 0, 0, 0, 170, 161, 169,
-	168, 284, 0, 0, 171, 172, 0, 0, 0, 0,
-	45, 46, 47, 48, 52, 49, 50, 51, 59, 53,
-	54, 55, 56, 57, 58, 0, 160, 159, 0, 0,
-	0, 0, 170, 161, 169, 168, 310, 0, 0, 171,
-	172, 165, 174, 173, 164, 163, 166, 162, 0, 0,
-	0, 0, 160, 159, 0, 0, 0, 0, 170, 161,
-	169, 168, 579, 0, 0, 171, 172, 165, 174, 173,
-	164, 163, 166, 162, 0, 0, 0, 165, 174, 173,
-	164, 163, 166, 162, 0, 0, 0, 0, 568, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 496, 0,
-	0, 165, 174, 173, 164, 163, 166, 162, 0, 0,
-	0, 0, 160, 159, 0, 0, 0, 0, 170, 161,
-	169, 168, 404, 0, 0, 171, 172, 165, 174, 173,
-	164, 163, 166, 162, 0, 0, 0, 0, 160, 159,
-	0, 0, 0, 0, 170, 161, 169, 168, 160, 159,
-	240, 171, 172, 0, 170, 161, 169, 168, 0, 0,
-	0, 171, 172, 165, 174, 173, 164, 163, 166, 162,
-	0, 0, 160, 159, 0, 0, 0, 0, 170, 161,
-	169, 168, 0, 44, 158, 171, 172, 165, 174, 173,
-	164, 163, 166, 162, 0, 0, 0, 0, 160, 159,
-	0, 0, 0, 79, 170, 161, 169, 168, 0, 0,
-	0, 171, 172, 165, 489, 173, 164, 163, 166, 162,
-	44, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 160, 159, 0, 0, 0, 0,
-	170, 161, 169, 168, 0, 0, 0, 171, 172, 165,
-	379, 173, 164, 163, 166, 162, 44, 0, 160, 159,
-	0, 0, 0, 0, 170, 161, 169, 168, 0, 0,
-	0, 171, 172, 0, 0, 0, 79, 44, 0, 0,
-	0, 0, 0, 0, 160, 159, 0, 0, 0, 0,
-	170, 161, 169, 168, 0, 434, 0, 171, 172, 45,
-	46, 47, 48, 52, 49, 50, 51, 59, 53, 54,
-	55, 56, 57, 58, 0, 0, 0, 0, 0, 0,
-	160, 159, 0, 0, 0, 460, 170, 161, 169, 168,
-	0, 0, 0, 171, 172, 44, 45, 46, 47, 48,
-	52, 49, 50, 51, 59, 53, 54, 55, 56, 57,
-	58, 165, 174, 417, 164, 163, 166, 162, 0, 0,
-	0, 0, 457, 0, 0, 0, 0, 44, 0, 0,
-	0, 0, 45, 46, 47, 48, 52, 49, 50, 51,
-	59, 53, 54, 55, 56, 57, 58, 208, 44, 0,
-	280, 0, 0, 45, 46, 47, 48, 52, 49, 50,
-	51, 59, 53, 54, 55, 56, 57, 58, 44, 0,
-	277, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 160, 159, 0, 44, 0, 0, 170, 161,
-	169, 168, 61, 0, 0, 171, 172, 0, 0, 0,
-	44, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 45, 46, 47, 48, 52, 49, 50, 51, 59,
-	53, 54, 55, 56, 57, 58, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 45, 46, 47, 48, 52, 49, 50,
-	51, 59, 53, 54, 55, 56, 57, 58, 0, 0,
-	0, 0, 0, 0, 45, 46, 47, 48, 52, 49,
-	50, 51, 59, 53, 54, 55, 56, 57, 58, 0,
-	0, 0, 0, 0, 45, 46, 47, 48, 52, 49,
-	50, 51, 59, 53, 54, 55, 56, 57, 58, 0,
-	0, 45, 46, 47, 48, 52, 49, 50, 51, 59,
-	53, 54, 55, 56, 57, 58, 45, 46, 47, 48,
-	52, 49, 50, 51, 59, 53, 54, 55, 56, 57,
-	58,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 71, 72, 156, 125, 74, 75, 76, 0, 0,
+	59, 60, 61, 62, 77, 78, 63, 64, 65, 66,
+	67, 68, 69, 70, 79, 80, 84, 81, 82, 83,
+	157, 158, 159, 71, 72, 73, 125, 74, 75, 76,
+	0, 0, 59, 60, 61, 62, 77, 78, 63, 64,
+	65, 66, 67, 68, 69, 70, 79, 80, 84, 81,
+	82, 83, 157, 158, 159,
 }
-var yyPact = [...]int{
 
-	2646, -1000, 177, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2218, 2044,
-	-1000, -1000, 175, 160, 719, 707, 782, 3451, -1000, 366,
-	3466, 3466, 513, -1000, -1000, 780, 379, 2044, 2044, 2044,
-	196, 1606, 789, 728, -1000, -1000, -1000, -1000, -1000, -1000,
+var yyPact = [...]int{
+	3687, -1000, 286, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	3599, 3369, -1000, -1000, 194, 161, 269, 267, 264, 1012,
+	1008, 1005, 1002, 1098, 1109, 5579, -1000, 549, 5611, 5611,
+	548, -1000, 991, 5611, 1105, 566, 3369, 3369, 3369, 374,
+	5284, 5284, 764, 304, 3962, -1000, 1115, 1019, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	186, -1000, 2646, 3129, 1957, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 186, -1000, -1000, -47, -69,
-	-1000, -1000, -1000, -1000, -1000, -1000, 2044, 2044, 155, 153,
-	151, -1000, 2044, 240, 146, 2044, 2044, 3466, 145, -1000,
-	-1000, 442, 3153, 1957, 688, 759, 3393, 2283, 772, 627,
-	541, -1000, 533, 2044, 3466, 3393, -1000, -1, 185, -1000,
-	375, -1000, 3466, 3466, 3466, -1000, -1000, 3466, -1000, -1000,
-	-1000, -1000, 2044, 2044, 167, -1000, -1000, -1000, -1000, -1000,
-	776, 3153, 2647, 3153, 2305, 3093, 23, 586, 782, -1000,
-	-1000, -1000, -1000, -6, 3466, -1000, 2044, -1000, 2646, 2044,
-	2044, 2044, 550, 2044, 560, 123, 2044, 614, 2044, 2044,
-	2044, 2044, 2044, 2044, 2044, 1268, 87, 92, 91, 219,
-	3434, 1783, 3414, -1000, -1000, 2044, 541, 541, 443, 123,
-	123, 562, 611, -1000, -1000, 1056, -1000, 259, 541, 432,
-	2044, 87, 648, 678, 3393, 768, -13, -1000, -1000, 2920,
-	773, 764, 2920, 583, 583, 583, 1870, -1000, 82, -1000,
-	1415, 211, 703, 782, 2044, 309, 208, 144, 143, -1000,
-	-1000, -1000, 757, 3153, 3153, 704, 3466, 2044, 3153, 2044,
-	2504, 3466, 782, 3466, 25, 579, 728, 206, 3153, 431,
-	4, -48, -48, 612, 3215, 2044, 123, 2044, -1000, 1957,
-	-1000, -48, 123, 123, -39, -39, -1000, -1000, -1000, 3317,
-	1056, -1000, 2044, -1000, -1000, -1000, -1000, -1000, 2044, -1000,
-	-1000, 2044, 1696, 430, 2044, -1000, -1000, 123, 142, 140,
-	138, 550, -1000, 2044, 372, 2646, 3067, 646, 2044, 2131,
-	130, 3361, 3282, 3393, 764, 26, -1000, 3303, -1000, -1000,
-	2417, -1000, 2920, 683, 2044, -1000, 219, -1000, 219, 219,
-	-1000, -14, 754, -1000, 3153, -1000, -1000, -65, 134, 133,
-	132, 131, 129, 128, -1000, 3466, 533, -1000, 3246, 3209,
-	3282, -1000, 3153, 533, 3466, 533, 100, 3466, 782, -1000,
-	-1000, -1000, 3153, 371, 176, -1000, -1000, 2218, 2044, -1000,
-	-1000, -1000, -1000, -1000, 405, -1000, -16, 397, 3466, 3466,
-	-1000, 126, 3466, 369, 429, 2646, 2044, -1000, -1000, 2044,
-	3179, -1000, -48, -1000, -1000, -1000, 80, 79, 78, 77,
-	367, 2044, 3043, 575, 99, -1000, 99, -1000, 99, -1000,
-	335, 76, 501, -1000, 2646, -1000, 2044, 1445, -1000, -18,
-	663, 3153, -1000, 123, 3282, -1000, -1000, 3466, 772, -19,
-	104, -77, -1000, -1000, 643, 640, 617, 617, 650, 2920,
-	-1000, -1000, -1000, -1000, 3466, 89, 764, 680, 675, 3153,
-	592, -1000, -1000, 592, 1870, 3466, 1783, 541, 541, 541,
-	2044, 2044, 2044, 72, -23, -1000, 809, 3466, 701, -1000,
-	3282, 696, -1000, 71, -1000, 749, 69, -28, -1000, -1000,
-	-30, 699, -44, -1000, 454, 2504, 3033, 438, 2504, 2504,
-	384, 381, 533, 68, 487, 365, -1000, 3007, 1056, 2044,
-	-1000, -1000, -1000, -1000, -1000, 3153, 2044, 123, 67, -36,
-	65, 62, -1000, 531, 220, -1000, 442, 3153, -1000, 534,
-	215, 2131, 212, -1000, -1000, -1000, 61, -37, -1000, 764,
-	3282, 2044, 2920, 2920, 633, -1000, 632, 622, 617, -1000,
-	-1000, -1000, -1000, -1000, 2044, 2044, -1000, -1000, 60, 2044,
-	2044, 1696, 2044, 59, 57, 54, 742, 3466, -1000, -1000,
-	-1000, 3282, 3282, 53, -38, 2044, 52, 3466, 741, 235,
-	739, 782, 782, 2044, 737, 782, -1000, -1000, 2504, 428,
-	2044, 362, 355, 2504, 2504, 51, 736, -1000, 483, 2646,
-	1056, 2947, -1000, -1000, 123, -1000, -1000, -1000, 682, -1000,
-	-1000, -1000, -1000, 720, 595, 3282, -1000, -1000, 3153, 650,
-	691, 2920, 2920, 2920, 613, 3153, -1000, 292, 50, 48,
-	44, 42, 40, 291, 276, 244, 533, -1000, -1000, -1000,
-	809, 3466, 3153, -1000, -1000, 533, 2575, 233, -1000, -1000,
-	-1000, 699, 3153, 229, 38, 403, 354, 2504, 2921, 453,
-	451, 353, 348, -1000, 125, -1000, 467, -1000, -1000, 124,
-	-1000, -1000, -1000, 123, -1000, -1000, -1000, 2044, 122, 691,
-	878, 650, 2920, 121, 284, 283, 264, 254, 242, 119,
-	118, 210, 116, 207, -1000, -1000, -1000, -1000, 346, 174,
-	-1000, -1000, 2218, 2044, -1000, -1000, 2044, 2044, 2575, 2575,
-	735, 345, 421, 2504, 2044, 499, -1000, 2504, -1000, -1000,
-	450, 449, 533, -1000, 688, -1000, 3153, 3466, -1000, 2044,
-	650, 294, 113, 111, 110, 109, 108, 294, 294, 253,
-	294, 248, -1000, 2575, 2886, 436, 2861, 20, 578, 3153,
-	344, 343, 224, 481, 333, -1000, 2826, -1000, 438, -1000,
-	-1000, 37, 35, 33, 3153, 32, -1000, 689, 670, 294,
-	294, 294, 294, 294, 27, 688, 24, 107, 22, 106,
-	-1000, 2575, 420, 2044, 2373, 3466, 3466, -1000, -1000, 2575,
-	-1000, 478, 2504, -1000, -1000, -1000, -1000, -1000, -1000, 655,
-	2044, 19, 17, 16, 15, 14, -1000, -1000, 294, -1000,
-	294, 387, 331, 2575, 2798, 329, 170, -1000, -1000, 2218,
-	2044, -1000, -1000, -1000, 377, 376, 328, -1000, 465, 2131,
-	-1000, -1000, -1000, -1000, -1000, -1000, 11, 3, 326, 413,
-	2575, 2044, 498, -1000, 2575, 448, 2373, 2774, 435, 2373,
-	2373, -1000, -1000, 198, -1000, -1000, 476, 324, -1000, 2764,
-	-1000, 436, -1000, -1000, 2373, 412, 2044, 323, 321, -1000,
-	571, -1000, 475, 2575, -1000, 380, 318, 2373, 2738, 447,
-	446, -1000, 584, 521, 519, 508, -1000, 464, 315, 411,
-	2373, 2044, 492, -1000, 2373, -1000, -1000, 569, 518, -1000,
-	512, 504, -1000, -1000, -1000, -1000, 474, 314, -1000, 2678,
-	-1000, 435, 580, -1000, -1000, -1000, -1000, -1000, 470, 2373,
-	-1000, -1000, 516, -1000, -1000, 461, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 292, 2775, 2500, -1000, 3687,
+	4797, 3121, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 292, -1000, -1000, -75, -78, -1000, -1000, -1000,
+	-1000, -1000, -1000, 3369, 3369, 261, 259, 245, -1000, 3369,
+	364, 244, 3369, 3369, 5611, -1000, 243, -1000, -1000, 638,
+	4815, 3121, 952, 780, 1065, 5284, 4994, 1080, 892, -1000,
+	764, 645, 2891, 3369, 3369, 3369, 5419, 5284, 5284, 5284,
+	5284, -1000, -21, 290, -1000, 739, 471, -1000, -1000, -1000,
+	-1000, 5611, 5611, 5611, -1000, -1000, 5611, -1000, -1000, -1000,
+	-1000, 3369, 3369, 5387, -1000, 275, -1000, 796, -1000, -1000,
+	-1000, -1000, 1096, 1092, 4815, 4216, 4815, 3369, 990, -1000,
+	-1000, 340, -1000, 173, 3847, 4815, 3369, -1000, -1000, -24,
+	5611, -1000, 3369, 4761, 24, 822, 1109, -1000, -1000, 561,
+	285, -1000, -1000, 3599, 3369, -1000, -1000, -1000, 5611, 5611,
+	-1000, 3687, 384, 3369, 3369, 3369, 805, 785, 833, 183,
+	3369, 3369, 979, 3369, 834, 3369, 3369, 3369, 3369, 3369,
+	3369, 3369, 123, 148, 150, 149, 5508, 2660, 5476, -1000,
+	-1000, 3369, 780, 780, 639, 183, 183, 800, 832, -1000,
+	-1000, 1323, -1000, 388, 780, 610, 3369, 148, 917, 936,
+	3006, -1000, 5284, 1074, -25, 2258, 1089, 1068, 2258, 842,
+	842, 842, -1000, 146, 145, -1000, 404, 2045, -1000, -76,
+	-79, 144, 141, 140, 307, 814, -1000, 978, 982, -1000,
+	1109, 3369, 469, 474, 367, 294, 236, 235, 5218, -1000,
+	-1000, -1000, 1064, 4815, 4815, -1000, 5611, 932, 3369, 5611,
+	5611, 3369, 4815, 3369, 5284, 780, 4815, 3369, 4815, 1019,
+	237, 4815, 2500, 5611, 1109, 5611, 40, 820, 651, 2500,
+	4683, 634, -1000, -1000, 602, 381, -48, 260, 260, 875,
+	3369, -1000, 785, 183, 3369, 3369, 976, -1000, 3121, -1000,
+	680, 489, 3369, 260, 183, 183, 8, 8, 386, 386,
+	386, 4940, 1323, -1000, 3369, -1000, -1000, -1000, -1000, -1000,
+	3369, -1000, -1000, 3369, 2891, 608, 3369, -1000, -1000, 785,
+	234, 233, 231, 805, -1000, 3369, 552, 3687, 4647, 896,
+	3369, 3484, 1060, -26, 1055, -1000, 4815, -1000, -84, 229,
+	228, 224, 223, 222, 220, 160, 5316, 5092, 5284, 1068,
+	60, -1000, 3924, 5252, -1000, -1000, 2544, -1000, 218, 2258,
+	949, 3369, -1000, 173, -1000, 173, 173, -1000, -1000, 217,
+	216, 4168, 4122, -1000, -1000, -1000, 5611, 764, -1000, 826,
+	5611, 4045, 4008, 5092, -1000, 4815, 764, 465, 473, 5611,
+	764, 122, 5611, 215, 213, 1109, -1000, -1000, 4815, -1000,
+	-1000, -1000, 2168, 338, 3006, 4815, -1000, 211, 5611, 550,
+	583, -1000, -35, 574, 5611, 5611, -1000, -1000, 2500, 607,
+	3369, 542, 606, 3687, 3369, 3369, -1000, -1000, 4878, 3369,
+	-1000, 302, 204, 3369, 3369, 3369, 103, -1000, -1000, -1000,
+	137, 136, 135, 134, 540, 3369, 4629, 183, 198, -1000,
+	198, -1000, 198, -1000, 491, 132, 709, -1000, 3687, 463,
+	3369, 1644, -1000, -36, 939, 4815, -1000, -86, 1109, 3006,
+	5611, 2660, 780, 780, 780, 3369, 3369, 3369, 183, 5092,
+	-1000, -1000, 5611, 1080, -37, 208, -87, -1000, -1000, 909,
+	908, 864, 864, 894, 874, 2258, -1000, -1000, -1000, 5026,
+	210, 5611, 183, 114, 3369, 1068, 943, 934, 4815, 846,
+	-1000, -1000, 846, 5092, 3484, -1000, -1000, 130, -40, -1000,
+	5611, 312, 992, 5611, 996, -1000, 5092, 963, -1000, 764,
+	455, 128, -1000, 327, 127, -46, -1000, -1000, -47, 984,
+	-94, 5611, 5611, -1000, -1000, 5611, 4523, 209, -1000, 764,
+	116, 666, 2500, 2500, 573, 567, 599, 538, 2500, 4617,
+	701, 536, -1000, 4472, -1000, 3369, 4827, 3369, 3369, 81,
+	260, 260, 3369, -1000, -1000, -1000, -1000, -1000, 4815, 3369,
+	817, 115, -53, 113, 112, -1000, 760, 390, -1000, 638,
+	1076, 4815, -1000, 775, 360, 3484, 358, -1000, -1000, 1080,
+	-1000, -1000, 107, 3369, 3369, 2891, 3369, 102, 94, 92,
+	-1000, 91, -60, -1000, 1068, 5092, 3369, 2258, 2258, 906,
+	-1000, 900, 887, 864, 883, 864, -1000, 89, -61, 4523,
+	5611, 5611, 197, 88, -1000, 2811, -1000, -1000, 3369, 3369,
+	87, 86, -66, 1054, 1024, 5611, 196, -1000, -1000, -1000,
+	-1000, 5092, 5092, 85, -70, 3369, 84, 5611, -1000, 764,
+	1048, 1047, -1000, 327, 1109, 1109, 3369, 1040, 1109, 80,
+	-73, 5611, 79, -1000, -1000, -1000, 5611, 74, 1032, -1000,
+	532, 523, 2500, 2500, 522, 601, 2500, 3369, 708, -1000,
+	2500, -1000, 697, 3687, 1323, 3369, 260, 260, 3369, 260,
+	2019, 183, -1000, 183, -1000, -1000, -1000, 950, -1000, -1000,
+	-1000, -1000, -1000, 1009, 1068, 439, 73, 72, 67, 66,
+	64, 438, 410, 405, 821, 5092, -1000, -1000, 4815, 894,
+	1256, 2258, 2258, 2258, 881, 2258, 879, 5058, 5026, -1000,
+	-1000, 5611, 62, 5611, -1000, 774, 4815, -1000, 314, -1000,
+	3484, 5611, 764, -1000, 5611, 764, -1000, -1000, 992, 5611,
+	4815, -1000, -1000, -1000, 764, 417, 1028, -1000, -1000, -1000,
+	984, 4815, 403, 59, -1000, 5611, -1000, -1000, 57, -1000,
+	195, 663, 662, 520, 512, 694, 510, -1000, 4445, -1000,
+	634, -1000, 682, 1323, 260, -1000, -1000, -1000, 193, -1000,
+	-1000, -1000, 949, 190, 435, 434, 432, 420, 406, 189,
+	185, 357, 184, 354, 183, -1000, -1000, -1000, 3369, 180,
+	1256, 1170, 894, 2258, 761, 2258, -1000, 5611, -1000, 1027,
+	-1000, 52, 318, 175, -1000, -1000, -1000, 47, -1000, -1000,
+	-1000, -1000, 3209, 399, 3209, 1026, -1000, -1000, 764, -1000,
+	-1000, 659, 658, -1000, 691, 2500, -1000, -1000, 952, 943,
+	443, 170, 169, 168, 167, 162, 443, 443, 419, 443,
+	418, -1000, 4815, 5611, -1000, 3369, 894, 857, 930, 761,
+	-1000, 3369, -1000, -1000, 3484, 1024, 508, 280, -1000, -1000,
+	3599, 3369, -1000, -1000, 61, -1000, 3369, 3369, 2340, 3209,
+	506, 395, 46, -1000, -1000, -1000, 679, 45, 952, 39,
+	-1000, 956, 443, 443, 443, 443, 443, 38, 952, 32,
+	153, 30, 23, 14, 4815, 3369, 3369, 856, 4815, 12,
+	764, -1000, 3209, 4409, 623, 631, 4815, 4334, 22, 819,
+	505, 279, -1000, -1000, 3599, 3369, -1000, -1000, -1000, 504,
+	-1000, 3209, -1000, -1000, -1000, 917, -1000, -1000, 921, 7,
+	6, 4, 0, -9, -1000, -1000, 443, -1000, 443, -1000,
+	4815, -1000, 3369, -1000, -1000, -1000, 3209, 600, 3369, -1000,
+	2340, 5611, 5611, 647, 2340, 4298, 622, -1000, 503, 896,
+	3484, -1000, -1000, -1000, -1000, -1000, -12, -13, 4815, 595,
+	502, 3209, 4280, 500, 564, 492, -1000, -1000, 2340, 598,
+	3369, -1000, -1000, 343, -1000, -1000, 497, 587, 3209, 3369,
+	707, -1000, 3209, 656, 2340, 2340, 593, 493, 2340, 4262,
+	-1000, 787, 690, 490, -1000, 3404, -1000, 623, -1000, 488,
+	487, 478, 586, 2340, 3369, 704, -1000, 2340, -1000, 795,
+	755, 752, 724, -1000, 688, 3209, -1000, 655, 653, 684,
+	477, -1000, 2926, -1000, 622, 798, 750, -1000, 740, 721,
+	-1000, -1000, -1000, -1000, 676, -1000, -1000, -1000, 681, 2340,
+	-1000, 794, -1000, -1000, -1000, -1000, -1000, -1000, 673, -1000,
+	742, -1000, -1000, -1000,
 }
-var yyPgo = [...]int{
 
-	0, 76, 62, 26, 211, 632, 202, 941, 72, 940,
-	34, 939, 934, 926, 925, 130, 7, 924, 923, 920,
-	919, 917, 914, 58, 19, 24, 913, 911, 36, 910,
-	909, 38, 33, 906, 905, 904, 901, 898, 1044, 71,
-	64, 894, 55, 56, 893, 891, 21, 884, 40, 881,
-	52, 879, 48, 69, 66, 65, 39, 850, 43, 30,
-	23, 13, 877, 873, 872, 868, 1285, 867, 866, 863,
-	862, 836, 423, 860, 859, 49, 20, 50, 14, 857,
-	855, 3, 849, 846, 70, 68, 60, 844, 16, 833,
-	22, 832, 826, 824, 12, 41, 822, 29, 25, 45,
-	17, 46, 818, 817, 816, 42, 814, 27, 51, 10,
-	11, 8, 9, 2, 4, 47, 809, 15, 808, 6,
-	807, 5, 804, 0, 91, 18, 407, 798, 57, 61,
-	59, 54, 44, 53, 67, 797, 37, 401,
+var yyPgo = [...]int{
+	0, 89, 23, 239, 152, 508, 142, 1255, 511, 85,
+	1253, 64, 1252, 1250, 1249, 1246, 17, 140, 46, 1244,
+	1243, 1240, 1234, 1223, 1222, 63, 38, 37, 1217, 41,
+	1216, 59, 1215, 1213, 1212, 1211, 35, 48, 1208, 1206,
+	55, 47, 1205, 1204, 1202, 1201, 1200, 1276, 1199, 80,
+	70, 1198, 56, 58, 24, 15, 25, 14, 57, 11,
+	946, 1194, 74, 50, 81, 79, 109, 943, 60, 1192,
+	159, 33, 13, 1190, 1189, 1188, 1187, 1491, 1178, 1176,
+	1173, 1171, 1406, 1082, 1166, 1165, 7, 26, 22, 34,
+	1163, 1162, 3, 1156, 1153, 78, 82, 69, 1152, 31,
+	1151, 19, 30, 1148, 1147, 27, 1144, 18, 45, 1139,
+	43, 21, 62, 42, 68, 1137, 1136, 1134, 54, 1133,
+	28, 71, 16, 49, 4, 12, 2, 6, 67, 1132,
+	32, 1130, 5, 1129, 8, 1128, 0, 885, 29, 9,
+	1124, 76, 100, 53, 72, 75, 61, 73, 66, 77,
+	1122, 20, 549,
 }
-var yyR1 = [...]int{
 
+var yyR1 = [...]int{
 	0, 1, 1, 1, 2, 2, 3, 3, 4, 4,
 	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
-	5, 5, 5, 6, 6, 7, 7, 8, 8, 8,
-	8, 8, 9, 9, 10, 10, 12, 12, 11, 11,
-	11, 11, 11, 13, 13, 13, 13, 13, 13, 14,
-	14, 15, 15, 15, 16, 16, 17, 17, 18, 18,
-	18, 18, 18, 19, 19, 19, 19, 19, 19, 20,
-	20, 20, 20, 21, 21, 22, 22, 22, 22, 22,
-	22, 22, 22, 23, 23, 24, 24, 25, 25, 25,
-	25, 25, 26, 26, 26, 26, 26, 27, 27, 27,
-	27, 28, 29, 29, 30, 31, 31, 32, 32, 32,
-	33, 33, 33, 33, 33, 34, 34, 34, 34, 34,
-	34, 34, 35, 35, 35, 36, 36, 36, 36, 36,
-	36, 36, 36, 36, 36, 36, 37, 37, 37, 38,
-	39, 39, 39, 39, 40, 40, 41, 42, 42, 43,
-	43, 44, 44, 45, 45, 46, 46, 47, 47, 47,
-	48, 48, 49, 49, 50, 50, 51, 51, 52, 52,
-	53, 53, 53, 53, 53, 53, 54, 55, 56, 56,
-	56, 56, 56, 57, 57, 57, 57, 57, 57, 57,
-	57, 57, 57, 57, 57, 57, 57, 58, 59, 59,
-	60, 60, 61, 61, 62, 62, 63, 63, 64, 64,
-	64, 65, 65, 66, 67, 68, 68, 68, 68, 68,
-	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
-	68, 68, 68, 68, 68, 68, 68, 69, 69, 69,
-	69, 69, 69, 69, 70, 70, 70, 70, 71, 71,
-	72, 72, 73, 73, 73, 73, 73, 74, 74, 75,
-	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
-	76, 77, 77, 78, 78, 79, 79, 80, 80, 80,
-	81, 81, 81, 82, 82, 83, 83, 84, 84, 85,
-	85, 85, 87, 88, 88, 88, 88, 88, 88, 88,
-	89, 89, 89, 89, 89, 89, 90, 90, 91, 91,
-	92, 92, 92, 93, 94, 94, 95, 95, 96, 96,
-	97, 97, 98, 98, 99, 99, 86, 86, 100, 100,
-	101, 101, 102, 102, 102, 102, 103, 104, 105, 105,
-	106, 106, 107, 107, 108, 108, 109, 109, 110, 110,
-	111, 111, 112, 112, 113, 113, 114, 114, 115, 115,
-	116, 116, 117, 117, 118, 118, 119, 119, 120, 120,
-	121, 121, 122, 122, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	124, 125, 125, 126, 127, 127, 128, 128, 129, 129,
-	130, 130, 131, 131, 132, 132, 133, 133, 134, 134,
-	135, 135, 136, 136, 137, 137,
+	5, 5, 5, 5, 5, 6, 6, 6, 6, 7,
+	7, 8, 8, 8, 8, 8, 8, 9, 9, 10,
+	10, 11, 11, 13, 13, 12, 12, 12, 12, 12,
+	14, 14, 14, 14, 14, 14, 15, 15, 16, 16,
+	16, 16, 17, 17, 18, 18, 19, 19, 20, 20,
+	20, 20, 20, 21, 21, 21, 21, 21, 21, 22,
+	22, 22, 22, 23, 23, 23, 23, 23, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 24, 24, 25, 25, 26, 26, 27, 27, 27,
+	27, 27, 32, 32, 32, 32, 32, 32, 32, 33,
+	33, 33, 33, 34, 34, 35, 36, 36, 37, 38,
+	38, 39, 40, 40, 41, 41, 41, 42, 42, 42,
+	42, 42, 43, 43, 43, 43, 43, 43, 43, 44,
+	44, 44, 45, 45, 45, 45, 45, 45, 45, 45,
+	45, 45, 45, 45, 45, 45, 45, 45, 45, 45,
+	30, 30, 31, 31, 46, 46, 46, 46, 46, 46,
+	47, 47, 48, 49, 49, 49, 49, 50, 50, 51,
+	52, 52, 53, 53, 54, 54, 55, 55, 56, 56,
+	57, 57, 57, 58, 58, 59, 59, 60, 60, 61,
+	61, 62, 62, 63, 63, 63, 63, 63, 63, 64,
+	65, 66, 66, 66, 66, 66, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	67, 68, 68, 68, 68, 69, 69, 69, 70, 70,
+	71, 71, 72, 72, 73, 73, 74, 74, 75, 75,
+	75, 76, 76, 77, 78, 79, 79, 79, 79, 79,
+	79, 79, 79, 79, 79, 79, 79, 79, 79, 79,
+	79, 79, 79, 79, 79, 79, 79, 79, 79, 79,
+	79, 79, 79, 79, 79, 79, 79, 79, 80, 80,
+	80, 80, 80, 80, 80, 81, 81, 81, 81, 82,
+	82, 83, 83, 83, 83, 83, 83, 84, 84, 84,
+	84, 84, 85, 85, 86, 86, 86, 86, 86, 86,
+	86, 86, 86, 86, 86, 87, 88, 88, 89, 89,
+	90, 90, 91, 91, 91, 92, 92, 92, 93, 93,
+	94, 94, 95, 95, 96, 96, 96, 28, 28, 28,
+	28, 29, 29, 98, 98, 98, 98, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 100,
+	100, 100, 100, 100, 100, 100, 100, 101, 101, 102,
+	102, 103, 103, 103, 106, 107, 107, 108, 108, 109,
+	109, 110, 110, 111, 111, 112, 112, 97, 97, 113,
+	113, 104, 105, 105, 114, 114, 115, 115, 115, 115,
+	116, 117, 118, 118, 119, 119, 120, 120, 121, 121,
+	122, 122, 123, 123, 124, 124, 125, 125, 126, 126,
+	127, 127, 128, 128, 129, 129, 130, 130, 131, 131,
+	132, 132, 133, 133, 134, 134, 135, 135, 136, 136,
+	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
+	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
+	136, 136, 136, 136, 136, 136, 136, 136, 136, 137,
+	138, 138, 139, 140, 140, 141, 141, 142, 142, 143,
+	143, 144, 144, 145, 145, 146, 146, 147, 147, 148,
+	148, 149, 149, 150, 150, 151, 151, 152, 152,
 }
-var yyR2 = [...]int{
 
+var yyR2 = [...]int{
 	0, 0, 1, 3, 0, 3, 0, 3, 0, 3,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 6, 8, 8,
-	9, 9, 1, 1, 1, 2, 1, 1, 7, 8,
-	6, 1, 1, 7, 8, 6, 1, 1, 1, 1,
-	1, 6, 8, 8, 1, 2, 1, 1, 7, 8,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 1,
+	1, 6, 8, 8, 9, 9, 4, 1, 3, 1,
+	1, 1, 2, 1, 1, 7, 8, 6, 1, 1,
+	7, 8, 6, 1, 1, 1, 1, 1, 6, 8,
+	8, 4, 1, 3, 1, 2, 1, 1, 7, 8,
 	6, 1, 1, 7, 8, 6, 1, 1, 1, 2,
-	2, 1, 2, 1, 1, 6, 8, 5, 6, 8,
-	5, 7, 7, 1, 3, 1, 3, 0, 1, 1,
-	2, 2, 5, 2, 2, 3, 5, 6, 8, 5,
-	3, 1, 1, 3, 3, 1, 3, 1, 1, 3,
-	9, 10, 10, 12, 3, 0, 1, 1, 1, 1,
-	2, 2, 5, 6, 3, 4, 2, 2, 2, 4,
-	2, 2, 2, 2, 2, 4, 2, 3, 4, 5,
-	5, 4, 4, 4, 1, 1, 3, 0, 2, 0,
-	2, 0, 3, 0, 2, 0, 3, 0, 3, 4,
-	0, 2, 0, 2, 0, 2, 6, 9, 1, 3,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	3, 3, 3, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 1, 3, 1,
+	2, 1, 2, 1, 1, 3, 4, 2, 6, 8,
+	5, 9, 11, 8, 3, 5, 6, 6, 8, 5,
+	7, 7, 3, 1, 3, 1, 3, 0, 1, 1,
+	2, 2, 5, 6, 7, 2, 2, 3, 5, 6,
+	8, 5, 3, 7, 7, 2, 1, 3, 1, 1,
+	3, 3, 1, 3, 1, 1, 3, 10, 11, 10,
+	12, 3, 0, 1, 1, 1, 1, 2, 2, 5,
+	6, 3, 4, 2, 2, 2, 4, 2, 3, 2,
+	4, 2, 2, 2, 2, 4, 4, 5, 8, 2,
+	2, 2, 0, 2, 2, 3, 4, 1, 2, 3,
+	5, 7, 13, 5, 4, 4, 4, 1, 1, 3,
+	0, 2, 0, 2, 0, 3, 0, 2, 0, 3,
+	0, 3, 4, 0, 2, 0, 2, 0, 2, 6,
+	9, 1, 3, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 3, 3, 3, 1, 1, 1, 1,
+	5, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 1, 5, 5, 9, 1, 3, 3, 3, 1,
 	1, 3, 1, 3, 2, 4, 1, 1, 0, 1,
 	1, 1, 1, 3, 3, 3, 3, 3, 3, 4,
-	4, 5, 6, 6, 3, 4, 6, 4, 3, 4,
-	4, 6, 4, 4, 6, 4, 2, 3, 3, 3,
-	3, 3, 2, 2, 3, 3, 2, 2, 0, 1,
-	4, 4, 5, 5, 5, 5, 1, 5, 10, 8,
-	9, 9, 9, 9, 9, 8, 8, 10, 8, 10,
-	2, 1, 5, 0, 3, 2, 5, 2, 2, 2,
-	2, 2, 2, 2, 1, 2, 1, 1, 1, 1,
-	2, 3, 1, 1, 1, 2, 3, 1, 1, 3,
-	4, 5, 6, 7, 5, 6, 2, 4, 1, 1,
-	1, 3, 1, 5, 0, 1, 4, 5, 0, 2,
-	1, 3, 1, 3, 1, 3, 1, 3, 1, 3,
-	1, 3, 6, 9, 5, 8, 7, 3, 1, 3,
-	5, 6, 4, 5, 0, 2, 4, 5, 0, 2,
+	4, 6, 7, 7, 3, 4, 6, 4, 3, 4,
+	5, 6, 3, 4, 5, 6, 4, 5, 6, 7,
+	3, 4, 6, 4, 4, 6, 4, 2, 3, 3,
+	3, 3, 3, 2, 2, 3, 3, 2, 2, 0,
+	1, 4, 4, 4, 4, 4, 4, 5, 5, 5,
+	5, 1, 5, 10, 8, 9, 9, 9, 9, 9,
+	8, 8, 10, 8, 10, 2, 1, 5, 0, 3,
+	2, 5, 2, 2, 2, 2, 2, 2, 2, 1,
+	2, 1, 1, 1, 1, 2, 3, 1, 2, 2,
+	5, 1, 3, 1, 4, 4, 6, 1, 4, 5,
+	6, 1, 2, 3, 5, 6, 1, 1, 3, 4,
+	5, 6, 7, 5, 6, 8, 9, 2, 4, 1,
+	1, 1, 3, 1, 5, 0, 1, 4, 5, 0,
+	2, 1, 3, 1, 3, 1, 3, 1, 3, 1,
+	3, 3, 1, 3, 1, 3, 6, 9, 5, 8,
+	7, 3, 1, 3, 5, 6, 4, 5, 0, 2,
 	4, 5, 0, 2, 4, 5, 0, 2, 4, 5,
 	0, 2, 4, 5, 0, 2, 4, 5, 0, 2,
-	4, 5, 0, 2, 1, 1, 1, 1, 1, 1,
+	4, 5, 0, 2, 4, 5, 0, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 3, 3, 1, 3, 1, 3, 0, 1,
-	0, 1, 0, 1, 0, 1, 1, 1, 0, 1,
-	0, 1, 0, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 3, 3, 1, 3, 1, 3, 0, 1, 0,
+	1, 0, 1, 0, 1, 0, 1, 0, 1, 1,
+	1, 0, 1, 0, 1, 0, 1, 1, 1,
 }
-var yyChk = [...]int{
 
-	-1000, -1, -7, -5, -11, -38, -102, -103, -106, -72,
-	-22, -20, -26, -27, -33, -21, -36, -37, 81, 80,
-	-8, -10, -50, -123, 26, 29, 118, 89, -126, 95,
-	93, 94, 92, 103, 104, 16, 119, 108, 109, 110,
-	111, 83, 107, 73, 4, 120, 121, 122, 123, 125,
-	126, 127, 124, 129, 130, 131, 132, 133, 134, 128,
-	-124, 11, 140, -57, 146, -56, -53, -69, -67, -66,
-	-72, -73, -93, -68, -70, -124, -126, -35, -123, 24,
-	5, 6, 7, -54, 10, -55, 143, 144, 81, 131,
-	129, -74, 80, -59, 63, 67, 145, 90, 130, 9,
-	71, -94, -57, 146, -39, 19, 15, 17, -41, -40,
-	13, -66, 146, 146, 30, 30, -128, -127, -124, -128,
-	-123, -124, 90, 38, 112, -123, -123, -34, 96, 97,
-	31, 32, 98, 99, 12, 12, 122, 123, 125, 126,
-	124, -57, -57, -57, 128, -57, -124, -125, -9, 118,
-	89, 6, -52, -51, -135, 25, 137, -1, 85, 136,
-	135, 142, 70, 68, 67, 64, 69, -137, 144, 143,
-	141, 148, 149, 66, 65, -57, -98, -38, -71, -50,
-	151, 146, 151, -57, -57, 146, 146, 146, -94, 135,
-	142, -130, -137, 67, -66, -57, -57, -123, 146, -115,
-	84, -98, -46, 39, 20, -86, -84, -123, 24, 14,
-	-86, -42, 14, 58, 59, 60, -129, 72, -71, -98,
-	-57, -123, -84, 150, 137, 90, 38, 112, 113, -123,
-	-123, -123, -123, -57, -57, 142, 14, 150, -57, 6,
-	87, 64, 150, 64, -124, -125, 150, -123, -57, -1,
-	-57, -57, -57, -130, -57, 68, 64, 69, -59, 146,
-	-66, -57, 62, 61, -57, -57, -57, -57, -57, -57,
-	-57, 147, 150, 147, 147, 147, -123, 6, -129, -123,
-	6, -129, -129, -95, 84, -59, -59, 68, 64, 62,
-	61, 70, 129, -129, -116, 86, -57, -47, 45, 42,
-	-85, -84, 16, 150, -99, -88, -85, -87, -89, 23,
-	146, -66, 14, -43, 18, -99, -134, 61, -134, -134,
-	-101, -92, -91, -58, -57, -75, 141, -123, 131, 129,
-	130, 132, 133, 134, 147, 146, -136, 22, 27, 28,
-	36, -128, -57, 91, 146, 22, 146, 146, 20, -53,
-	-123, -98, -57, -2, -12, -5, -13, 81, 80, -8,
-	-10, -6, 105, 106, -123, -125, -124, -123, 64, 64,
-	-52, 22, 146, -108, -107, 86, 82, -54, -55, 65,
-	-57, -59, -57, -59, -59, -98, -71, -71, -71, -58,
-	-96, 86, -57, -59, 146, -66, 146, -66, 146, -66,
-	-130, -71, 88, -1, 85, -49, 46, -57, -61, -62,
-	-63, -57, -75, 21, 146, -38, -123, 22, -105, -104,
-	-56, -123, -86, -43, 54, -131, -133, 53, 57, 150,
-	49, 51, 52, -123, 22, -88, -99, -44, 40, -57,
-	-40, -39, -40, -40, 150, 22, 146, 146, 146, 146,
-	146, 146, 146, -100, -123, -38, -23, 146, -123, -56,
-	146, -56, -38, -100, -38, 147, -32, -29, -31, -28,
-	-30, -124, -123, -125, 88, 140, -57, -94, 87, 87,
-	-123, -123, 146, -100, 88, -108, -1, -57, -57, 65,
-	147, 147, 147, 147, 88, -57, 85, 65, -60, -59,
-	-60, -60, 93, 64, 147, 80, -1, -57, -48, 47,
-	73, 150, -64, 43, 44, -60, -97, -56, -123, -42,
-	150, 142, 48, 48, -132, 50, -132, -131, -133, -99,
-	-123, 147, -43, -45, 41, 42, -101, -123, -71, -129,
-	-129, -129, -129, -71, -71, -71, 147, 150, -25, 31,
-	32, 33, 34, -24, -23, 35, -97, 37, 147, 22,
-	147, 150, 150, 35, 147, 150, 83, -2, 85, -117,
-	84, -2, -2, 87, 87, -38, 147, 81, 88, 85,
-	-57, -57, -59, 147, 150, 147, 147, 74, 117, -115,
-	-48, 120, -61, 121, 147, 150, -43, -105, -57, -88,
-	-88, 48, 48, 48, -132, -57, -98, 147, -71, -71,
-	-71, -58, -71, 147, 147, 147, -136, -100, -56, -56,
-	147, 150, -57, 147, -123, 22, 114, 22, -28, -31,
-	-31, -124, -57, 22, -32, -2, -118, 86, -57, 88,
-	88, -2, -2, 147, 22, 81, -1, -95, -60, 40,
-	-65, 31, 32, 21, -38, -97, -90, 55, 56, -88,
-	-88, -88, 48, 102, 147, 147, 147, 147, 147, 102,
-	102, 116, 102, 116, -38, -25, -24, -38, -3, -14,
-	-5, -18, 81, 80, -15, -16, 83, 115, 114, 114,
-	147, -110, -109, 86, 82, 88, -2, 85, 83, 83,
-	88, 88, 146, -107, 146, -60, -57, 146, -90, 55,
-	-88, 146, 102, 102, 102, 102, 102, 146, 146, 121,
-	146, 121, 88, 140, -57, -94, -57, -124, -125, -57,
-	-3, -3, 22, 88, -110, -2, -57, 80, -2, 83,
-	83, -38, -46, -100, -57, -77, -76, -78, 101, 146,
-	146, 146, 146, 146, -76, -78, -77, 102, -76, 102,
-	-3, 85, -119, 84, 87, 64, 64, 88, 88, 114,
-	81, 88, 85, -117, 147, 147, 147, 147, -46, 39,
-	42, -77, -77, -77, -77, -76, 147, 147, 146, 147,
-	146, -3, -120, 86, -57, -4, -17, -5, -19, 81,
-	80, -15, -16, -6, -123, -123, -3, 81, -2, 42,
-	-98, 147, 147, 147, 147, 147, -77, -76, -112, -111,
-	86, 82, 88, -3, 85, 88, 140, -57, -94, 87,
-	87, 88, -109, -61, 147, 147, 88, -112, -3, -57,
-	80, -3, 83, -4, 85, -121, 84, -4, -4, -79,
-	127, 81, 88, 85, -119, -4, -122, 86, -57, 88,
-	88, -80, 68, 75, 6, 78, 81, -3, -114, -113,
-	86, 82, 88, -4, 85, 83, 83, -82, 75, -81,
-	6, 78, 76, 76, 79, -111, 88, -114, -4, -57,
-	80, -4, 65, 76, 76, 77, 79, 81, 88, 85,
-	-121, -83, 75, -81, 81, -4, 77, -113,
+var yyChk = [...]int{
+	-1000, -1, -7, -5, -12, -47, -48, -115, -116, -119,
+	-83, -24, -22, -32, -33, -34, -42, -23, -45, -46,
+	91, 90, -9, -11, -60, -136, 161, 162, 163, 27,
+	29, 37, 30, 40, 139, 100, -139, 106, 104, 105,
+	103, 114, 115, 116, 16, 140, 120, 121, 122, 135,
+	41, 42, 43, 123, 124, -8, 119, 83, 4, 141,
+	142, 143, 144, 147, 148, 149, 150, 151, 152, 153,
+	154, 132, 133, 134, 136, 137, 138, 145, 146, 155,
+	156, 158, 159, 160, 157, -137, 93, 94, 11, 170,
+	-67, 177, -66, -63, -80, -78, -77, -83, -84, -106,
+	-79, -81, -137, -139, -44, -136, 24, 5, 6, 7,
+	-64, 10, -65, 174, 175, 91, 160, 158, -85, 90,
+	-70, 69, 73, 176, 101, 135, 159, 9, 77, -107,
+	-67, 177, -49, 13, 19, 15, 17, -51, -50, -77,
+	177, 171, 177, 177, 177, 177, 31, 31, 31, 31,
+	14, -141, -140, -137, -141, -136, 134, 161, 162, 163,
+	-137, 101, 39, 125, -136, -136, -43, 107, 108, 32,
+	33, 109, 110, 38, -136, 12, 12, 143, 144, 147,
+	148, 149, 145, 146, -67, -67, -67, 136, -95, -136,
+	24, -95, -47, -60, 157, -67, 6, 6, -62, -61,
+	-150, 26, 167, -67, -137, -138, -10, 139, 100, -2,
+	-13, -5, -14, 91, 90, -9, -11, -6, 117, 118,
+	-1, 96, 131, 165, 164, 173, 76, 74, 73, 70,
+	75, 79, 81, 166, -152, 175, 174, 172, 179, 180,
+	72, 71, -67, -111, -47, -82, 182, 177, 182, -67,
+	-67, 177, 177, 177, -107, 164, 173, -144, -152, 73,
+	-77, -67, -67, -136, 177, -128, 95, -111, -56, 44,
+	-142, 82, 20, -97, -95, 14, -97, -52, 14, 64,
+	65, 66, -8, -82, -68, -111, -69, -67, 172, -136,
+	24, -82, -82, -82, -136, 91, -95, -95, -95, -95,
+	181, 167, 101, 138, 137, 39, 125, 126, 102, -136,
+	-136, -136, -136, -67, -67, -136, 116, 173, 75, 14,
+	14, 181, -67, 38, 151, 13, -67, 6, -67, 181,
+	-136, -67, 98, 70, 181, 70, -137, -138, 99, 170,
+	-67, -107, -136, -136, -1, 132, -67, -67, -67, -144,
+	-145, 78, 74, 70, 75, 79, 81, -70, 177, -77,
+	-67, -67, 38, -67, 68, 67, -67, -67, -67, -67,
+	-67, -67, -67, 178, 181, 178, 178, 178, -136, 6,
+	-142, -136, 6, -142, -142, -108, 95, -70, -70, 74,
+	70, 68, 67, 76, 158, -142, -129, 97, -67, -57,
+	50, 47, -114, -103, -102, -68, -67, -86, -136, 160,
+	158, 159, 161, 162, 163, -96, -95, 16, 181, -112,
+	-99, -96, -95, -98, -100, 23, 177, -77, 25, 14,
+	-53, 18, -112, -149, 67, -149, -149, 178, 178, 66,
+	155, 182, 182, 178, 178, 178, 177, -151, 22, 73,
+	38, 28, 29, 37, -141, -67, 102, 101, 138, 177,
+	22, 177, 177, -136, 5, 20, -136, -63, -67, -136,
+	-136, -111, -67, -95, -142, -67, -62, 22, 177, -2,
+	-136, -138, -137, -136, 70, 70, 94, -2, 96, -130,
+	95, -121, -120, 97, 92, 133, -64, -65, -67, -145,
+	-70, -67, -67, 38, 80, 80, -67, -70, -70, -111,
+	-82, -82, -82, -68, -109, 97, -67, -145, 177, -77,
+	177, -77, 177, -77, -144, -82, 99, -1, 96, -59,
+	51, -67, -72, -73, -74, -67, -86, -136, 20, 181,
+	22, 177, 177, 177, 177, 177, 177, 177, 21, 177,
+	-47, -136, 22, -118, -117, -66, -136, -97, -53, 59,
+	-146, -148, 58, 62, 63, 181, 54, 56, 57, 177,
+	-136, 22, 21, -99, 177, -112, -54, 45, -67, -50,
+	-49, -50, -50, 177, 177, 172, 172, -113, -136, -47,
+	69, -136, -25, 177, -136, -66, 177, -66, -47, 102,
+	101, -113, -47, 178, -41, -38, -40, -37, -39, -137,
+	-136, 177, 177, -138, -31, -30, -136, 152, -114, 177,
+	-113, 99, 98, 98, -136, -136, -2, -131, 97, -67,
+	99, -121, -1, -67, -67, 71, -67, 80, 80, -67,
+	-67, -67, 80, 178, 178, 178, 178, 99, -67, 96,
+	-70, -71, -70, -71, -71, 104, 70, 178, 90, -1,
+	102, -67, -58, 52, 83, 181, -75, 48, 49, -138,
+	-114, -136, -82, -142, -142, -142, -142, -82, -82, -82,
+	-71, -110, -66, -136, -52, 181, 173, 53, 53, -147,
+	55, -147, -146, -148, -146, 56, -112, -29, -28, -136,
+	28, 177, -136, -71, 178, -67, -53, -55, 46, 47,
+	-110, -105, -104, -102, 178, 181, -136, 156, -27, 32,
+	33, 34, 35, -26, -25, 36, -110, 38, -47, 102,
+	178, -143, 153, 178, 181, 181, 36, 178, 181, -36,
+	-35, -136, -36, -31, -136, -63, 177, -47, 178, 93,
+	-2, -2, 98, 98, -123, -122, 97, 92, 99, -2,
+	96, 91, 99, 96, -67, 71, -67, -67, 80, -67,
+	-67, 71, 178, 181, 178, 178, 84, 130, -128, 15,
+	-58, 141, -72, 142, -52, 178, -82, -82, -82, -68,
+	-82, 178, 178, 178, 178, 181, -53, -118, -67, -99,
+	-99, 53, 53, 53, -147, 53, -147, 178, 181, -136,
+	-63, -136, -113, 177, 178, 178, -67, -111, 178, 178,
+	181, 22, -151, -113, 177, -151, -66, -66, 178, 181,
+	-67, 178, -136, -47, 22, 22, -143, -37, -40, -40,
+	-137, -67, 22, -41, 178, 181, -136, 178, -113, 178,
+	22, 99, 99, -2, -2, 99, -123, -2, -67, 90,
+	-2, 91, -1, -67, -67, -108, -70, -71, 45, -76,
+	32, 33, -53, 113, 178, 178, 178, 178, 178, 113,
+	113, 129, 113, 129, 21, -47, -110, -101, 60, 61,
+	-99, -99, -99, 53, -99, 53, -136, 22, -29, -136,
+	178, -113, 83, 155, -105, -136, -47, -113, -47, -27,
+	-26, -47, 127, 22, 127, 178, -36, 178, 177, 93,
+	93, 99, 99, 91, 99, 96, -130, -120, 177, -54,
+	177, 113, 113, 113, 113, 113, 177, 177, 142, 177,
+	142, -71, -67, 177, -101, 60, -99, -89, 112, -99,
+	-136, 22, 178, 154, 177, 178, -3, -15, -5, -20,
+	91, 90, -17, -18, -136, -16, 128, 93, 94, 127,
+	-3, 22, -47, 93, 93, 91, -2, -56, -55, -88,
+	-87, -89, 177, 177, 177, 177, 177, -87, -89, -88,
+	113, -87, 113, -113, -67, 60, 47, -89, -67, -105,
+	-151, 99, 170, -67, -107, 171, -67, -67, -137, -138,
+	-4, -19, -5, -21, 91, 90, -17, -18, -6, -3,
+	99, 127, 178, -122, 178, -56, 178, -56, 44, -88,
+	-88, -88, -88, -87, 178, 178, 177, 178, 177, 178,
+	-67, -111, 60, 178, -47, -3, 96, -132, 95, -16,
+	98, 70, 70, 99, 170, -67, -107, 99, -3, -57,
+	47, 178, 178, 178, 178, 178, -88, -87, -67, -3,
+	-133, 97, -67, -4, -136, -136, 94, -4, 96, -134,
+	95, 99, -59, -72, 178, 178, -125, -124, 97, 92,
+	99, -3, 96, 99, 98, 98, -4, -135, 97, -67,
+	-90, 150, 99, -125, -3, -67, 90, -3, 93, -4,
+	-4, -127, -126, 97, 92, 99, -4, 96, -91, 74,
+	85, 6, 88, 91, 99, 96, -132, 99, 99, 99,
+	-127, -4, -67, 90, -4, -93, 85, -92, 6, 88,
+	86, 86, 89, 91, -3, 93, 93, 91, 99, 96,
+	-134, 71, 86, 86, 87, 89, -124, 91, -4, -94,
+	85, -92, -126, 87,
 }
-var yyDef = [...]int{
 
-	-2, -2, 2, 25, 26, 10, 11, 12, 13, 14,
-	15, 16, 17, 18, 19, 20, 21, 22, 0, 314,
-	41, 42, 0, 0, 0, 0, 0, 0, 71, 0,
-	0, 0, 115, 73, 74, 0, 0, 0, 0, 0,
-	0, 0, 34, 410, 374, 375, 376, 377, 378, 379,
-	380, 381, 382, 383, 384, 385, 386, 387, 388, 389,
-	0, 390, -2, 0, -2, 183, 184, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 178, 0,
-	170, 171, 172, 173, 174, 175, 0, 0, 0, 385,
-	383, 256, 314, 400, 0, 0, 0, 0, 384, 176,
-	177, 0, 315, 164, -2, 0, 0, 0, 147, 0,
-	398, 145, 164, 248, 0, 0, 69, 396, 394, 70,
-	0, 72, 0, 0, 0, 93, 94, 0, 116, 117,
-	118, 119, 0, 0, 0, 126, 131, 132, 133, 134,
-	0, 127, 128, 130, 136, 0, 193, 0, 0, 32,
-	33, 35, 165, 168, 0, 411, 0, 3, -2, 0,
-	414, 415, 400, 0, 0, 0, 0, 0, 0, 0,
+var yyDef = [...]int{
+	-2, -2, 2, 29, 30, 10, 11, 12, 13, 14,
+	15, 16, 17, 18, 19, 20, 21, 22, 23, 24,
+	0, 395, 48, 49, 0, 0, 485, 486, 487, 0,
+	0, 0, 0, 0, 0, 0, 81, 0, 0, 0,
+	142, 83, 84, 0, 0, 0, 0, 0, 0, 474,
+	0, 0, 207, 0, 177, 37, 41, 513, 458, 459,
+	460, 461, 462, 463, 464, 465, 466, 467, 468, 469,
+	470, 471, 472, 473, 475, 476, 477, 478, 479, 480,
+	481, 482, 483, 484, 488, 0, 0, -2, 489, -2,
+	0, -2, 226, 227, 228, 229, 231, 232, 233, 234,
+	235, 236, 237, 238, 239, 221, 0, 213, 214, 215,
+	216, 217, 218, 0, 0, 0, 484, 482, 321, 395,
+	501, 0, 0, 0, 0, 474, 483, 219, 220, 0,
+	396, 207, -2, 497, 0, 0, 0, 190, 0, 188,
+	207, 0, 309, 309, 309, 309, 0, 0, 0, 0,
+	0, 79, 495, 493, 80, 0, 473, 485, 486, 487,
+	82, 0, 0, 0, 115, 116, 0, 143, 144, 145,
+	146, 0, 0, 0, 87, 0, 153, 159, 161, 162,
+	163, 164, 0, 0, 154, 155, 157, 0, 0, 352,
+	353, 0, 169, 0, 174, 178, 214, 42, 208, 211,
+	0, 514, 0, 0, 237, 0, 0, 39, 40, 0,
+	0, 43, 44, 0, 395, 53, 54, 55, 25, 26,
+	3, -2, 0, 0, 517, 518, 501, 503, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 248, 0, 242, 243, 248, 398, 398, 0, 414,
-	415, 0, 0, 401, 236, 246, 247, 0, 398, 360,
-	0, 0, 157, 0, 0, 0, 326, 287, 288, 0,
-	0, 149, 0, 408, 408, 408, 0, 399, 0, 249,
-	322, 412, 0, 0, 0, 0, 0, 0, 0, 95,
-	100, 114, 0, 120, 121, 0, 0, 0, 137, 171,
-	-2, 0, 0, 0, 0, 0, 410, 0, 393, 344,
-	214, -2, -2, 0, 0, 0, 0, 0, 224, 164,
-	199, -2, 0, 0, 237, 238, 239, 240, 241, 244,
-	245, 196, 0, 198, 213, 251, 179, 181, 248, 180,
-	182, 248, 248, 318, 0, 216, 218, 0, 0, 0,
-	0, 400, 124, 248, 0, -2, 0, 162, 0, 0,
-	164, 289, 0, 0, 149, -2, 293, 294, 297, 298,
-	164, 292, 0, 151, 0, 148, 0, 409, 0, 0,
-	146, 330, 310, 312, 308, 309, 197, 178, 385, 383,
-	384, 386, 387, 388, 250, 0, 164, 413, 0, 0,
-	0, 397, 395, 164, 0, 164, 0, 0, 0, 125,
-	135, 129, 138, 0, 0, 36, 37, 0, 314, 46,
-	47, 48, 23, 24, 0, 392, 391, 0, 0, 0,
-	169, 0, 0, 0, 344, -2, 0, 219, 220, 0,
-	0, 225, -2, 230, 233, 323, 0, 0, 0, 0,
-	0, 0, 0, 0, 164, 227, 164, 232, 164, 235,
-	0, 0, 0, 361, -2, 139, 0, 160, 156, 202,
-	208, 206, 207, 0, 0, 334, 290, 0, 147, 338,
-	0, 178, 327, 340, 0, 0, 404, 404, 402, 0,
-	403, 406, 407, 295, 0, 402, 149, 153, 0, 150,
-	141, 144, 142, 143, 0, 0, 248, 398, 398, 398,
-	248, 248, 248, 0, 328, 77, 87, 0, 83, 80,
-	0, 0, 92, 0, 99, 0, 0, 107, 108, 102,
-	105, 101, 0, 96, 0, -2, 0, 0, -2, -2,
-	0, 0, 164, 0, 0, 0, 345, 0, 221, 0,
-	252, 253, 254, 255, 313, 319, 0, 0, 0, 200,
-	0, 0, 122, 0, 257, 40, 358, 163, 158, 160,
-	0, 0, 204, 209, 210, 332, 0, 320, 291, 149,
-	0, 0, 0, 0, 0, 405, 0, 0, 404, 325,
-	296, 299, 341, 140, 0, 0, 331, 311, 0, 248,
-	248, 248, 248, 0, 0, 0, -2, 0, 78, 88,
-	89, 0, 0, 0, 85, 0, 0, 0, 97, 0,
-	0, 0, 0, 0, 0, 0, 27, 5, -2, 364,
-	0, 0, 0, -2, -2, 0, 0, 38, 0, -2,
-	222, 316, 223, 226, 0, 231, 234, 123, 0, 359,
-	159, 161, 203, 0, 164, 0, 336, 339, 337, 300,
-	402, 0, 0, 0, 0, 154, 152, 250, 0, 0,
-	0, 0, 0, 0, 0, 0, 164, 329, 90, 91,
-	87, 0, 84, 81, 82, 164, -2, 0, 103, 109,
-	106, 0, 104, 0, 0, 348, 0, -2, 0, 0,
-	0, 0, 0, 166, 0, 39, 342, 317, 201, 0,
-	205, 211, 212, 0, 335, 321, 301, 0, 0, 402,
-	402, 304, 0, 0, 252, 253, 254, 255, 257, 0,
-	0, 0, 0, 0, 76, 79, 86, 98, 0, 0,
-	49, 50, 0, 314, 61, 62, 0, 54, -2, -2,
-	0, 0, 348, -2, 0, 0, 365, -2, 28, 29,
-	0, 0, 164, 343, 155, 333, 306, 0, 302, 0,
-	305, 273, 0, 0, 0, 0, 0, 273, 273, 0,
-	273, 0, 110, -2, 0, 0, 0, 193, 0, 55,
-	0, 0, 0, 0, 0, 349, 0, 45, 362, 30,
-	31, 0, 0, 0, 303, 0, 271, 155, 0, 273,
-	273, 273, 273, 273, 0, 155, 0, 0, 0, 0,
-	7, -2, 368, 0, -2, 0, 0, 111, 112, -2,
-	43, 0, -2, 363, 167, 258, 307, 259, 270, 0,
-	0, 0, 0, 0, 0, 0, 265, 266, 273, 268,
-	273, 352, 0, -2, 0, 0, 0, 56, 57, 0,
-	314, 66, 67, 68, 0, 0, 0, 44, 346, 0,
-	274, 260, 261, 262, 263, 264, 0, 0, 0, 352,
-	-2, 0, 0, 369, -2, 0, -2, 0, 0, -2,
-	-2, 113, 347, 156, 267, 269, 0, 0, 353, 0,
-	60, 366, 51, 9, -2, 372, 0, 0, 0, 272,
-	0, 58, 0, -2, 367, 356, 0, -2, 0, 0,
-	0, 275, 0, 0, 0, 0, 59, 350, 0, 356,
-	-2, 0, 0, 373, -2, 52, 53, 0, 0, 284,
-	0, 0, 277, 278, 279, 351, 0, 0, 357, 0,
-	65, 370, 0, 283, 280, 281, 282, 63, 0, -2,
-	371, 276, 0, 286, 64, 354, 285, 355,
+	0, 0, 0, 0, 0, 0, 0, 309, 0, 303,
+	304, 309, 497, 497, 0, 517, 518, 0, 0, 502,
+	297, 307, 308, 0, 497, 444, 0, 0, 200, 0,
+	0, 498, 0, 0, 407, 0, 0, 192, 0, 511,
+	511, 511, 38, 0, 0, 310, 241, 403, 245, 221,
+	0, 0, 0, 0, 515, 0, 94, 0, 0, 102,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 117,
+	122, 141, 0, 147, 148, 85, 0, 0, 0, 0,
+	0, 0, 158, 0, 0, 497, 175, 214, 179, 513,
+	0, 492, -2, 0, 0, 0, 0, 0, 0, -2,
+	0, 0, 27, 28, 428, 0, 264, -2, -2, 0,
+	0, 504, 503, 0, 0, 0, 0, 274, 207, 249,
+	-2, -2, 0, -2, 0, 0, 298, 299, 300, 301,
+	302, 305, 306, 240, 0, 248, 263, 312, 222, 224,
+	309, 223, 225, 309, 309, 399, 0, 266, 268, 503,
+	0, 0, 0, 501, 151, 309, 0, -2, 0, 205,
+	0, 0, 189, 414, 391, 393, 389, 390, 221, 484,
+	482, 483, 485, 486, 487, 207, 354, 0, 0, 192,
+	-2, 367, 354, 371, 376, 377, 207, 363, 0, 0,
+	194, 0, 191, 0, 512, 0, 0, 311, 313, 0,
+	0, 0, 0, 314, 315, 316, 0, 207, 516, 0,
+	0, 0, 0, 0, 496, 494, 207, 0, 0, 0,
+	207, 0, 0, 0, 0, 0, 86, 152, 160, 165,
+	166, 156, 172, 0, 0, 176, 212, 0, 0, 0,
+	0, 491, 490, 0, 0, 0, 36, 5, -2, 448,
+	0, 0, 428, -2, 0, 0, 269, 270, 0, 0,
+	275, -2, -2, 0, 0, 0, -2, 291, 294, 404,
+	0, 0, 0, 0, 0, 0, 0, 0, 207, 277,
+	207, 293, 207, 296, 0, 0, 0, 445, -2, 180,
+	0, 203, 199, 252, 258, 256, 257, 221, 0, 0,
+	0, 309, 497, 497, 497, 309, 309, 309, 0, 0,
+	418, 355, 0, 190, 422, 0, 221, 408, 424, 0,
+	0, 507, 507, 505, 505, 0, 506, 509, 510, 0,
+	372, 0, 0, 505, 0, 192, 196, 0, 193, 184,
+	187, 185, 186, 0, 0, 246, 247, 0, 409, 90,
+	0, 95, 107, 0, 103, 99, 0, 0, 112, 207,
+	0, 0, 121, 499, 0, 134, 135, 129, 132, 128,
+	0, 0, 0, 118, 167, 172, 0, 0, 189, 207,
+	0, 0, -2, -2, 0, 0, 432, 0, -2, 0,
+	0, 0, 429, 0, 230, 0, 0, 0, 0, -2,
+	280, 284, 0, 317, 318, 319, 320, 394, 400, 0,
+	0, 0, 250, 0, 0, 149, 0, 322, 47, 442,
+	0, 206, 201, 203, 0, 0, 254, 259, 260, 190,
+	415, 392, 0, 309, 309, 309, 309, 0, 0, 0,
+	416, 0, 401, 356, 192, 0, 0, 0, 0, 0,
+	508, 0, 0, 507, 0, 507, 406, 0, 361, 357,
+	0, 0, 373, 0, 378, 0, 425, 183, 0, 0,
+	0, 0, 412, 0, -2, 0, 515, 96, 97, 108,
+	109, 0, 0, 0, 105, 0, 0, 0, 113, 207,
+	119, 0, 500, 499, 0, 0, 0, 0, 0, 0,
+	126, 0, 0, 173, 170, 171, 0, 0, 0, 31,
+	0, 0, -2, -2, 0, 432, -2, 0, 0, 449,
+	-2, 45, 0, -2, 271, 0, 281, 285, 0, 288,
+	397, 0, 276, 0, 292, 295, 150, 0, 443, 181,
+	202, 204, 253, 0, 192, 311, 0, 0, 0, 0,
+	0, 314, 315, 316, 207, 0, 420, 423, 421, 379,
+	505, 0, 0, 0, 0, 0, 0, 368, 0, 358,
+	359, 0, 0, 0, 364, 365, 197, 195, 242, 243,
+	0, 0, 207, 410, 0, 207, 110, 111, 107, 0,
+	104, 100, 101, 114, 207, 0, 0, 130, 136, 133,
+	0, 131, 0, 0, 123, 0, 125, 124, 0, 209,
+	0, 0, 0, 0, 0, 0, 0, 433, 0, 52,
+	446, 46, 426, 272, 289, 398, 273, 251, 0, 255,
+	261, 262, 194, 0, 317, 318, 319, 320, 322, 0,
+	0, 0, 0, 0, 0, 419, 402, 380, 0, 0,
+	505, 505, 383, 0, -2, 0, 369, 0, 362, 0,
+	374, 0, 0, 0, 413, 411, 89, 0, 93, 98,
+	106, 120, -2, 0, -2, 0, 127, 168, 207, 32,
+	33, 0, 0, 50, 0, -2, 447, 427, 198, 196,
+	338, 0, 0, 0, 0, 0, 338, 338, 0, 338,
+	0, 417, 387, 0, 381, 0, 384, 0, 0, -2,
+	370, 0, 375, 366, 0, -2, 0, 0, 56, 57,
+	0, 395, 71, 72, 0, 62, 64, 0, -2, -2,
+	0, 0, 0, 34, 35, 51, 430, 0, 198, 0,
+	336, 198, 338, 338, 338, 338, 338, 0, 198, 0,
+	0, 0, 0, 0, 382, 0, 0, 0, 360, 0,
+	207, 137, -2, 0, 0, 0, 65, 0, 237, 0,
+	0, 0, 66, 67, 0, 395, 76, 77, 78, 0,
+	139, -2, 210, 431, 323, 200, 324, 335, 0, 0,
+	0, 0, 0, 0, 330, 331, 338, 333, 338, 388,
+	385, 339, 0, 244, 92, 7, -2, 452, 0, 63,
+	-2, 0, 0, 0, -2, 0, 0, 138, 0, 205,
+	0, 325, 326, 327, 328, 329, 0, 0, 386, 436,
+	0, -2, 0, 0, 0, 0, 61, 9, -2, 456,
+	0, 140, 182, 199, 332, 334, 0, 436, -2, 0,
+	0, 453, -2, 0, -2, -2, 440, 0, -2, 0,
+	337, 0, 0, 0, 437, 0, 70, 450, 58, 0,
+	0, 0, 440, -2, 0, 0, 457, -2, 340, 0,
+	0, 0, 0, 68, 0, -2, 451, 0, 0, 0,
+	0, 441, 0, 75, 454, 0, 0, 349, 0, 0,
+	342, 343, 344, 69, 434, 59, 60, 73, 0, -2,
+	455, 0, 348, 345, 346, 347, 435, 74, 438, 341,
+	0, 351, 439, 350,
 }
-var yyTok1 = [...]int{
 
+var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 145, 3, 3, 3, 149, 3, 3,
-	146, 147, 141, 144, 150, 143, 151, 148, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 140,
-	3, 142,
+	3, 3, 3, 176, 3, 3, 3, 180, 3, 3,
+	177, 178, 172, 175, 181, 174, 182, 179, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 171, 170,
+	3, 173,
 }
-var yyTok2 = [...]int{
 
+var yyTok2 = [...]int{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
@@ -1332,8 +1801,12 @@ var yyTok2 = [...]int{
 	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
 	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
 	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
-	132, 133, 134, 135, 136, 137, 138, 139,
+	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
+	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	162, 163, 164, 165, 166, 167, 168, 169,
 }
+
 var yyTok3 = [...]int{
 	0,
 }
@@ -1416,9 +1889,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -1428,13 +1901,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -1465,30 +1938,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -1543,7 +2016,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -1554,8 +2027,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -1568,7 +2041,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -1577,18 +2050,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -1610,10 +2083,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -1649,7 +2122,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -1660,16 +2133,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -1677,850 +2150,1133 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:220
+//line parser.y:250
 		{
 			yyVAL.program = nil
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:225
+//line parser.y:255
 		{
 			yyVAL.program = []Statement{yyDollar[1].statement}
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:230
+//line parser.y:260
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 4:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:237
+//line parser.y:267
 		{
 			yyVAL.program = nil
 		}
 	case 5:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:241
+//line parser.y:271
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 6:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:247
+//line parser.y:277
 		{
 			yyVAL.program = nil
 		}
 	case 7:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:251
+//line parser.y:281
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 8:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:257
+//line parser.y:287
 		{
 			yyVAL.program = nil
 		}
 	case 9:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:261
+//line parser.y:291
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:267
+//line parser.y:297
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:271
+//line parser.y:301
 		{
-			yyVAL.statement = yyDollar[1].expression
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:275
+//line parser.y:305
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:279
+//line parser.y:309
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:283
+//line parser.y:313
 		{
-			yyVAL.statement = yyDollar[1].queryexpr
+			yyVAL.statement = yyDollar[1].expression
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:287
+//line parser.y:317
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:291
+//line parser.y:321
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:295
+//line parser.y:325
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:299
+//line parser.y:329
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:303
+//line parser.y:333
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:307
+//line parser.y:337
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:311
+//line parser.y:341
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:315
+//line parser.y:345
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:321
+//line parser.y:349
 		{
-			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:325
+//line parser.y:353
 		{
-			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:331
+//line parser.y:359
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:335
+//line parser.y:363
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
 		}
 	case 27:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:341
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:367
 		{
-			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
+			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token, Label: yyDollar[2].identifier}
 		}
 	case 28:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:345
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:371
 		{
-			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
+			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token, Label: yyDollar[2].identifier}
 		}
 	case 29:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:349
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:377
 		{
-			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 30:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:353
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:381
 		{
-			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: []Variable{yyDollar[3].variable}, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 31:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:357
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:387
 		{
-			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: yyDollar[3].variables, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
+			yyVAL.statement = While{BaseExpr: NewBaseExpr(yyDollar[1].token), Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
 	case 32:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:363
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:391
 		{
-			yyVAL.token = yyDollar[1].token
+			yyVAL.statement = WhileInCursor{BaseExpr: NewBaseExpr(yyDollar[1].token), Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
 	case 33:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:367
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:395
 		{
-			yyVAL.token = yyDollar[1].token
+			yyVAL.statement = WhileInCursor{BaseExpr: NewBaseExpr(yyDollar[1].token), Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
 	case 34:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:373
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:399
 		{
-			yyVAL.statement = Exit{}
+			yyVAL.statement = WhileInCursor{BaseExpr: NewBaseExpr(yyDollar[1].token), WithDeclaration: true, Variables: []Variable{yyDollar[3].variable}, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
 	case 35:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:377
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:403
 		{
-			yyVAL.statement = Exit{Code: value.NewIntegerFromString(yyDollar[2].token.Literal)}
+			yyVAL.statement = WhileInCursor{BaseExpr: NewBaseExpr(yyDollar[1].token), WithDeclaration: true, Variables: yyDollar[3].variables, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
 	case 36:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:383
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:407
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = Loop{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].program}
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:387
+//line parser.y:413
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 38:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:393
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:417
 		{
-			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
+			yyVAL.statement = labelLoop(yyDollar[3].statement, yyDollar[1].identifier)
 		}
 	case 39:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:397
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:423
 		{
-			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
+			yyVAL.token = yyDollar[1].token
 		}
 	case 40:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:401
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:427
 		{
-			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
+			yyVAL.token = yyDollar[1].token
 		}
 	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:405
+//line parser.y:433
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = Exit{}
 		}
 	case 42:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:409
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:437
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = Exit{Code: value.NewIntegerFromString(yyDollar[2].token.Literal)}
 		}
 	case 43:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:415
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:443
 		{
-			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 44:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:419
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:447
 		{
-			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 45:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:423
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:453
 		{
-			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
+			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
 	case 46:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:427
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:457
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
 	case 47:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:431
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:461
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
 	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:435
+//line parser.y:465
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:441
+//line parser.y:469
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 50:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:445
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:475
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
 	case 51:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:451
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:479
 		{
-			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
+			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
 	case 52:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:455
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:483
 		{
-			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
+			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
 	case 53:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:459
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:487
 		{
-			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:465
+//line parser.y:491
 		{
-			yyVAL.statement = Return{Value: NewNullValue()}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 55:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:469
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:495
 		{
-			yyVAL.statement = Return{Value: yyDollar[2].queryexpr}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:475
+//line parser.y:501
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:479
+//line parser.y:505
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 58:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:485
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:511
 		{
-			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
+			yyVAL.statement = While{BaseExpr: NewBaseExpr(yyDollar[1].token), Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
 	case 59:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:489
+//line parser.y:515
 		{
-			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
+			yyVAL.statement = WhileInCursor{BaseExpr: NewBaseExpr(yyDollar[1].token), Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
 	case 60:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:493
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:519
 		{
-			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
+			yyVAL.statement = WhileInCursor{BaseExpr: NewBaseExpr(yyDollar[1].token), Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
 	case 61:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:497
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:523
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = Loop{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].program}
 		}
 	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:501
+//line parser.y:529
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 63:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:507
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:533
 		{
-			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
+			yyVAL.statement = labelLoop(yyDollar[3].statement, yyDollar[1].identifier)
 		}
 	case 64:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:511
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:539
 		{
-			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
+			yyVAL.statement = Return{Value: NewNullValue()}
 		}
 	case 65:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:515
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:543
 		{
-			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
+			yyVAL.statement = Return{Value: yyDollar[2].queryexpr}
 		}
 	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:519
+//line parser.y:549
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:523
+//line parser.y:553
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 68:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:527
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:559
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
 	case 69:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:533
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:563
 		{
-			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
+			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
 	case 70:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:537
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:567
 		{
-			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
+			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
 	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:541
+//line parser.y:571
 		{
-			yyVAL.statement = yyDollar[1].queryexpr
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 72:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:545
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:575
 		{
-			yyVAL.statement = DisposeVariable{Variable: yyDollar[2].variable}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 73:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:551
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:581
 		{
-			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
+			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
 	case 74:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:555
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:585
 		{
-			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
+			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
 	case 75:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:561
+//line parser.y:589
 		{
-			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs}
+			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
 	case 76:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:565
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:593
 		{
-			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 77:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:569
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:597
 		{
-			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Query: yyDollar[5].queryexpr}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 78:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:573
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:601
 		{
-			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: []ColumnDefault{yyDollar[5].columndef}, Position: yyDollar[6].expression}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 79:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:577
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:607
 		{
-			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].columndefs, Position: yyDollar[8].expression}
+			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
 	case 80:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:581
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:611
 		{
-			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: []QueryExpression{yyDollar[5].queryexpr}}
+			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
 	case 81:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:585
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:615
 		{
-			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].queryexprs}
+			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 82:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:589
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:619
 		{
-			yyVAL.statement = RenameColumn{Table: yyDollar[3].queryexpr, Old: yyDollar[5].queryexpr, New: yyDollar[7].identifier}
+			yyVAL.statement = DisposeVariable{Variable: yyDollar[2].variable}
 		}
 	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:595
+//line parser.y:625
 		{
-			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier}
+			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
 	case 84:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:599
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:629
 		{
-			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier, Value: yyDollar[3].queryexpr}
+			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
 	case 85:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:605
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:633
 		{
-			yyVAL.columndefs = []ColumnDefault{yyDollar[1].columndef}
+			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token, Savepoint: yyDollar[3].identifier}
 		}
 	case 86:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:609
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:637
 		{
-			yyVAL.columndefs = append([]ColumnDefault{yyDollar[1].columndef}, yyDollar[3].columndefs...)
+			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token, Savepoint: yyDollar[4].identifier}
 		}
 	case 87:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:615
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:641
 		{
-			yyVAL.expression = nil
+			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token, Savepoint: yyDollar[2].identifier}
 		}
 	case 88:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:619
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:647
 		{
-			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
+			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs}
 		}
 	case 89:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:623
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:651
 		{
-			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
+			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
 		}
 	case 90:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:627
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:655
 		{
-			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
+			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Query: yyDollar[5].queryexpr}
 		}
 	case 91:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:631
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:659
 		{
-			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
+			yyVAL.statement = CreateTable{Table: yyDollar[6].identifier, Fields: yyDollar[8].queryexprs, IfNotExists: true}
 		}
 	case 92:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:663
+		{
+			yyVAL.statement = CreateTable{Table: yyDollar[6].identifier, Fields: yyDollar[8].queryexprs, Query: yyDollar[11].queryexpr, IfNotExists: true}
+		}
+	case 93:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:667
+		{
+			yyVAL.statement = CreateTable{Table: yyDollar[6].identifier, Query: yyDollar[8].queryexpr, IfNotExists: true}
+		}
+	case 94:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:671
+		{
+			yyVAL.statement = DropTable{Table: yyDollar[3].queryexpr}
+		}
+	case 95:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:637
+//line parser.y:675
+		{
+			yyVAL.statement = RenameTable{Table: yyDollar[3].queryexpr, NewName: yyDollar[5].identifier}
+		}
+	case 96:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:679
+		{
+			yyVAL.statement = RenameTable{Table: yyDollar[3].queryexpr, NewName: yyDollar[5].identifier, Overwrite: true}
+		}
+	case 97:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:683
+		{
+			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: []ColumnDefault{yyDollar[5].columndef}, Position: yyDollar[6].expression}
+		}
+	case 98:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:687
+		{
+			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].columndefs, Position: yyDollar[8].expression}
+		}
+	case 99:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:691
+		{
+			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: []QueryExpression{yyDollar[5].queryexpr}}
+		}
+	case 100:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:695
+		{
+			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].queryexprs}
+		}
+	case 101:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:699
+		{
+			yyVAL.statement = RenameColumn{Table: yyDollar[3].queryexpr, Old: yyDollar[5].queryexpr, New: yyDollar[7].identifier}
+		}
+	case 102:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:703
+		{
+			yyVAL.statement = Deduplicate{Table: yyDollar[3].queryexpr}
+		}
+	case 103:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:709
+		{
+			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier}
+		}
+	case 104:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:713
+		{
+			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier, Value: yyDollar[3].queryexpr}
+		}
+	case 105:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:719
+		{
+			yyVAL.columndefs = []ColumnDefault{yyDollar[1].columndef}
+		}
+	case 106:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:723
+		{
+			yyVAL.columndefs = append([]ColumnDefault{yyDollar[1].columndef}, yyDollar[3].columndefs...)
+		}
+	case 107:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:729
+		{
+			yyVAL.expression = nil
+		}
+	case 108:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:733
+		{
+			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
+		}
+	case 109:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:737
+		{
+			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
+		}
+	case 110:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:741
+		{
+			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
+		}
+	case 111:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:745
+		{
+			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
+		}
+	case 112:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:751
 		{
 			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 93:
+	case 113:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:755
+		{
+			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Scroll: yyDollar[3].token, Query: yyDollar[6].queryexpr.(SelectQuery)}
+		}
+	case 114:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:759
+		{
+			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Negation: yyDollar[3].token, Scroll: yyDollar[4].token, Query: yyDollar[7].queryexpr.(SelectQuery)}
+		}
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:641
+//line parser.y:763
 		{
 			yyVAL.statement = OpenCursor{Cursor: yyDollar[2].identifier}
 		}
-	case 94:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:645
+//line parser.y:767
 		{
 			yyVAL.statement = CloseCursor{Cursor: yyDollar[2].identifier}
 		}
-	case 95:
+	case 117:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:649
+//line parser.y:771
 		{
 			yyVAL.statement = DisposeCursor{Cursor: yyDollar[3].identifier}
 		}
-	case 96:
+	case 118:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:653
+//line parser.y:775
 		{
 			yyVAL.statement = FetchCursor{Position: yyDollar[2].fetchpos, Cursor: yyDollar[3].identifier, Variables: yyDollar[5].variables}
 		}
-	case 97:
+	case 119:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:659
+//line parser.y:781
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs}
 		}
-	case 98:
+	case 120:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:663
+//line parser.y:785
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
 		}
-	case 99:
+	case 121:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:667
+//line parser.y:789
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Query: yyDollar[5].queryexpr}
 		}
-	case 100:
+	case 122:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:671
+//line parser.y:793
 		{
 			yyVAL.statement = DisposeView{View: yyDollar[3].identifier}
 		}
-	case 101:
+	case 123:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:799
+		{
+			yyVAL.statement = SchemaDeclaration{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[4].identifier, Fields: yyDollar[6].schemafields}
+		}
+	case 124:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:803
+		{
+			yyVAL.statement = SchemaDeclaration{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: Identifier{BaseExpr: NewBaseExpr(yyDollar[4].token), Literal: yyDollar[4].token.Literal, Quoted: true}, Fields: yyDollar[6].schemafields}
+		}
+	case 125:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:809
+		{
+			yyVAL.schemafield = SchemaField{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier, Type: yyDollar[2].identifier}
+		}
+	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:677
+//line parser.y:815
+		{
+			yyVAL.schemafields = []SchemaField{yyDollar[1].schemafield}
+		}
+	case 127:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:819
+		{
+			yyVAL.schemafields = append([]SchemaField{yyDollar[1].schemafield}, yyDollar[3].schemafields...)
+		}
+	case 128:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:825
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 102:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:683
+//line parser.y:831
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 103:
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:687
+//line parser.y:835
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassign)
 		}
-	case 104:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:693
+//line parser.y:841
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 105:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:699
+//line parser.y:847
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 106:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:703
+//line parser.y:851
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 107:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:709
+//line parser.y:857
 		{
 			yyVAL.varassigns = yyDollar[1].varassigns
 		}
-	case 108:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:713
+//line parser.y:861
 		{
 			yyVAL.varassigns = yyDollar[1].varassigns
 		}
-	case 109:
+	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:717
+//line parser.y:865
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassigns...)
 		}
-	case 110:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:723
+	case 137:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:871
 		{
-			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Statements: yyDollar[8].program}
+			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Deterministic: yyDollar[6].token, Statements: yyDollar[9].program}
 		}
-	case 111:
-		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:727
+	case 138:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:875
 		{
-			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Parameters: yyDollar[5].varassigns, Statements: yyDollar[9].program}
+			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Parameters: yyDollar[5].varassigns, Deterministic: yyDollar[7].token, Statements: yyDollar[10].program}
 		}
-	case 112:
+	case 139:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:731
+//line parser.y:879
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Statements: yyDollar[9].program}
 		}
-	case 113:
+	case 140:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:735
+//line parser.y:883
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Parameters: yyDollar[7].varassigns, Statements: yyDollar[11].program}
 		}
-	case 114:
+	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:739
+//line parser.y:887
 		{
 			yyVAL.statement = DisposeFunction{Name: yyDollar[3].identifier}
 		}
-	case 115:
+	case 142:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:745
+//line parser.y:893
 		{
 			yyVAL.fetchpos = FetchPosition{}
 		}
-	case 116:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:749
+//line parser.y:897
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 117:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:753
+//line parser.y:901
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 118:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:757
+//line parser.y:905
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 119:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:761
+//line parser.y:909
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 120:
+	case 147:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:765
+//line parser.y:913
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 121:
+	case 148:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:769
+//line parser.y:917
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 122:
+	case 149:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:775
+//line parser.y:923
 		{
 			yyVAL.queryexpr = CursorStatus{CursorLit: yyDollar[1].token.Literal, Cursor: yyDollar[2].identifier, Is: yyDollar[3].token.Literal, Negation: yyDollar[4].token, Type: yyDollar[5].token.Token, TypeLit: yyDollar[5].token.Literal}
 		}
-	case 123:
+	case 150:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:779
+//line parser.y:927
 		{
 			yyVAL.queryexpr = CursorStatus{CursorLit: yyDollar[1].token.Literal, Cursor: yyDollar[2].identifier, Is: yyDollar[3].token.Literal, Negation: yyDollar[4].token, Type: yyDollar[6].token.Token, TypeLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal}
 		}
-	case 124:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:783
+//line parser.y:931
 		{
 			yyVAL.queryexpr = CursorAttrebute{CursorLit: yyDollar[1].token.Literal, Cursor: yyDollar[2].identifier, Attrebute: yyDollar[3].token}
 		}
-	case 125:
+	case 152:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:789
+//line parser.y:937
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal, Value: yyDollar[4].queryexpr.(PrimitiveType).Value}
 		}
-	case 126:
+	case 153:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:793
+//line parser.y:941
 		{
 			yyVAL.statement = ShowFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].token.Literal}
 		}
-	case 127:
+	case 154:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:797
+//line parser.y:945
 		{
 			yyVAL.statement = Print{Value: yyDollar[2].queryexpr}
 		}
-	case 128:
+	case 155:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:801
+//line parser.y:949
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr}
 		}
-	case 129:
+	case 156:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:805
+//line parser.y:953
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 130:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:809
+//line parser.y:957
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].queryexpr}
 		}
-	case 131:
+	case 158:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:961
+		{
+			yyVAL.statement = UseRepository{BaseExpr: NewBaseExpr(yyDollar[1].token), Repository: yyDollar[3].queryexpr}
+		}
+	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:813
+//line parser.y:965
 		{
 			yyVAL.statement = ShowObjects{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].token.Token}
 		}
-	case 132:
+	case 160:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:969
+		{
+			yyVAL.statement = ShowObjects{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].token.Token, Pattern: yyDollar[4].queryexpr}
+		}
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:817
+//line parser.y:973
 		{
 			yyVAL.statement = ShowObjects{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].token.Token}
 		}
-	case 133:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:821
+//line parser.y:977
 		{
 			yyVAL.statement = ShowObjects{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].token.Token}
 		}
-	case 134:
+	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:825
+//line parser.y:981
 		{
 			yyVAL.statement = ShowObjects{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].token.Token}
 		}
-	case 135:
+	case 164:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:985
+		{
+			yyVAL.statement = ShowObjects{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].token.Token}
+		}
+	case 165:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:829
+//line parser.y:989
 		{
 			yyVAL.statement = ShowFields{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[4].identifier}
 		}
-	case 136:
+	case 166:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:993
+		{
+			yyVAL.statement = ShowFields{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[4].identifier}
+		}
+	case 167:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:997
+		{
+			yyVAL.statement = Export{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[2].queryexpr, FilePath: yyDollar[4].queryexpr, Options: yyDollar[5].exportopts}
+		}
+	case 168:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:1001
+		{
+			yyVAL.statement = Diff{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[2].queryexpr, Against: yyDollar[4].queryexpr, Keys: yyDollar[7].queryexprs}
+		}
+	case 169:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1005
+		{
+			yyVAL.statement = Explain{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr.(SelectQuery)}
+		}
+	case 170:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1011
+		{
+			yyVAL.exportopt = ExportOption{Name: yyDollar[1].identifier, Value: yyDollar[2].identifier}
+		}
+	case 171:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1015
+		{
+			yyVAL.exportopt = ExportOption{Name: yyDollar[1].identifier, Value: yyDollar[2].queryexpr}
+		}
+	case 172:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1021
+		{
+			yyVAL.exportopts = nil
+		}
+	case 173:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1025
+		{
+			yyVAL.exportopts = append([]ExportOption{yyDollar[1].exportopt}, yyDollar[2].exportopts...)
+		}
+	case 174:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1031
+		{
+			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[2].token.Token}
+		}
+	case 175:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1035
+		{
+			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[2].token.Token, Message: yyDollar[3].queryexpr}
+		}
+	case 176:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1039
+		{
+			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[2].token.Token, Message: yyDollar[4].queryexpr, Code: value.NewIntegerFromString(yyDollar[3].token.Literal)}
+		}
+	case 177:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1043
+		{
+			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: ERROR}
+		}
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:835
+//line parser.y:1047
 		{
-			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[2].token.Token}
+			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: ERROR, Message: yyDollar[2].queryexpr}
 		}
-	case 137:
+	case 179:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:839
+//line parser.y:1051
 		{
-			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[2].token.Token, Message: yyDollar[3].queryexpr}
+			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: ERROR, Message: yyDollar[3].queryexpr, Code: value.NewIntegerFromString(yyDollar[2].token.Literal)}
 		}
-	case 138:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:843
+	case 180:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1057
 		{
-			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[2].token.Token, Message: yyDollar[4].queryexpr, Code: value.NewIntegerFromString(yyDollar[3].token.Literal)}
+			yyVAL.queryexpr = SelectQuery{
+				WithClause:    yyDollar[1].queryexpr,
+				SelectEntity:  yyDollar[2].queryexpr,
+				OrderByClause: yyDollar[3].queryexpr,
+				LimitClause:   yyDollar[4].queryexpr,
+				OffsetClause:  yyDollar[5].queryexpr,
+			}
 		}
-	case 139:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:849
+	case 181:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1067
 		{
 			yyVAL.queryexpr = SelectQuery{
+				BaseExpr:      NewBaseExpr(yyDollar[6].token),
 				WithClause:    yyDollar[1].queryexpr,
 				SelectEntity:  yyDollar[2].queryexpr,
 				OrderByClause: yyDollar[3].queryexpr,
 				LimitClause:   yyDollar[4].queryexpr,
 				OffsetClause:  yyDollar[5].queryexpr,
+				ForUpdate:     true,
+				ForUpdateLit:  yyDollar[6].token.Literal + " " + yyDollar[7].token.Literal,
 			}
 		}
-	case 140:
+	case 182:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line parser.y:1082
+		{
+			yyVAL.statement = SelectInto{
+				BaseExpr: NewBaseExpr(yyDollar[2].token),
+				Query: SelectQuery{
+					WithClause: yyDollar[1].queryexpr,
+					SelectEntity: SelectEntity{
+						SelectClause:  SelectClause{BaseExpr: NewBaseExpr(yyDollar[2].token), Select: yyDollar[2].token.Literal, Distinct: yyDollar[3].token, Fields: yyDollar[4].queryexprs},
+						FromClause:    yyDollar[7].queryexpr,
+						WhereClause:   yyDollar[8].queryexpr,
+						GroupByClause: yyDollar[9].queryexpr,
+						HavingClause:  yyDollar[10].queryexpr,
+					},
+					OrderByClause: yyDollar[11].queryexpr,
+					LimitClause:   yyDollar[12].queryexpr,
+					OffsetClause:  yyDollar[13].queryexpr,
+				},
+				Variables: yyDollar[6].variables,
+			}
+		}
+	case 183:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:861
+//line parser.y:1104
 		{
 			yyVAL.queryexpr = SelectEntity{
 				SelectClause:  yyDollar[1].queryexpr,
@@ -2530,9 +3286,9 @@ yydefault:
 				HavingClause:  yyDollar[5].queryexpr,
 			}
 		}
-	case 141:
+	case 184:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:871
+//line parser.y:1114
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -2541,9 +3297,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 142:
+	case 185:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:880
+//line parser.y:1123
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -2552,9 +3308,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 143:
+	case 186:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:889
+//line parser.y:1132
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -2563,429 +3319,482 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 144:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:900
+//line parser.y:1143
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 145:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:904
+//line parser.y:1147
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 146:
+	case 189:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:910
+//line parser.y:1153
 		{
 			yyVAL.queryexpr = SelectClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Select: yyDollar[1].token.Literal, Distinct: yyDollar[2].token, Fields: yyDollar[3].queryexprs}
 		}
-	case 147:
+	case 190:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:916
+//line parser.y:1159
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 148:
+	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:920
+//line parser.y:1163
 		{
 			yyVAL.queryexpr = FromClause{From: yyDollar[1].token.Literal, Tables: yyDollar[2].queryexprs}
 		}
-	case 149:
+	case 192:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:926
+//line parser.y:1169
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 150:
+	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:930
+//line parser.y:1173
 		{
 			yyVAL.queryexpr = WhereClause{Where: yyDollar[1].token.Literal, Filter: yyDollar[2].queryexpr}
 		}
-	case 151:
+	case 194:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:936
+//line parser.y:1179
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 152:
+	case 195:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:940
+//line parser.y:1183
 		{
 			yyVAL.queryexpr = GroupByClause{GroupBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Items: yyDollar[3].queryexprs}
 		}
-	case 153:
+	case 196:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:946
+//line parser.y:1189
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 154:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:950
+//line parser.y:1193
 		{
 			yyVAL.queryexpr = HavingClause{Having: yyDollar[1].token.Literal, Filter: yyDollar[2].queryexpr}
 		}
-	case 155:
+	case 198:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:956
+//line parser.y:1199
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 156:
+	case 199:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:960
+//line parser.y:1203
 		{
 			yyVAL.queryexpr = OrderByClause{OrderBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Items: yyDollar[3].queryexprs}
 		}
-	case 157:
+	case 200:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:966
+//line parser.y:1209
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 158:
+	case 201:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:970
+//line parser.y:1213
 		{
 			yyVAL.queryexpr = LimitClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Limit: yyDollar[1].token.Literal, Value: yyDollar[2].queryexpr, With: yyDollar[3].queryexpr}
 		}
-	case 159:
+	case 202:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:974
+//line parser.y:1217
 		{
 			yyVAL.queryexpr = LimitClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Limit: yyDollar[1].token.Literal, Value: yyDollar[2].queryexpr, Percent: yyDollar[3].token.Literal, With: yyDollar[4].queryexpr}
 		}
-	case 160:
+	case 203:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:980
+//line parser.y:1223
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 161:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:984
+//line parser.y:1227
 		{
 			yyVAL.queryexpr = LimitWith{With: yyDollar[1].token.Literal, Type: yyDollar[2].token}
 		}
-	case 162:
+	case 205:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:990
+//line parser.y:1233
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 163:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:994
+//line parser.y:1237
 		{
 			yyVAL.queryexpr = OffsetClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Offset: yyDollar[1].token.Literal, Value: yyDollar[2].queryexpr}
 		}
-	case 164:
+	case 207:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1000
+//line parser.y:1243
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 165:
+	case 208:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1004
+//line parser.y:1247
 		{
 			yyVAL.queryexpr = WithClause{With: yyDollar[1].token.Literal, InlineTables: yyDollar[2].queryexprs}
 		}
-	case 166:
+	case 209:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1010
+//line parser.y:1253
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, As: yyDollar[3].token.Literal, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 167:
+	case 210:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1014
+//line parser.y:1257
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, Fields: yyDollar[4].queryexprs, As: yyDollar[6].token.Literal, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 168:
+	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1020
+//line parser.y:1263
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 169:
+	case 212:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1024
+//line parser.y:1267
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 170:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1030
+//line parser.y:1273
 		{
 			yyVAL.queryexpr = NewStringValue(yyDollar[1].token.Literal)
 		}
-	case 171:
+	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1034
+//line parser.y:1277
 		{
 			yyVAL.queryexpr = NewIntegerValueFromString(yyDollar[1].token.Literal)
 		}
-	case 172:
+	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1038
+//line parser.y:1281
 		{
 			yyVAL.queryexpr = NewFloatValueFromString(yyDollar[1].token.Literal)
 		}
-	case 173:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1042
+//line parser.y:1285
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 174:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1046
+//line parser.y:1289
 		{
 			yyVAL.queryexpr = NewDatetimeValueFromString(yyDollar[1].token.Literal)
 		}
-	case 175:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1050
+//line parser.y:1293
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 176:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1056
+//line parser.y:1299
 		{
 			yyVAL.queryexpr = NewTernaryValueFromString(yyDollar[1].token.Literal)
 		}
-	case 177:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1062
+//line parser.y:1305
 		{
 			yyVAL.queryexpr = NewNullValueFromString(yyDollar[1].token.Literal)
 		}
-	case 178:
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1068
+//line parser.y:1311
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier}
 		}
-	case 179:
+	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1072
+//line parser.y:1315
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Column: yyDollar[3].identifier}
 		}
-	case 180:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1076
+//line parser.y:1319
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Column: yyDollar[3].identifier}
 		}
-	case 181:
+	case 224:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1080
+//line parser.y:1323
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 182:
+	case 225:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1084
+//line parser.y:1327
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 183:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1090
+//line parser.y:1333
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 184:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1094
+//line parser.y:1337
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 185:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1098
+//line parser.y:1341
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 186:
+	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1102
+//line parser.y:1345
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 187:
+	case 230:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1349
+		{
+			yyVAL.queryexpr = AtTimeZone{BaseExpr: NewBaseExpr(yyDollar[2].token), AtTimeZone: yyDollar[2].token.Literal + " " + yyDollar[3].token.Literal + " " + yyDollar[4].token.Literal, Value: yyDollar[1].queryexpr, TimeZone: yyDollar[5].queryexpr}
+		}
+	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1106
+//line parser.y:1353
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 188:
+	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1110
+//line parser.y:1357
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 189:
+	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1114
+//line parser.y:1361
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 190:
+	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1118
+//line parser.y:1365
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 191:
+	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1122
+//line parser.y:1369
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 192:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1126
+//line parser.y:1373
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 193:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1130
+//line parser.y:1377
 		{
 			yyVAL.queryexpr = yyDollar[1].variable
 		}
-	case 194:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1134
+//line parser.y:1381
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 195:
+	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1138
+//line parser.y:1385
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 196:
+	case 240:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1142
+//line parser.y:1389
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 197:
+	case 241:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1395
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 242:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1399
+		{
+			ac := yyDollar[1].queryexpr.(AllColumns)
+			ac.ExceptLit = yyDollar[2].token.Literal
+			ac.Except = yyDollar[4].queryexprs
+			yyVAL.queryexpr = ac
+		}
+	case 243:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1406
+		{
+			ac := yyDollar[1].queryexpr.(AllColumns)
+			ac.ReplaceLit = yyDollar[2].token.Literal
+			ac.Replace = yyDollar[4].queryexprs
+			yyVAL.queryexpr = ac
+		}
+	case 244:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:1413
+		{
+			ac := yyDollar[1].queryexpr.(AllColumns)
+			ac.ExceptLit = yyDollar[2].token.Literal
+			ac.Except = yyDollar[4].queryexprs
+			ac.ReplaceLit = yyDollar[6].token.Literal
+			ac.Replace = yyDollar[8].queryexprs
+			yyVAL.queryexpr = ac
+		}
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1148
+//line parser.y:1424
 		{
 			yyVAL.queryexpr = AllColumns{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 198:
+	case 246:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1428
+		{
+			yyVAL.queryexpr = AllColumns{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier}
+		}
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1154
+//line parser.y:1432
+		{
+			yyVAL.queryexpr = AllColumns{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}}
+		}
+	case 248:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1438
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: ValueList{Values: yyDollar[2].queryexprs}}
 		}
-	case 199:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1158
+//line parser.y:1442
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: yyDollar[1].queryexpr.GetBaseExpr(), Value: yyDollar[1].queryexpr}
 		}
-	case 200:
+	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1164
+//line parser.y:1448
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 201:
+	case 251:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1168
+//line parser.y:1452
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 202:
+	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1174
+//line parser.y:1458
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 203:
+	case 253:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1178
+//line parser.y:1462
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 204:
+	case 254:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1184
+//line parser.y:1468
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token}
 		}
-	case 205:
+	case 255:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1188
+//line parser.y:1472
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token, Nulls: yyDollar[3].token.Literal, Position: yyDollar[4].token}
 		}
-	case 206:
+	case 256:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1194
+//line parser.y:1478
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 207:
+	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1198
+//line parser.y:1482
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 208:
+	case 258:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1204
+//line parser.y:1488
 		{
 			yyVAL.token = Token{}
 		}
-	case 209:
+	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1208
+//line parser.y:1492
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 210:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1212
+//line parser.y:1496
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 211:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1218
+//line parser.y:1502
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 212:
+	case 262:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1222
+//line parser.y:1506
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 213:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1228
+//line parser.y:1512
 		{
 			yyVAL.queryexpr = Subquery{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr.(SelectQuery)}
 		}
-	case 214:
+	case 264:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1234
+//line parser.y:1518
 		{
 			var item1 []QueryExpression
 			var item2 []QueryExpression
@@ -3006,1215 +3815,1533 @@ yydefault:
 
 			yyVAL.queryexpr = Concat{Items: append(item1, item2...)}
 		}
-	case 215:
+	case 265:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1257
+//line parser.y:1541
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, RHS: yyDollar[3].queryexpr}
 		}
-	case 216:
+	case 266:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1261
+//line parser.y:1545
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, RHS: yyDollar[3].queryexpr}
 		}
-	case 217:
+	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1265
+//line parser.y:1549
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: "=", RHS: yyDollar[3].queryexpr}
 		}
-	case 218:
+	case 268:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1269
+//line parser.y:1553
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: "=", RHS: yyDollar[3].queryexpr}
 		}
-	case 219:
+	case 269:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1557
+		{
+			yyVAL.queryexpr = Is{Is: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
+		}
+	case 270:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1273
+//line parser.y:1561
 		{
 			yyVAL.queryexpr = Is{Is: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 220:
+	case 271:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1565
+		{
+			yyVAL.queryexpr = Between{Between: yyDollar[2].token.Literal, And: yyDollar[5].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Symmetric: yyDollar[3].token}
+		}
+	case 272:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1569
+		{
+			yyVAL.queryexpr = Between{Between: yyDollar[3].token.Literal, And: yyDollar[6].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[5].queryexpr, High: yyDollar[7].queryexpr, Negation: yyDollar[2].token, Symmetric: yyDollar[4].token}
+		}
+	case 273:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1573
+		{
+			yyVAL.queryexpr = Between{Between: yyDollar[3].token.Literal, And: yyDollar[6].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[5].queryexpr, High: yyDollar[7].queryexpr, Negation: yyDollar[2].token, Symmetric: yyDollar[4].token}
+		}
+	case 274:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1577
+		{
+			yyVAL.queryexpr = In{In: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[3].queryexpr}
+		}
+	case 275:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1581
+		{
+			yyVAL.queryexpr = In{In: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
+		}
+	case 276:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1585
+		{
+			yyVAL.queryexpr = In{In: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Values: RowValueList{RowValues: yyDollar[5].queryexprs}, Negation: yyDollar[2].token}
+		}
+	case 277:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1589
+		{
+			yyVAL.queryexpr = In{In: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
+		}
+	case 278:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1593
+		{
+			yyVAL.queryexpr = Like{Like: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr}
+		}
+	case 279:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1277
+//line parser.y:1597
 		{
-			yyVAL.queryexpr = Is{Is: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
+			yyVAL.queryexpr = Like{Like: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 221:
+	case 280:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1281
-		{
-			yyVAL.queryexpr = Between{Between: yyDollar[2].token.Literal, And: yyDollar[4].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[3].queryexpr, High: yyDollar[5].queryexpr}
-		}
-	case 222:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1285
+//line parser.y:1601
 		{
-			yyVAL.queryexpr = Between{Between: yyDollar[3].token.Literal, And: yyDollar[5].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
+			yyVAL.queryexpr = Like{Like: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr, EscapeLit: yyDollar[4].token.Literal, Escape: yyDollar[5].queryexpr}
 		}
-	case 223:
+	case 281:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1289
+//line parser.y:1605
 		{
-			yyVAL.queryexpr = Between{Between: yyDollar[3].token.Literal, And: yyDollar[5].token.Literal, LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
+			yyVAL.queryexpr = Like{Like: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token, EscapeLit: yyDollar[5].token.Literal, Escape: yyDollar[6].queryexpr}
 		}
-	case 224:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1293
+//line parser.y:1609
 		{
-			yyVAL.queryexpr = In{In: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[3].queryexpr}
+			yyVAL.queryexpr = Like{Like: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr}
 		}
-	case 225:
+	case 283:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1297
+//line parser.y:1613
 		{
-			yyVAL.queryexpr = In{In: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
+			yyVAL.queryexpr = Like{Like: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 226:
+	case 284:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1617
+		{
+			yyVAL.queryexpr = Like{Like: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr, EscapeLit: yyDollar[4].token.Literal, Escape: yyDollar[5].queryexpr}
+		}
+	case 285:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1301
+//line parser.y:1621
 		{
-			yyVAL.queryexpr = In{In: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Values: RowValueList{RowValues: yyDollar[5].queryexprs}, Negation: yyDollar[2].token}
+			yyVAL.queryexpr = Like{Like: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token, EscapeLit: yyDollar[5].token.Literal, Escape: yyDollar[6].queryexpr}
 		}
-	case 227:
+	case 286:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1305
+//line parser.y:1625
 		{
-			yyVAL.queryexpr = In{In: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
+			yyVAL.queryexpr = SimilarTo{BaseExpr: NewBaseExpr(yyDollar[2].token), SimilarTo: yyDollar[2].token.Literal + " " + yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr}
 		}
-	case 228:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1309
+	case 287:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1629
 		{
-			yyVAL.queryexpr = Like{Like: yyDollar[2].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr}
+			yyVAL.queryexpr = SimilarTo{BaseExpr: NewBaseExpr(yyDollar[3].token), SimilarTo: yyDollar[3].token.Literal + " " + yyDollar[4].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[5].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 229:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1313
+	case 288:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1633
 		{
-			yyVAL.queryexpr = Like{Like: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
+			yyVAL.queryexpr = SimilarTo{BaseExpr: NewBaseExpr(yyDollar[2].token), SimilarTo: yyDollar[2].token.Literal + " " + yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, EscapeLit: yyDollar[5].token.Literal, Escape: yyDollar[6].queryexpr}
 		}
-	case 230:
+	case 289:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1637
+		{
+			yyVAL.queryexpr = SimilarTo{BaseExpr: NewBaseExpr(yyDollar[3].token), SimilarTo: yyDollar[3].token.Literal + " " + yyDollar[4].token.Literal, LHS: yyDollar[1].queryexpr, Pattern: yyDollar[5].queryexpr, Negation: yyDollar[2].token, EscapeLit: yyDollar[6].token.Literal, Escape: yyDollar[7].queryexpr}
+		}
+	case 290:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1641
+		{
+			yyVAL.queryexpr = RegExpMatch{BaseExpr: NewBaseExpr(yyDollar[2].token), LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Pattern: yyDollar[3].queryexpr}
+		}
+	case 291:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1317
+//line parser.y:1645
 		{
 			yyVAL.queryexpr = Any{Any: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 231:
+	case 292:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1321
+//line parser.y:1649
 		{
 			yyVAL.queryexpr = Any{Any: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: RowValueList{RowValues: yyDollar[5].queryexprs}}
 		}
-	case 232:
+	case 293:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1325
+//line parser.y:1653
 		{
 			yyVAL.queryexpr = Any{Any: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 233:
+	case 294:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1329
+//line parser.y:1657
 		{
 			yyVAL.queryexpr = All{All: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 234:
+	case 295:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1333
+//line parser.y:1661
 		{
 			yyVAL.queryexpr = All{All: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: RowValueList{RowValues: yyDollar[5].queryexprs}}
 		}
-	case 235:
+	case 296:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1337
+//line parser.y:1665
 		{
 			yyVAL.queryexpr = All{All: yyDollar[3].token.Literal, LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token.Literal, Values: yyDollar[4].queryexpr}
 		}
-	case 236:
+	case 297:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1341
+//line parser.y:1669
 		{
 			yyVAL.queryexpr = Exists{Exists: yyDollar[1].token.Literal, Query: yyDollar[2].queryexpr.(Subquery)}
 		}
-	case 237:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1347
+//line parser.y:1675
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('+'), RHS: yyDollar[3].queryexpr}
 		}
-	case 238:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1351
+//line parser.y:1679
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('-'), RHS: yyDollar[3].queryexpr}
 		}
-	case 239:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1355
+//line parser.y:1683
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('*'), RHS: yyDollar[3].queryexpr}
 		}
-	case 240:
+	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1359
+//line parser.y:1687
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('/'), RHS: yyDollar[3].queryexpr}
 		}
-	case 241:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1363
+//line parser.y:1691
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: int('%'), RHS: yyDollar[3].queryexpr}
 		}
-	case 242:
+	case 303:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1367
+//line parser.y:1695
 		{
 			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 243:
+	case 304:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1371
+//line parser.y:1699
 		{
 			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 244:
+	case 305:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1377
+//line parser.y:1705
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 245:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1381
+//line parser.y:1709
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 246:
+	case 307:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1385
+//line parser.y:1713
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 247:
+	case 308:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1389
+//line parser.y:1717
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 248:
+	case 309:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1395
+//line parser.y:1723
 		{
 			yyVAL.queryexprs = nil
 		}
-	case 249:
+	case 310:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1399
+//line parser.y:1727
 		{
 			yyVAL.queryexprs = yyDollar[1].queryexprs
 		}
-	case 250:
+	case 311:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1405
+//line parser.y:1733
 		{
 			yyVAL.queryexpr = Function{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 251:
+	case 312:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1409
+//line parser.y:1737
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 252:
+	case 313:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1741
+		{
+			yyVAL.queryexpr = Function{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: []QueryExpression{yyDollar[3].queryexpr}}
+		}
+	case 314:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1745
+		{
+			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
+		}
+	case 315:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1749
+		{
+			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
+		}
+	case 316:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1753
+		{
+			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
+		}
+	case 317:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1416
+//line parser.y:1760
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 253:
+	case 318:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1420
+//line parser.y:1764
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 254:
+	case 319:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1424
+//line parser.y:1768
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 255:
+	case 320:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1428
+//line parser.y:1772
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}}
 		}
-	case 256:
+	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1432
+//line parser.y:1776
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 257:
+	case 322:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1438
+//line parser.y:1782
 		{
 			yyVAL.queryexpr = ListAgg{BaseExpr: NewBaseExpr(yyDollar[1].token), ListAgg: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 258:
+	case 323:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1442
+//line parser.y:1786
 		{
 			yyVAL.queryexpr = ListAgg{BaseExpr: NewBaseExpr(yyDollar[1].token), ListAgg: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, WithinGroup: yyDollar[6].token.Literal + " " + yyDollar[7].token.Literal, OrderBy: yyDollar[9].queryexpr}
 		}
-	case 259:
+	case 324:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1448
+//line parser.y:1792
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 260:
+	case 325:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1452
+//line parser.y:1796
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 261:
+	case 326:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1456
+//line parser.y:1800
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 262:
+	case 327:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1460
+//line parser.y:1804
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 263:
+	case 328:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1464
+//line parser.y:1808
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 264:
+	case 329:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1468
+//line parser.y:1812
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Over: yyDollar[6].token.Literal, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 265:
+	case 330:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1472
+//line parser.y:1816
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 266:
+	case 331:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1476
+//line parser.y:1820
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 267:
+	case 332:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1480
+//line parser.y:1824
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreNulls: true, IgnoreNullsLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
 		}
-	case 268:
+	case 333:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1484
+//line parser.y:1828
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, Over: yyDollar[5].token.Literal, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 269:
+	case 334:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1488
+//line parser.y:1832
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreNulls: true, IgnoreNullsLit: yyDollar[5].token.Literal + " " + yyDollar[6].token.Literal, Over: yyDollar[7].token.Literal, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
 		}
-	case 270:
+	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1494
+//line parser.y:1838
 		{
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: yyDollar[2].queryexpr}
 		}
-	case 271:
+	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1500
+//line parser.y:1844
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 272:
+	case 337:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1504
+//line parser.y:1848
 		{
 			orderByClause := OrderByClause{OrderBy: yyDollar[2].token.Literal + " " + yyDollar[3].token.Literal, Items: yyDollar[4].queryexprs}
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: orderByClause, WindowingClause: yyDollar[5].queryexpr}
 		}
-	case 273:
+	case 338:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1511
+//line parser.y:1855
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 274:
+	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1515
+//line parser.y:1859
 		{
 			yyVAL.queryexpr = PartitionClause{PartitionBy: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal, Values: yyDollar[3].queryexprs}
 		}
-	case 275:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1521
+//line parser.y:1865
 		{
 			yyVAL.queryexpr = WindowingClause{Rows: yyDollar[1].token.Literal, FrameLow: yyDollar[2].queryexpr}
 		}
-	case 276:
+	case 341:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1525
+//line parser.y:1869
 		{
 			yyVAL.queryexpr = WindowingClause{Rows: yyDollar[1].token.Literal, FrameLow: yyDollar[3].queryexpr, FrameHigh: yyDollar[5].queryexpr, Between: yyDollar[2].token.Literal, And: yyDollar[4].token.Literal}
 		}
-	case 277:
+	case 342:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1531
+//line parser.y:1875
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Unbounded: true, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 278:
+	case 343:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1535
+//line parser.y:1879
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Offset: i, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 279:
+	case 344:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1540
+//line parser.y:1884
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 280:
+	case 345:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1546
+//line parser.y:1890
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Offset: i, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 281:
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1551
+//line parser.y:1895
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Offset: i, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 282:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1556
+//line parser.y:1900
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token.Token, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 283:
+	case 348:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1562
+//line parser.y:1906
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Unbounded: true, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 284:
+	case 349:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1566
+//line parser.y:1910
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 285:
+	case 350:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1572
+//line parser.y:1916
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token.Token, Unbounded: true, Literal: yyDollar[1].token.Literal + " " + yyDollar[2].token.Literal}
 		}
-	case 286:
+	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1576
+//line parser.y:1920
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 287:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1582
+//line parser.y:1926
 		{
 			yyVAL.queryexpr = yyDollar[1].identifier
 		}
-	case 288:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1586
+//line parser.y:1930
 		{
 			yyVAL.queryexpr = Stdin{BaseExpr: NewBaseExpr(yyDollar[1].token), Stdin: yyDollar[1].token.Literal}
 		}
-	case 289:
+	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1592
+//line parser.y:1936
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 290:
+	case 355:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1596
+//line parser.y:1940
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 291:
+	case 356:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1600
+//line parser.y:1944
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
 		}
-	case 292:
+	case 357:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1950
+		{
+			yyVAL.tableopt = TableOption{Name: yyDollar[1].identifier}
+		}
+	case 358:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1954
+		{
+			yyVAL.tableopt = TableOption{Name: yyDollar[1].identifier, Value: yyDollar[2].identifier}
+		}
+	case 359:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1958
+		{
+			yyVAL.tableopt = TableOption{Name: yyDollar[1].identifier, Value: yyDollar[2].queryexpr}
+		}
+	case 360:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1962
+		{
+			yyVAL.tableopt = TableOption{Name: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Value: ComputedColumn{BaseExpr: yyDollar[2].identifier.BaseExpr, Column: yyDollar[2].identifier, Name: yyDollar[3].identifier, As: yyDollar[4].token.Literal, Value: yyDollar[5].queryexpr}}
+		}
+	case 361:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1968
+		{
+			yyVAL.tableopts = []TableOption{yyDollar[1].tableopt}
+		}
+	case 362:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1972
+		{
+			yyVAL.tableopts = append([]TableOption{yyDollar[1].tableopt}, yyDollar[3].tableopts...)
+		}
+	case 363:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1606
+//line parser.y:1978
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 293:
+	case 364:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1982
+		{
+			yyVAL.queryexpr = ValuesTable{BaseExpr: NewBaseExpr(yyDollar[1].token), Values: yyDollar[2].token.Literal, RowValues: yyDollar[3].queryexprs}
+		}
+	case 365:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1986
+		{
+			yyVAL.queryexpr = UnnestTable{BaseExpr: NewBaseExpr(yyDollar[1].token), Unnest: yyDollar[1].token.Literal, Value: yyDollar[3].queryexpr}
+		}
+	case 366:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:1990
+		{
+			yyVAL.queryexpr = UnnestTable{BaseExpr: NewBaseExpr(yyDollar[1].token), Unnest: yyDollar[1].token.Literal, Value: yyDollar[3].queryexpr, With: yyDollar[5].token.Literal, Ordinality: yyDollar[6].token.Literal}
+		}
+	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1612
+//line parser.y:1996
 		{
 			yyVAL.queryexpr = yyDollar[1].table
 		}
-	case 294:
+	case 368:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2000
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Options: yyDollar[3].tableopts}
+		}
+	case 369:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:2004
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Options: yyDollar[3].tableopts, Alias: yyDollar[5].identifier}
+		}
+	case 370:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2008
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Options: yyDollar[3].tableopts, As: yyDollar[5].token.Literal, Alias: yyDollar[6].identifier}
+		}
+	case 371:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1616
+//line parser.y:2012
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 295:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1620
+//line parser.y:2016
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 296:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1624
+//line parser.y:2020
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
+		}
+	case 374:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:2024
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier, Fields: yyDollar[4].queryexprs}
+		}
+	case 375:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2028
 		{
-			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs}
 		}
-	case 297:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1628
+//line parser.y:2032
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 298:
+	case 377:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1632
+//line parser.y:2036
 		{
 			yyVAL.queryexpr = Table{Object: Dual{Dual: yyDollar[1].token.Literal}}
 		}
-	case 299:
+	case 378:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1636
+//line parser.y:2040
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 300:
+	case 379:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1642
+//line parser.y:2046
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[3].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: nil}
 		}
-	case 301:
+	case 380:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1646
+//line parser.y:2050
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[3].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: yyDollar[5].queryexpr}
 		}
-	case 302:
+	case 381:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1650
+//line parser.y:2054
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: yyDollar[6].queryexpr}
 		}
-	case 303:
+	case 382:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:1654
+//line parser.y:2058
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: JoinCondition{Literal: yyDollar[6].token.Literal, On: yyDollar[7].queryexpr}}
 		}
-	case 304:
+	case 383:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1658
+//line parser.y:2062
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 305:
+	case 384:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1662
+//line parser.y:2066
 		{
 			yyVAL.queryexpr = Join{Join: yyDollar[5].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].queryexpr, JoinType: yyDollar[4].token, Direction: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 306:
+	case 385:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:2070
+		{
+			yyVAL.queryexpr = Join{BaseExpr: NewBaseExpr(yyDollar[2].token), Join: yyDollar[4].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, AsOf: yyDollar[2].token, Partition: yyDollar[6].queryexpr, Condition: JoinCondition{Literal: yyDollar[7].token.Literal, On: yyDollar[8].queryexpr}}
+		}
+	case 386:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:2074
+		{
+			yyVAL.queryexpr = Join{BaseExpr: NewBaseExpr(yyDollar[2].token), Join: yyDollar[5].token.Literal, Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].queryexpr, JoinType: yyDollar[4].token, Direction: yyDollar[3].token, AsOf: yyDollar[2].token, Partition: yyDollar[7].queryexpr, Condition: JoinCondition{Literal: yyDollar[8].token.Literal, On: yyDollar[9].queryexpr}}
+		}
+	case 387:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1668
+//line parser.y:2080
 		{
 			yyVAL.queryexpr = JoinCondition{Literal: yyDollar[1].token.Literal, On: yyDollar[2].queryexpr}
 		}
-	case 307:
+	case 388:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1672
+//line parser.y:2084
 		{
 			yyVAL.queryexpr = JoinCondition{Literal: yyDollar[1].token.Literal, Using: yyDollar[3].queryexprs}
 		}
-	case 308:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1678
+//line parser.y:2090
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 309:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1682
+//line parser.y:2094
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 310:
+	case 391:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1688
+//line parser.y:2100
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 311:
+	case 392:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1692
+//line parser.y:2104
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
 		}
-	case 312:
+	case 393:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1696
+//line parser.y:2108
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 313:
+	case 394:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1702
+//line parser.y:2114
 		{
 			yyVAL.queryexpr = CaseExpr{Case: yyDollar[1].token.Literal, End: yyDollar[5].token.Literal, Value: yyDollar[2].queryexpr, When: yyDollar[3].queryexprs, Else: yyDollar[4].queryexpr}
 		}
-	case 314:
+	case 395:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1708
+//line parser.y:2120
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 315:
+	case 396:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1712
+//line parser.y:2124
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 316:
+	case 397:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1718
+//line parser.y:2130
 		{
 			yyVAL.queryexprs = []QueryExpression{CaseExprWhen{When: yyDollar[1].token.Literal, Then: yyDollar[3].token.Literal, Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}
 		}
-	case 317:
+	case 398:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1722
+//line parser.y:2134
 		{
 			yyVAL.queryexprs = append([]QueryExpression{CaseExprWhen{When: yyDollar[1].token.Literal, Then: yyDollar[3].token.Literal, Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}, yyDollar[5].queryexprs...)
 		}
-	case 318:
+	case 399:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1728
+//line parser.y:2140
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 319:
+	case 400:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1732
+//line parser.y:2144
 		{
 			yyVAL.queryexpr = CaseExprElse{Else: yyDollar[1].token.Literal, Result: yyDollar[2].queryexpr}
 		}
-	case 320:
+	case 401:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1738
+//line parser.y:2150
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 321:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1742
+//line parser.y:2154
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 322:
+	case 403:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1748
+//line parser.y:2160
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 323:
+	case 404:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1752
+//line parser.y:2164
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 324:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1758
+//line parser.y:2170
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 325:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1762
+//line parser.y:2174
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 326:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1768
+//line parser.y:2180
 		{
 			yyVAL.queryexprs = []QueryExpression{Table{Object: yyDollar[1].queryexpr}}
 		}
-	case 327:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1772
+//line parser.y:2184
 		{
 			yyVAL.queryexprs = append([]QueryExpression{Table{Object: yyDollar[1].queryexpr}}, yyDollar[3].queryexprs...)
 		}
-	case 328:
+	case 409:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1778
+//line parser.y:2190
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].identifier}
 		}
-	case 329:
+	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1782
+//line parser.y:2194
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].identifier}, yyDollar[3].queryexprs...)
 		}
-	case 330:
+	case 411:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2200
+		{
+			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr, As: yyDollar[2].token.Literal, Alias: yyDollar[3].identifier}
+		}
+	case 412:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1788
+//line parser.y:2206
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 331:
+	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1792
+//line parser.y:2210
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 332:
+	case 414:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2216
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 415:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2220
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 416:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1798
+//line parser.y:2226
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, ValuesList: yyDollar[6].queryexprs}
 		}
-	case 333:
+	case 417:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1802
+//line parser.y:2230
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, Fields: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs}
 		}
-	case 334:
+	case 418:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1806
+//line parser.y:2234
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 335:
+	case 419:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1810
+//line parser.y:2238
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, Fields: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 336:
+	case 420:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:1816
+//line parser.y:2244
 		{
 			yyVAL.expression = UpdateQuery{WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, SetList: yyDollar[5].updatesets, FromClause: yyDollar[6].queryexpr, WhereClause: yyDollar[7].queryexpr}
 		}
-	case 337:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1822
+//line parser.y:2250
 		{
 			yyVAL.updateset = UpdateSet{Field: yyDollar[1].queryexpr, Value: yyDollar[3].queryexpr}
 		}
-	case 338:
+	case 422:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1828
+//line parser.y:2256
 		{
 			yyVAL.updatesets = []UpdateSet{yyDollar[1].updateset}
 		}
-	case 339:
+	case 423:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1832
+//line parser.y:2260
 		{
 			yyVAL.updatesets = append([]UpdateSet{yyDollar[1].updateset}, yyDollar[3].updatesets...)
 		}
-	case 340:
+	case 424:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1838
+//line parser.y:2266
 		{
 			from := FromClause{From: yyDollar[3].token.Literal, Tables: yyDollar[4].queryexprs}
 			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, FromClause: from, WhereClause: yyDollar[5].queryexpr}
 		}
-	case 341:
+	case 425:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1843
+//line parser.y:2271
 		{
 			from := FromClause{From: yyDollar[4].token.Literal, Tables: yyDollar[5].queryexprs}
 			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, FromClause: from, WhereClause: yyDollar[6].queryexpr}
 		}
-	case 342:
+	case 426:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1850
+//line parser.y:2278
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 343:
+	case 427:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1854
+//line parser.y:2282
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 344:
+	case 428:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1860
+//line parser.y:2288
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 345:
+	case 429:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1864
+//line parser.y:2292
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 346:
+	case 430:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1870
+//line parser.y:2298
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 347:
+	case 431:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1874
+//line parser.y:2302
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 348:
+	case 432:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1880
+//line parser.y:2308
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 349:
+	case 433:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1884
+//line parser.y:2312
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 350:
+	case 434:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1890
+//line parser.y:2318
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 351:
+	case 435:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1894
+//line parser.y:2322
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 352:
+	case 436:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1900
+//line parser.y:2328
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 353:
+	case 437:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1904
+//line parser.y:2332
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 354:
+	case 438:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1910
+//line parser.y:2338
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 355:
+	case 439:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1914
+//line parser.y:2342
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 356:
+	case 440:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1920
+//line parser.y:2348
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 357:
+	case 441:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1924
+//line parser.y:2352
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 358:
+	case 442:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1930
+//line parser.y:2358
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 359:
+	case 443:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1934
+//line parser.y:2362
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 360:
+	case 444:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1940
+//line parser.y:2368
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 361:
+	case 445:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1944
+//line parser.y:2372
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 362:
+	case 446:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1950
+//line parser.y:2378
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 363:
+	case 447:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1954
+//line parser.y:2382
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 364:
+	case 448:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1960
+//line parser.y:2388
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 365:
+	case 449:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1964
+//line parser.y:2392
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 366:
+	case 450:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1970
+//line parser.y:2398
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 367:
+	case 451:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1974
+//line parser.y:2402
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 368:
+	case 452:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1980
+//line parser.y:2408
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 369:
+	case 453:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1984
+//line parser.y:2412
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 370:
+	case 454:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1990
+//line parser.y:2418
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 371:
+	case 455:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1994
+//line parser.y:2422
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 372:
+	case 456:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2000
+//line parser.y:2428
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 373:
+	case 457:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2004
+//line parser.y:2432
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 374:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2010
+//line parser.y:2438
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 375:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2014
+//line parser.y:2442
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 376:
+	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2018
+//line parser.y:2446
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 377:
+	case 461:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2022
+//line parser.y:2450
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 378:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2026
+//line parser.y:2454
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 379:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2030
+//line parser.y:2458
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 380:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2034
+//line parser.y:2462
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 381:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2038
+//line parser.y:2466
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 382:
+	case 466:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2042
+//line parser.y:2470
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 383:
+	case 467:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2046
+//line parser.y:2474
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 384:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2050
+//line parser.y:2478
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 385:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2054
+//line parser.y:2482
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 386:
+	case 470:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2058
+//line parser.y:2486
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 387:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2062
+//line parser.y:2490
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 388:
+	case 472:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2066
+//line parser.y:2494
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 389:
+	case 473:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2070
+//line parser.y:2498
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 390:
+	case 474:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2502
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 475:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2506
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 476:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2510
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 477:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2514
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 478:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2518
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 479:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2522
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 480:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2526
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 481:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2530
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 482:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2534
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 483:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2538
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 484:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2542
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 485:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2546
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 486:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2550
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 487:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2554
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 488:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2558
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 489:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2076
+//line parser.y:2564
 		{
 			yyVAL.variable = Variable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 391:
+	case 490:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2082
+//line parser.y:2570
 		{
 			yyVAL.variables = []Variable{yyDollar[1].variable}
 		}
-	case 392:
+	case 491:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2086
+//line parser.y:2574
 		{
 			yyVAL.variables = append([]Variable{yyDollar[1].variable}, yyDollar[3].variables...)
 		}
-	case 393:
+	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2092
+//line parser.y:2580
 		{
 			yyVAL.queryexpr = VariableSubstitution{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 394:
+	case 493:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2098
+//line parser.y:2586
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 395:
+	case 494:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2102
+//line parser.y:2590
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 396:
+	case 495:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2108
+//line parser.y:2596
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 397:
+	case 496:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2112
+//line parser.y:2600
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 398:
+	case 497:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2118
+//line parser.y:2606
 		{
 			yyVAL.token = Token{}
 		}
-	case 399:
+	case 498:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2122
+//line parser.y:2610
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 400:
+	case 499:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2128
+//line parser.y:2616
 		{
 			yyVAL.token = Token{}
 		}
-	case 401:
+	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2132
+//line parser.y:2620
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 402:
+	case 501:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2138
+//line parser.y:2626
 		{
 			yyVAL.token = Token{}
 		}
-	case 403:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2142
+//line parser.y:2630
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 404:
+	case 503:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2148
+//line parser.y:2636
 		{
 			yyVAL.token = Token{}
 		}
-	case 405:
+	case 504:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2152
+//line parser.y:2640
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 406:
+	case 505:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2646
+		{
+			yyVAL.token = Token{}
+		}
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2158
+//line parser.y:2650
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 407:
+	case 507:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2656
+		{
+			yyVAL.token = Token{}
+		}
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2162
+//line parser.y:2660
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 408:
+	case 509:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2666
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 510:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2670
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 511:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2168
+//line parser.y:2676
 		{
 			yyVAL.token = Token{}
 		}
-	case 409:
+	case 512:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2172
+//line parser.y:2680
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 410:
+	case 513:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2178
+//line parser.y:2686
 		{
 			yyVAL.token = Token{}
 		}
-	case 411:
+	case 514:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2182
+//line parser.y:2690
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 412:
+	case 515:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2188
+//line parser.y:2696
 		{
 			yyVAL.token = Token{}
 		}
-	case 413:
+	case 516:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2192
+//line parser.y:2700
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 414:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2198
+//line parser.y:2706
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 415:
+	case 518:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2202
+//line parser.y:2710
 		{
 			yyDollar[1].token.Token = COMPARISON_OP
 			yyVAL.token = yyDollar[1].token
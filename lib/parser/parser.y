@@ -25,11 +25,17 @@ import (
     updatesets  []UpdateSet
     columndef   ColumnDefault
     columndefs  []ColumnDefault
+    exportopt   ExportOption
+    exportopts  []ExportOption
+    tableopt    TableOption
+    tableopts   []TableOption
     elseif      []ElseIf
     elseexpr    Else
     casewhen    []CaseWhen
     caseelse    CaseElse
     fetchpos    FetchPosition
+    schemafield  SchemaField
+    schemafields []SchemaField
     token       Token
 }
 
@@ -41,6 +47,7 @@ import (
 %type<statement>   common_loop_flow_control_statement
 %type<statement>   procedure_statement
 %type<statement>   while_statement
+%type<statement>   labeled_while_statement
 %type<token>       while_variable_declaration
 %type<statement>   exit_statement
 %type<statement>   flow_control_statement
@@ -48,6 +55,7 @@ import (
 %type<statement>   loop_flow_control_statement
 %type<statement>   function_statement
 %type<statement>   function_while_statement
+%type<statement>   function_labeled_while_statement
 %type<statement>   function_exit_statement
 %type<statement>   function_loop_statement
 %type<statement>   function_flow_control_statement
@@ -58,8 +66,15 @@ import (
 %type<columndef>   column_default
 %type<columndefs>  column_defaults
 %type<expression>  column_position
+%type<tableopt>    table_option
+%type<tableopts>   table_options
+%type<exportopt>   export_option
+%type<exportopts>  export_options
 %type<statement>   cursor_statement
 %type<statement>   temporary_table_statement
+%type<statement>   schema_statement
+%type<schemafield> schema_field
+%type<schemafields> schema_fields
 %type<varassign>   parameter
 %type<varassigns>  parameters
 %type<varassign>   optional_parameter
@@ -71,6 +86,7 @@ import (
 %type<statement>   command_statement
 %type<statement>   trigger_statement
 %type<queryexpr>   select_query
+%type<statement>   select_into_query
 %type<queryexpr>   select_entity
 %type<queryexpr>   select_set_entity
 %type<queryexpr>   select_clause
@@ -91,6 +107,7 @@ import (
 %type<queryexpr>   field_reference
 %type<queryexpr>   value
 %type<queryexpr>   wildcard
+%type<queryexpr>   all_columns
 %type<queryexpr>   row_value
 %type<queryexprs>  row_values
 %type<queryexprs>  order_items
@@ -125,6 +142,8 @@ import (
 %type<queryexpr>   join_condition
 %type<queryexpr>   field_object
 %type<queryexpr>   field
+%type<queryexpr>   replace_field
+%type<queryexprs>  replace_fields
 %type<queryexpr>   case_expr
 %type<queryexpr>   case_value
 %type<queryexprs>  case_expr_when
@@ -162,7 +181,9 @@ import (
 %type<varassign>   variable_assignment
 %type<varassigns>  variable_assignments
 %type<token>       distinct
+%type<token>       deterministic
 %type<token>       negation
+%type<token>       symmetric
 %type<token>       join_type_inner
 %type<token>       join_type_outer
 %type<token>       join_outer_direction
@@ -172,45 +193,54 @@ import (
 %type<token>       comparison_operator
 
 %token<token> IDENTIFIER STRING INTEGER FLOAT BOOLEAN TERNARY DATETIME VARIABLE FLAG
-%token<token> SELECT FROM UPDATE SET DELETE WHERE INSERT INTO VALUES AS DUAL STDIN
+%token<token> SELECT FROM UPDATE SET DELETE WHERE INSERT INTO VALUES AS DUAL STDIN UNNEST
 %token<token> RECURSIVE
 %token<token> CREATE ADD DROP ALTER TABLE FIRST LAST AFTER BEFORE DEFAULT RENAME TO VIEW
+%token<token> DEDUPLICATE EXPORT DIFF EXPLAIN
 %token<token> ORDER GROUP HAVING BY ASC DESC LIMIT OFFSET PERCENT
-%token<token> JOIN INNER OUTER LEFT RIGHT FULL CROSS ON USING NATURAL
+%token<token> JOIN INNER OUTER LEFT RIGHT FULL CROSS ON USING NATURAL ASOF
 %token<token> UNION INTERSECT EXCEPT
 %token<token> ALL ANY EXISTS IN
 %token<token> AND OR NOT BETWEEN LIKE IS NULL
+%token<token> SYMMETRIC ILIKE ESCAPE SIMILAR
 %token<token> DISTINCT WITH
 %token<token> RANGE UNBOUNDED PRECEDING FOLLOWING CURRENT ROW
-%token<token> CASE IF ELSEIF WHILE WHEN THEN ELSE DO END
+%token<token> CASE IF ELSEIF WHILE LOOP WHEN THEN ELSE DO END
 %token<token> DECLARE CURSOR FOR FETCH OPEN CLOSE DISPOSE
 %token<token> NEXT PRIOR ABSOLUTE RELATIVE
 %token<token> SEPARATOR PARTITION OVER
-%token<token> COMMIT ROLLBACK
+%token<token> COMMIT ROLLBACK SAVEPOINT
 %token<token> CONTINUE BREAK EXIT
-%token<token> PRINT PRINTF SOURCE TRIGGER
+%token<token> PRINT PRINTF SOURCE TRIGGER RAISE
 %token<token> FUNCTION AGGREGATE BEGIN RETURN
 %token<token> IGNORE WITHIN
+%token<token> AT TIME ZONE
+%token<token> SCHEMA
+%token<token> USE REPOSITORY
+%token<token> NO SCROLL
 %token<token> VAR SHOW
-%token<token> TIES NULLS TABLES VIEWS FIELDS CURSORS FUNCTIONS ROWS
+%token<token> TIES NULLS TABLES VIEWS FIELDS COLUMNS CURSORS FUNCTIONS FLAGS ROWS AGAINST KEY DETERMINISTIC ORDINALITY
+%token<token> REPLACE OVERWRITE
 %token<token> ERROR
 %token<token> COUNT LISTAGG
 %token<token> AGGREGATE_FUNCTION ANALYTIC_FUNCTION FUNCTION_NTH FUNCTION_WITH_INS
-%token<token> COMPARISON_OP STRING_OP SUBSTITUTION_OP
+%token<token> COMPARISON_OP STRING_OP REGEXP_OP SUBSTITUTION_OP
 %token<token> UMINUS UPLUS
-%token<token> ';' '*' '=' '-' '+' '!' '(' ')'
+%token<token> ';' ':' '*' '=' '-' '+' '!' '(' ')'
 
 %right SUBSTITUTION_OP
 %left UNION EXCEPT
 %left INTERSECT
-%left CROSS FULL NATURAL JOIN
+%left CROSS FULL NATURAL ASOF JOIN
 %left OR
 %left AND
 %right NOT
-%nonassoc '=' COMPARISON_OP IS BETWEEN IN LIKE
+%nonassoc '=' COMPARISON_OP IS BETWEEN IN LIKE ILIKE SIMILAR REGEXP_OP
+%nonassoc ESCAPE
 %left STRING_OP
 %left '+' '-'
 %left '*' '/' '%'
+%left AT
 %right UMINUS UPLUS '!'
 
 %%
@@ -267,6 +297,10 @@ common_statement
     {
         $$ = $1
     }
+    | select_into_query
+    {
+        $$ = $1
+    }
     | insert_query
     {
         $$ = $1
@@ -299,6 +333,10 @@ common_statement
     {
         $$ = $1
     }
+    | schema_statement
+    {
+        $$ = $1
+    }
     | user_defined_function_statement
     {
         $$ = $1
@@ -325,6 +363,14 @@ common_loop_flow_control_statement
     {
         $$ = FlowControl{Token: $1.Token}
     }
+    | CONTINUE identifier
+    {
+        $$ = FlowControl{Token: $1.Token, Label: $2}
+    }
+    | BREAK identifier
+    {
+        $$ = FlowControl{Token: $1.Token, Label: $2}
+    }
 
 procedure_statement
     : common_statement
@@ -339,23 +385,37 @@ procedure_statement
 while_statement
     : WHILE value DO loop_program END WHILE
     {
-        $$ = While{Condition: $2, Statements: $4}
+        $$ = While{BaseExpr: NewBaseExpr($1), Condition: $2, Statements: $4}
     }
     | WHILE variable IN identifier DO loop_program END WHILE
     {
-        $$ = WhileInCursor{Variables: []Variable{$2}, Cursor: $4, Statements: $6}
+        $$ = WhileInCursor{BaseExpr: NewBaseExpr($1), Variables: []Variable{$2}, Cursor: $4, Statements: $6}
     }
     | WHILE variables IN identifier DO loop_program END WHILE
     {
-        $$ = WhileInCursor{Variables: $2, Cursor: $4, Statements: $6}
+        $$ = WhileInCursor{BaseExpr: NewBaseExpr($1), Variables: $2, Cursor: $4, Statements: $6}
     }
     | WHILE while_variable_declaration variable IN identifier DO loop_program END WHILE
     {
-        $$ = WhileInCursor{WithDeclaration: true, Variables: []Variable{$3}, Cursor: $5, Statements: $7}
+        $$ = WhileInCursor{BaseExpr: NewBaseExpr($1), WithDeclaration: true, Variables: []Variable{$3}, Cursor: $5, Statements: $7}
     }
     | WHILE while_variable_declaration variables IN identifier DO loop_program END WHILE
     {
-        $$ = WhileInCursor{WithDeclaration: true, Variables: $3, Cursor: $5, Statements: $7}
+        $$ = WhileInCursor{BaseExpr: NewBaseExpr($1), WithDeclaration: true, Variables: $3, Cursor: $5, Statements: $7}
+    }
+    | LOOP loop_program END LOOP
+    {
+        $$ = Loop{BaseExpr: NewBaseExpr($1), Statements: $2}
+    }
+
+labeled_while_statement
+    : while_statement
+    {
+        $$ = $1
+    }
+    | identifier ':' while_statement
+    {
+        $$ = labelLoop($3, $1)
     }
 
 while_variable_declaration
@@ -401,7 +461,7 @@ flow_control_statement
     {
         $$ = Case{Value: $2, When: $3, Else: $4}
     }
-    | while_statement
+    | labeled_while_statement
     {
         $$ = $1
     }
@@ -423,7 +483,7 @@ loop_flow_control_statement
     {
         $$ = Case{Value: $2, When: $3, Else: $4}
     }
-    | while_statement
+    | labeled_while_statement
     {
         $$ = $1
     }
@@ -449,15 +509,29 @@ function_statement
 function_while_statement
     : WHILE value DO function_loop_program END WHILE
     {
-        $$ = While{Condition: $2, Statements: $4}
+        $$ = While{BaseExpr: NewBaseExpr($1), Condition: $2, Statements: $4}
     }
     | WHILE variable IN identifier DO function_loop_program END WHILE
     {
-        $$ = WhileInCursor{Variables: []Variable{$2}, Cursor: $4, Statements: $6}
+        $$ = WhileInCursor{BaseExpr: NewBaseExpr($1), Variables: []Variable{$2}, Cursor: $4, Statements: $6}
     }
     | WHILE variables IN identifier DO function_loop_program END WHILE
     {
-        $$ = WhileInCursor{Variables: $2, Cursor: $4, Statements: $6}
+        $$ = WhileInCursor{BaseExpr: NewBaseExpr($1), Variables: $2, Cursor: $4, Statements: $6}
+    }
+    | LOOP function_loop_program END LOOP
+    {
+        $$ = Loop{BaseExpr: NewBaseExpr($1), Statements: $2}
+    }
+
+function_labeled_while_statement
+    : function_while_statement
+    {
+        $$ = $1
+    }
+    | identifier ':' function_while_statement
+    {
+        $$ = labelLoop($3, $1)
     }
 
 function_exit_statement
@@ -493,7 +567,7 @@ function_flow_control_statement
     {
         $$ = Case{Value: $2, When: $3, Else: $4}
     }
-    | function_while_statement
+    | function_labeled_while_statement
     {
         $$ = $1
     }
@@ -515,7 +589,7 @@ function_loop_flow_control_statement
     {
         $$ = Case{Value: $2, When: $3, Else: $4}
     }
-    | function_while_statement
+    | function_labeled_while_statement
     {
         $$ = $1
     }
@@ -555,6 +629,18 @@ transaction_statement
     {
         $$ = TransactionControl{BaseExpr: NewBaseExpr($1), Token: $1.Token}
     }
+    | ROLLBACK TO identifier
+    {
+        $$ = TransactionControl{BaseExpr: NewBaseExpr($1), Token: $1.Token, Savepoint: $3}
+    }
+    | ROLLBACK TO SAVEPOINT identifier
+    {
+        $$ = TransactionControl{BaseExpr: NewBaseExpr($1), Token: $1.Token, Savepoint: $4}
+    }
+    | SAVEPOINT identifier
+    {
+        $$ = TransactionControl{BaseExpr: NewBaseExpr($1), Token: $1.Token, Savepoint: $2}
+    }
 
 table_operation_statement
     : CREATE TABLE identifier '(' identifiers ')'
@@ -569,6 +655,30 @@ table_operation_statement
     {
         $$ = CreateTable{Table: $3, Query: $5}
     }
+    | CREATE TABLE IF NOT EXISTS identifier '(' identifiers ')'
+    {
+        $$ = CreateTable{Table: $6, Fields: $8, IfNotExists: true}
+    }
+    | CREATE TABLE IF NOT EXISTS identifier '(' identifiers ')' as select_query
+    {
+        $$ = CreateTable{Table: $6, Fields: $8, Query: $11, IfNotExists: true}
+    }
+    | CREATE TABLE IF NOT EXISTS identifier as select_query
+    {
+        $$ = CreateTable{Table: $6, Query: $8, IfNotExists: true}
+    }
+    | DROP TABLE table_identifier
+    {
+        $$ = DropTable{Table: $3}
+    }
+    | RENAME TABLE table_identifier TO identifier
+    {
+        $$ = RenameTable{Table: $3, NewName: $5}
+    }
+    | RENAME TABLE table_identifier TO identifier OVERWRITE
+    {
+        $$ = RenameTable{Table: $3, NewName: $5, Overwrite: true}
+    }
     | ALTER TABLE table_identifier ADD column_default column_position
     {
         $$ = AddColumns{Table: $3, Columns: []ColumnDefault{$5}, Position: $6}
@@ -589,6 +699,10 @@ table_operation_statement
     {
         $$ = RenameColumn{Table: $3, Old: $5, New: $7}
     }
+    | DEDUPLICATE FROM table_identifier
+    {
+        $$ = Deduplicate{Table: $3}
+    }
 
 column_default
     : identifier
@@ -637,6 +751,14 @@ cursor_statement
     {
         $$ = CursorDeclaration{Cursor:$2, Query: $5.(SelectQuery)}
     }
+    | DECLARE identifier SCROLL CURSOR FOR select_query
+    {
+        $$ = CursorDeclaration{Cursor:$2, Scroll: $3, Query: $6.(SelectQuery)}
+    }
+    | DECLARE identifier NO SCROLL CURSOR FOR select_query
+    {
+        $$ = CursorDeclaration{Cursor:$2, Negation: $3, Scroll: $4, Query: $7.(SelectQuery)}
+    }
     | OPEN identifier
     {
         $$ = OpenCursor{Cursor: $2}
@@ -672,6 +794,32 @@ temporary_table_statement
         $$ = DisposeView{View: $3}
     }
 
+schema_statement
+    : DECLARE SCHEMA FOR identifier '(' schema_fields ')'
+    {
+        $$ = SchemaDeclaration{BaseExpr: NewBaseExpr($1), Table: $4, Fields: $6}
+    }
+    | DECLARE SCHEMA FOR STRING '(' schema_fields ')'
+    {
+        $$ = SchemaDeclaration{BaseExpr: NewBaseExpr($1), Table: Identifier{BaseExpr: NewBaseExpr($4), Literal: $4.Literal, Quoted: true}, Fields: $6}
+    }
+
+schema_field
+    : identifier identifier
+    {
+        $$ = SchemaField{BaseExpr: $1.BaseExpr, Column: $1, Type: $2}
+    }
+
+schema_fields
+    : schema_field
+    {
+        $$ = []SchemaField{$1}
+    }
+    | schema_field ',' schema_fields
+    {
+        $$ = append([]SchemaField{$1}, $3...)
+    }
+
 parameter
     : variable
     {
@@ -719,13 +867,13 @@ function_parameters
     }
 
 user_defined_function_statement
-    : DECLARE identifier FUNCTION '(' ')' AS BEGIN function_program END
+    : DECLARE identifier FUNCTION '(' ')' deterministic AS BEGIN function_program END
     {
-        $$ = FunctionDeclaration{Name: $2, Statements: $8}
+        $$ = FunctionDeclaration{Name: $2, Deterministic: $6, Statements: $9}
     }
-    | DECLARE identifier FUNCTION '(' function_parameters ')' AS BEGIN function_program END
+    | DECLARE identifier FUNCTION '(' function_parameters ')' deterministic AS BEGIN function_program END
     {
-        $$ = FunctionDeclaration{Name: $2, Parameters: $5, Statements: $9}
+        $$ = FunctionDeclaration{Name: $2, Parameters: $5, Deterministic: $7, Statements: $10}
     }
     | DECLARE identifier AGGREGATE '(' identifier ')' AS BEGIN function_program END
     {
@@ -809,10 +957,18 @@ command_statement
     {
         $$ = Source{BaseExpr: NewBaseExpr($1), FilePath: $2}
     }
+    | USE REPOSITORY value
+    {
+        $$ = UseRepository{BaseExpr: NewBaseExpr($1), Repository: $3}
+    }
     | SHOW TABLES
     {
         $$ = ShowObjects{BaseExpr: NewBaseExpr($1), Type: $2.Token}
     }
+    | SHOW TABLES LIKE value
+    {
+        $$ = ShowObjects{BaseExpr: NewBaseExpr($1), Type: $2.Token, Pattern: $4}
+    }
     | SHOW VIEWS
     {
         $$ = ShowObjects{BaseExpr: NewBaseExpr($1), Type: $2.Token}
@@ -825,10 +981,50 @@ command_statement
     {
         $$ = ShowObjects{BaseExpr: NewBaseExpr($1), Type: $2.Token}
     }
+    | SHOW FLAGS
+    {
+        $$ = ShowObjects{BaseExpr: NewBaseExpr($1), Type: $2.Token}
+    }
     | SHOW FIELDS FROM identifier
     {
         $$ = ShowFields{BaseExpr: NewBaseExpr($1), Table: $4}
     }
+    | SHOW COLUMNS FROM identifier
+    {
+        $$ = ShowFields{BaseExpr: NewBaseExpr($1), Table: $4}
+    }
+    | EXPORT table_identifier TO value export_options
+    {
+        $$ = Export{BaseExpr: NewBaseExpr($1), Table: $2, FilePath: $4, Options: $5}
+    }
+    | DIFF table_identifier AGAINST table_identifier KEY '(' identifiers ')'
+    {
+        $$ = Diff{BaseExpr: NewBaseExpr($1), Table: $2, Against: $4, Keys: $7}
+    }
+    | EXPLAIN select_query
+    {
+        $$ = Explain{BaseExpr: NewBaseExpr($1), Query: $2.(SelectQuery)}
+    }
+
+export_option
+    : identifier identifier
+    {
+        $$ = ExportOption{Name: $1, Value: $2}
+    }
+    | identifier primitive_type
+    {
+        $$ = ExportOption{Name: $1, Value: $2}
+    }
+
+export_options
+    :
+    {
+        $$ = nil
+    }
+    | export_option export_options
+    {
+        $$ = append([]ExportOption{$1}, $2...)
+    }
 
 trigger_statement
     : TRIGGER ERROR
@@ -843,6 +1039,18 @@ trigger_statement
     {
         $$ = Trigger{BaseExpr: NewBaseExpr($1), Token: $2.Token, Message: $4, Code: value.NewIntegerFromString($3.Literal)}
     }
+    | RAISE
+    {
+        $$ = Trigger{BaseExpr: NewBaseExpr($1), Token: ERROR}
+    }
+    | RAISE value
+    {
+        $$ = Trigger{BaseExpr: NewBaseExpr($1), Token: ERROR, Message: $2}
+    }
+    | RAISE INTEGER value
+    {
+        $$ = Trigger{BaseExpr: NewBaseExpr($1), Token: ERROR, Message: $3, Code: value.NewIntegerFromString($2.Literal)}
+    }
 
 select_query
     : with_clause select_entity order_by_clause limit_clause offset_clause
@@ -855,6 +1063,41 @@ select_query
             OffsetClause:  $5,
         }
     }
+    | with_clause select_entity order_by_clause limit_clause offset_clause FOR UPDATE
+    {
+        $$ = SelectQuery{
+            BaseExpr:      NewBaseExpr($6),
+            WithClause:    $1,
+            SelectEntity:  $2,
+            OrderByClause: $3,
+            LimitClause:   $4,
+            OffsetClause:  $5,
+            ForUpdate:     true,
+            ForUpdateLit:  $6.Literal + " " + $7.Literal,
+        }
+    }
+
+select_into_query
+    : with_clause SELECT distinct fields INTO variables from_clause where_clause group_by_clause having_clause order_by_clause limit_clause offset_clause
+    {
+        $$ = SelectInto{
+            BaseExpr: NewBaseExpr($2),
+            Query: SelectQuery{
+                WithClause: $1,
+                SelectEntity: SelectEntity{
+                    SelectClause:  SelectClause{BaseExpr: NewBaseExpr($2), Select: $2.Literal, Distinct: $3, Fields: $4},
+                    FromClause:    $7,
+                    WhereClause:   $8,
+                    GroupByClause: $9,
+                    HavingClause:  $10,
+                },
+                OrderByClause: $11,
+                LimitClause:   $12,
+                OffsetClause:  $13,
+            },
+            Variables: $6,
+        }
+    }
 
 select_entity
     : select_clause from_clause where_clause group_by_clause having_clause
@@ -1102,6 +1345,10 @@ value
     {
         $$ = $1
     }
+    | value AT TIME ZONE value %prec AT
+    {
+        $$ = AtTimeZone{BaseExpr: NewBaseExpr($2), AtTimeZone: $2.Literal + " " + $3.Literal + " " + $4.Literal, Value: $1, TimeZone: $5}
+    }
     | subquery
     {
         $$ = $1
@@ -1144,10 +1391,47 @@ value
     }
 
 wildcard
+    : all_columns %prec SUBSTITUTION_OP
+    {
+        $$ = $1
+    }
+    | all_columns EXCEPT '(' field_references ')'
+    {
+        ac := $1.(AllColumns)
+        ac.ExceptLit = $2.Literal
+        ac.Except = $4
+        $$ = ac
+    }
+    | all_columns REPLACE '(' replace_fields ')'
+    {
+        ac := $1.(AllColumns)
+        ac.ReplaceLit = $2.Literal
+        ac.Replace = $4
+        $$ = ac
+    }
+    | all_columns EXCEPT '(' field_references ')' REPLACE '(' replace_fields ')'
+    {
+        ac := $1.(AllColumns)
+        ac.ExceptLit = $2.Literal
+        ac.Except = $4
+        ac.ReplaceLit = $6.Literal
+        ac.Replace = $8
+        $$ = ac
+    }
+
+all_columns
     : '*'
     {
         $$ = AllColumns{BaseExpr: NewBaseExpr($1)}
     }
+    | identifier '.' '*'
+    {
+        $$ = AllColumns{BaseExpr: $1.BaseExpr, View: $1}
+    }
+    | STDIN '.' '*'
+    {
+        $$ = AllColumns{BaseExpr: NewBaseExpr($1), View: Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal}}
+    }
 
 row_value
     : '(' values ')'
@@ -1277,17 +1561,17 @@ comparison
     {
         $$ = Is{Is: $2.Literal, LHS: $1, RHS: $4, Negation: $3}
     }
-    | value BETWEEN value AND value
+    | value BETWEEN symmetric value AND value
     {
-        $$ = Between{Between: $2.Literal, And: $4.Literal, LHS: $1, Low: $3, High: $5}
+        $$ = Between{Between: $2.Literal, And: $5.Literal, LHS: $1, Low: $4, High: $6, Symmetric: $3}
     }
-    | value NOT BETWEEN value AND value
+    | value NOT BETWEEN symmetric value AND value
     {
-        $$ = Between{Between: $3.Literal, And: $5.Literal, LHS: $1, Low: $4, High: $6, Negation: $2}
+        $$ = Between{Between: $3.Literal, And: $6.Literal, LHS: $1, Low: $5, High: $7, Negation: $2, Symmetric: $4}
     }
-    | row_value negation BETWEEN row_value AND row_value
+    | row_value negation BETWEEN symmetric row_value AND row_value
     {
-        $$ = Between{Between: $3.Literal, And: $5.Literal, LHS: $1, Low: $4, High: $6, Negation: $2}
+        $$ = Between{Between: $3.Literal, And: $6.Literal, LHS: $1, Low: $5, High: $7, Negation: $2, Symmetric: $4}
     }
     | value IN row_value
     {
@@ -1313,6 +1597,50 @@ comparison
     {
         $$ = Like{Like: $3.Literal, LHS: $1, Pattern: $4, Negation: $2}
     }
+    | value LIKE value ESCAPE value
+    {
+        $$ = Like{Like: $2.Literal, LHS: $1, Pattern: $3, EscapeLit: $4.Literal, Escape: $5}
+    }
+    | value NOT LIKE value ESCAPE value
+    {
+        $$ = Like{Like: $3.Literal, LHS: $1, Pattern: $4, Negation: $2, EscapeLit: $5.Literal, Escape: $6}
+    }
+    | value ILIKE value
+    {
+        $$ = Like{Like: $2.Literal, LHS: $1, Pattern: $3}
+    }
+    | value NOT ILIKE value
+    {
+        $$ = Like{Like: $3.Literal, LHS: $1, Pattern: $4, Negation: $2}
+    }
+    | value ILIKE value ESCAPE value
+    {
+        $$ = Like{Like: $2.Literal, LHS: $1, Pattern: $3, EscapeLit: $4.Literal, Escape: $5}
+    }
+    | value NOT ILIKE value ESCAPE value
+    {
+        $$ = Like{Like: $3.Literal, LHS: $1, Pattern: $4, Negation: $2, EscapeLit: $5.Literal, Escape: $6}
+    }
+    | value SIMILAR TO value %prec SIMILAR
+    {
+        $$ = SimilarTo{BaseExpr: NewBaseExpr($2), SimilarTo: $2.Literal + " " + $3.Literal, LHS: $1, Pattern: $4}
+    }
+    | value NOT SIMILAR TO value %prec SIMILAR
+    {
+        $$ = SimilarTo{BaseExpr: NewBaseExpr($3), SimilarTo: $3.Literal + " " + $4.Literal, LHS: $1, Pattern: $5, Negation: $2}
+    }
+    | value SIMILAR TO value ESCAPE value
+    {
+        $$ = SimilarTo{BaseExpr: NewBaseExpr($2), SimilarTo: $2.Literal + " " + $3.Literal, LHS: $1, Pattern: $4, EscapeLit: $5.Literal, Escape: $6}
+    }
+    | value NOT SIMILAR TO value ESCAPE value
+    {
+        $$ = SimilarTo{BaseExpr: NewBaseExpr($3), SimilarTo: $3.Literal + " " + $4.Literal, LHS: $1, Pattern: $5, Negation: $2, EscapeLit: $6.Literal, Escape: $7}
+    }
+    | value REGEXP_OP value
+    {
+        $$ = RegExpMatch{BaseExpr: NewBaseExpr($2), LHS: $1, Operator: $2.Literal, Pattern: $3}
+    }
     | value comparison_operator ANY row_value
     {
         $$ = Any{Any: $3.Literal, LHS: $1, Operator: $2.Literal, Values: $4}
@@ -1409,6 +1737,22 @@ function
     {
         $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
     }
+    | identifier '(' wildcard ')'
+    {
+        $$ = Function{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: []QueryExpression{$3}}
+    }
+    | ANALYTIC_FUNCTION '(' arguments ')'
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
+    }
+    | FUNCTION_NTH '(' arguments ')'
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
+    }
+    | FUNCTION_WITH_INS '(' arguments ')'
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
+    }
 
 
 aggregate_function
@@ -1601,17 +1945,69 @@ identified_table
         $$ = Table{Object: $1, As: $2.Literal, Alias: $3}
     }
 
+table_option
+    : identifier
+    {
+        $$ = TableOption{Name: $1}
+    }
+    | identifier identifier
+    {
+        $$ = TableOption{Name: $1, Value: $2}
+    }
+    | identifier primitive_type
+    {
+        $$ = TableOption{Name: $1, Value: $2}
+    }
+    | ADD identifier identifier AS value
+    {
+        $$ = TableOption{Name: Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal}, Value: ComputedColumn{BaseExpr: $2.BaseExpr, Column: $2, Name: $3, As: $4.Literal, Value: $5}}
+    }
+
+table_options
+    : table_option
+    {
+        $$ = []TableOption{$1}
+    }
+    | table_option ',' table_options
+    {
+        $$ = append([]TableOption{$1}, $3...)
+    }
+
 virtual_table_object
     : subquery
     {
         $$ = $1
     }
+    | '(' VALUES row_values ')'
+    {
+        $$ = ValuesTable{BaseExpr: NewBaseExpr($1), Values: $2.Literal, RowValues: $3}
+    }
+    | UNNEST '(' value ')'
+    {
+        $$ = UnnestTable{BaseExpr: NewBaseExpr($1), Unnest: $1.Literal, Value: $3}
+    }
+    | UNNEST '(' value ')' WITH ORDINALITY
+    {
+        $$ = UnnestTable{BaseExpr: NewBaseExpr($1), Unnest: $1.Literal, Value: $3, With: $5.Literal, Ordinality: $6.Literal}
+    }
 
 table
     : identified_table
     {
         $$ = $1
     }
+    | table_identifier '(' table_options ')'
+    {
+        $$ = Table{Object: $1, Options: $3}
+    }
+    | table_identifier '(' table_options ')' identifier
+    {
+        $$ = Table{Object: $1, Options: $3, Alias: $5}
+    }
+    | table_identifier '(' table_options ')' AS identifier
+    {
+        $$ = Table{Object: $1, Options: $3, As: $5.Literal, Alias: $6}
+    }
     | virtual_table_object
     {
         $$ = Table{Object: $1}
@@ -1624,6 +2020,14 @@ table
     {
         $$ = Table{Object: $1, As: $2.Literal, Alias: $3}
     }
+    | virtual_table_object identifier '(' identifiers ')'
+    {
+        $$ = Table{Object: $1, Alias: $2, Fields: $4}
+    }
+    | virtual_table_object AS identifier '(' identifiers ')'
+    {
+        $$ = Table{Object: $1, As: $2.Literal, Alias: $3, Fields: $5}
+    }
     | join
     {
         $$ = Table{Object: $1}
@@ -1662,6 +2066,14 @@ join
     {
         $$ = Join{Join: $5.Literal, Table: $1, JoinTable: $6, JoinType: $4, Direction: $3, Natural: $2}
     }
+    | table ASOF join_type_inner JOIN table partition_clause ON value
+    {
+        $$ = Join{BaseExpr: NewBaseExpr($2), Join: $4.Literal, Table: $1, JoinTable: $5, JoinType: $3, AsOf: $2, Partition: $6, Condition: JoinCondition{Literal:$7.Literal, On: $8}}
+    }
+    | table ASOF LEFT join_type_outer JOIN table partition_clause ON value
+    {
+        $$ = Join{BaseExpr: NewBaseExpr($2), Join: $5.Literal, Table: $1, JoinTable: $6, JoinType: $4, Direction: $3, AsOf: $2, Partition: $7, Condition: JoinCondition{Literal:$8.Literal, On: $9}}
+    }
 
 join_condition
     : ON value
@@ -1783,6 +2195,22 @@ identifiers
         $$ = append([]QueryExpression{$1}, $3...)
     }
 
+replace_field
+    : field_object AS identifier
+    {
+        $$ = Field{Object: $1, As: $2.Literal, Alias: $3}
+    }
+
+replace_fields
+    : replace_field
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | replace_field ',' replace_fields
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
 fields
     : field
     {
@@ -2034,14 +2462,74 @@ identifier
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | FLAGS
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
     | ROWS
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | AGAINST
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | KEY
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | DETERMINISTIC
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | ORDINALITY
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | TIME
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | ZONE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | SCHEMA
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | USE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | REPOSITORY
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | NO
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | SCROLL
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
     | FIELDS
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | COLUMNS
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | REPLACE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | OVERWRITE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
     | COUNT
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
@@ -2123,6 +2611,16 @@ distinct
         $$ = $1
     }
 
+deterministic
+    :
+    {
+        $$ = Token{}
+    }
+    | DETERMINISTIC
+    {
+        $$ = $1
+    }
+
 negation
     :
     {
@@ -2133,6 +2631,16 @@ negation
         $$ = $1
     }
 
+symmetric
+    :
+    {
+        $$ = Token{}
+    }
+    | SYMMETRIC
+    {
+        $$ = $1
+    }
+
 join_type_inner
     :
     {
@@ -121,6 +121,74 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select c1 from table1 for update",
+		Output: []Statement{
+			SelectQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 23},
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "table1"}},
+					}},
+				},
+				ForUpdate:    true,
+				ForUpdateLit: "for update",
+			},
+		},
+	},
+	{
+		Input: "select c1 from stdin (no header) s, table1 (header, delimiter ';') as t",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{
+						Table{
+							Object: Stdin{BaseExpr: &BaseExpr{line: 1, char: 16}, Stdin: "stdin"},
+							Options: []TableOption{
+								{
+									Name:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "no"},
+									Value: Identifier{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "header"},
+								},
+							},
+							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "s"},
+						},
+						Table{
+							Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "table1"},
+							Options: []TableOption{
+								{
+									Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 45}, Literal: "header"},
+								},
+								{
+									Name:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "delimiter"},
+									Value: NewStringValue(";"),
+								},
+							},
+							As:    "as",
+							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 71}, Literal: "t"},
+						},
+					}},
+				},
+			},
+		},
+	},
 	{
 		Input: "select 1 from table1, (select 2 from dual)",
 		Output: []Statement{
@@ -181,6 +249,153 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select 1 from table1 (add column total as column1 * 2) t",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"},
+								Options: []TableOption{
+									{
+										Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "add"},
+										Value: ComputedColumn{
+											BaseExpr: &BaseExpr{line: 1, char: 27},
+											Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "column"},
+											Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "total"},
+											As:       "as",
+											Value: Arithmetic{
+												LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 43}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "column1"}},
+												Operator: int('*'),
+												RHS:      NewIntegerValueFromString("2"),
+											},
+										},
+									},
+								},
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 56}, Literal: "t"},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from (values (1, 'a'), (2, 'b')) as v(id, name)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: ValuesTable{
+									BaseExpr: &BaseExpr{line: 1, char: 15},
+									Values:   "values",
+									RowValues: []QueryExpression{
+										RowValue{
+											BaseExpr: &BaseExpr{line: 1, char: 23},
+											Value: ValueList{
+												Values: []QueryExpression{
+													NewIntegerValueFromString("1"),
+													NewStringValue("a"),
+												},
+											},
+										},
+										RowValue{
+											BaseExpr: &BaseExpr{line: 1, char: 33},
+											Value: ValueList{
+												Values: []QueryExpression{
+													NewIntegerValueFromString("2"),
+													NewStringValue("b"),
+												},
+											},
+										},
+									},
+								},
+								As:    "as",
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "v"},
+								Fields: []QueryExpression{
+									Identifier{BaseExpr: &BaseExpr{line: 1, char: 48}, Literal: "id"},
+									Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "name"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from (values (1)) v(id)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: ValuesTable{
+									BaseExpr: &BaseExpr{line: 1, char: 15},
+									Values:   "values",
+									RowValues: []QueryExpression{
+										RowValue{
+											BaseExpr: &BaseExpr{line: 1, char: 23},
+											Value: ValueList{
+												Values: []QueryExpression{
+													NewIntegerValueFromString("1"),
+												},
+											},
+										},
+									},
+								},
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "v"},
+								Fields: []QueryExpression{
+									Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "id"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from unnest(@arr) with ordinality as u(elem, idx)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: UnnestTable{
+									BaseExpr:   &BaseExpr{line: 1, char: 15},
+									Unnest:     "unnest",
+									Value:      Variable{BaseExpr: &BaseExpr{line: 1, char: 22}, Name: "@arr"},
+									With:       "with",
+									Ordinality: "ordinality",
+								},
+								As:    "as",
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "u"},
+								Fields: []QueryExpression{
+									Identifier{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "elem"},
+									Identifier{BaseExpr: &BaseExpr{line: 1, char: 55}, Literal: "idx"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select 1 from table1 as alias, (select 2 from dual) as alias2",
 		Output: []Statement{
@@ -345,6 +560,105 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select * except (column1, tbl.column2) from dual",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AllColumns{
+								BaseExpr:  &BaseExpr{line: 1, char: 8},
+								ExceptLit: "except",
+								Except: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column1"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 27}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "tbl"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "column2"}},
+								},
+							}},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select t1.*, stdin.* except (column1), t2.column3 from dual",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AllColumns{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								View:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "t1"},
+							}},
+							Field{Object: AllColumns{
+								BaseExpr:  &BaseExpr{line: 1, char: 14},
+								View:      Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "stdin"},
+								ExceptLit: "except",
+								Except: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 30}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "column1"}},
+								},
+							}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 40}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "t2"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "column3"}}},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select * except (column1) replace (upper(column2) as column2), replace(column1, 'a', 'b') from dual",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AllColumns{
+								BaseExpr:  &BaseExpr{line: 1, char: 8},
+								ExceptLit: "except",
+								Except: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 18}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "column1"}},
+								},
+								ReplaceLit: "replace",
+								Replace: []QueryExpression{
+									Field{
+										Object: Function{
+											BaseExpr: &BaseExpr{line: 1, char: 36},
+											Name:     "upper",
+											Args: []QueryExpression{
+												FieldReference{BaseExpr: &BaseExpr{line: 1, char: 42}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "column2"}},
+											},
+										},
+										As:    "as",
+										Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 54}, Literal: "column2"},
+									},
+								},
+							}},
+							Field{Object: Function{
+								BaseExpr: &BaseExpr{line: 1, char: 64},
+								Name:     "replace",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 72}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 72}, Literal: "column1"}},
+									NewStringValue("a"),
+									NewStringValue("b"),
+								},
+							}},
+						},
+					},
+					FromClause: FromClause{From: "from", Tables: []QueryExpression{Table{Object: Dual{Dual: "dual"}}}},
+				},
+			},
+		},
+	},
 	{
 		Input: "with ct as (select 1) select * from ct",
 		Output: []Statement{
@@ -544,6 +858,58 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select column1 at time zone 'UTC' = column2",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Comparison{
+								LHS: AtTimeZone{
+									BaseExpr:   &BaseExpr{line: 1, char: 16},
+									AtTimeZone: "at time zone",
+									Value:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									TimeZone:   NewStringValue("UTC"),
+								},
+								Operator: "=",
+								RHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 37}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "column2"}},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select time at time zone zone from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AtTimeZone{
+								BaseExpr:   &BaseExpr{line: 1, char: 13},
+								AtTimeZone: "at time zone",
+								Value:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "time"}},
+								TimeZone:   FieldReference{BaseExpr: &BaseExpr{line: 1, char: 26}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "zone"}},
+							}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select column1 = 1",
 		Output: []Statement{
@@ -745,6 +1111,41 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select column1 between symmetric 10 and 1 and column2 not between symmetric 3 and 1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Logic{
+								LHS: Between{
+									Between:   "between",
+									And:       "and",
+									LHS:       FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									Low:       NewIntegerValueFromString("10"),
+									High:      NewIntegerValueFromString("1"),
+									Symmetric: Token{Token: SYMMETRIC, Literal: "symmetric", Line: 1, Char: 24},
+								},
+								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 43},
+								RHS: Between{
+									Between:   "between",
+									And:       "and",
+									LHS:       FieldReference{BaseExpr: &BaseExpr{line: 1, char: 47}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "column2"}},
+									Low:       NewIntegerValueFromString("3"),
+									High:      NewIntegerValueFromString("1"),
+									Negation:  Token{Token: NOT, Literal: "not", Line: 1, Char: 55},
+									Symmetric: Token{Token: SYMMETRIC, Literal: "symmetric", Line: 1, Char: 67},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select (column1, column2) not between (1, 2) and (3, 4) and (column3, column4) between (5, 6) and (7, 8)",
 		Output: []Statement{
@@ -943,13 +1344,102 @@ var parseTests = []struct {
 										},
 									},
 								},
-								Values: Subquery{
-									BaseExpr: &BaseExpr{line: 1, char: 30},
-									Query: SelectQuery{
-										SelectEntity: SelectEntity{
-											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 31}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
-										},
-									},
+								Values: Subquery{
+									BaseExpr: &BaseExpr{line: 1, char: 30},
+									Query: SelectQuery{
+										SelectEntity: SelectEntity{
+											SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 31}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select column1 not like 'pattern1' and column2 like 'pattern2'",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Logic{
+								LHS: Like{
+									Like:     "like",
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									Pattern:  NewStringValue("pattern1"),
+									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 16},
+								},
+								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 36},
+								RHS: Like{
+									Like:    "like",
+									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 40}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "column2"}},
+									Pattern: NewStringValue("pattern2"),
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select column1 like 'pattern1' or column2 not like 'pattern2'",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Logic{
+								LHS: Like{
+									Like:    "like",
+									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									Pattern: NewStringValue("pattern1"),
+								},
+								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 32},
+								RHS: Like{
+									Like:     "like",
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 35}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "column2"}},
+									Pattern:  NewStringValue("pattern2"),
+									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 43},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select column1 like 'a!%' escape '!' and column2 not ilike 'b%'",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Logic{
+								LHS: Like{
+									Like:      "like",
+									LHS:       FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									Pattern:   NewStringValue("a!%"),
+									EscapeLit: "escape",
+									Escape:    NewStringValue("!"),
+								},
+								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 38},
+								RHS: Like{
+									Like:     "ilike",
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 42}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "column2"}},
+									Pattern:  NewStringValue("b%"),
+									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 50},
 								},
 							}},
 						},
@@ -959,7 +1449,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select column1 not like 'pattern1' and column2 like 'pattern2'",
+		Input: "select column1 similar to 'a(b|c)%' escape '!' and column2 not similar to 'b%'",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -968,17 +1458,21 @@ var parseTests = []struct {
 						Select:   "select",
 						Fields: []QueryExpression{
 							Field{Object: Logic{
-								LHS: Like{
-									Like:     "like",
-									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-									Pattern:  NewStringValue("pattern1"),
-									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 16},
-								},
-								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 36},
-								RHS: Like{
-									Like:    "like",
-									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 40}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 40}, Literal: "column2"}},
-									Pattern: NewStringValue("pattern2"),
+								LHS: SimilarTo{
+									BaseExpr:  &BaseExpr{line: 1, char: 16},
+									SimilarTo: "similar to",
+									LHS:       FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									Pattern:   NewStringValue("a(b|c)%"),
+									EscapeLit: "escape",
+									Escape:    NewStringValue("!"),
+								},
+								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 48},
+								RHS: SimilarTo{
+									BaseExpr:  &BaseExpr{line: 1, char: 64},
+									SimilarTo: "similar to",
+									LHS:       FieldReference{BaseExpr: &BaseExpr{line: 1, char: 52}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "column2"}},
+									Pattern:   NewStringValue("b%"),
+									Negation:  Token{Token: NOT, Literal: "not", Line: 1, Char: 60},
 								},
 							}},
 						},
@@ -988,7 +1482,7 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select column1 like 'pattern1' or column2 not like 'pattern2'",
+		Input: "select column1 ~ '^a' or column2 !~* 'b$'",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -997,17 +1491,18 @@ var parseTests = []struct {
 						Select:   "select",
 						Fields: []QueryExpression{
 							Field{Object: Logic{
-								LHS: Like{
-									Like:    "like",
-									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
-									Pattern: NewStringValue("pattern1"),
+								LHS: RegExpMatch{
+									BaseExpr: &BaseExpr{line: 1, char: 16},
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									Operator: "~",
+									Pattern:  NewStringValue("^a"),
 								},
-								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 32},
-								RHS: Like{
-									Like:     "like",
-									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 35}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "column2"}},
-									Pattern:  NewStringValue("pattern2"),
-									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 43},
+								Operator: Token{Token: OR, Literal: "or", Line: 1, Char: 23},
+								RHS: RegExpMatch{
+									BaseExpr: &BaseExpr{line: 1, char: 34},
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 26}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "column2"}},
+									Operator: "!~*",
+									Pattern:  NewStringValue("b$"),
 								},
 							}},
 						},
@@ -1620,6 +2115,39 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select row_number(), lag(column1), first_value(column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Function{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "row_number",
+							}},
+							Field{Object: Function{
+								BaseExpr: &BaseExpr{line: 1, char: 22},
+								Name:     "lag",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 26}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "column1"}},
+								},
+							}},
+							Field{Object: Function{
+								BaseExpr: &BaseExpr{line: 1, char: 36},
+								Name:     "first_value",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 48}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 48}, Literal: "column1"}},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select trim(column1)",
 		Output: []Statement{
@@ -1734,6 +2262,31 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select row_hash(t.*)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: Function{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "row_hash",
+								Args: []QueryExpression{
+									AllColumns{
+										BaseExpr: &BaseExpr{line: 1, char: 17},
+										View:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "t"},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select count(distinct *)",
 		Output: []Statement{
@@ -2722,6 +3275,38 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select cume_dist() ignore nulls over (order by column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr:       &BaseExpr{line: 1, char: 8},
+								Name:           "cume_dist",
+								IgnoreNulls:    true,
+								IgnoreNullsLit: "ignore nulls",
+								Over:           "over",
+								AnalyticClause: AnalyticClause{
+									OrderByClause: OrderByClause{
+										OrderBy: "order by",
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 48}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 48}, Literal: "column1"}},
+											},
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select lag(column1) ignore nulls over (partition by column1 order by column2)",
 		Output: []Statement{
@@ -3018,6 +3603,77 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select 1 from t1 asof join t2 on t1.t >= t2.t",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: Join{
+									BaseExpr:  &BaseExpr{line: 1, char: 18},
+									Join:      "join",
+									Table:     Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "t1"}},
+									JoinTable: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "t2"}},
+									AsOf:      Token{Token: ASOF, Literal: "asof", Line: 1, Char: 18},
+									Condition: JoinCondition{
+										Literal: "on",
+										On: Comparison{
+											LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 34}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "t1"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "t"}},
+											Operator: ">=",
+											RHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 42}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "t2"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 45}, Literal: "t"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from t1 asof left join t2 partition by sym on t1.t < t2.t",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Select: "select", Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: Join{
+									BaseExpr:  &BaseExpr{line: 1, char: 18},
+									Join:      "join",
+									Table:     Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "t1"}},
+									JoinTable: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "t2"}},
+									AsOf:      Token{Token: ASOF, Literal: "asof", Line: 1, Char: 18},
+									Direction: Token{Token: LEFT, Literal: "left", Line: 1, Char: 23},
+									Partition: PartitionClause{
+										PartitionBy: "partition by",
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 49}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 49}, Literal: "sym"}},
+										},
+									},
+									Condition: JoinCondition{
+										Literal: "on",
+										On: Comparison{
+											LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 56}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 56}, Literal: "t1"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 59}, Literal: "t"}},
+											Operator: "<",
+											RHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 63}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 63}, Literal: "t2"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 66}, Literal: "t"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select 1 from table1 full join table2 on table1.id = table2.id full join table3 on table3.id = table1.id",
 		Output: []Statement{
@@ -3492,6 +4148,100 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "create table if not exists newtable (column1, column2)",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "column1"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "column2"},
+				},
+				IfNotExists: true,
+			},
+		},
+	},
+	{
+		Input: "create table if not exists newtable (column1, column2) as select 1, 2",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "column1"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "column2"},
+				},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 59},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{
+									Object: NewIntegerValueFromString("1"),
+								},
+								Field{
+									Object: NewIntegerValueFromString("2"),
+								},
+							},
+						},
+					},
+				},
+				IfNotExists: true,
+			},
+		},
+	},
+	{
+		Input: "create table if not exists newtable select 1, 2",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "newtable"},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 37},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{
+									Object: NewIntegerValueFromString("1"),
+								},
+								Field{
+									Object: NewIntegerValueFromString("2"),
+								},
+							},
+						},
+					},
+				},
+				IfNotExists: true,
+			},
+		},
+	},
+	{
+		Input: "drop table table1",
+		Output: []Statement{
+			DropTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "table1"},
+			},
+		},
+	},
+	{
+		Input: "rename table table1 to table2",
+		Output: []Statement{
+			RenameTable{
+				Table:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "table1"},
+				NewName: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "table2"},
+			},
+		},
+	},
+	{
+		Input: "rename table table1 to table2 overwrite",
+		Output: []Statement{
+			RenameTable{
+				Table:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "table1"},
+				NewName:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "table2"},
+				Overwrite: true,
+			},
+		},
+	},
 	{
 		Input: "alter table table1 add column1",
 		Output: []Statement{
@@ -3618,20 +4368,58 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "commit",
+		Input: "deduplicate from table1",
+		Output: []Statement{
+			Deduplicate{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "table1"},
+			},
+		},
+	},
+	{
+		Input: "commit",
+		Output: []Statement{
+			TransactionControl{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Token:    COMMIT,
+			},
+		},
+	},
+	{
+		Input: "rollback",
+		Output: []Statement{
+			TransactionControl{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Token:    ROLLBACK,
+			},
+		},
+	},
+	{
+		Input: "rollback to sp1",
+		Output: []Statement{
+			TransactionControl{
+				BaseExpr:  &BaseExpr{line: 1, char: 1},
+				Token:     ROLLBACK,
+				Savepoint: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "sp1"},
+			},
+		},
+	},
+	{
+		Input: "rollback to savepoint sp1",
 		Output: []Statement{
 			TransactionControl{
-				BaseExpr: &BaseExpr{line: 1, char: 1},
-				Token:    COMMIT,
+				BaseExpr:  &BaseExpr{line: 1, char: 1},
+				Token:     ROLLBACK,
+				Savepoint: Identifier{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "sp1"},
 			},
 		},
 	},
 	{
-		Input: "rollback",
+		Input: "savepoint sp1",
 		Output: []Statement{
 			TransactionControl{
-				BaseExpr: &BaseExpr{line: 1, char: 1},
-				Token:    ROLLBACK,
+				BaseExpr:  &BaseExpr{line: 1, char: 1},
+				Token:     SAVEPOINT,
+				Savepoint: Identifier{BaseExpr: &BaseExpr{line: 1, char: 11}, Literal: "sp1"},
 			},
 		},
 	},
@@ -3701,6 +4489,16 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "show tables like 'table%'",
+		Output: []Statement{
+			ShowObjects{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Type:     TABLES,
+				Pattern:  NewStringValue("table%"),
+			},
+		},
+	},
 	{
 		Input: "show views",
 		Output: []Statement{
@@ -3728,6 +4526,15 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "show flags",
+		Output: []Statement{
+			ShowObjects{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Type:     FLAGS,
+			},
+		},
+	},
 	{
 		Input: "show fields from table1",
 		Output: []Statement{
@@ -3737,6 +4544,126 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "show columns from table1",
+		Output: []Statement{
+			ShowFields{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "table1"},
+			},
+		},
+	},
+	{
+		Input: "diff table1 against stdin key (id, key)",
+		Output: []Statement{
+			Diff{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 6}, Literal: "table1"},
+				Against:  Stdin{BaseExpr: &BaseExpr{line: 1, char: 21}, Stdin: "stdin"},
+				Keys: []QueryExpression{
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 32}, Literal: "id"},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "key"},
+				},
+			},
+		},
+	},
+	{
+		Input: "explain select c1 from table1",
+		Output: []Statement{
+			Explain{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 9},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{
+									Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "c1"}},
+								},
+							},
+						},
+						FromClause: FromClause{From: "from", Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "table1"}},
+						}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select c1, c2 into @var1, @var2 from table1 where c1 = 1",
+		Output: []Statement{
+			SelectInto{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 1},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{
+									Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+								},
+								Field{
+									Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 12}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "c2"}},
+								},
+							},
+						},
+						FromClause: FromClause{From: "from", Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "table1"}},
+						}},
+						WhereClause: WhereClause{
+							Where: "where",
+							Filter: Comparison{
+								LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 51}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "c1"}},
+								RHS:      NewIntegerValueFromString("1"),
+								Operator: "=",
+							},
+						},
+					},
+				},
+				Variables: []Variable{
+					{BaseExpr: &BaseExpr{line: 1, char: 20}, Name: "@var1"},
+					{BaseExpr: &BaseExpr{line: 1, char: 27}, Name: "@var2"},
+				},
+			},
+		},
+	},
+	{
+		Input: "export table1 to 'table1.json'",
+		Output: []Statement{
+			Export{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "table1"},
+				FilePath: NewStringValue("table1.json"),
+			},
+		},
+	},
+	{
+		Input: "export table1 to 'table1.txt' format tsv encoding 'sjis' header false",
+		Output: []Statement{
+			Export{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "table1"},
+				FilePath: NewStringValue("table1.txt"),
+				Options: []ExportOption{
+					{
+						Name:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "format"},
+						Value: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "tsv"},
+					},
+					{
+						Name:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "encoding"},
+						Value: NewStringValue("sjis"),
+					},
+					{
+						Name:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 58}, Literal: "header"},
+						Value: NewTernaryValueFromString("false"),
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "trigger error",
 		Output: []Statement{
@@ -3767,6 +4694,27 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "raise 'user error'",
+		Output: []Statement{
+			Trigger{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Token:    ERROR,
+				Message:  NewStringValue("user error"),
+			},
+		},
+	},
+	{
+		Input: "raise 300 'user error'",
+		Output: []Statement{
+			Trigger{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Token:    ERROR,
+				Message:  NewStringValue("user error"),
+				Code:     value.NewInteger(300),
+			},
+		},
+	},
 	{
 		Input: "declare cur cursor for select 1",
 		Output: []Statement{
@@ -3786,6 +4734,47 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "declare cur scroll cursor for select 1",
+		Output: []Statement{
+			CursorDeclaration{
+				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "cur"},
+				Scroll: Token{Token: SCROLL, Literal: "scroll", Line: 1, Char: 13},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 31},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{Object: NewIntegerValueFromString("1")},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare cur no scroll cursor for select 1",
+		Output: []Statement{
+			CursorDeclaration{
+				Cursor:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "cur"},
+				Negation: Token{Token: NO, Literal: "no", Line: 1, Char: 13},
+				Scroll:   Token{Token: SCROLL, Literal: "scroll", Line: 1, Char: 16},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 34},
+							Select:   "select",
+							Fields: []QueryExpression{
+								Field{Object: NewIntegerValueFromString("1")},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "open cur",
 		Output: []Statement{
@@ -3982,6 +4971,80 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "declare schema for table1 (column1 integer, column2 datetime)",
+		Output: []Statement{
+			SchemaDeclaration{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "table1"},
+				Fields: []SchemaField{
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 28},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "column1"},
+						Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "integer"},
+					},
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 45},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 45}, Literal: "column2"},
+						Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "datetime"},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare schema for 'data.csv' (schema string)",
+		Output: []Statement{
+			SchemaDeclaration{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "data.csv", Quoted: true},
+				Fields: []SchemaField{
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 32},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 32}, Literal: "schema"},
+						Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "string"},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "use repository '/path/to/dir'",
+		Output: []Statement{
+			UseRepository{
+				BaseExpr:   &BaseExpr{line: 1, char: 1},
+				Repository: NewStringValue("/path/to/dir"),
+			},
+		},
+	},
+	{
+		Input: "select use, repository from table1(repository '/path/to/dir')",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Select:   "select",
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "use"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 13}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "repository"}}},
+						},
+					},
+					FromClause: FromClause{
+						From: "from",
+						Tables: []QueryExpression{
+							Table{
+								Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 29}, Literal: "table1"},
+								Options: []TableOption{
+									{Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "repository"}, Value: NewStringValue("/path/to/dir")},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "if @var1 = 1 then print 1; end if",
 		Output: []Statement{
@@ -4043,6 +5106,7 @@ var parseTests = []struct {
 		Input: "while @var1 do print @var1; end while",
 		Output: []Statement{
 			While{
+				BaseExpr:  &BaseExpr{line: 1, char: 1},
 				Condition: Variable{BaseExpr: &BaseExpr{line: 1, char: 7}, Name: "@var1"},
 				Statements: []Statement{
 					Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 22}, Name: "@var1"}},
@@ -4054,6 +5118,7 @@ var parseTests = []struct {
 		Input: "while @var1 in cur do print @var1; end while",
 		Output: []Statement{
 			WhileInCursor{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
 				Variables: []Variable{
 					{BaseExpr: &BaseExpr{line: 1, char: 7}, Name: "@var1"},
 				},
@@ -4068,6 +5133,7 @@ var parseTests = []struct {
 		Input: "while @var1, @var2 in cur do print @var1; end while",
 		Output: []Statement{
 			WhileInCursor{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
 				Variables: []Variable{
 					{BaseExpr: &BaseExpr{line: 1, char: 7}, Name: "@var1"},
 					{BaseExpr: &BaseExpr{line: 1, char: 14}, Name: "@var2"},
@@ -4083,6 +5149,7 @@ var parseTests = []struct {
 		Input: "while var @var1 in cur do print @var1; end while",
 		Output: []Statement{
 			WhileInCursor{
+				BaseExpr:        &BaseExpr{line: 1, char: 1},
 				WithDeclaration: true,
 				Variables: []Variable{
 					{BaseExpr: &BaseExpr{line: 1, char: 11}, Name: "@var1"},
@@ -4098,6 +5165,7 @@ var parseTests = []struct {
 		Input: "while declare @var1, @var2 in cur do print @var1; end while",
 		Output: []Statement{
 			WhileInCursor{
+				BaseExpr:        &BaseExpr{line: 1, char: 1},
 				WithDeclaration: true,
 				Variables: []Variable{
 					{BaseExpr: &BaseExpr{line: 1, char: 15}, Name: "@var1"},
@@ -4173,6 +5241,7 @@ var parseTests = []struct {
 		Input: "while true do print @var1; continue; end while",
 		Output: []Statement{
 			While{
+				BaseExpr:  &BaseExpr{line: 1, char: 1},
 				Condition: NewTernaryValueFromString("true"),
 				Statements: []Statement{
 					Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 21}, Name: "@var1"}},
@@ -4185,6 +5254,7 @@ var parseTests = []struct {
 		Input: "while true do break; end while",
 		Output: []Statement{
 			While{
+				BaseExpr:  &BaseExpr{line: 1, char: 1},
 				Condition: NewTernaryValueFromString("true"),
 				Statements: []Statement{
 					FlowControl{Token: BREAK},
@@ -4192,10 +5262,61 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "loop print @var1; break; end loop",
+		Output: []Statement{
+			Loop{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Statements: []Statement{
+					Print{Value: Variable{BaseExpr: &BaseExpr{line: 1, char: 12}, Name: "@var1"}},
+					FlowControl{Token: BREAK},
+				},
+			},
+		},
+	},
+	{
+		Input: "lbl1: while true do loop continue lbl1; end loop; end while",
+		Output: []Statement{
+			While{
+				BaseExpr:  &BaseExpr{line: 1, char: 7},
+				Label:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 1}, Literal: "lbl1"},
+				Condition: NewTernaryValueFromString("true"),
+				Statements: []Statement{
+					Loop{
+						BaseExpr: &BaseExpr{line: 1, char: 21},
+						Statements: []Statement{
+							FlowControl{Token: CONTINUE, Label: Identifier{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "lbl1"}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "lbl1: while @var1 in cur do lbl2: loop break lbl1; end loop; end while",
+		Output: []Statement{
+			WhileInCursor{
+				BaseExpr:  &BaseExpr{line: 1, char: 7},
+				Label:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 1}, Literal: "lbl1"},
+				Variables: []Variable{{BaseExpr: &BaseExpr{line: 1, char: 13}, Name: "@var1"}},
+				Cursor:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "cur"},
+				Statements: []Statement{
+					Loop{
+						BaseExpr: &BaseExpr{line: 1, char: 35},
+						Label:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 29}, Literal: "lbl2"},
+						Statements: []Statement{
+							FlowControl{Token: BREAK, Label: Identifier{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "lbl1"}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "while true do exit; end while",
 		Output: []Statement{
 			While{
+				BaseExpr:  &BaseExpr{line: 1, char: 1},
 				Condition: NewTernaryValueFromString("true"),
 				Statements: []Statement{
 					Exit{},
@@ -4207,6 +5328,7 @@ var parseTests = []struct {
 		Input: "while true do if @var1 = 1 then continue; end if; end while",
 		Output: []Statement{
 			While{
+				BaseExpr:  &BaseExpr{line: 1, char: 1},
 				Condition: NewTernaryValueFromString("true"),
 				Statements: []Statement{
 					If{
@@ -4227,6 +5349,7 @@ var parseTests = []struct {
 		Input: "while true do if @var1 = 1 then continue; elseif @var1 = 2 then break; elseif @var1 = 3 then exit; else continue; end if; end while",
 		Output: []Statement{
 			While{
+				BaseExpr:  &BaseExpr{line: 1, char: 1},
 				Condition: NewTernaryValueFromString("true"),
 				Statements: []Statement{
 					If{
@@ -4274,6 +5397,7 @@ var parseTests = []struct {
 		Input: "while true do case when true then print @var1; when false then continue; end case; end while",
 		Output: []Statement{
 			While{
+				BaseExpr:  &BaseExpr{line: 1, char: 1},
 				Condition: NewTernaryValueFromString("true"),
 				Statements: []Statement{
 					Case{
@@ -4300,6 +5424,7 @@ var parseTests = []struct {
 		Input: "while true do case when true then print @var1; when false then exit; else continue; end case; end while",
 		Output: []Statement{
 			While{
+				BaseExpr:  &BaseExpr{line: 1, char: 1},
 				Condition: NewTernaryValueFromString("true"),
 				Statements: []Statement{
 					Case{
@@ -4335,6 +5460,18 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "declare func1 function (@arg1) deterministic as begin end",
+		Output: []Statement{
+			FunctionDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
+				Parameters: []VariableAssignment{
+					{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 25}, Name: "@arg1"}},
+				},
+				Deterministic: Token{Token: DETERMINISTIC, Literal: "deterministic", Line: 1, Char: 32},
+			},
+		},
+	},
 	{
 		Input: "declare func1 function (@arg1 default 0, @arg2 default 1) as begin end",
 		Output: []Statement{
@@ -4347,6 +5484,23 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "declare func1 function () as begin lbl: loop break lbl; end loop; end",
+		Output: []Statement{
+			FunctionDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
+				Statements: []Statement{
+					Loop{
+						BaseExpr: &BaseExpr{line: 1, char: 41},
+						Label:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "lbl"},
+						Statements: []Statement{
+							FlowControl{Token: BREAK, Label: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "lbl"}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "declare func1 function (@arg1, @arg2 default 0) as begin \n" +
 			"if @var1 = 1 then print 1; end if; \n" +
@@ -4419,12 +5573,14 @@ var parseTests = []struct {
 						},
 					},
 					While{
+						BaseExpr:  &BaseExpr{line: 4, char: 1},
 						Condition: NewTernaryValueFromString("true"),
 						Statements: []Statement{
 							FlowControl{Token: BREAK},
 						},
 					},
 					While{
+						BaseExpr:  &BaseExpr{line: 5, char: 1},
 						Condition: NewTernaryValueFromString("true"),
 						Statements: []Statement{
 							If{
@@ -4440,6 +5596,7 @@ var parseTests = []struct {
 						},
 					},
 					While{
+						BaseExpr:  &BaseExpr{line: 6, char: 1},
 						Condition: NewTernaryValueFromString("true"),
 						Statements: []Statement{
 							If{
@@ -4482,6 +5639,7 @@ var parseTests = []struct {
 						},
 					},
 					WhileInCursor{
+						BaseExpr: &BaseExpr{line: 7, char: 1},
 						Variables: []Variable{
 							{BaseExpr: &BaseExpr{line: 7, char: 7}, Name: "@var1"},
 						},
@@ -4491,6 +5649,7 @@ var parseTests = []struct {
 						},
 					},
 					WhileInCursor{
+						BaseExpr: &BaseExpr{line: 8, char: 1},
 						Variables: []Variable{
 							{BaseExpr: &BaseExpr{line: 8, char: 7}, Name: "@var1"},
 							{BaseExpr: &BaseExpr{line: 8, char: 14}, Name: "@var2"},
@@ -4538,6 +5697,7 @@ var parseTests = []struct {
 						},
 					},
 					While{
+						BaseExpr:  &BaseExpr{line: 11, char: 1},
 						Condition: NewTernaryValueFromString("true"),
 						Statements: []Statement{
 							Case{
@@ -4559,6 +5719,7 @@ var parseTests = []struct {
 						},
 					},
 					While{
+						BaseExpr:  &BaseExpr{line: 12, char: 1},
 						Condition: NewTernaryValueFromString("true"),
 						Statements: []Statement{
 							Case{
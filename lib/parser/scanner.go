@@ -45,20 +45,39 @@ var stringOperators = []string{
 	"||",
 }
 
+var regExpOperators = []string{
+	"~",
+	"~*",
+	"!~",
+	"!~*",
+}
+
 var aggregateFunctions = []string{
 	"MIN",
 	"MAX",
 	"SUM",
+	"PRODUCT",
 	"AVG",
 	"MEDIAN",
+	"BOOL_AND",
+	"BOOL_OR",
+	"EVERY",
+	"BIT_AND",
+	"BIT_OR",
+	"BIT_XOR",
+	"APPROX_COUNT_DISTINCT",
+	"ARG_MAX",
+	"ARG_MIN",
+	"COUNT_IF",
+	"SUM_IF",
+	"PERCENTILE",
+	"ARRAY_AGG",
 }
 
 var analyticFunctions = []string{
 	"ROW_NUMBER",
 	"RANK",
 	"DENSE_RANK",
-	"CUME_DIST",
-	"PERCENT_RANK",
 	"NTILE",
 }
 
@@ -69,6 +88,8 @@ var functionsNth = []string{
 }
 
 var functionsWithIgnoreNulls = []string{
+	"CUME_DIST",
+	"PERCENT_RANK",
 	"LAG",
 	"LEAD",
 }
@@ -213,6 +234,8 @@ func (s *Scanner) Scan() (Token, error) {
 			token = COMPARISON_OP
 		} else if s.isStringOperators(literal) {
 			token = STRING_OP
+		} else if s.isRegExpOperators(literal) {
+			token = REGEXP_OP
 		} else if literal == SUBSTITUTION_OPERATOR {
 			token = SUBSTITUTION_OP
 		} else if 1 < len(literal) {
@@ -314,12 +337,15 @@ func (s *Scanner) scanOperator() {
 	for s.isOperatorRune(s.peek()) {
 		s.next()
 	}
+	if runes := s.runes(); runes[len(runes)-1] == '~' && s.peek() == '*' {
+		s.next()
+	}
 	return
 }
 
 func (s *Scanner) isOperatorRune(ch rune) bool {
 	switch ch {
-	case '=', '>', '<', '!', '|', ':':
+	case '=', '>', '<', '!', '|', ':', '~':
 		return true
 	}
 	return false
@@ -395,6 +421,15 @@ func (s *Scanner) isStringOperators(str string) bool {
 	return false
 }
 
+func (s *Scanner) isRegExpOperators(str string) bool {
+	for _, v := range regExpOperators {
+		if v == str {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Scanner) isCommentRune(ch rune) bool {
 	if ch == '/' && s.peek() == '*' {
 		s.next()
@@ -169,6 +169,26 @@ var scanTests = []struct {
 			},
 		},
 	},
+	{
+		Name:  "RegExpOperator",
+		Input: "~",
+		Output: []scanResult{
+			{
+				Token:   REGEXP_OP,
+				Literal: "~",
+			},
+		},
+	},
+	{
+		Name:  "RegExpOperator Case Insensitive Negation",
+		Input: "!~*",
+		Output: []scanResult{
+			{
+				Token:   REGEXP_OP,
+				Literal: "!~*",
+			},
+		},
+	},
 	{
 		Name:  "SubstitutionOperator",
 		Input: ":=",
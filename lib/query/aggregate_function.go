@@ -1,9 +1,13 @@
 package query
 
 import (
+	"fmt"
+	"math"
 	"sort"
 	"strings"
 
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/ternary"
@@ -11,13 +15,121 @@ import (
 
 type AggregateFunction func([]value.Primary) value.Primary
 
+// ANY is not provided as an alias for BOOL_OR, because ANY(x) is ambiguous
+// with the ANY operator.
 var AggregateFunctions = map[string]AggregateFunction{
-	"COUNT":  Count,
-	"MAX":    Max,
-	"MIN":    Min,
-	"SUM":    Sum,
-	"AVG":    Avg,
-	"MEDIAN": Median,
+	"COUNT":     Count,
+	"MAX":       Max,
+	"MIN":       Min,
+	"SUM":       Sum,
+	"PRODUCT":   Product,
+	"AVG":       Avg,
+	"MEDIAN":    Median,
+	"BOOL_AND":  BoolAnd,
+	"BOOL_OR":   BoolOr,
+	"EVERY":     BoolAnd,
+	"BIT_AND":   BitAnd,
+	"BIT_OR":    BitOr,
+	"BIT_XOR":   BitXor,
+	"COUNT_IF":  CountIf,
+	"ARRAY_AGG": ArrayAgg,
+
+	"APPROX_COUNT_DISTINCT": ApproxCountDistinct,
+}
+
+// ArgAggregateFunction takes the values of the first argument and the values of the second argument in the same order.
+type ArgAggregateFunction func([]value.Primary, []value.Primary) value.Primary
+
+var ArgAggregateFunctions = map[string]ArgAggregateFunction{
+	"ARG_MAX": ArgMax,
+	"ARG_MIN": ArgMin,
+	"SUM_IF":  SumIf,
+}
+
+// FractionAggregateFunction takes the values of the first argument and the constant fraction given as the second argument.
+type FractionAggregateFunction func([]value.Primary, float64) value.Primary
+
+var FractionAggregateFunctions = map[string]FractionAggregateFunction{
+	"PERCENTILE": Percentile,
+}
+
+// Aggregator accumulates values for an aggregate function implemented in Go.
+// Values are accumulated by multiple aggregators in parallel, and the aggregators are merged into one.
+type Aggregator interface {
+	Accumulate(value.Primary) error
+	Merge(Aggregator) error
+	Finalize() (value.Primary, error)
+}
+
+var ExternalAggregateFunctions = map[string]func() Aggregator{}
+
+// RegisterAggregateFunction registers an aggregate function implemented in Go so that it can be called in queries.
+// The function newAggregator must return a new aggregator each time it is called.
+func RegisterAggregateFunction(name string, newAggregator func() Aggregator) error {
+	uname := strings.ToUpper(name)
+	if len(uname) < 1 {
+		return fmt.Errorf("function name is empty")
+	}
+	if newAggregator == nil {
+		return fmt.Errorf("function %s is nil", name)
+	}
+	if isReservedFunctionName(uname) {
+		return fmt.Errorf("function %s already exists", name)
+	}
+
+	ExternalAggregateFunctions[uname] = newAggregator
+	return nil
+}
+
+// Null values are not passed to the aggregators.
+func aggregateWithAggregator(expr parser.QueryExpression, name string, newAggregator func() Aggregator, list []value.Primary) (value.Primary, error) {
+	gm := NewGoroutineManager(len(list), 150)
+	aggregators := make([]Aggregator, gm.CPU)
+
+	for i := 0; i < gm.CPU; i++ {
+		gm.Add()
+		go func(thIdx int) {
+			start, end := gm.RecordRange(thIdx)
+			agg := newAggregator()
+
+			for i := start; i < end; i++ {
+				if gm.HasError() {
+					break
+				}
+				if value.IsNull(list[i]) {
+					continue
+				}
+				if err := agg.Accumulate(list[i]); err != nil {
+					gm.SetError(err)
+					break
+				}
+			}
+
+			aggregators[thIdx] = agg
+			gm.Done()
+		}(i)
+	}
+	gm.Wait()
+
+	if gm.HasError() {
+		return nil, NewFunctionInvalidArgumentError(expr, name, gm.Error().Error())
+	}
+
+	agg := newAggregator()
+	for _, v := range aggregators {
+		if err := agg.Merge(v); err != nil {
+			return nil, NewFunctionInvalidArgumentError(expr, name, err.Error())
+		}
+	}
+
+	p, err := agg.Finalize()
+	if err != nil {
+		return nil, NewFunctionInvalidArgumentError(expr, name, err.Error())
+	}
+	if p == nil {
+		return value.NewNull(), nil
+	}
+	return p, nil
 }
 
 func Count(list []value.Primary) value.Primary {
@@ -31,6 +143,53 @@ func Count(list []value.Primary) value.Primary {
 	return value.NewInteger(count)
 }
 
+// CountIf returns the number of conditions that are TRUE. Nulls are regarded as FALSE.
+func CountIf(list []value.Primary) value.Primary {
+	var count int64
+	for _, v := range list {
+		if v.Ternary() == ternary.TRUE {
+			count++
+		}
+	}
+
+	return value.NewInteger(count)
+}
+
+// ArrayAgg collects values including nulls into an array in the order of the list.
+func ArrayAgg(list []value.Primary) value.Primary {
+	if len(list) < 1 {
+		return value.NewNull()
+	}
+
+	values := make([]value.Primary, len(list))
+	copy(values, list)
+	return value.NewArray(values)
+}
+
+func CountDistinct(list []value.Primary) value.Primary {
+	keys := make(map[string]bool, len(list))
+	for _, v := range list {
+		if !value.IsNull(v) {
+			keys[SerializeComparisonKeys([]value.Primary{v})] = true
+		}
+	}
+
+	return value.NewInteger(int64(len(keys)))
+}
+
+// ApproxCountDistinct estimates the number of distinct values with HyperLogLog
+// whose precision is specified by the approx-precision option.
+func ApproxCountDistinct(list []value.Primary) value.Primary {
+	hll := newHyperLogLog(cmd.GetFlags().ApproxPrecision)
+	for _, v := range list {
+		if !value.IsNull(v) {
+			hll.Add(SerializeComparisonKeys([]value.Primary{v}))
+		}
+	}
+
+	return value.NewInteger(hll.Count())
+}
+
 func Max(list []value.Primary) value.Primary {
 	var result value.Primary
 	result = value.NewNull()
@@ -75,6 +234,37 @@ func Min(list []value.Primary) value.Primary {
 	return result
 }
 
+// ArgMax returns the value in the record where the key is the greatest.
+// Records with null keys are ignored, and ties resolve to the first record.
+func ArgMax(list []value.Primary, keys []value.Primary) value.Primary {
+	return argAggregate(list, keys, value.Greater)
+}
+
+// ArgMin returns the value in the record where the key is the least.
+// Records with null keys are ignored, and ties resolve to the first record.
+func ArgMin(list []value.Primary, keys []value.Primary) value.Primary {
+	return argAggregate(list, keys, value.Less)
+}
+
+func argAggregate(list []value.Primary, keys []value.Primary, compare func(value.Primary, value.Primary) ternary.Value) value.Primary {
+	var result value.Primary
+	result = value.NewNull()
+
+	var best value.Primary
+	for i, key := range keys {
+		if value.IsNull(key) {
+			continue
+		}
+
+		if best == nil || compare(key, best) == ternary.TRUE {
+			best = key
+			result = list[i]
+		}
+	}
+
+	return result
+}
+
 func Sum(list []value.Primary) value.Primary {
 	var sum float64
 	var count int
@@ -95,6 +285,72 @@ func Sum(list []value.Primary) value.Primary {
 	return value.ParseFloat64(sum)
 }
 
+// SumIf returns the sum of the values in the records where the conditions are TRUE.
+// Nulls in the conditions are regarded as FALSE.
+func SumIf(conditions []value.Primary, list []value.Primary) value.Primary {
+	values := make([]value.Primary, 0, len(list))
+	for i, c := range conditions {
+		if c.Ternary() == ternary.TRUE {
+			values = append(values, list[i])
+		}
+	}
+
+	return Sum(values)
+}
+
+// Product multiplies values as integers while all of them are integers and the product does not overflow,
+// otherwise as floats.
+func Product(list []value.Primary) value.Primary {
+	var iprod int64 = 1
+	var fprod float64 = 1
+	var isFloat bool
+	var count int
+
+	for _, v := range list {
+		if _, ok := v.(value.Float); !ok && !isFloat {
+			if i := value.ToInteger(v); !value.IsNull(i) {
+				n := i.(value.Integer).Raw()
+				if p, ok := multiplyInt64(iprod, n); ok {
+					iprod = p
+					count++
+					continue
+				}
+			}
+		}
+
+		f := value.ToFloat(v)
+		if value.IsNull(f) {
+			continue
+		}
+
+		if !isFloat {
+			fprod = float64(iprod)
+			isFloat = true
+		}
+		fprod *= f.(value.Float).Raw()
+		count++
+	}
+
+	if count < 1 {
+		return value.NewNull()
+	}
+	if isFloat {
+		return value.NewFloat(fprod)
+	}
+	return value.NewInteger(iprod)
+}
+
+func multiplyInt64(i1 int64, i2 int64) (int64, bool) {
+	if i1 == 0 || i2 == 0 {
+		return 0, true
+	}
+	p := i1 * i2
+	if p/i2 != i1 || (i1 == -1 && i2 == math.MinInt64) || (i2 == -1 && i1 == math.MinInt64) {
+		return 0, false
+	}
+	return p, true
+}
+
 func Avg(list []value.Primary) value.Primary {
 	var sum float64
 	var count int
@@ -118,6 +374,40 @@ func Avg(list []value.Primary) value.Primary {
 }
 
 func Median(list []value.Primary) value.Primary {
+	values := sortedFloatValues(list)
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	var median float64
+	if len(values)%2 == 1 {
+		idx := ((len(values) + 1) / 2) - 1
+		median = values[idx]
+	} else {
+		idx := (len(values) / 2) - 1
+		median = (values[idx] + values[idx+1]) / float64(2)
+	}
+	return value.ParseFloat64(median)
+}
+
+// Percentile returns the value at the fraction of the sorted values, interpolating between the two nearest values.
+func Percentile(list []value.Primary, fraction float64) value.Primary {
+	values := sortedFloatValues(list)
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	pos := fraction * float64(len(values)-1)
+	idx := int(pos)
+	if len(values)-1 <= idx {
+		return value.ParseFloat64(values[len(values)-1])
+	}
+	return value.ParseFloat64(values[idx] + (values[idx+1]-values[idx])*(pos-float64(idx)))
+}
+
+// Datetime values are converted to the number of seconds since the unix epoch, and the other values that cannot be
+// converted to floats are ignored.
+func sortedFloatValues(list []value.Primary) []float64 {
 	var values []float64
 
 	for _, v := range list {
@@ -131,21 +421,82 @@ func Median(list []value.Primary) value.Primary {
 		}
 	}
 
-	if len(values) < 1 {
-		return value.NewNull()
+	sort.Float64s(values)
+	return values
+}
+
+func BoolAnd(list []value.Primary) value.Primary {
+	var result value.Primary
+	result = value.NewNull()
+
+	for _, v := range list {
+		b := value.ToBoolean(v)
+		if value.IsNull(b) {
+			continue
+		}
+
+		if !b.(value.Boolean).Raw() {
+			return b
+		}
+		result = b
 	}
 
-	sort.Float64s(values)
+	return result
+}
 
-	var median float64
-	if len(values)%2 == 1 {
-		idx := ((len(values) + 1) / 2) - 1
-		median = values[idx]
-	} else {
-		idx := (len(values) / 2) - 1
-		median = (values[idx] + values[idx+1]) / float64(2)
+func BoolOr(list []value.Primary) value.Primary {
+	var result value.Primary
+	result = value.NewNull()
+
+	for _, v := range list {
+		b := value.ToBoolean(v)
+		if value.IsNull(b) {
+			continue
+		}
+
+		if b.(value.Boolean).Raw() {
+			return b
+		}
+		result = b
 	}
-	return value.ParseFloat64(median)
+
+	return result
+}
+
+func BitAnd(list []value.Primary) value.Primary {
+	return bitwiseAggregate(list, func(i1 int64, i2 int64) int64 { return i1 & i2 })
+}
+
+func BitOr(list []value.Primary) value.Primary {
+	return bitwiseAggregate(list, func(i1 int64, i2 int64) int64 { return i1 | i2 })
+}
+
+func BitXor(list []value.Primary) value.Primary {
+	return bitwiseAggregate(list, func(i1 int64, i2 int64) int64 { return i1 ^ i2 })
+}
+
+func bitwiseAggregate(list []value.Primary, fn func(int64, int64) int64) value.Primary {
+	var result int64
+	var count int
+
+	for _, v := range list {
+		i := value.ToInteger(v)
+		if value.IsNull(i) {
+			continue
+		}
+
+		if count < 1 {
+			result = i.(value.Integer).Raw()
+		} else {
+			result = fn(result, i.(value.Integer).Raw())
+		}
+		count++
+	}
+
+	if count < 1 {
+		return value.NewNull()
+	}
+	return value.NewInteger(result)
 }
 
 func ListAgg(list []value.Primary, separator string) value.Primary {
@@ -164,3 +515,45 @@ func ListAgg(list []value.Primary, separator string) value.Primary {
 
 	return value.NewString(strings.Join(strlist, separator))
 }
+
+// The fraction must not refer to any records, so it is evaluated only once.
+func evalAggregateFraction(expr parser.QueryExpression, name string, arg parser.QueryExpression, filter *Filter) (float64, error) {
+	argsFilter := filter.CreateNode()
+	argsFilter.Records = nil
+
+	p, err := argsFilter.Evaluate(arg)
+	if err != nil {
+		return 0, NewFunctionInvalidArgumentError(expr, name, "the second argument must be a constant number between 0 and 1")
+	}
+	f := value.ToFloat(p)
+	if value.IsNull(f) || f.(value.Float).Raw() < 0 || 1 < f.(value.Float).Raw() {
+		return 0, NewFunctionInvalidArgumentError(expr, name, "the second argument must be a constant number between 0 and 1")
+	}
+	return f.(value.Float).Raw(), nil
+}
+
+func aggregateListExprs(name string, distinct bool, args []parser.QueryExpression) []parser.QueryExpression {
+	if name == "COUNT" && distinct {
+		return args
+	}
+	return args[:1]
+}
+
+func evalAggregateListValue(filter *Filter, exprs []parser.QueryExpression) (value.Primary, error) {
+	if len(exprs) == 1 {
+		return filter.Evaluate(exprs[0])
+	}
+
+	values := make([]value.Primary, len(exprs))
+	for i, expr := range exprs {
+		p, err := filter.Evaluate(expr)
+		if err != nil {
+			return nil, err
+		}
+		if value.IsNull(p) {
+			return value.NewNull(), nil
+		}
+		values[i] = p
+	}
+	return value.NewString(SerializeComparisonKeys(values)), nil
+}
@@ -1,11 +1,17 @@
 package query
 
 import (
+	"errors"
+	"math"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
 )
 
 type aggregateTests struct {
@@ -100,6 +106,72 @@ func TestMin(t *testing.T) {
 	}
 }
 
+var argAggregateTests = []struct {
+	List      []value.Primary
+	Keys      []value.Primary
+	MaxResult value.Primary
+	MinResult value.Primary
+}{
+	{
+		List: []value.Primary{
+			value.NewString("a"),
+			value.NewString("b"),
+			value.NewString("c"),
+			value.NewString("d"),
+			value.NewString("e"),
+		},
+		Keys: []value.Primary{
+			value.NewInteger(2),
+			value.NewInteger(4),
+			value.NewNull(),
+			value.NewInteger(4),
+			value.NewInteger(1),
+		},
+		MaxResult: value.NewString("b"),
+		MinResult: value.NewString("e"),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+			value.NewString("b"),
+		},
+		Keys: []value.Primary{
+			value.NewInteger(3),
+			value.NewInteger(3),
+		},
+		MaxResult: value.NewNull(),
+		MinResult: value.NewNull(),
+	},
+	{
+		List: []value.Primary{
+			value.NewString("a"),
+		},
+		Keys: []value.Primary{
+			value.NewNull(),
+		},
+		MaxResult: value.NewNull(),
+		MinResult: value.NewNull(),
+	},
+}
+
+func TestArgMax(t *testing.T) {
+	for _, v := range argAggregateTests {
+		r := ArgMax(v.List, v.Keys)
+		if !reflect.DeepEqual(r, v.MaxResult) {
+			t.Errorf("arg_max list = %s, keys = %s: result = %s, want %s", v.List, v.Keys, r, v.MaxResult)
+		}
+	}
+}
+
+func TestArgMin(t *testing.T) {
+	for _, v := range argAggregateTests {
+		r := ArgMin(v.List, v.Keys)
+		if !reflect.DeepEqual(r, v.MinResult) {
+			t.Errorf("arg_min list = %s, keys = %s: result = %s, want %s", v.List, v.Keys, r, v.MinResult)
+		}
+	}
+}
+
 var sumTests = []aggregateTests{
 	{
 		List: []value.Primary{
@@ -129,6 +201,163 @@ func TestSum(t *testing.T) {
 	}
 }
 
+var countIfTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewTernary(ternary.TRUE),
+			value.NewTernary(ternary.FALSE),
+			value.NewTernary(ternary.UNKNOWN),
+			value.NewNull(),
+			value.NewTernary(ternary.TRUE),
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		List:   []value.Primary{},
+		Result: value.NewInteger(0),
+	},
+}
+
+func TestCountIf(t *testing.T) {
+	for _, v := range countIfTests {
+		r := CountIf(v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("count_if list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var arrayAggTests = []struct {
+	List   []value.Primary
+	Result value.Primary
+}{
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewNull(),
+			value.NewString("a"),
+		},
+		Result: value.NewArray([]value.Primary{
+			value.NewInteger(1),
+			value.NewNull(),
+			value.NewString("a"),
+		}),
+	},
+	{
+		List:   []value.Primary{},
+		Result: value.NewNull(),
+	},
+}
+
+func TestArrayAgg(t *testing.T) {
+	for _, v := range arrayAggTests {
+		r := ArrayAgg(v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("array_agg list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var sumIfTests = []struct {
+	Conditions []value.Primary
+	List       []value.Primary
+	Result     value.Primary
+}{
+	{
+		Conditions: []value.Primary{
+			value.NewTernary(ternary.TRUE),
+			value.NewTernary(ternary.FALSE),
+			value.NewNull(),
+			value.NewTernary(ternary.TRUE),
+		},
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(4),
+			value.NewInteger(8),
+		},
+		Result: value.NewInteger(9),
+	},
+	{
+		Conditions: []value.Primary{
+			value.NewTernary(ternary.FALSE),
+			value.NewNull(),
+		},
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestSumIf(t *testing.T) {
+	for _, v := range sumIfTests {
+		r := SumIf(v.Conditions, v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("sum_if conditions = %s, list = %s: result = %s, want %s", v.Conditions, v.List, r, v.Result)
+		}
+	}
+}
+
+var productTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewInteger(2),
+			value.NewNull(),
+			value.NewString("3"),
+			value.NewInteger(-4),
+			value.NewString("abc"),
+		},
+		Result: value.NewInteger(-24),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(2),
+			value.NewFloat(1.5),
+			value.NewInteger(2),
+		},
+		Result: value.NewFloat(6),
+	},
+	{
+		List: []value.Primary{
+			value.NewString("1.5"),
+			value.NewInteger(0),
+		},
+		Result: value.NewFloat(0),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(0),
+			value.NewInteger(5),
+		},
+		Result: value.NewInteger(0),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(4294967296),
+			value.NewInteger(4294967296),
+			value.NewInteger(2),
+		},
+		Result: value.NewFloat(36893488147419103232),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestProduct(t *testing.T) {
+	for _, v := range productTests {
+		r := Product(v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("product list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
 var avgTests = []aggregateTests{
 	{
 		List: []value.Primary{
@@ -208,6 +437,276 @@ func TestMedian(t *testing.T) {
 	}
 }
 
+var percentileTests = []struct {
+	List     []value.Primary
+	Fraction float64
+	Result   value.Primary
+}{
+	{
+		List: []value.Primary{
+			value.NewInteger(4),
+			value.NewInteger(1),
+			value.NewNull(),
+			value.NewInteger(2),
+			value.NewInteger(3),
+		},
+		Fraction: 0.5,
+		Result:   value.NewFloat(2.5),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(10),
+			value.NewInteger(20),
+			value.NewInteger(30),
+			value.NewInteger(40),
+			value.NewInteger(50),
+		},
+		Fraction: 0.95,
+		Result:   value.NewInteger(48),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(3),
+		},
+		Fraction: 0,
+		Result:   value.NewInteger(1),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(3),
+		},
+		Fraction: 1,
+		Result:   value.NewInteger(3),
+	},
+	{
+		List: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 2, 5, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Fraction: 0.5,
+		Result:   value.NewInteger(time.Date(2012, 2, 4, 9, 18, 15, 0, GetTestLocation()).Unix()),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Fraction: 0.5,
+		Result:   value.NewNull(),
+	},
+}
+
+func TestPercentile(t *testing.T) {
+	for _, v := range percentileTests {
+		r := Percentile(v.List, v.Fraction)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("percentile list = %s, fraction = %f: result = %s, want %s", v.List, v.Fraction, r, v.Result)
+		}
+	}
+}
+
+var boolAndTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewBoolean(true),
+			value.NewTernary(ternary.TRUE),
+			value.NewNull(),
+			value.NewTernary(ternary.UNKNOWN),
+		},
+		Result: value.NewBoolean(true),
+	},
+	{
+		List: []value.Primary{
+			value.NewTernary(ternary.TRUE),
+			value.NewTernary(ternary.FALSE),
+			value.NewBoolean(true),
+		},
+		Result: value.NewBoolean(false),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+			value.NewTernary(ternary.UNKNOWN),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestBoolAnd(t *testing.T) {
+	for _, v := range boolAndTests {
+		r := BoolAnd(v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("bool_and list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var boolOrTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewBoolean(false),
+			value.NewTernary(ternary.FALSE),
+			value.NewNull(),
+			value.NewTernary(ternary.UNKNOWN),
+		},
+		Result: value.NewBoolean(false),
+	},
+	{
+		List: []value.Primary{
+			value.NewTernary(ternary.FALSE),
+			value.NewTernary(ternary.TRUE),
+			value.NewBoolean(false),
+		},
+		Result: value.NewBoolean(true),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+			value.NewTernary(ternary.UNKNOWN),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestBoolOr(t *testing.T) {
+	for _, v := range boolOrTests {
+		r := BoolOr(v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("bool_or list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var bitAndTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewInteger(12),
+			value.NewNull(),
+			value.NewString("6"),
+			value.NewFloat(5),
+			value.NewString("abc"),
+		},
+		Result: value.NewInteger(4),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestBitAnd(t *testing.T) {
+	for _, v := range bitAndTests {
+		r := BitAnd(v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("bit_and list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var bitOrTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewInteger(12),
+			value.NewNull(),
+			value.NewString("6"),
+			value.NewFloat(5),
+			value.NewString("abc"),
+		},
+		Result: value.NewInteger(15),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestBitOr(t *testing.T) {
+	for _, v := range bitOrTests {
+		r := BitOr(v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("bit_or list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var bitXorTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewInteger(12),
+			value.NewNull(),
+			value.NewString("6"),
+			value.NewFloat(5),
+			value.NewString("abc"),
+		},
+		Result: value.NewInteger(15),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestBitXor(t *testing.T) {
+	for _, v := range bitXorTests {
+		r := BitXor(v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("bit_xor list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var approxCountDistinctTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewString("1"),
+			value.NewNull(),
+			value.NewString("abc"),
+			value.NewFloat(2.5),
+			value.NewInteger(1),
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewInteger(0),
+	},
+}
+
+func TestApproxCountDistinct(t *testing.T) {
+	for _, v := range approxCountDistinctTests {
+		r := ApproxCountDistinct(v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("approx_count_distinct list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+
+	flags := cmd.GetFlags()
+	list := make([]value.Primary, 0, 200000)
+	for i := 0; i < 100000; i++ {
+		list = append(list, value.NewInteger(int64(i)), value.NewInteger(int64(i)))
+	}
+
+	for _, precision := range []int{10, cmd.DEFAULT_APPROX_PRECISION} {
+		flags.ApproxPrecision = precision
+		r := ApproxCountDistinct(list).(value.Integer).Raw()
+		tolerance := 3 * 1.04 / math.Sqrt(float64(int(1)<<uint(precision)))
+		if math.Abs(float64(r)-100000)/100000 > tolerance {
+			t.Errorf("approx_count_distinct with precision %d: result = %d, want about %d", precision, r, 100000)
+		}
+	}
+	flags.ApproxPrecision = cmd.DEFAULT_APPROX_PRECISION
+}
+
 var listAggTests = []struct {
 	List      []value.Primary
 	Separator string
@@ -242,3 +741,133 @@ func TestListAgg(t *testing.T) {
 		}
 	}
 }
+
+type testSumAggregator struct {
+	sum int64
+}
+
+func (agg *testSumAggregator) Accumulate(p value.Primary) error {
+	i := value.ToInteger(p)
+	if value.IsNull(i) {
+		return errors.New("values must be integers")
+	}
+	agg.sum += i.(value.Integer).Raw()
+	return nil
+}
+
+func (agg *testSumAggregator) Merge(other Aggregator) error {
+	agg.sum += other.(*testSumAggregator).sum
+	return nil
+}
+
+func (agg *testSumAggregator) Finalize() (value.Primary, error) {
+	return value.NewInteger(agg.sum), nil
+}
+
+func newTestSumAggregator() Aggregator {
+	return &testSumAggregator{}
+}
+
+func TestRegisterAggregateFunction(t *testing.T) {
+	if err := RegisterAggregateFunction("test_sum", newTestSumAggregator); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer delete(ExternalAggregateFunctions, "TEST_SUM")
+
+	for _, name := range []string{"test_sum", "sum", "coalesce", ""} {
+		if err := RegisterAggregateFunction(name, newTestSumAggregator); err == nil {
+			t.Errorf("no error, want error for function name %q", name)
+		}
+	}
+	if err := RegisterFunction("test_sum", func(args []value.Primary) (value.Primary, error) { return nil, nil }); err == nil {
+		t.Errorf("no error, want error for function name %q", "test_sum")
+	}
+
+	filter := &Filter{
+		Records: []FilterRecord{
+			{
+				View: &View{
+					Header: NewHeader("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str1"),
+								value.NewString("str2"),
+								value.NewString("str3"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				RecordIndex: 0,
+			},
+		},
+	}
+
+	result, err := filter.Evaluate(parser.Function{
+		Name: "test_sum",
+		Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+	})
+	if err != nil {
+		t.Errorf("unexpected error %q", err)
+	} else if !reflect.DeepEqual(result, value.NewInteger(4)) {
+		t.Errorf("result = %s, want %s", result, value.NewInteger(4))
+	}
+
+	_, err = filter.Evaluate(parser.AggregateFunction{
+		Name: "test_sum",
+		Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+	})
+	expectErr := "[L:- C:-] values must be integers for function test_sum"
+	if err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q", err.Error(), expectErr)
+	}
+
+	view := &View{
+		Header: NewHeader("table1", []string{"column1"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewInteger(1)}),
+			NewRecord([]value.Primary{value.NewInteger(2)}),
+		},
+		Filter: NewEmptyFilter(),
+	}
+	err = Analyze(view, parser.AnalyticFunction{
+		Name: "test_sum",
+		Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+	}, nil)
+	if err != nil {
+		t.Errorf("unexpected error %q", err)
+	} else {
+		for i, record := range view.RecordSet {
+			if !reflect.DeepEqual(record[1].Value(), value.NewInteger(3)) {
+				t.Errorf("analytic result of record %d = %s, want %s", i, record[1].Value(), value.NewInteger(3))
+			}
+		}
+	}
+}
+
+func TestAggregateWithAggregator(t *testing.T) {
+	flags := cmd.GetFlags()
+	cpu := flags.CPU
+	flags.CPU = 3
+	defer func() { flags.CPU = cpu }()
+
+	list := make([]value.Primary, 1000)
+	for i := range list {
+		list[i] = value.NewInteger(int64(i + 1))
+	}
+
+	result, err := aggregateWithAggregator(parser.Function{Name: "test_sum"}, "test_sum", newTestSumAggregator, list)
+	if err != nil {
+		t.Errorf("unexpected error %q", err)
+	} else if !reflect.DeepEqual(result, value.NewInteger(500500)) {
+		t.Errorf("result = %s, want %s", result, value.NewInteger(500500))
+	}
+}
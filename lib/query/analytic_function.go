@@ -2,6 +2,7 @@ package query
 
 import (
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/mithrandie/csvq/lib/parser"
@@ -30,52 +31,38 @@ type AnalyticFunction interface {
 
 type Partition []int
 
-func (p Partition) Reverse() {
-	sort.Sort(sort.Reverse(sort.IntSlice(p)))
+// Returns a reversed copy so that a partition shared by several functions is not modified.
+func (p Partition) Reverse() Partition {
+	reversed := make(Partition, len(p))
+	copy(reversed, p)
+	sort.Sort(sort.Reverse(sort.IntSlice(reversed)))
+	return reversed
 }
 
 type Partitions map[string]Partition
 
-func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) error {
-	const (
-		ANALYTIC = iota
-		AGGREGATE
-		USER_DEFINED
-	)
-
-	var anfn AnalyticFunction
-	var aggfn AggregateFunction
-	var udfn *UserDefinedFunction
-
-	fnType := -1
-	var err error
+type PartitionList struct {
+	Partitions Partitions
+	Keys       []string
+}
 
-	uname := strings.ToUpper(fn.Name)
-	if f, ok := AnalyticFunctions[uname]; ok {
-		anfn = f
-		fnType = ANALYTIC
-	} else if f, ok := AggregateFunctions[uname]; ok {
-		aggfn = f
-		fnType = AGGREGATE
-	} else {
-		if udfn, err = view.Filter.Functions.Get(fn, uname); err != nil || !udfn.IsAggregate {
-			return NewFunctionNotExistError(fn, fn.Name)
-		}
-		fnType = USER_DEFINED
+func partitionListKey(partitionIndices []int) string {
+	keys := make([]string, len(partitionIndices))
+	for i, idx := range partitionIndices {
+		keys[i] = strconv.Itoa(idx)
 	}
+	return strings.Join(keys, ",")
+}
 
-	switch fnType {
-	case ANALYTIC:
-		if err := anfn.CheckArgsLen(fn); err != nil {
-			return err
-		}
-	case AGGREGATE:
-		if len(fn.Args) != 1 {
-			return NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
-		}
-	case USER_DEFINED:
-		if err := udfn.CheckArgsLen(fn, fn.Name, len(fn.Args)-1); err != nil {
-			return err
+// Returns the partitions of the records in the current order.
+// Partitions are shared by the windows having the same partition clause
+// while the cache of the view is enabled.
+func (view *View) partitionList(partitionIndices []int) PartitionList {
+	var cacheKey string
+	if view.partitionLists != nil {
+		cacheKey = partitionListKey(partitionIndices)
+		if list, ok := view.partitionLists[cacheKey]; ok {
+			return list
 		}
 	}
 
@@ -120,18 +107,106 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 
 	gm.Wait()
 
-	partitions := Partitions{}
-	partitionMapKeys := []string{}
+	list := PartitionList{
+		Partitions: Partitions{},
+		Keys:       []string{},
+	}
 	for i, key := range partitionKeys {
-		if _, ok := partitions[key]; ok {
-			partitions[key] = append(partitions[key], i)
+		if _, ok := list.Partitions[key]; ok {
+			list.Partitions[key] = append(list.Partitions[key], i)
 		} else {
-			partitions[key] = Partition{i}
-			partitionMapKeys = append(partitionMapKeys, key)
+			list.Partitions[key] = Partition{i}
+			list.Keys = append(list.Keys, key)
 		}
 	}
 
-	gm = NewGoroutineManager(len(partitionMapKeys), 0)
+	if view.partitionLists != nil {
+		view.partitionLists[cacheKey] = list
+	}
+	return list
+}
+
+func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) error {
+	enterPhase(PROFILE_ANALYTIC)
+	defer leavePhase(PROFILE_ANALYTIC)
+
+	const (
+		ANALYTIC = iota
+		AGGREGATE
+		USER_DEFINED
+	)
+
+	var anfn AnalyticFunction
+	var aggfn AggregateFunction
+	var argfn ArgAggregateFunction
+	var fracfn FractionAggregateFunction
+	var newAggregator func() Aggregator
+	var udfn *UserDefinedFunction
+
+	fnType := -1
+	var fraction float64
+	var err error
+
+	uname := strings.ToUpper(fn.Name)
+	if f, ok := AnalyticFunctions[uname]; ok {
+		anfn = f
+		fnType = ANALYTIC
+	} else if f, ok := AggregateFunctions[uname]; ok {
+		aggfn = f
+		fnType = AGGREGATE
+	} else if f, ok := ArgAggregateFunctions[uname]; ok {
+		argfn = f
+		fnType = AGGREGATE
+	} else if f, ok := FractionAggregateFunctions[uname]; ok {
+		fracfn = f
+		fnType = AGGREGATE
+	} else if f, ok := ExternalAggregateFunctions[uname]; ok {
+		newAggregator = f
+		fnType = AGGREGATE
+	} else {
+		if udfn, err = view.Filter.Functions.Get(fn, uname); err != nil || !udfn.IsAggregate {
+			return NewFunctionNotExistError(fn, fn.Name)
+		}
+		fnType = USER_DEFINED
+	}
+
+	switch fnType {
+	case ANALYTIC:
+		if err := anfn.CheckArgsLen(fn); err != nil {
+			return err
+		}
+	case AGGREGATE:
+		if uname == "COUNT" && fn.IsDistinct() {
+			if len(fn.Args) < 1 {
+				return NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least "+FormatCount(1, "argument"))
+			}
+		} else if argfn != nil {
+			if len(fn.Args) != 2 {
+				return NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+			}
+			// Values and keys must be listed in pairs, and DISTINCT does not change the result.
+			fn.Distinct = parser.Token{}
+		} else if fracfn != nil {
+			if len(fn.Args) != 2 {
+				return NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+			}
+			if fraction, err = evalAggregateFraction(fn, fn.Name, fn.Args[1], view.Filter); err != nil {
+				return err
+			}
+		} else if len(fn.Args) != 1 {
+			return NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+		}
+	case USER_DEFINED:
+		if err := udfn.CheckArgsLen(fn, fn.Name, len(fn.Args)-1); err != nil {
+			return err
+		}
+	}
+
+	partitionList := view.partitionList(partitionIndices)
+	partitions := partitionList.Partitions
+	partitionMapKeys := partitionList.Keys
+
+	gm := NewGoroutineManager(len(partitionMapKeys), 0)
 	for i := 0; i < gm.CPU; i++ {
 		gm.Add()
 		go func(thIdx int) {
@@ -162,9 +237,10 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 
 					if fnType == AGGREGATE {
 						partition := partitions[partitionMapKeys[i]]
-						frameSet := WindowFrameSet(partition, fn.AnalyticClause)
+						frameSet := WindowFrameSet(partition, fn.AnalyticClause, view.sortValuesInEachRecord)
 
 						valueCache := make(map[int]value.Primary, len(partition))
+						keyCache := make(map[int]value.Primary, len(partition))
 
 						for _, frame := range frameSet {
 							values, e := windowValues(frame, partition, fn, filter, valueCache)
@@ -172,7 +248,27 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 								gm.SetError(e)
 								break AnalyzeLoop
 							}
-							val := aggfn(values)
+
+							var val value.Primary
+							if argfn != nil {
+								keyFn := fn
+								keyFn.Args = fn.Args[1:]
+								keys, e := windowValues(frame, partition, keyFn, filter, keyCache)
+								if e != nil {
+									gm.SetError(e)
+									break AnalyzeLoop
+								}
+								val = argfn(values, keys)
+							} else if fracfn != nil {
+								val = fracfn(values, fraction)
+							} else if newAggregator != nil {
+								if val, e = aggregateWithAggregator(fn, fn.Name, newAggregator, values); e != nil {
+									gm.SetError(e)
+									break AnalyzeLoop
+								}
+							} else {
+								val = aggfn(values)
+							}
 
 							for _, idx := range frame.Records {
 								view.RecordSet[idx] = append(view.RecordSet[idx], NewCell(val))
@@ -180,7 +276,7 @@ func Analyze(view *View, fn parser.AnalyticFunction, partitionIndices []int) err
 						}
 					} else { //User Defined Function
 						partition := partitions[partitionMapKeys[i]]
-						frameSet := WindowFrameSet(partition, fn.AnalyticClause)
+						frameSet := WindowFrameSet(partition, fn.AnalyticClause, view.sortValuesInEachRecord)
 
 						valueCache := make(map[int]value.Primary, len(partition))
 
@@ -234,7 +330,10 @@ type WindowFrame struct {
 	Records []int
 }
 
-func WindowFrameSet(partition Partition, expr parser.AnalyticClause) []WindowFrame {
+// Without windowing clause, the frame of each record is the whole partition if the analytic clause has no order by clause,
+// otherwise the frame is from the first record of the partition to the last record that has the same sort values as
+// the current record.
+func WindowFrameSet(partition Partition, expr parser.AnalyticClause, sortValues []SortValues) []WindowFrame {
 	var singleFrameSet = func(partition Partition) []WindowFrame {
 		indices := make([]int, len(partition))
 		for i, idx := range partition {
@@ -274,17 +373,26 @@ func WindowFrameSet(partition Partition, expr parser.AnalyticClause) []WindowFra
 
 	frameSet := make([]WindowFrame, 0, length)
 
-	var windowClause parser.WindowingClause
 	if expr.WindowingClause == nil {
-		windowClause = parser.WindowingClause{
-			FrameLow: parser.WindowFramePosition{
-				Direction: parser.PRECEDING,
-				Unbounded: true,
-			},
+		for current := 0; current < length; {
+			high := current
+			if sortValues != nil {
+				for high+1 < length && sortValues[partition[high+1]].EquivalentTo(sortValues[partition[current]]) {
+					high++
+				}
+			}
+
+			frameSet = append(frameSet, WindowFrame{
+				Low:     0,
+				High:    high,
+				Records: partition[current : high+1],
+			})
+			current = high + 1
 		}
-	} else {
-		windowClause = expr.WindowingClause.(parser.WindowingClause)
+		return frameSet
 	}
+
+	windowClause := expr.WindowingClause.(parser.WindowingClause)
 	frameLow := windowClause.FrameLow.(parser.WindowFramePosition)
 
 	if windowClause.FrameHigh == nil {
@@ -315,6 +423,7 @@ func WindowFrameSet(partition Partition, expr parser.AnalyticClause) []WindowFra
 
 func windowValues(frame WindowFrame, partition Partition, expr parser.AnalyticFunction, filter *Filter, valueCache map[int]value.Primary) ([]value.Primary, error) {
 	values := make([]value.Primary, 0, frame.High-frame.Low+1)
+	listExprs := aggregateListExprs(strings.ToUpper(expr.Name), expr.IsDistinct(), expr.Args)
 
 	for i := frame.Low; i <= frame.High; i++ {
 		if i < 0 || len(partition) <= i {
@@ -326,7 +435,7 @@ func windowValues(frame WindowFrame, partition Partition, expr parser.AnalyticFu
 			values = append(values, v)
 		} else {
 			filter.Records[0].RecordIndex = recordIdx
-			p, e := filter.Evaluate(expr.Args[0])
+			p, e := evalAggregateListValue(filter, listExprs)
 			if e != nil {
 				return nil, e
 			}
@@ -430,6 +539,9 @@ func (fn CumeDist) CheckArgsLen(expr parser.AnalyticFunction) error {
 
 func (fn CumeDist) Execute(partition Partition, expr parser.AnalyticFunction, filter *Filter) (map[int]value.Primary, error) {
 	list := make(map[int]value.Primary, len(partition))
+	if expr.IgnoreNulls {
+		partition = excludeNullOrderValues(partition, filter.Records[0].View, list)
+	}
 
 	groups := perseCumulativeGroups(partition, filter.Records[0].View)
 	total := float64(len(partition))
@@ -454,6 +566,9 @@ func (fn PercentRank) CheckArgsLen(expr parser.AnalyticFunction) error {
 
 func (fn PercentRank) Execute(partition Partition, expr parser.AnalyticFunction, filter *Filter) (map[int]value.Primary, error) {
 	list := make(map[int]value.Primary, len(partition))
+	if expr.IgnoreNulls {
+		partition = excludeNullOrderValues(partition, filter.Records[0].View, list)
+	}
 
 	groups := perseCumulativeGroups(partition, filter.Records[0].View)
 	denom := float64(len(partition) - 1)
@@ -490,6 +605,30 @@ func perseCumulativeGroups(partition Partition, view *View) [][]int {
 	return groups
 }
 
+func excludeNullOrderValues(partition Partition, view *View, list map[int]value.Primary) Partition {
+	if view.sortValuesInEachRecord == nil {
+		return partition
+	}
+
+	p := make(Partition, 0, len(partition))
+	for _, idx := range partition {
+		isNull := true
+		for _, v := range view.sortValuesInEachRecord[idx] {
+			if v.Type != SORT_VALUE_NULL {
+				isNull = false
+				break
+			}
+		}
+
+		if isNull {
+			list[idx] = value.NewNull()
+		} else {
+			p = append(p, idx)
+		}
+	}
+	return p
+}
+
 type NTile struct{}
 
 func (fn NTile) CheckArgsLen(expr parser.AnalyticFunction) error {
@@ -564,8 +703,7 @@ func (fn LastValue) CheckArgsLen(expr parser.AnalyticFunction) error {
 }
 
 func (fn LastValue) Execute(partition Partition, expr parser.AnalyticFunction, filter *Filter) (map[int]value.Primary, error) {
-	partition.Reverse()
-	return setNthValue(partition, expr, filter, 1)
+	return setNthValue(partition.Reverse(), expr, filter, 1)
 }
 
 type NthValue struct{}
@@ -596,7 +734,7 @@ func (fn NthValue) Execute(partition Partition, expr parser.AnalyticFunction, fi
 }
 
 func setNthValue(partition Partition, expr parser.AnalyticFunction, filter *Filter, n int) (map[int]value.Primary, error) {
-	frameSet := WindowFrameSet(partition, expr.AnalyticClause)
+	frameSet := WindowFrameSet(partition, expr.AnalyticClause, filter.Records[0].View.sortValuesInEachRecord)
 	list := make(map[int]value.Primary, len(partition))
 
 	valueCache := make(map[int]value.Primary, len(partition))
@@ -657,8 +795,7 @@ func (fn Lead) CheckArgsLen(expr parser.AnalyticFunction) error {
 }
 
 func (fn Lead) Execute(partition Partition, expr parser.AnalyticFunction, filter *Filter) (map[int]value.Primary, error) {
-	partition.Reverse()
-	return setLag(partition, expr, filter)
+	return setLag(partition.Reverse(), expr, filter)
 }
 
 func setLag(partition Partition, expr parser.AnalyticFunction, filter *Filter) (map[int]value.Primary, error) {
@@ -412,6 +412,88 @@ var analyzeTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Analyze AggregateFunction with Peers in Default Frame",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("c"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("d"),
+					value.NewInteger(3),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+			sortValuesInEachRecord: []SortValues{
+				{NewSortValue(value.NewInteger(1))},
+				{NewSortValue(value.NewInteger(2))},
+				{NewSortValue(value.NewInteger(2))},
+				{NewSortValue(value.NewInteger(3))},
+			},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "sum",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(2),
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("c"),
+					value.NewInteger(2),
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("d"),
+					value.NewInteger(3),
+					value.NewInteger(8),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+			sortValuesInEachCell: [][]*SortValue{
+				{nil, nil},
+				{nil, nil},
+				{nil, nil},
+				{nil, nil},
+			},
+			sortValuesInEachRecord: []SortValues{
+				{NewSortValue(value.NewInteger(1))},
+				{NewSortValue(value.NewInteger(2))},
+				{NewSortValue(value.NewInteger(2))},
+				{NewSortValue(value.NewInteger(3))},
+			},
+		},
+	},
 	{
 		Name: "Analyze AggregateFunction With Distinct",
 		View: &View{
@@ -501,6 +583,244 @@ var analyzeTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Analyze AggregateFunction Count Distinct Multiple Columns",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+			sortValuesInEachCell: [][]*SortValue{
+				{NewSortValue(value.NewString("a")), nil},
+				{NewSortValue(value.NewString("a")), nil},
+				{NewSortValue(value.NewString("b")), nil},
+				{NewSortValue(value.NewString("b")), nil},
+				{NewSortValue(value.NewString("b")), nil},
+			},
+		},
+		Function: parser.AnalyticFunction{
+			Name:     "count",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		PartitionIndices: []int{0},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+			sortValuesInEachCell: [][]*SortValue{
+				{NewSortValue(value.NewString("a")), nil},
+				{NewSortValue(value.NewString("a")), nil},
+				{NewSortValue(value.NewString("b")), nil},
+				{NewSortValue(value.NewString("b")), nil},
+				{NewSortValue(value.NewString("b")), nil},
+			},
+		},
+	},
+	{
+		Name: "Analyze AggregateFunction ArgMin",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("c"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("d"),
+					value.NewInteger(1),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		Function: parser.AnalyticFunction{
+			Name:     "arg_min",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewString("b"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewString("b"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("c"),
+					value.NewNull(),
+					value.NewString("b"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("d"),
+					value.NewInteger(1),
+					value.NewString("b"),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+			sortValuesInEachCell: [][]*SortValue{
+				{nil, nil},
+				{nil, nil},
+				{nil, nil},
+				{nil, nil},
+			},
+		},
+	},
+	{
+		Name: "Analyze AggregateFunction Percentile",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(3),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(5),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		Function: parser.AnalyticFunction{
+			Name: "percentile",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewFloatValue(0.5),
+			},
+			AnalyticClause: parser.AnalyticClause{
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		PartitionIndices: []int{0},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(3),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(5),
+					value.NewInteger(5),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+			sortValuesInEachCell: [][]*SortValue{
+				{NewSortValue(value.NewString("a")), nil},
+				{NewSortValue(value.NewString("a")), nil},
+				{NewSortValue(value.NewString("b")), nil},
+			},
+		},
+	},
+	{
+		Name: "Analyze AggregateFunction Percentile Fraction Error",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		Function: parser.AnalyticFunction{
+			Name: "percentile",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewIntegerValue(-1),
+			},
+		},
+		Error: "[L:- C:-] the second argument must be a constant number between 0 and 1 for function percentile",
+	},
 	{
 		Name: "Analyze AggregateFunction Argument Length Error",
 		View: &View{
@@ -1550,6 +1870,28 @@ var cumeDistExecuteTests = []analyticFunctionExecuteTests{
 			3: value.NewFloat(1),
 		},
 	},
+	{
+		Name:  "CumeDist Execute Ignore Nulls",
+		Items: Partition{0, 2, 4, 1, 3},
+		SortValues: map[int]SortValues{
+			0: {NewSortValue(value.NewNull())},
+			2: {NewSortValue(value.NewString("1"))},
+			4: {NewSortValue(value.NewString("2"))},
+			1: {NewSortValue(value.NewString("2"))},
+			3: {NewSortValue(value.NewString("3"))},
+		},
+		Function: parser.AnalyticFunction{
+			Name:        "cume_dist",
+			IgnoreNulls: true,
+		},
+		Result: map[int]value.Primary{
+			0: value.NewNull(),
+			2: value.NewFloat(0.25),
+			4: value.NewFloat(0.75),
+			1: value.NewFloat(0.75),
+			3: value.NewFloat(1),
+		},
+	},
 }
 
 func TestCumeDist_Execute(t *testing.T) {
@@ -1595,6 +1937,30 @@ var percentRankExecuteTests = []analyticFunctionExecuteTests{
 			5: value.NewFloat(1),
 		},
 	},
+	{
+		Name:  "PercentRank Execute Ignore Nulls",
+		Items: Partition{2, 4, 1, 3, 5, 0},
+		SortValues: map[int]SortValues{
+			2: {NewSortValue(value.NewString("1"))},
+			4: {NewSortValue(value.NewString("2"))},
+			1: {NewSortValue(value.NewString("2"))},
+			3: {NewSortValue(value.NewString("3"))},
+			5: {NewSortValue(value.NewString("4"))},
+			0: {NewSortValue(value.NewNull())},
+		},
+		Function: parser.AnalyticFunction{
+			Name:        "percent_rank",
+			IgnoreNulls: true,
+		},
+		Result: map[int]value.Primary{
+			2: value.NewFloat(0),
+			4: value.NewFloat(0.25),
+			1: value.NewFloat(0.25),
+			3: value.NewFloat(0.75),
+			5: value.NewFloat(1),
+			0: value.NewNull(),
+		},
+	},
 }
 
 func TestPercentRank_Execute(t *testing.T) {
@@ -1649,6 +2015,58 @@ var ntileValueExecuteTests = []analyticFunctionExecuteTests{
 			2: value.NewInteger(2),
 		},
 	},
+	{
+		Name:  "NTile Execute Less Records Than Tile Number",
+		Items: Partition{1, 2, 3},
+		Function: parser.AnalyticFunction{
+			Name: "ntile",
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValue(5),
+			},
+		},
+		Result: map[int]value.Primary{
+			1: value.NewInteger(1),
+			2: value.NewInteger(2),
+			3: value.NewInteger(3),
+		},
+	},
+	{
+		Name:  "NTile Execute Same Tile Number",
+		Items: Partition{1, 2, 3},
+		Function: parser.AnalyticFunction{
+			Name: "ntile",
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValue(3),
+			},
+		},
+		Result: map[int]value.Primary{
+			1: value.NewInteger(1),
+			2: value.NewInteger(2),
+			3: value.NewInteger(3),
+		},
+	},
+	{
+		Name:  "NTile Execute Uneven Remainders",
+		Items: Partition{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		Function: parser.AnalyticFunction{
+			Name: "ntile",
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValue(4),
+			},
+		},
+		Result: map[int]value.Primary{
+			1:  value.NewInteger(1),
+			2:  value.NewInteger(1),
+			3:  value.NewInteger(1),
+			4:  value.NewInteger(2),
+			5:  value.NewInteger(2),
+			6:  value.NewInteger(2),
+			7:  value.NewInteger(3),
+			8:  value.NewInteger(3),
+			9:  value.NewInteger(4),
+			10: value.NewInteger(4),
+		},
+	},
 	{
 		Name:  "NTile Execute Argument Evaluation Error",
 		Items: Partition{1, 2, 3, 4, 5, 6, 7},
@@ -19,6 +19,9 @@ func Calculate(p1 value.Primary, p2 value.Primary, operator int) value.Primary {
 	pf2 := value.ToFloat(p2)
 
 	if value.IsNull(pf1) || value.IsNull(pf2) {
+		if operator == '-' && value.IsNull(pf1) && value.IsNull(pf2) {
+			return calculateDatetimeDiff(p1, p2)
+		}
 		return value.NewNull()
 	}
 
@@ -42,6 +45,20 @@ func Calculate(p1 value.Primary, p2 value.Primary, operator int) value.Primary {
 	return value.ParseFloat64(result)
 }
 
+func calculateDatetimeDiff(p1 value.Primary, p2 value.Primary) value.Primary {
+	dt1 := value.ToDatetime(p1)
+	if value.IsNull(dt1) {
+		return value.NewNull()
+	}
+	dt2 := value.ToDatetime(p2)
+	if value.IsNull(dt2) {
+		return value.NewNull()
+	}
+
+	dur := dt1.(value.Datetime).Raw().Sub(dt2.(value.Datetime).Raw())
+	return value.ParseFloat64(dur.Seconds())
+}
+
 func calculateInteger(i1 int64, i2 int64, operator int) value.Primary {
 	var result int64 = 0
 	switch operator {
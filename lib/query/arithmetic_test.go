@@ -3,6 +3,7 @@ package query
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/value"
 )
@@ -73,6 +74,30 @@ var calculateTests = []struct {
 		Operator: '%',
 		Result:   value.NewFloat(0.5),
 	},
+	{
+		LHS:      value.NewString("2012-02-03 09:18:15.5"),
+		RHS:      value.NewString("2012-02-03 09:00:00"),
+		Operator: '-',
+		Result:   value.NewFloat(1095.5),
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2012, 2, 3, 9, 0, 0, 0, GetTestLocation())),
+		RHS:      value.NewDatetime(time.Date(2012, 2, 4, 9, 0, 0, 0, GetTestLocation())),
+		Operator: '-',
+		Result:   value.NewInteger(-86400),
+	},
+	{
+		LHS:      value.NewString("2012-02-03 09:18:15"),
+		RHS:      value.NewString("2012-02-03 09:00:00"),
+		Operator: '+',
+		Result:   value.NewNull(),
+	},
+	{
+		LHS:      value.NewString("2012-02-03 09:18:15"),
+		RHS:      value.NewString("abc"),
+		Operator: '-',
+		Result:   value.NewNull(),
+	},
 }
 
 func TestCalculate(t *testing.T) {
@@ -14,6 +14,8 @@ import (
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
 )
 
 func Print(expr parser.Print, filter *Filter) (string, error) {
@@ -90,17 +92,137 @@ func Source(expr parser.Source, filter *Filter) ([]parser.Statement, error) {
 	return statements, err
 }
 
+func UseRepository(expr parser.UseRepository, filter *Filter) error {
+	p, err := filter.Evaluate(expr.Repository)
+	if err != nil {
+		return err
+	}
+	s := value.ToString(p)
+	if value.IsNull(s) {
+		return NewRepositoryInvalidArgumentError(expr, expr.Repository)
+	}
+	dir := s.(value.String).Raw()
+
+	if err := cmd.SetRepository(dir); err != nil || len(dir) < 1 {
+		return NewRepositoryNotExistError(expr, dir)
+	}
+	return nil
+}
+
+func Export(expr parser.Export, filter *Filter) (string, error) {
+	if cmd.GetFlags().ReadOnly {
+		return "", NewReadOnlyError(expr, "EXPORT")
+	}
+
+	p, err := filter.Evaluate(expr.FilePath)
+	if err != nil {
+		return "", err
+	}
+	s := value.ToString(p)
+	if value.IsNull(s) {
+		return "", NewExportInvalidArgumentError(expr, expr.FilePath)
+	}
+	fpath := s.(value.String).Raw()
+	if !filepath.IsAbs(fpath) {
+		fpath = filepath.Join(cmd.GetFlags().Repository, fpath)
+	}
+	fpath, err = filepath.Abs(fpath)
+	if err != nil {
+		return "", NewWriteFileError(expr, err.Error())
+	}
+
+	format := cmd.CSV
+	switch strings.ToUpper(filepath.Ext(fpath)) {
+	case ".TSV":
+		format = cmd.TSV
+	case ".JSON":
+		format = cmd.JSON
+	}
+	var delimiter rune = cmd.UNDEF
+	encoding := cmd.UTF8
+	lineBreak := cmd.GetFlags().LineBreak
+	withoutHeader := false
+
+	for _, opt := range expr.Options {
+		var p value.Primary
+		switch opt.Value.(type) {
+		case parser.Identifier:
+			p = value.NewString(opt.Value.(parser.Identifier).Literal)
+		default:
+			p = opt.Value.(parser.PrimitiveType).Value
+		}
+
+		switch strings.ToUpper(opt.Name.Literal) {
+		case "FORMAT", "DELIMITER", "ENCODING", "LINE_BREAK":
+			p = value.ToString(p)
+		case "HEADER":
+			p = value.ToBoolean(p)
+		default:
+			return "", NewExportInvalidOptionNameError(opt)
+		}
+		if value.IsNull(p) {
+			return "", NewExportInvalidOptionValueError(opt)
+		}
+
+		switch strings.ToUpper(opt.Name.Literal) {
+		case "FORMAT":
+			format, err = cmd.ParseFormat(p.(value.String).Raw())
+		case "DELIMITER":
+			runes := []rune(cmd.UnescapeString(p.(value.String).Raw()))
+			if len(runes) != 1 {
+				return "", NewExportInvalidOptionValueError(opt)
+			}
+			delimiter = runes[0]
+		case "ENCODING":
+			encoding, err = cmd.ParseEncoding(p.(value.String).Raw())
+		case "LINE_BREAK":
+			lineBreak, err = cmd.ParseLineBreak(p.(value.String).Raw())
+		case "HEADER":
+			withoutHeader = !p.(value.Boolean).Raw()
+		}
+		if err != nil {
+			return "", NewExportInvalidOptionValueError(opt)
+		}
+	}
+
+	if format == cmd.TSV {
+		delimiter = '\t'
+	} else if delimiter == cmd.UNDEF {
+		delimiter = ','
+	}
+
+	view := NewView()
+	if err = view.LoadFromTableIdentifier(expr.Table, filter.CreateNode()); err != nil {
+		return "", err
+	}
+
+	viewstr, err := EncodeView(view, format, delimiter, withoutHeader, encoding, lineBreak)
+	if err != nil {
+		return "", NewWriteFileError(expr, err.Error())
+	}
+	if err = cmd.CreateFile(fpath, viewstr); err != nil {
+		return "", NewWriteFileError(expr, err.Error())
+	}
+
+	return fmt.Sprintf("%s exported to %q.", FormatCount(view.RecordLen(), "record"), fpath), nil
+}
+
 func SetFlag(expr parser.SetFlag) error {
 	var p value.Primary
 
 	switch strings.ToUpper(expr.Name) {
-	case "@@DELIMITER", "@@ENCODING", "@@LINE_BREAK", "@@TIMEZONE", "@@REPOSITORY", "@@DATETIME_FORMAT":
+	case "@@DELIMITER", "@@ENCODING", "@@LINE_BREAK", "@@TIMEZONE", "@@REPOSITORY", "@@DATETIME_FORMAT", "@@COMMENT", "@@TSV_STYLE", "@@LOCALE", "@@BOOLEAN_LITERALS", "@@INFER_TYPES":
 		p = value.ToString(expr.Value)
+	case "@@SKIP_LINES", "@@HEAD", "@@MAX_ERRORS", "@@MAX_ITERATIONS", "@@APPROX_PRECISION", "@@MAX_RESULT_ROWS", "@@CPU":
+		p = value.ToInteger(expr.Value)
 	case "@@WAIT_TIMEOUT":
 		p = value.ToFloat(expr.Value)
-	case "@@NO_HEADER", "@@WITHOUT_NULL", "@@STATS":
+	case "@@ENCODING_FALLBACK", "@@NO_HEADER", "@@WITHOUT_NULL", "@@TRIM_TRAILING_DELIMITER", "@@PRESERVE_QUOTING", "@@PRESERVE_LINEBREAK", "@@SCHEMA_NULL_ON_ERROR", "@@STRICT_UNION_TYPES", "@@STRICT_SELECT_INTO", "@@QUIET", "@@STATS", "@@PROFILE":
 		p = value.ToBoolean(expr.Value)
 	default:
+		if InStrSliceWithCaseInsensitive(expr.Name, readOnlyFlagNames) {
+			return NewFlagNotSettableError(expr)
+		}
 		return NewInvalidFlagNameError(expr, expr.Name)
 	}
 	if value.IsNull(p) {
@@ -114,6 +236,8 @@ func SetFlag(expr parser.SetFlag) error {
 		err = cmd.SetDelimiter(p.(value.String).Raw())
 	case "@@ENCODING":
 		err = cmd.SetEncoding(p.(value.String).Raw())
+	case "@@ENCODING_FALLBACK":
+		cmd.SetEncodingFallback(p.(value.Boolean).Raw())
 	case "@@LINE_BREAK":
 		err = cmd.SetLineBreak(p.(value.String).Raw())
 	case "@@TIMEZONE":
@@ -128,8 +252,48 @@ func SetFlag(expr parser.SetFlag) error {
 		cmd.SetNoHeader(p.(value.Boolean).Raw())
 	case "@@WITHOUT_NULL":
 		cmd.SetWithoutNull(p.(value.Boolean).Raw())
+	case "@@TRIM_TRAILING_DELIMITER":
+		cmd.SetTrimTrailingDelimiter(p.(value.Boolean).Raw())
+	case "@@SKIP_LINES":
+		cmd.SetSkipLines(int(p.(value.Integer).Raw()))
+	case "@@HEAD":
+		cmd.SetHead(int(p.(value.Integer).Raw()))
+	case "@@COMMENT":
+		cmd.SetCommentPrefix(p.(value.String).Raw())
+	case "@@TSV_STYLE":
+		err = cmd.SetTsvStyle(p.(value.String).Raw())
+	case "@@PRESERVE_QUOTING":
+		cmd.SetPreserveQuoting(p.(value.Boolean).Raw())
+	case "@@PRESERVE_LINEBREAK":
+		cmd.SetPreserveLineBreak(p.(value.Boolean).Raw())
+	case "@@LOCALE":
+		err = cmd.SetLocale(p.(value.String).Raw())
+	case "@@BOOLEAN_LITERALS":
+		err = cmd.SetBooleanLiterals(p.(value.String).Raw())
+	case "@@INFER_TYPES":
+		err = cmd.SetInferTypes(p.(value.String).Raw())
+	case "@@SCHEMA_NULL_ON_ERROR":
+		cmd.SetSchemaNullOnError(p.(value.Boolean).Raw())
+	case "@@MAX_ERRORS":
+		cmd.SetMaxErrors(int(p.(value.Integer).Raw()))
+	case "@@MAX_ITERATIONS":
+		cmd.SetMaxIterations(int(p.(value.Integer).Raw()))
+	case "@@APPROX_PRECISION":
+		cmd.SetApproxPrecision(int(p.(value.Integer).Raw()))
+	case "@@MAX_RESULT_ROWS":
+		cmd.SetMaxResultRows(int(p.(value.Integer).Raw()))
+	case "@@STRICT_UNION_TYPES":
+		cmd.SetStrictUnionTypes(p.(value.Boolean).Raw())
+	case "@@STRICT_SELECT_INTO":
+		cmd.SetStrictSelectInto(p.(value.Boolean).Raw())
+	case "@@QUIET":
+		cmd.SetQuiet(p.(value.Boolean).Raw())
+	case "@@CPU":
+		cmd.SetCPU(int(p.(value.Integer).Raw()))
 	case "@@STATS":
 		cmd.SetStats(p.(value.Boolean).Raw())
+	case "@@PROFILE":
+		cmd.SetProfile(p.(value.Boolean).Raw())
 	}
 
 	if err != nil {
@@ -139,6 +303,59 @@ func SetFlag(expr parser.SetFlag) error {
 	return nil
 }
 
+var flagNames = []string{
+	"@@DELIMITER",
+	"@@ENCODING",
+	"@@ENCODING_FALLBACK",
+	"@@LINE_BREAK",
+	"@@TIMEZONE",
+	"@@REPOSITORY",
+	"@@DATETIME_FORMAT",
+	"@@WAIT_TIMEOUT",
+	"@@NO_HEADER",
+	"@@WITHOUT_NULL",
+	"@@TRIM_TRAILING_DELIMITER",
+	"@@SKIP_LINES",
+	"@@HEAD",
+	"@@COMMENT",
+	"@@TSV_STYLE",
+	"@@PRESERVE_QUOTING",
+	"@@PRESERVE_LINEBREAK",
+	"@@LOCALE",
+	"@@BOOLEAN_LITERALS",
+	"@@INFER_TYPES",
+	"@@SCHEMA_NULL_ON_ERROR",
+	"@@MAX_ERRORS",
+	"@@MAX_ITERATIONS",
+	"@@APPROX_PRECISION",
+	"@@MAX_RESULT_ROWS",
+	"@@STRICT_UNION_TYPES",
+	"@@STRICT_SELECT_INTO",
+	"@@QUIET",
+	"@@CPU",
+	"@@STATS",
+	"@@PROFILE",
+}
+
+// Flags that are specified only by the command options and cannot be changed by SET statements.
+var readOnlyFlagNames = []string{
+	"@@SOURCE",
+	"@@WRITE_ENCODING",
+	"@@OUT",
+	"@@FORMAT",
+	"@@WRITE_DELIMITER",
+	"@@WITHOUT_HEADER",
+	"@@NUMBER_NOTATION",
+	"@@EMPTY_RESULT",
+	"@@SEED",
+	"@@WARNINGS",
+	"@@READ_ONLY",
+	"@@BACKUP",
+	"@@BACKUP_SUFFIX",
+	"@@ERROR_FORMAT",
+	"@@SUMMARY",
+}
+
 func ShowFlag(expr parser.ShowFlag) (string, error) {
 	var s string
 
@@ -153,6 +370,8 @@ func ShowFlag(expr parser.ShowFlag) (string, error) {
 		}
 	case "@@ENCODING":
 		s = flags.Encoding.String()
+	case "@@ENCODING_FALLBACK":
+		s = strconv.FormatBool(flags.EncodingFallback)
 	case "@@LINE_BREAK":
 		s = flags.LineBreak.String()
 	case "@@TIMEZONE":
@@ -171,8 +390,98 @@ func ShowFlag(expr parser.ShowFlag) (string, error) {
 		s = strconv.FormatBool(flags.NoHeader)
 	case "@@WITHOUT_NULL":
 		s = strconv.FormatBool(flags.WithoutNull)
+	case "@@TRIM_TRAILING_DELIMITER":
+		s = strconv.FormatBool(flags.TrimTrailingDelimiter)
+	case "@@SKIP_LINES":
+		s = strconv.Itoa(flags.SkipLines)
+	case "@@HEAD":
+		s = strconv.Itoa(flags.Head)
+	case "@@COMMENT":
+		if len(flags.CommentPrefix) < 1 {
+			s = "(not set)"
+		} else {
+			s = flags.CommentPrefix
+		}
+	case "@@TSV_STYLE":
+		s = flags.TsvStyle.String()
+	case "@@PRESERVE_QUOTING":
+		s = strconv.FormatBool(flags.PreserveQuoting)
+	case "@@PRESERVE_LINEBREAK":
+		s = strconv.FormatBool(flags.PreserveLineBreak)
+	case "@@LOCALE":
+		s = flags.Locale.String()
+	case "@@BOOLEAN_LITERALS":
+		if len(flags.BooleanLiterals) < 1 {
+			s = "(not set)"
+		} else {
+			s = flags.BooleanLiterals
+		}
+	case "@@INFER_TYPES":
+		s = flags.InferTypes.String()
+	case "@@SCHEMA_NULL_ON_ERROR":
+		s = strconv.FormatBool(flags.SchemaNullOnError)
+	case "@@MAX_ERRORS":
+		s = strconv.Itoa(flags.MaxErrors)
+	case "@@MAX_ITERATIONS":
+		s = strconv.Itoa(flags.MaxIterations)
+	case "@@APPROX_PRECISION":
+		s = strconv.Itoa(flags.ApproxPrecision)
+	case "@@MAX_RESULT_ROWS":
+		s = strconv.Itoa(flags.MaxResultRows)
+	case "@@STRICT_UNION_TYPES":
+		s = strconv.FormatBool(flags.StrictUnionTypes)
+	case "@@STRICT_SELECT_INTO":
+		s = strconv.FormatBool(flags.StrictSelectInto)
+	case "@@QUIET":
+		s = strconv.FormatBool(flags.Quiet)
+	case "@@CPU":
+		s = strconv.Itoa(flags.CPU)
 	case "@@STATS":
 		s = strconv.FormatBool(flags.Stats)
+	case "@@PROFILE":
+		s = strconv.FormatBool(flags.Profile)
+	case "@@SOURCE":
+		if len(flags.Source) < 1 {
+			s = "(not set)"
+		} else {
+			s = flags.Source
+		}
+	case "@@WRITE_ENCODING":
+		s = flags.WriteEncoding.String()
+	case "@@OUT":
+		if len(flags.OutFile) < 1 {
+			s = "(not set)"
+		} else {
+			s = flags.OutFile
+		}
+	case "@@FORMAT":
+		s = flags.Format.String()
+	case "@@WRITE_DELIMITER":
+		s = "'" + cmd.EscapeString(string(flags.WriteDelimiter)) + "'"
+	case "@@WITHOUT_HEADER":
+		s = strconv.FormatBool(flags.WithoutHeader)
+	case "@@NUMBER_NOTATION":
+		s = flags.NumberNotation.String()
+	case "@@EMPTY_RESULT":
+		s = flags.EmptyResult.String()
+	case "@@SEED":
+		if flags.Seed == 0 {
+			s = "(not set)"
+		} else {
+			s = strconv.FormatInt(flags.Seed, 10)
+		}
+	case "@@WARNINGS":
+		s = strconv.FormatBool(flags.Warnings)
+	case "@@READ_ONLY":
+		s = strconv.FormatBool(flags.ReadOnly)
+	case "@@BACKUP":
+		s = strconv.FormatBool(flags.Backup)
+	case "@@BACKUP_SUFFIX":
+		s = flags.BackupSuffix
+	case "@@ERROR_FORMAT":
+		s = flags.ErrorFormat.String()
+	case "@@SUMMARY":
+		s = flags.Summary.String()
 	default:
 		return s, NewInvalidFlagNameError(expr, expr.Name)
 	}
@@ -185,56 +494,9 @@ func ShowObjects(expr parser.ShowObjects, filter *Filter) (string, error) {
 
 	switch expr.Type {
 	case parser.TABLES:
-		repository := cmd.GetFlags().Repository
-
-		files, err := ioutil.ReadDir(repository)
-		if err != nil {
-			return "", err
-		}
-
-		var filePaths []string
-		var absPaths []string
-		for _, f := range files {
-			if f.IsDir() {
-				continue
-			}
-
-			ext := filepath.Ext(f.Name())
-			switch strings.ToUpper(ext) {
-			case strings.ToUpper(cmd.CSV_EXT), strings.ToUpper(cmd.TSV_EXT):
-				absPath := filepath.Join(repository, f.Name())
-				if !filepath.IsAbs(absPath) {
-					p, err := filepath.Abs(absPath)
-					if err != nil {
-						return "", err
-					}
-					absPath = p
-				}
-				filePaths = append(filePaths, f.Name())
-				absPaths = append(absPaths, absPath)
-			}
-		}
-		sort.Strings(filePaths)
-
-		var cachedPaths []string
-		for _, v := range ViewCache {
-			cachedPath := v.FileInfo.Path
-			if !InStrSlice(cachedPath, absPaths) {
-				cachedPaths = append(cachedPaths, cachedPath)
-			}
-		}
-		sort.Strings(cachedPaths)
-
-		if len(filePaths) < 1 && len(cachedPaths) < 1 {
-			s = fmt.Sprintf("Repository %q is empty", repository)
-		} else {
-			if 0 < len(filePaths) {
-				s += formatHeader(fmt.Sprintf("Tables in %s", repository)) + strings.Join(filePaths, "\n") + "\n"
-			}
-			if 0 < len(cachedPaths) {
-				s += formatHeader("Tables in other directories") + strings.Join(cachedPaths, "\n") + "\n"
-			}
-		}
+		return showTables(expr, filter)
+	case parser.FLAGS:
+		return showFlags()
 	case parser.VIEWS:
 		views := filter.TempViews.List()
 		if len(views) < 1 {
@@ -266,6 +528,136 @@ func ShowObjects(expr parser.ShowObjects, filter *Filter) (string, error) {
 	return s, nil
 }
 
+func showTables(expr parser.ShowObjects, filter *Filter) (string, error) {
+	flags := cmd.GetFlags()
+	repository := flags.Repository
+
+	var pattern value.Primary
+	if expr.Pattern != nil {
+		p, err := filter.Evaluate(expr.Pattern)
+		if err != nil {
+			return "", err
+		}
+		pattern = p
+	}
+
+	files, err := ioutil.ReadDir(repository)
+	if err != nil {
+		return "", err
+	}
+
+	var absPaths []string
+	records := RecordSet{}
+	otherRecords := RecordSet{}
+	addRecord := func(list *RecordSet, name string, fpath string, delimiter rune) {
+		if pattern != nil && Like(value.NewString(name), pattern) != ternary.TRUE {
+			return
+		}
+
+		var size value.Primary = value.NewNull()
+		if info, err := os.Stat(fpath); err == nil {
+			size = value.NewInteger(info.Size())
+		}
+		*list = append(*list, NewRecord([]value.Primary{
+			value.NewString(name),
+			size,
+			value.NewString(cmd.EscapeString(string(delimiter))),
+		}))
+	}
+
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+
+		ext := filepath.Ext(f.Name())
+		switch strings.ToUpper(ext) {
+		case strings.ToUpper(cmd.CSV_EXT), strings.ToUpper(cmd.TSV_EXT):
+			fileInfo, err := NewFileInfo(parser.Identifier{Literal: f.Name()}, repository, flags.Delimiter)
+			if err != nil {
+				return "", err
+			}
+			absPaths = append(absPaths, fileInfo.Path)
+			addRecord(&records, f.Name(), fileInfo.Path, fileInfo.Delimiter)
+		}
+	}
+
+	var cachedPaths []string
+	cachedViews := make(map[string]*View)
+	for _, v := range ViewCache {
+		cachedPath := v.FileInfo.Path
+		if !InStrSlice(cachedPath, absPaths) {
+			cachedPaths = append(cachedPaths, cachedPath)
+			cachedViews[cachedPath] = v
+		}
+	}
+	sort.Strings(cachedPaths)
+	for _, cachedPath := range cachedPaths {
+		addRecord(&otherRecords, cachedPath, cachedPath, cachedViews[cachedPath].FileInfo.Delimiter)
+	}
+
+	if len(records) < 1 && len(otherRecords) < 1 {
+		if len(absPaths) < 1 && len(cachedPaths) < 1 {
+			return fmt.Sprintf("Repository %q is empty", repository), nil
+		}
+		return "No table is matched", nil
+	}
+
+	s := ""
+	if 0 < len(records) {
+		list, err := encodeTableList(records)
+		if err != nil {
+			return "", err
+		}
+		s += formatHeader(fmt.Sprintf("Tables in %s", repository)) + list
+	}
+	if 0 < len(otherRecords) {
+		list, err := encodeTableList(otherRecords)
+		if err != nil {
+			return "", err
+		}
+		if 0 < len(s) {
+			s += "\n"
+		}
+		s += formatHeader("Tables in other directories") + list
+	}
+	return s, nil
+}
+
+func encodeTableList(records RecordSet) (string, error) {
+	view := &View{
+		Header:    NewHeader("", []string{"File", "Size", "Delimiter"}),
+		RecordSet: records,
+	}
+	return EncodeView(view, cmd.TEXT, cmd.GetFlags().WriteDelimiter, false, cmd.UTF8, cmd.LF)
+}
+
+func showFlags() (string, error) {
+	records := make(RecordSet, 0, len(flagNames)+len(readOnlyFlagNames))
+	addRecords := func(names []string, settable bool) {
+		for _, name := range names {
+			s, _ := ShowFlag(parser.ShowFlag{Name: name})
+			records = append(records, NewRecord([]value.Primary{
+				value.NewString(name),
+				value.NewString(s),
+				value.NewBoolean(settable),
+			}))
+		}
+	}
+	addRecords(flagNames, true)
+	addRecords(readOnlyFlagNames, false)
+
+	view := &View{
+		Header:    NewHeader("", []string{"Flag", "Value", "Settable"}),
+		RecordSet: records,
+	}
+	s, err := EncodeView(view, cmd.TEXT, cmd.GetFlags().WriteDelimiter, false, cmd.UTF8, cmd.LF)
+	if err != nil {
+		return "", err
+	}
+	return formatHeader("Flags") + s, nil
+}
+
 func ShowFields(expr parser.ShowFields, filter *Filter) (string, error) {
 	var fields []string
 
@@ -280,7 +672,7 @@ func ShowFields(expr parser.ShowFields, filter *Filter) (string, error) {
 			return "", err
 		}
 
-		if ViewCache.Exists(fileInfo.Path) {
+		if ViewCache.HasColumns(fileInfo.Path, nil) {
 			pathIdent := parser.Identifier{Literal: fileInfo.Path}
 			header, _ := ViewCache.GetHeader(pathIdent)
 			fields = header.TableColumnNames()
@@ -290,7 +682,7 @@ func ShowFields(expr parser.ShowFields, filter *Filter) (string, error) {
 				return "", err
 			}
 
-			if ViewCache.Exists(fileInfo.Path) {
+			if ViewCache.HasColumns(fileInfo.Path, nil) {
 				pathIdent := parser.Identifier{Literal: fileInfo.Path}
 				header, _ := ViewCache.GetHeader(pathIdent)
 				fields = header.TableColumnNames()
@@ -298,22 +690,30 @@ func ShowFields(expr parser.ShowFields, filter *Filter) (string, error) {
 				fp, err := file.OpenToRead(fileInfo.Path)
 				if err != nil {
 					if _, ok := err.(*file.TimeoutError); ok {
-						NewFileLockTimeoutError(expr.Table, fileInfo.Path)
+						return "", NewFileLockTimeoutError(expr.Table, fileInfo.Path)
 					}
 					return "", NewReadFileError(expr.Table, err.Error())
 				}
 				defer file.Close(fp)
 
-				r := cmd.GetReader(fp, flags.Encoding)
-				reader := csv.NewReader(r)
-				reader.Delimiter = fileInfo.Delimiter
-				reader.WithoutNull = flags.WithoutNull
+				if isXlsxFile(fileInfo.Path) {
+					view, err := loadViewFromXlsx(fp, fileInfo)
+					if err != nil {
+						return "", NewXlsxParsingError(expr.Table, fileInfo.Path, err.Error())
+					}
+					fields = view.Header.TableColumnNames()
+				} else {
+					reader, _, err := newCsvReader(fp, fileInfo.Delimiter, flags.Encoding)
+					if err != nil {
+						return "", NewReadFileError(expr.Table, err.Error())
+					}
 
-				header, err := reader.ReadHeader()
-				if err != nil && err != csv.EOF {
-					return "", err
+					header, err := reader.ReadHeader()
+					if err != nil && err != csv.EOF {
+						return "", err
+					}
+					fields = header
 				}
-				fields = header
 			}
 		}
 	}
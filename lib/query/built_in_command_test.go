@@ -2,6 +2,7 @@ package query
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -224,11 +225,212 @@ func TestSource(t *testing.T) {
 	}
 }
 
+var useRepositoryTests = []struct {
+	Name   string
+	Expr   parser.UseRepository
+	Result string
+	Error  string
+}{
+	{
+		Name: "Use Repository",
+		Expr: parser.UseRepository{
+			Repository: parser.NewStringValue(TestDir),
+		},
+		Result: TestDir,
+	},
+	{
+		Name: "Use Repository Argument Evaluation Error",
+		Expr: parser.UseRepository{
+			Repository: parser.FieldReference{Column: parser.Identifier{Literal: "ident"}},
+		},
+		Error: "[L:- C:-] field ident does not exist",
+	},
+	{
+		Name: "Use Repository Argument Not String Error",
+		Expr: parser.UseRepository{
+			Repository: parser.NewNullValueFromString("NULL"),
+		},
+		Error: "[L:- C:-] USE REPOSITORY: argument NULL is not a string",
+	},
+	{
+		Name: "Use Repository Directory Not Exist Error",
+		Expr: parser.UseRepository{
+			Repository: parser.NewStringValue(GetTestFilePath("notexist")),
+		},
+		Error: fmt.Sprintf("[L:- C:-] USE REPOSITORY: directory %s does not exist", GetTestFilePath("notexist")),
+	},
+	{
+		Name: "Use Repository Not Directory Error",
+		Expr: parser.UseRepository{
+			Repository: parser.NewStringValue(GetTestFilePath("table1.csv")),
+		},
+		Error: fmt.Sprintf("[L:- C:-] USE REPOSITORY: directory %s does not exist", GetTestFilePath("table1.csv")),
+	},
+}
+
+func TestUseRepository(t *testing.T) {
+	flags := cmd.GetFlags()
+	oldRepository := flags.Repository
+	filter := NewEmptyFilter()
+
+	for _, v := range useRepositoryTests {
+		flags.Repository = "."
+
+		err := UseRepository(v.Expr, filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if flags.Repository != v.Result {
+			t.Errorf("%s: repository = %q, want %q", v.Name, flags.Repository, v.Result)
+		}
+	}
+	flags.Repository = oldRepository
+}
+
+var exportTests = []struct {
+	Name    string
+	Expr    parser.Export
+	Result  string
+	Path    string
+	Content string
+	Error   string
+}{
+	{
+		Name: "Export",
+		Expr: parser.Export{
+			Table:    parser.Identifier{Literal: "table1"},
+			FilePath: parser.NewStringValue(GetTestFilePath("export.json")),
+		},
+		Result:  fmt.Sprintf("3 records exported to %q.", GetTestFilePath("export.json")),
+		Content: "[{\"column1\":\"1\",\"column2\":\"str1\"},{\"column1\":\"2\",\"column2\":\"str2\"},{\"column1\":\"3\",\"column2\":\"str3\"}]",
+	},
+	{
+		Name: "Export With Options",
+		Expr: parser.Export{
+			Table:    parser.Identifier{Literal: "table1"},
+			FilePath: parser.NewStringValue(GetTestFilePath("export.txt")),
+			Options: []parser.ExportOption{
+				{Name: parser.Identifier{Literal: "format"}, Value: parser.Identifier{Literal: "csv"}},
+				{Name: parser.Identifier{Literal: "delimiter"}, Value: parser.NewStringValue(";")},
+				{Name: parser.Identifier{Literal: "line_break"}, Value: parser.NewStringValue("crlf")},
+				{Name: parser.Identifier{Literal: "header"}, Value: parser.NewTernaryValueFromString("false")},
+			},
+		},
+		Result:  fmt.Sprintf("3 records exported to %q.", GetTestFilePath("export.txt")),
+		Content: "\"1\";\"str1\"\r\n\"2\";\"str2\"\r\n\"3\";\"str3\"",
+	},
+	{
+		Name: "Export Relative Path",
+		Expr: parser.Export{
+			Table:    parser.Identifier{Literal: "table1"},
+			FilePath: parser.NewStringValue("export_relative.csv"),
+		},
+		Result:  fmt.Sprintf("3 records exported to %q.", GetTestFilePath("export_relative.csv")),
+		Path:    GetTestFilePath("export_relative.csv"),
+		Content: "\"column1\",\"column2\"\n\"1\",\"str1\"\n\"2\",\"str2\"\n\"3\",\"str3\"",
+	},
+	{
+		Name: "Export File Already Exists Error",
+		Expr: parser.Export{
+			Table:    parser.Identifier{Literal: "table1"},
+			FilePath: parser.NewStringValue(GetTestFilePath("table2.csv")),
+		},
+		Error: "[L:- C:-] failed to write to file: file exists",
+	},
+	{
+		Name: "Export Table Not Loaded Error",
+		Expr: parser.Export{
+			Table:    parser.Identifier{Literal: "notexist"},
+			FilePath: parser.NewStringValue(GetTestFilePath("export_notexist.csv")),
+		},
+		Error: "[L:- C:-] file notexist does not exist",
+	},
+	{
+		Name: "Export File Argument Not String Error",
+		Expr: parser.Export{
+			Table:    parser.Identifier{Literal: "table1"},
+			FilePath: parser.NewNullValueFromString("NULL"),
+		},
+		Error: "[L:- C:-] EXPORT: argument NULL is not a string",
+	},
+	{
+		Name: "Export Invalid Option Name Error",
+		Expr: parser.Export{
+			Table:    parser.Identifier{Literal: "table1"},
+			FilePath: parser.NewStringValue(GetTestFilePath("export_error.csv")),
+			Options: []parser.ExportOption{
+				{Name: parser.Identifier{Literal: "notexist"}, Value: parser.NewStringValue("1")},
+			},
+		},
+		Error: "[L:- C:-] EXPORT: option name notexist is invalid",
+	},
+	{
+		Name: "Export Invalid Option Value Error",
+		Expr: parser.Export{
+			Table:    parser.Identifier{Literal: "table1"},
+			FilePath: parser.NewStringValue(GetTestFilePath("export_error.csv")),
+			Options: []parser.ExportOption{
+				{Name: parser.Identifier{Literal: "delimiter"}, Value: parser.NewStringValue(";;")},
+			},
+		},
+		Error: "[L:- C:-] EXPORT: option value ';;' for delimiter is invalid",
+	},
+}
+
+func TestExport(t *testing.T) {
+	initFlag()
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.Quiet = true
+	filter := NewEmptyFilter()
+
+	for _, v := range exportTests {
+		ReleaseResources()
+		result, err := Export(v.Expr, filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, v.Result)
+		}
+
+		fpath := v.Path
+		if len(fpath) < 1 {
+			fpath = v.Expr.FilePath.(parser.PrimitiveType).Value.(value.String).Raw()
+		}
+		buf, _ := ioutil.ReadFile(fpath)
+		if string(buf) != v.Content {
+			t.Errorf("%s: content = %q, want %q", v.Name, string(buf), v.Content)
+		}
+	}
+	ReleaseResources()
+	tf.Quiet = false
+}
+
 var setFlagTests = []struct {
 	Name             string
 	Expr             parser.SetFlag
 	ResultFlag       string
 	ResultStrValue   string
+	ResultIntValue   int
 	ResultFloatValue float64
 	ResultBoolValue  bool
 	Error            string
@@ -251,6 +453,15 @@ var setFlagTests = []struct {
 		ResultFlag:     "encoding",
 		ResultStrValue: "SJIS",
 	},
+	{
+		Name: "Set EncodingFallback",
+		Expr: parser.SetFlag{
+			Name:  "@@encoding_fallback",
+			Value: value.NewBoolean(false),
+		},
+		ResultFlag:      "encoding_fallback",
+		ResultBoolValue: false,
+	},
 	{
 		Name: "Set LineBreak",
 		Expr: parser.SetFlag{
@@ -314,6 +525,184 @@ var setFlagTests = []struct {
 		ResultFlag:      "without_null",
 		ResultBoolValue: true,
 	},
+	{
+		Name: "Set TrimTrailingDelimiter",
+		Expr: parser.SetFlag{
+			Name:  "@@trim_trailing_delimiter",
+			Value: value.NewBoolean(true),
+		},
+		ResultFlag:      "trim_trailing_delimiter",
+		ResultBoolValue: true,
+	},
+	{
+		Name: "Set SkipLines",
+		Expr: parser.SetFlag{
+			Name:  "@@skip_lines",
+			Value: value.NewInteger(2),
+		},
+		ResultFlag:     "skip_lines",
+		ResultIntValue: 2,
+	},
+	{
+		Name: "Set Head",
+		Expr: parser.SetFlag{
+			Name:  "@@head",
+			Value: value.NewInteger(100),
+		},
+		ResultFlag:     "head",
+		ResultIntValue: 100,
+	},
+	{
+		Name: "Set CommentPrefix",
+		Expr: parser.SetFlag{
+			Name:  "@@comment",
+			Value: value.NewString("#"),
+		},
+		ResultFlag:     "comment",
+		ResultStrValue: "#",
+	},
+	{
+		Name: "Set TsvStyle",
+		Expr: parser.SetFlag{
+			Name:  "@@tsv_style",
+			Value: value.NewString("escape"),
+		},
+		ResultFlag:     "tsv_style",
+		ResultStrValue: "ESCAPE",
+	},
+	{
+		Name: "Set TsvStyle Value Error",
+		Expr: parser.SetFlag{
+			Name:  "@@tsv_style",
+			Value: value.NewString("error"),
+		},
+		Error: "[L:- C:-] SET: flag value 'error' for @@tsv_style is invalid",
+	},
+	{
+		Name: "Set PreserveQuoting",
+		Expr: parser.SetFlag{
+			Name:  "@@preserve_quoting",
+			Value: value.NewBoolean(true),
+		},
+		ResultFlag:      "preserve_quoting",
+		ResultBoolValue: true,
+	},
+	{
+		Name: "Set Locale",
+		Expr: parser.SetFlag{
+			Name:  "@@locale",
+			Value: value.NewString("JA"),
+		},
+		ResultFlag:     "locale",
+		ResultStrValue: "ja",
+	},
+	{
+		Name: "Set BooleanLiterals",
+		Expr: parser.SetFlag{
+			Name:  "@@boolean_literals",
+			Value: value.NewString("yes:no"),
+		},
+		ResultFlag:     "boolean_literals",
+		ResultStrValue: "yes:no",
+	},
+	{
+		Name: "Set BooleanLiterals Value Error",
+		Expr: parser.SetFlag{
+			Name:  "@@boolean_literals",
+			Value: value.NewString("yes"),
+		},
+		Error: "[L:- C:-] SET: flag value 'yes' for @@boolean_literals is invalid",
+	},
+	{
+		Name: "Set InferTypes",
+		Expr: parser.SetFlag{
+			Name:  "@@infer_types",
+			Value: value.NewString("per-column"),
+		},
+		ResultFlag:     "infer_types",
+		ResultStrValue: "PER-COLUMN",
+	},
+	{
+		Name: "Set SchemaNullOnError",
+		Expr: parser.SetFlag{
+			Name:  "@@schema_null_on_error",
+			Value: value.NewBoolean(true),
+		},
+		ResultFlag:      "schema_null_on_error",
+		ResultBoolValue: true,
+	},
+	{
+		Name: "Set MaxErrors",
+		Expr: parser.SetFlag{
+			Name:  "@@max_errors",
+			Value: value.NewInteger(5),
+		},
+		ResultFlag:     "max_errors",
+		ResultIntValue: 5,
+	},
+	{
+		Name: "Set MaxIterations",
+		Expr: parser.SetFlag{
+			Name:  "@@max_iterations",
+			Value: value.NewInteger(1000),
+		},
+		ResultFlag:     "max_iterations",
+		ResultIntValue: 1000,
+	},
+	{
+		Name: "Set ApproxPrecision",
+		Expr: parser.SetFlag{
+			Name:  "@@approx_precision",
+			Value: value.NewInteger(10),
+		},
+		ResultFlag:     "approx_precision",
+		ResultIntValue: 10,
+	},
+	{
+		Name: "Set MaxResultRows",
+		Expr: parser.SetFlag{
+			Name:  "@@max_result_rows",
+			Value: value.NewInteger(1000),
+		},
+		ResultFlag:     "max_result_rows",
+		ResultIntValue: 1000,
+	},
+	{
+		Name: "Set StrictUnionTypes",
+		Expr: parser.SetFlag{
+			Name:  "@@strict_union_types",
+			Value: value.NewBoolean(true),
+		},
+		ResultFlag:      "strict_union_types",
+		ResultBoolValue: true,
+	},
+	{
+		Name: "Set StrictSelectInto",
+		Expr: parser.SetFlag{
+			Name:  "@@strict_select_into",
+			Value: value.NewBoolean(true),
+		},
+		ResultFlag:      "strict_select_into",
+		ResultBoolValue: true,
+	},
+	{
+		Name: "Set Quiet",
+		Expr: parser.SetFlag{
+			Name:  "@@quiet",
+			Value: value.NewBoolean(true),
+		},
+		ResultFlag:      "quiet",
+		ResultBoolValue: true,
+	},
+	{
+		Name: "Set CPU",
+		Expr: parser.SetFlag{
+			Name:  "@@cpu",
+			Value: value.NewInteger(1),
+		},
+		ResultFlag:     "cpu",
+		ResultIntValue: 1,
+	},
 	{
 		Name: "Set Stats",
 		Expr: parser.SetFlag{
@@ -323,6 +712,15 @@ var setFlagTests = []struct {
 		ResultFlag:      "stats",
 		ResultBoolValue: true,
 	},
+	{
+		Name: "Set Profile",
+		Expr: parser.SetFlag{
+			Name:  "@@profile",
+			Value: value.NewBoolean(true),
+		},
+		ResultFlag:      "profile",
+		ResultBoolValue: true,
+	},
 	{
 		Name: "Set Delimiter Value Error",
 		Expr: parser.SetFlag{
@@ -347,6 +745,14 @@ var setFlagTests = []struct {
 		},
 		Error: "[L:- C:-] SET: flag value 'string' for @@without_null is invalid",
 	},
+	{
+		Name: "Set Read-only Flag Error",
+		Expr: parser.SetFlag{
+			Name:  "@@read_only",
+			Value: value.NewBoolean(false),
+		},
+		Error: "[L:- C:-] SET: flag @@read_only cannot be set, it is specified only by the command option",
+	},
 	{
 		Name: "Invalid Flag Name Error",
 		Expr: parser.SetFlag{
@@ -393,6 +799,14 @@ func TestSetFlag(t *testing.T) {
 			if flags.Encoding.String() != v.ResultStrValue {
 				t.Errorf("%s: encoding = %q, want %q", v.Name, flags.Encoding.String(), v.ResultStrValue)
 			}
+		case "TRIM_TRAILING_DELIMITER":
+			if flags.TrimTrailingDelimiter != v.ResultBoolValue {
+				t.Errorf("%s: trim-trailing-delimiter = %t, want %t", v.Name, flags.TrimTrailingDelimiter, v.ResultBoolValue)
+			}
+		case "ENCODING_FALLBACK":
+			if flags.EncodingFallback != v.ResultBoolValue {
+				t.Errorf("%s: encoding-fallback = %t, want %t", v.Name, flags.EncodingFallback, v.ResultBoolValue)
+			}
 		case "LINE_BREAK":
 			if flags.LineBreak.Value() != v.ResultStrValue {
 				t.Errorf("%s: line-break = %q, want %q", v.Name, flags.LineBreak.Value(), v.ResultStrValue)
@@ -421,10 +835,82 @@ func TestSetFlag(t *testing.T) {
 			if flags.WithoutNull != v.ResultBoolValue {
 				t.Errorf("%s: without-null = %t, want %t", v.Name, flags.WithoutNull, v.ResultBoolValue)
 			}
+		case "SKIP_LINES":
+			if flags.SkipLines != v.ResultIntValue {
+				t.Errorf("%s: skip-lines = %d, want %d", v.Name, flags.SkipLines, v.ResultIntValue)
+			}
+		case "HEAD":
+			if flags.Head != v.ResultIntValue {
+				t.Errorf("%s: head = %d, want %d", v.Name, flags.Head, v.ResultIntValue)
+			}
+		case "COMMENT":
+			if flags.CommentPrefix != v.ResultStrValue {
+				t.Errorf("%s: comment = %q, want %q", v.Name, flags.CommentPrefix, v.ResultStrValue)
+			}
+		case "TSV_STYLE":
+			if flags.TsvStyle.String() != v.ResultStrValue {
+				t.Errorf("%s: tsv-style = %q, want %q", v.Name, flags.TsvStyle.String(), v.ResultStrValue)
+			}
+		case "PRESERVE_QUOTING":
+			if flags.PreserveQuoting != v.ResultBoolValue {
+				t.Errorf("%s: preserve-quoting = %t, want %t", v.Name, flags.PreserveQuoting, v.ResultBoolValue)
+			}
+		case "LOCALE":
+			if flags.Locale.String() != v.ResultStrValue {
+				t.Errorf("%s: locale = %q, want %q", v.Name, flags.Locale.String(), v.ResultStrValue)
+			}
+		case "BOOLEAN_LITERALS":
+			if flags.BooleanLiterals != v.ResultStrValue {
+				t.Errorf("%s: boolean-literals = %q, want %q", v.Name, flags.BooleanLiterals, v.ResultStrValue)
+			}
+		case "INFER_TYPES":
+			if flags.InferTypes.String() != v.ResultStrValue {
+				t.Errorf("%s: infer-types = %q, want %q", v.Name, flags.InferTypes.String(), v.ResultStrValue)
+			}
+		case "SCHEMA_NULL_ON_ERROR":
+			if flags.SchemaNullOnError != v.ResultBoolValue {
+				t.Errorf("%s: schema-null-on-error = %t, want %t", v.Name, flags.SchemaNullOnError, v.ResultBoolValue)
+			}
+		case "MAX_ERRORS":
+			if flags.MaxErrors != v.ResultIntValue {
+				t.Errorf("%s: max-errors = %d, want %d", v.Name, flags.MaxErrors, v.ResultIntValue)
+			}
+		case "MAX_ITERATIONS":
+			if flags.MaxIterations != v.ResultIntValue {
+				t.Errorf("%s: max-iterations = %d, want %d", v.Name, flags.MaxIterations, v.ResultIntValue)
+			}
+		case "APPROX_PRECISION":
+			if flags.ApproxPrecision != v.ResultIntValue {
+				t.Errorf("%s: approx-precision = %d, want %d", v.Name, flags.ApproxPrecision, v.ResultIntValue)
+			}
+		case "MAX_RESULT_ROWS":
+			if flags.MaxResultRows != v.ResultIntValue {
+				t.Errorf("%s: max-result-rows = %d, want %d", v.Name, flags.MaxResultRows, v.ResultIntValue)
+			}
+		case "STRICT_UNION_TYPES":
+			if flags.StrictUnionTypes != v.ResultBoolValue {
+				t.Errorf("%s: strict-union-types = %t, want %t", v.Name, flags.StrictUnionTypes, v.ResultBoolValue)
+			}
+		case "STRICT_SELECT_INTO":
+			if flags.StrictSelectInto != v.ResultBoolValue {
+				t.Errorf("%s: strict-select-into = %t, want %t", v.Name, flags.StrictSelectInto, v.ResultBoolValue)
+			}
+		case "QUIET":
+			if flags.Quiet != v.ResultBoolValue {
+				t.Errorf("%s: quiet = %t, want %t", v.Name, flags.Quiet, v.ResultBoolValue)
+			}
+		case "CPU":
+			if flags.CPU != v.ResultIntValue {
+				t.Errorf("%s: cpu = %d, want %d", v.Name, flags.CPU, v.ResultIntValue)
+			}
 		case "STATS":
 			if flags.Stats != v.ResultBoolValue {
 				t.Errorf("%s: stats = %t, want %t", v.Name, flags.Stats, v.ResultBoolValue)
 			}
+		case "PROFILE":
+			if flags.Profile != v.ResultBoolValue {
+				t.Errorf("%s: profile = %t, want %t", v.Name, flags.Profile, v.ResultBoolValue)
+			}
 		}
 	}
 }
@@ -527,6 +1013,17 @@ var showFlagTests = []struct {
 		},
 		Result: "15",
 	},
+	{
+		Name: "Show EncodingFallback",
+		Expr: parser.ShowFlag{
+			Name: "@@encoding_fallback",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@encoding_fallback",
+			Value: value.NewBoolean(false),
+		},
+		Result: "false",
+	},
 	{
 		Name: "Show NoHeader",
 		Expr: parser.ShowFlag{
@@ -549,6 +1046,218 @@ var showFlagTests = []struct {
 		},
 		Result: "true",
 	},
+	{
+		Name: "Show TrimTrailingDelimiter",
+		Expr: parser.ShowFlag{
+			Name: "@@trim_trailing_delimiter",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@trim_trailing_delimiter",
+			Value: value.NewBoolean(true),
+		},
+		Result: "true",
+	},
+	{
+		Name: "Show SkipLines",
+		Expr: parser.ShowFlag{
+			Name: "@@skip_lines",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@skip_lines",
+			Value: value.NewInteger(2),
+		},
+		Result: "2",
+	},
+	{
+		Name: "Show Head",
+		Expr: parser.ShowFlag{
+			Name: "@@head",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@head",
+			Value: value.NewInteger(100),
+		},
+		Result: "100",
+	},
+	{
+		Name: "Show CommentPrefix Not Set",
+		Expr: parser.ShowFlag{
+			Name: "@@comment",
+		},
+		Result: "(not set)",
+	},
+	{
+		Name: "Show CommentPrefix",
+		Expr: parser.ShowFlag{
+			Name: "@@comment",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@comment",
+			Value: value.NewString("#"),
+		},
+		Result: "#",
+	},
+	{
+		Name: "Show BooleanLiterals Not Set",
+		Expr: parser.ShowFlag{
+			Name: "@@boolean_literals",
+		},
+		Result: "(not set)",
+	},
+	{
+		Name: "Show BooleanLiterals",
+		Expr: parser.ShowFlag{
+			Name: "@@boolean_literals",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@boolean_literals",
+			Value: value.NewString("yes:no"),
+		},
+		Result: "yes:no",
+	},
+	{
+		Name: "Show InferTypes",
+		Expr: parser.ShowFlag{
+			Name: "@@infer_types",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@infer_types",
+			Value: value.NewString("per-cell"),
+		},
+		Result: "PER-CELL",
+	},
+	{
+		Name: "Show SchemaNullOnError",
+		Expr: parser.ShowFlag{
+			Name: "@@schema_null_on_error",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@schema_null_on_error",
+			Value: value.NewBoolean(true),
+		},
+		Result: "true",
+	},
+	{
+		Name: "Show MaxErrors",
+		Expr: parser.ShowFlag{
+			Name: "@@max_errors",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@max_errors",
+			Value: value.NewInteger(5),
+		},
+		Result: "5",
+	},
+	{
+		Name: "Show MaxIterations",
+		Expr: parser.ShowFlag{
+			Name: "@@max_iterations",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@max_iterations",
+			Value: value.NewInteger(1000),
+		},
+		Result: "1000",
+	},
+	{
+		Name: "Show ApproxPrecision",
+		Expr: parser.ShowFlag{
+			Name: "@@approx_precision",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@approx_precision",
+			Value: value.NewInteger(10),
+		},
+		Result: "10",
+	},
+	{
+		Name: "Show MaxResultRows",
+		Expr: parser.ShowFlag{
+			Name: "@@max_result_rows",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@max_result_rows",
+			Value: value.NewInteger(1000),
+		},
+		Result: "1000",
+	},
+	{
+		Name: "Show StrictUnionTypes",
+		Expr: parser.ShowFlag{
+			Name: "@@strict_union_types",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@strict_union_types",
+			Value: value.NewBoolean(true),
+		},
+		Result: "true",
+	},
+	{
+		Name: "Show StrictSelectInto",
+		Expr: parser.ShowFlag{
+			Name: "@@strict_select_into",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@strict_select_into",
+			Value: value.NewBoolean(true),
+		},
+		Result: "true",
+	},
+	{
+		Name: "Show TsvStyle",
+		Expr: parser.ShowFlag{
+			Name: "@@tsv_style",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@tsv_style",
+			Value: value.NewString("quote"),
+		},
+		Result: "QUOTE",
+	},
+	{
+		Name: "Show PreserveQuoting",
+		Expr: parser.ShowFlag{
+			Name: "@@preserve_quoting",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@preserve_quoting",
+			Value: value.NewBoolean(true),
+		},
+		Result: "true",
+	},
+	{
+		Name: "Show Locale",
+		Expr: parser.ShowFlag{
+			Name: "@@locale",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@locale",
+			Value: value.NewString("de"),
+		},
+		Result: "de",
+	},
+	{
+		Name: "Show Quiet",
+		Expr: parser.ShowFlag{
+			Name: "@@quiet",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@quiet",
+			Value: value.NewBoolean(true),
+		},
+		Result: "true",
+	},
+	{
+		Name: "Show CPU",
+		Expr: parser.ShowFlag{
+			Name: "@@cpu",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@cpu",
+			Value: value.NewInteger(1),
+		},
+		Result: "1",
+	},
 	{
 		Name: "Show Stats",
 		Expr: parser.ShowFlag{
@@ -560,6 +1269,31 @@ var showFlagTests = []struct {
 		},
 		Result: "true",
 	},
+	{
+		Name: "Show Profile",
+		Expr: parser.ShowFlag{
+			Name: "@@profile",
+		},
+		SetExpr: parser.SetFlag{
+			Name:  "@@profile",
+			Value: value.NewBoolean(true),
+		},
+		Result: "true",
+	},
+	{
+		Name: "Show ReadOnly",
+		Expr: parser.ShowFlag{
+			Name: "@@read_only",
+		},
+		Result: "false",
+	},
+	{
+		Name: "Show BackupSuffix",
+		Expr: parser.ShowFlag{
+			Name: "@@backup_suffix",
+		},
+		Result: ".bak",
+	},
 	{
 		Name: "Invalid Flag Name Error",
 		Expr: parser.ShowFlag{
@@ -610,20 +1344,63 @@ var showObjectsTests = []struct {
 		ViewCache: ViewMap{
 			"DUMMY.CSV": &View{
 				FileInfo: &FileInfo{
-					Path: filepath.Join(TestDir, "dummy.csv"),
+					Path:      filepath.Join(TestDir, "dummy.csv"),
+					Delimiter: ',',
 				},
 			},
 			"TABLE1.CSV": &View{
 				FileInfo: &FileInfo{
-					Path: filepath.Join(filepath.Join(TestDir, "test_show_objects"), "table1.csv"),
+					Path:      filepath.Join(filepath.Join(TestDir, "test_show_objects"), "table1.csv"),
+					Delimiter: ',',
 				},
 			},
 		},
 		Result: "\n" + fmt.Sprintf("    Tables in %s\n", filepath.Join(TestDir, "test_show_objects")) + strings.Repeat("-", len(filepath.Join(TestDir, "test_show_objects"))+18) + "\n" +
-			filepath.Join("table1.csv") + "\n" +
-			filepath.Join("table2.csv") + "\n" +
+			"+------------+------+-----------+\n" +
+			"| File       | Size | Delimiter |\n" +
+			"+------------+------+-----------+\n" +
+			"| table1.csv |   37 | ,         |\n" +
+			"| table2.csv |   39 | ,         |\n" +
+			"+------------+------+-----------+\n" +
 			"\n" + "    Tables in other directories\n" + "-----------------------------------\n" +
-			filepath.Join(TestDir, "dummy.csv") + "\n",
+			"+" + strings.Repeat("-", len(filepath.Join(TestDir, "dummy.csv"))+2) + "+------+-----------+\n" +
+			"| File" + strings.Repeat(" ", len(filepath.Join(TestDir, "dummy.csv"))-3) + "| Size | Delimiter |\n" +
+			"+" + strings.Repeat("-", len(filepath.Join(TestDir, "dummy.csv"))+2) + "+------+-----------+\n" +
+			"| " + filepath.Join(TestDir, "dummy.csv") + " | NULL | ,         |\n" +
+			"+" + strings.Repeat("-", len(filepath.Join(TestDir, "dummy.csv"))+2) + "+------+-----------+",
+	},
+	{
+		Name: "ShowObjects Tables with Pattern",
+		Expr: parser.ShowObjects{Type: parser.TABLES, Pattern: parser.NewStringValue("%1.csv")},
+		Result: "\n" + fmt.Sprintf("    Tables in %s\n", filepath.Join(TestDir, "test_show_objects")) + strings.Repeat("-", len(filepath.Join(TestDir, "test_show_objects"))+18) + "\n" +
+			"+------------+------+-----------+\n" +
+			"| File       | Size | Delimiter |\n" +
+			"+------------+------+-----------+\n" +
+			"| table1.csv |   37 | ,         |\n" +
+			"+------------+------+-----------+",
+	},
+	{
+		Name: "ShowObjects Tables in Other Directories with Pattern",
+		Expr: parser.ShowObjects{Type: parser.TABLES, Pattern: parser.NewStringValue("%dummy.csv")},
+		ViewCache: ViewMap{
+			"DUMMY.CSV": &View{
+				FileInfo: &FileInfo{
+					Path:      filepath.Join(TestDir, "dummy.csv"),
+					Delimiter: ',',
+				},
+			},
+		},
+		Result: "\n" + "    Tables in other directories\n" + "-----------------------------------\n" +
+			"+" + strings.Repeat("-", len(filepath.Join(TestDir, "dummy.csv"))+2) + "+------+-----------+\n" +
+			"| File" + strings.Repeat(" ", len(filepath.Join(TestDir, "dummy.csv"))-3) + "| Size | Delimiter |\n" +
+			"+" + strings.Repeat("-", len(filepath.Join(TestDir, "dummy.csv"))+2) + "+------+-----------+\n" +
+			"| " + filepath.Join(TestDir, "dummy.csv") + " | NULL | ,         |\n" +
+			"+" + strings.Repeat("-", len(filepath.Join(TestDir, "dummy.csv"))+2) + "+------+-----------+",
+	},
+	{
+		Name:   "ShowObjects Tables No Table Matched",
+		Expr:   parser.ShowObjects{Type: parser.TABLES, Pattern: parser.NewStringValue("notexist%")},
+		Result: "No table is matched",
 	},
 	{
 		Name:       "ShowObjects Tables Empty",
@@ -751,6 +1528,7 @@ func TestShowObjects(t *testing.T) {
 	copyfile(filepath.Join(tableDir, "table2.csv"), filepath.Join(TestDataDir, "table2.csv"))
 
 	flags := cmd.GetFlags()
+	flags.Delimiter = cmd.UNDEF
 
 	for _, v := range showObjectsTests {
 		if 0 < len(v.Repository) {
@@ -790,6 +1568,22 @@ func TestShowObjects(t *testing.T) {
 	ReleaseResources()
 }
 
+func TestShowFlags(t *testing.T) {
+	result, err := ShowObjects(parser.ShowObjects{Type: parser.FLAGS}, NewEmptyFilter())
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	for _, name := range append(flagNames, readOnlyFlagNames...) {
+		if _, err := ShowFlag(parser.ShowFlag{Name: name}); err != nil {
+			t.Errorf("%s: unexpected error %q", name, err)
+		}
+		if !strings.Contains(result, name) {
+			t.Errorf("%s: flag is not shown in %s", name, result)
+		}
+	}
+}
+
 var showFieldsTests = []struct {
 	Name      string
 	Expr      parser.ShowFields
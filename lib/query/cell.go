@@ -14,7 +14,11 @@ func NewGroupCell(values []value.Primary) Cell {
 	return values
 }
 
+// Value returns a null for an empty group.
 func (cell Cell) Value() value.Primary {
+	if len(cell) < 1 {
+		return value.NewNull()
+	}
 	return cell[0]
 }
 
@@ -1,8 +1,11 @@
 package query
 
 import (
+	"bytes"
+	"regexp"
 	"strings"
-	"unicode/utf8"
+	"sync"
+	"unicode"
 
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
@@ -19,111 +22,236 @@ func Is(p1 value.Primary, p2 value.Primary) ternary.Value {
 }
 
 func Like(p1 value.Primary, p2 value.Primary) ternary.Value {
-	if value.IsNull(p1) || value.IsNull(p2) {
-		return ternary.UNKNOWN
-	}
+	return LikeWithEscape(p1, p2, '\\')
+}
 
-	s1 := value.ToString(p1)
-	if value.IsNull(s1) {
-		return ternary.UNKNOWN
-	}
-	s2 := value.ToString(p2)
-	if value.IsNull(s2) {
+func LikeWithEscape(p1 value.Primary, p2 value.Primary, escape rune) ternary.Value {
+	s1, s2, ok := stringOperands(p1, p2)
+	if !ok {
 		return ternary.UNKNOWN
 	}
 
-	s := strings.ToUpper(p1.(value.String).Raw())
-	pattern := strings.ToUpper(p2.(value.String).Raw())
+	s := []rune(strings.ToUpper(s1))
+	pattern := stringPattern([]rune(s2), escape)
+
+	sPos := 0
+	pPos := 0
+	anyPos := -1
+	anyMatched := 0
+
+	for sPos < len(s) {
+		if pPos < len(pattern) && pattern[pPos].Kind == PATTERN_ANY_RUNES {
+			anyPos = pPos
+			anyMatched = sPos
+			pPos++
+			continue
+		}
+
+		if pPos < len(pattern) && (pattern[pPos].Kind == PATTERN_ANY_RUNE || pattern[pPos].Rune == s[sPos]) {
+			sPos++
+			pPos++
+			continue
+		}
+
+		if -1 < anyPos {
+			anyMatched++
+			sPos = anyMatched
+			pPos = anyPos + 1
+			continue
+		}
+
+		return ternary.FALSE
+	}
 
-	if s == pattern {
-		return ternary.TRUE
+	for pPos < len(pattern) && pattern[pPos].Kind == PATTERN_ANY_RUNES {
+		pPos++
 	}
-	if len(pattern) < 1 {
+	if pPos < len(pattern) {
 		return ternary.FALSE
 	}
+	return ternary.TRUE
+}
+
+const (
+	PATTERN_RUNE = iota
+	PATTERN_ANY_RUNE
+	PATTERN_ANY_RUNES
+)
 
-	patternRunes := []rune(pattern)
-	patternPos := 0
+type patternElement struct {
+	Kind int
+	Rune rune
+}
 
-	for {
-		anyRunesMinLen, anyRunexMaxLen, search, pos := stringPattern(patternRunes, patternPos)
-		patternPos = pos
+func stringPattern(pattern []rune, escape rune) []patternElement {
+	elements := make([]patternElement, 0, len(pattern))
 
-		anyString := s
-		if 0 < len(search) {
-			idx := strings.Index(s, search)
-			if idx < 0 {
-				return ternary.FALSE
+	escaped := false
+	for _, r := range pattern {
+		if escaped {
+			switch r {
+			case '%', '_', escape:
+			default:
+				elements = append(elements, patternElement{Kind: PATTERN_RUNE, Rune: escape})
 			}
-			anyString = s[:idx]
+			elements = append(elements, patternElement{Kind: PATTERN_RUNE, Rune: r})
+			escaped = false
+			continue
 		}
 
-		if utf8.RuneCountInString(anyString) < anyRunesMinLen {
-			return ternary.FALSE
-		}
-		if -1 < anyRunexMaxLen && anyRunexMaxLen < utf8.RuneCountInString(anyString) {
-			return ternary.FALSE
+		switch r {
+		case escape:
+			escaped = true
+		case '%':
+			if 0 < len(elements) && elements[len(elements)-1].Kind == PATTERN_ANY_RUNES {
+				continue
+			}
+			elements = append(elements, patternElement{Kind: PATTERN_ANY_RUNES})
+		case '_':
+			elements = append(elements, patternElement{Kind: PATTERN_ANY_RUNE})
+		default:
+			elements = append(elements, patternElement{Kind: PATTERN_RUNE, Rune: r})
 		}
+	}
+	if escaped {
+		elements = append(elements, patternElement{Kind: PATTERN_RUNE, Rune: escape})
+	}
 
-		if len(patternRunes) <= patternPos {
-			if len(anyString+search) < len(s) {
-				return ternary.FALSE
-			}
-			break
+	// Letters are folded after escapes are resolved so that an escape character is compared as it is.
+	for i := range elements {
+		if elements[i].Kind == PATTERN_RUNE {
+			elements[i].Rune = unicode.ToUpper(elements[i].Rune)
 		}
+	}
+	return elements
+}
+
+const REGEXP_CACHE_SIZE = 128
+
+type regExpCache struct {
+	exprs map[string]*regexp.Regexp
+	mtx   *sync.Mutex
+}
+
+var compiledRegExps = &regExpCache{
+	exprs: make(map[string]*regexp.Regexp),
+	mtx:   &sync.Mutex{},
+}
 
-		s = s[len(anyString+search):]
+func (c *regExpCache) Compile(expr string) (*regexp.Regexp, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if r, ok := c.exprs[expr]; ok {
+		return r, nil
 	}
 
-	return ternary.TRUE
+	r, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	if REGEXP_CACHE_SIZE <= len(c.exprs) {
+		c.exprs = make(map[string]*regexp.Regexp)
+	}
+	c.exprs[expr] = r
+	return r, nil
 }
 
-func stringPattern(pattern []rune, position int) (int, int, string, int) {
-	anyRunesMinLen := 0
-	anyRunesMaxLen := 0
-	search := []rune{}
-	returnPostion := position
+func RegExpMatch(p1 value.Primary, p2 value.Primary, caseInsensitive bool) (ternary.Value, error) {
+	s1, s2, ok := stringOperands(p1, p2)
+	if !ok {
+		return ternary.UNKNOWN, nil
+	}
 
-	escaped := false
-	for i := position; i < len(pattern); i++ {
-		r := pattern[i]
+	expr := s2
+	if caseInsensitive {
+		expr = "(?i)" + expr
+	}
+	return matchRegExp(s1, expr)
+}
+
+func SimilarTo(p1 value.Primary, p2 value.Primary, escape rune) (ternary.Value, error) {
+	s1, s2, ok := stringOperands(p1, p2)
+	if !ok {
+		return ternary.UNKNOWN, nil
+	}
+
+	return matchRegExp(s1, similarToRegExp([]rune(s2), escape))
+}
+
+func stringOperands(p1 value.Primary, p2 value.Primary) (string, string, bool) {
+	if value.IsNull(p1) || value.IsNull(p2) {
+		return "", "", false
+	}
+
+	s1 := value.ToString(p1)
+	if value.IsNull(s1) {
+		return "", "", false
+	}
+	s2 := value.ToString(p2)
+	if value.IsNull(s2) {
+		return "", "", false
+	}
+	return s1.(value.String).Raw(), s2.(value.String).Raw(), true
+}
+
+func matchRegExp(s string, expr string) (ternary.Value, error) {
+	r, err := compiledRegExps.Compile(expr)
+	if err != nil {
+		return ternary.UNKNOWN, err
+	}
+	return ternary.ConvertFromBool(r.MatchString(s)), nil
+}
+
+func similarToRegExp(pattern []rune, escape rune) string {
+	var buf bytes.Buffer
+	buf.WriteString("(?is)^(?:")
 
+	escaped := false
+	inBracket := false
+	for _, r := range pattern {
 		if escaped {
-			switch r {
-			case '%', '_':
-				search = append(search, r)
-			default:
-				search = append(search, '\\', r)
-			}
-			returnPostion++
+			buf.WriteString(regexp.QuoteMeta(string(r)))
 			escaped = false
 			continue
 		}
+		if r == escape {
+			escaped = true
+			continue
+		}
 
-		if (r == '%' || r == '_') && 0 < len(search) {
-			break
+		if inBracket {
+			switch r {
+			case ']':
+				inBracket = false
+			case '\\':
+				buf.WriteRune('\\')
+			}
+			buf.WriteRune(r)
+			continue
 		}
-		returnPostion++
 
 		switch r {
 		case '%':
-			anyRunesMaxLen = -1
+			buf.WriteString(".*")
 		case '_':
-			anyRunesMinLen++
-			if -1 < anyRunesMaxLen {
-				anyRunesMaxLen++
-			}
-		case '\\':
-			escaped = true
+			buf.WriteRune('.')
+		case '[':
+			inBracket = true
+			buf.WriteRune(r)
+		case '|', '*', '+', '?', '{', '}', '(', ')':
+			buf.WriteRune(r)
 		default:
-			search = append(search, r)
+			buf.WriteString(regexp.QuoteMeta(string(r)))
 		}
 	}
 	if escaped {
-		search = append(search, '\\')
+		buf.WriteString(regexp.QuoteMeta(string(escape)))
 	}
 
-	return anyRunesMinLen, anyRunesMaxLen, string(search), returnPostion
+	buf.WriteString(")$")
+	return buf.String()
 }
 
 func InRowValueList(rowValue value.RowValue, list []value.RowValue, matchType int, operator string) (ternary.Value, error) {
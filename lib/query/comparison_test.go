@@ -141,6 +141,380 @@ func TestLike(t *testing.T) {
 	}
 }
 
+var likeWithEscapeTests = []struct {
+	LHS     value.Primary
+	Pattern value.Primary
+	Escape  rune
+	Result  ternary.Value
+}{
+	{
+		LHS:     value.NewString("%abc%"),
+		Pattern: value.NewString("!%abc!%"),
+		Escape:  '!',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("xabc%"),
+		Pattern: value.NewString("!%abc!%"),
+		Escape:  '!',
+		Result:  ternary.FALSE,
+	},
+	{
+		LHS:     value.NewString("a%"),
+		Pattern: value.NewString("ax%"),
+		Escape:  'x',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("A%"),
+		Pattern: value.NewString("aX%"),
+		Escape:  'X',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("ax"),
+		Pattern: value.NewString("aX%"),
+		Escape:  'x',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("_abc_"),
+		Pattern: value.NewString("!_abc!_"),
+		Escape:  '!',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("xabcx"),
+		Pattern: value.NewString("!_abc!_"),
+		Escape:  '!',
+		Result:  ternary.FALSE,
+	},
+	{
+		LHS:     value.NewString("%abc"),
+		Pattern: value.NewString("!%%"),
+		Escape:  '!',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abc%"),
+		Pattern: value.NewString("%!%"),
+		Escape:  '!',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abc"),
+		Pattern: value.NewString("%!%"),
+		Escape:  '!',
+		Result:  ternary.FALSE,
+	},
+	{
+		LHS:     value.NewString("a!b"),
+		Pattern: value.NewString("a!!b"),
+		Escape:  '!',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("a!!b"),
+		Pattern: value.NewString("a!!b"),
+		Escape:  '!',
+		Result:  ternary.FALSE,
+	},
+	{
+		LHS:     value.NewString("a!b"),
+		Pattern: value.NewString("a!b"),
+		Escape:  '!',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abc!"),
+		Pattern: value.NewString("abc!"),
+		Escape:  '!',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("a%c"),
+		Pattern: value.NewString("a\\%c"),
+		Escape:  '\\',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abc"),
+		Pattern: value.NewString("a\\%c"),
+		Escape:  '\\',
+		Result:  ternary.FALSE,
+	},
+	{
+		LHS:     value.NewString("abc"),
+		Pattern: value.NewString("%%"),
+		Escape:  '!',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString(""),
+		Pattern: value.NewString("%%"),
+		Escape:  '!',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abc"),
+		Pattern: value.NewString("%_%"),
+		Escape:  '!',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString(""),
+		Pattern: value.NewString("%_%"),
+		Escape:  '!',
+		Result:  ternary.FALSE,
+	},
+	{
+		LHS:     value.NewString("abc"),
+		Pattern: value.NewString("__%"),
+		Escape:  '!',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("a"),
+		Pattern: value.NewString("__%"),
+		Escape:  '!',
+		Result:  ternary.FALSE,
+	},
+	{
+		LHS:     value.NewString("aab"),
+		Pattern: value.NewString("%ab"),
+		Escape:  '!',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abab"),
+		Pattern: value.NewString("%ab%ab"),
+		Escape:  '!',
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abac"),
+		Pattern: value.NewString("%ab%ab"),
+		Escape:  '!',
+		Result:  ternary.FALSE,
+	},
+}
+
+func TestLikeWithEscape(t *testing.T) {
+	for _, v := range likeWithEscapeTests {
+		r := LikeWithEscape(v.LHS, v.Pattern, v.Escape)
+		if r != v.Result {
+			t.Errorf("result = %s, want %s for (%s like %s escape %q)", r, v.Result, v.LHS, v.Pattern, v.Escape)
+		}
+	}
+}
+
+var regExpMatchTests = []struct {
+	LHS             value.Primary
+	Pattern         value.Primary
+	CaseInsensitive bool
+	Result          ternary.Value
+	Error           string
+}{
+	{
+		LHS:     value.NewString("abc"),
+		Pattern: value.NewNull(),
+		Result:  ternary.UNKNOWN,
+	},
+	{
+		LHS:     value.NewNull(),
+		Pattern: value.NewString("abc"),
+		Result:  ternary.UNKNOWN,
+	},
+	{
+		LHS:     value.NewString("abcdef"),
+		Pattern: value.NewString("^abc"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("ABCDEF"),
+		Pattern: value.NewString("^abc"),
+		Result:  ternary.FALSE,
+	},
+	{
+		LHS:             value.NewString("ABCDEF"),
+		Pattern:         value.NewString("^abc"),
+		CaseInsensitive: true,
+		Result:          ternary.TRUE,
+	},
+	{
+		LHS:     value.NewInteger(123),
+		Pattern: value.NewString("^[0-9]+$"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abc"),
+		Pattern: value.NewString("(abc"),
+		Result:  ternary.UNKNOWN,
+		Error:   "error parsing regexp: missing closing ): `(abc`",
+	},
+}
+
+func TestRegExpMatch(t *testing.T) {
+	for _, v := range regExpMatchTests {
+		r, err := RegExpMatch(v.LHS, v.Pattern, v.CaseInsensitive)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for (%s ~ %s)", err, v.LHS, v.Pattern)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for (%s ~ %s)", err.Error(), v.Error, v.LHS, v.Pattern)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for (%s ~ %s)", v.Error, v.LHS, v.Pattern)
+			continue
+		}
+		if r != v.Result {
+			t.Errorf("result = %s, want %s for (%s ~ %s)", r, v.Result, v.LHS, v.Pattern)
+		}
+	}
+}
+
+var similarToTests = []struct {
+	LHS     value.Primary
+	Pattern value.Primary
+	Result  ternary.Value
+	Error   string
+}{
+	{
+		LHS:     value.NewString("abc"),
+		Pattern: value.NewNull(),
+		Result:  ternary.UNKNOWN,
+	},
+	{
+		LHS:     value.NewString("abc"),
+		Pattern: value.NewString("abc"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abcd"),
+		Pattern: value.NewString("abc"),
+		Result:  ternary.FALSE,
+	},
+	{
+		LHS:     value.NewString("xabc"),
+		Pattern: value.NewString("abc"),
+		Result:  ternary.FALSE,
+	},
+	{
+		LHS:     value.NewString("ABC"),
+		Pattern: value.NewString("a%"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abc"),
+		Pattern: value.NewString("a_c"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abd"),
+		Pattern: value.NewString("a(b|c)d"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("aed"),
+		Pattern: value.NewString("a(b|c)d"),
+		Result:  ternary.FALSE,
+	},
+	{
+		LHS:     value.NewString("abbbc"),
+		Pattern: value.NewString("ab+c"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("ac"),
+		Pattern: value.NewString("ab?c"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abbc"),
+		Pattern: value.NewString("ab{2}c"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("a7c"),
+		Pattern: value.NewString("a[0-9]c"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("a.c"),
+		Pattern: value.NewString("a.c"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abc"),
+		Pattern: value.NewString("a.c"),
+		Result:  ternary.FALSE,
+	},
+	{
+		LHS:     value.NewString("a^$c"),
+		Pattern: value.NewString("a^$c"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("a\\c"),
+		Pattern: value.NewString("a\\c"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("a%c"),
+		Pattern: value.NewString("a!%c"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abc"),
+		Pattern: value.NewString("a!%c"),
+		Result:  ternary.FALSE,
+	},
+	{
+		LHS:     value.NewString("a|c"),
+		Pattern: value.NewString("a!|c"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("ab!"),
+		Pattern: value.NewString("ab!"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("a\nc"),
+		Pattern: value.NewString("a%c"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("abc"),
+		Pattern: value.NewString("a(bc"),
+		Result:  ternary.UNKNOWN,
+		Error:   "error parsing regexp: missing closing ): `(?is)^(?:a(bc)$`",
+	},
+}
+
+func TestSimilarTo(t *testing.T) {
+	for _, v := range similarToTests {
+		r, err := SimilarTo(v.LHS, v.Pattern, '!')
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for (%s similar to %s)", err, v.LHS, v.Pattern)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for (%s similar to %s)", err.Error(), v.Error, v.LHS, v.Pattern)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for (%s similar to %s)", v.Error, v.LHS, v.Pattern)
+			continue
+		}
+		if r != v.Result {
+			t.Errorf("result = %s, want %s for (%s similar to %s)", r, v.Result, v.LHS, v.Pattern)
+		}
+	}
+}
+
 var inRowValueListTests = []struct {
 	LHS      value.RowValue
 	List     []value.RowValue
@@ -151,7 +151,7 @@ func (m CursorMap) Declare(expr parser.CursorDeclaration) error {
 	if _, ok := m[uname]; ok {
 		return NewCursorRedeclaredError(expr.Cursor)
 	}
-	m[uname] = NewCursor(expr.Cursor.Literal, expr.Query)
+	m[uname] = NewCursor(expr.Cursor.Literal, expr.Query, expr.IsScrollable())
 	return nil
 }
 
@@ -170,6 +170,7 @@ func (m CursorMap) Dispose(name parser.Identifier) error {
 		if cur.isPseudo {
 			return NewPseudoCursorError(name)
 		}
+		cur.Close(name)
 		delete(m, uname)
 		return nil
 	}
@@ -199,19 +200,22 @@ func (m CursorMap) Fetch(name parser.Identifier, position int, number int) ([]va
 
 func (m CursorMap) IsOpen(name parser.Identifier) (ternary.Value, error) {
 	if cur, ok := m[strings.ToUpper(name.Literal)]; ok {
-		return ternary.ConvertFromBool(cur.view != nil), nil
+		return ternary.ConvertFromBool(cur.isOpen()), nil
 	}
 	return ternary.FALSE, NewUndeclaredCursorError(name)
 }
 
 func (m CursorMap) IsInRange(name parser.Identifier) (ternary.Value, error) {
 	if cur, ok := m[strings.ToUpper(name.Literal)]; ok {
-		if cur.view == nil {
+		if !cur.isOpen() {
 			return ternary.FALSE, NewCursorClosedError(name)
 		}
 		if !cur.fetched {
 			return ternary.UNKNOWN, nil
 		}
+		if cur.stream != nil {
+			return ternary.ConvertFromBool(-1 < cur.index && !cur.streamEnded), nil
+		}
 		return ternary.ConvertFromBool(-1 < cur.index && cur.index < cur.view.RecordLen()), nil
 	}
 	return ternary.FALSE, NewUndeclaredCursorError(name)
@@ -219,9 +223,12 @@ func (m CursorMap) IsInRange(name parser.Identifier) (ternary.Value, error) {
 
 func (m CursorMap) Count(name parser.Identifier) (int, error) {
 	if cur, ok := m[strings.ToUpper(name.Literal)]; ok {
-		if cur.view == nil {
+		if !cur.isOpen() {
 			return 0, NewCursorClosedError(name)
 		}
+		if err := cur.materialize(); err != nil {
+			return 0, err
+		}
 		return cur.view.RecordLen(), nil
 	}
 	return 0, NewUndeclaredCursorError(name)
@@ -230,17 +237,22 @@ func (m CursorMap) Count(name parser.Identifier) (int, error) {
 type Cursor struct {
 	name    string
 	query   parser.SelectQuery
+	scroll  ternary.Value
 	view    *View
 	index   int
 	fetched bool
 
+	stream      *recordStream
+	streamEnded bool
+
 	isPseudo bool
 }
 
-func NewCursor(name string, query parser.SelectQuery) *Cursor {
+func NewCursor(name string, query parser.SelectQuery, scroll ternary.Value) *Cursor {
 	return &Cursor{
-		name:  name,
-		query: query,
+		name:   name,
+		query:  query,
+		scroll: scroll,
 	}
 }
 
@@ -268,26 +280,71 @@ func (c *Cursor) Open(name parser.Identifier, filter *Filter) error {
 		return NewPseudoCursorError(name)
 	}
 
-	if c.view != nil {
+	if c.isOpen() {
 		return NewCursorOpenError(name)
 	}
 
-	view, err := Select(c.query, filter)
-	if err != nil {
-		return err
+	var stream *recordStream
+	var err error
+	if c.scroll != ternary.TRUE {
+		if stream, err = openRecordStream(c.query, filter); err != nil {
+			return err
+		}
+	}
+
+	if stream != nil {
+		c.stream = stream
+		c.streamEnded = false
+		cursorStreams[c] = true
+	} else {
+		view, err := Select(c.query, filter)
+		if err != nil {
+			return err
+		}
+		c.view = view
 	}
 
-	c.view = view
 	c.index = -1
 	c.fetched = false
 	return nil
 }
 
+func (c *Cursor) isOpen() bool {
+	return c.view != nil || c.stream != nil
+}
+
+func (c *Cursor) closeStream() {
+	if c.stream != nil {
+		c.stream.close()
+		c.stream = nil
+		delete(cursorStreams, c)
+	}
+}
+
+// materialize replaces the stream with the entire result of the query,
+// keeping the position of the cursor.
+func (c *Cursor) materialize() error {
+	if c.stream == nil {
+		return nil
+	}
+
+	parent := c.stream.parent
+	c.closeStream()
+
+	view, err := Select(c.query, parent)
+	if err != nil {
+		return err
+	}
+	c.view = view
+	return nil
+}
+
 func (c *Cursor) Close(name parser.Identifier) error {
 	if c.isPseudo {
 		return NewPseudoCursorError(name)
 	}
 
+	c.closeStream()
 	c.view = nil
 	c.index = 0
 	c.fetched = false
@@ -296,14 +353,32 @@ func (c *Cursor) Close(name parser.Identifier) error {
 }
 
 func (c *Cursor) Fetch(name parser.Identifier, position int, number int) ([]value.Primary, error) {
-	if c.view == nil {
+	if !c.isOpen() {
 		return nil, NewCursorClosedError(name)
 	}
 
+	if c.scroll == ternary.FALSE {
+		switch position {
+		case parser.ABSOLUTE, parser.RELATIVE, parser.FIRST, parser.LAST, parser.PRIOR:
+			return nil, NewCursorNotScrollableError(name, position)
+		}
+	}
+
 	if !c.fetched {
 		c.fetched = true
 	}
 
+	if c.stream != nil {
+		switch position {
+		case parser.ABSOLUTE, parser.RELATIVE, parser.FIRST, parser.LAST, parser.PRIOR:
+			if err := c.materialize(); err != nil {
+				return nil, err
+			}
+		default: // NEXT
+			return c.fetchFromStream()
+		}
+	}
+
 	switch position {
 	case parser.ABSOLUTE:
 		c.index = number
@@ -336,3 +411,19 @@ func (c *Cursor) Fetch(name parser.Identifier, position int, number int) ([]valu
 
 	return list, nil
 }
+
+func (c *Cursor) fetchFromStream() ([]value.Primary, error) {
+	if c.streamEnded {
+		return nil, nil
+	}
+
+	c.index++
+	list, err := c.stream.next()
+	if err != nil {
+		return nil, err
+	}
+	if list == nil {
+		c.streamEnded = true
+	}
+	return list, nil
+}
@@ -0,0 +1,358 @@
+package query
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/csv"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// Cursors reading files record by record, used to release them before the files are opened to update.
+var cursorStreams = make(map[*Cursor]bool)
+
+func materializeCursorStreams(fpath string) error {
+	for cur := range cursorStreams {
+		if cur.stream.fileInfo != nil && strings.EqualFold(cur.stream.fileInfo.Path, fpath) {
+			if err := cur.materialize(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// recordStream evaluates a simple select query against each record read from a csv file,
+// so that cursors do not need to hold the entire result of the query.
+type recordStream struct {
+	fp       *os.File
+	reader   *csv.Reader
+	fileInfo *FileInfo
+	table    parser.Identifier
+	viewName string
+	header   Header
+
+	selectClause parser.SelectClause
+	whereClause  parser.QueryExpression
+
+	parent *Filter
+	filter *Filter
+
+	buffer []value.Primary
+}
+
+// streamSource is the csv file from which the records of a simple select query can be read one by one.
+type streamSource struct {
+	entity          parser.SelectEntity
+	selectClause    parser.SelectClause
+	table           parser.Table
+	tableIdentifier parser.Identifier
+	fileInfo        *FileInfo
+}
+
+// findStreamSource returns nil without an error if the query cannot be evaluated record by record.
+func findStreamSource(query parser.SelectQuery, filter *Filter) (*streamSource, error) {
+	flags := cmd.GetFlags()
+	if flags.InferTypes == cmd.INFER_PER_COLUMN || flags.TrimTrailingDelimiter {
+		return nil, nil
+	}
+	if query.WithClause != nil || query.OrderByClause != nil || query.OffsetClause != nil || query.LimitClause != nil || query.ForUpdate {
+		return nil, nil
+	}
+
+	entity, ok := query.SelectEntity.(parser.SelectEntity)
+	if !ok || entity.FromClause == nil || entity.GroupByClause != nil || entity.HavingClause != nil {
+		return nil, nil
+	}
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	if selectClause.IsDistinct() {
+		return nil, nil
+	}
+	for _, f := range selectClause.Fields {
+		if !isStreamable(f) {
+			return nil, nil
+		}
+	}
+	if entity.WhereClause != nil && !isStreamable(entity.WhereClause.(parser.WhereClause).Filter) {
+		return nil, nil
+	}
+
+	fromClause := entity.FromClause.(parser.FromClause)
+	if len(fromClause.Tables) != 1 {
+		return nil, nil
+	}
+	table, ok := fromClause.Tables[0].(parser.Table)
+	if !ok {
+		return nil, nil
+	}
+	tableIdentifier, ok := table.Object.(parser.Identifier)
+	if !ok {
+		return nil, nil
+	}
+	if filter.RecursiveTable != nil && strings.EqualFold(tableIdentifier.Literal, filter.RecursiveTable.Name.Literal) {
+		return nil, nil
+	}
+	if _, err := filter.InlineTables.Get(tableIdentifier); err == nil {
+		return nil, nil
+	}
+	if filter.TempViews.Exists(tableIdentifier.Literal) {
+		return nil, nil
+	}
+
+	options, err := NewTableOptions(table.Options)
+	if err != nil {
+		return nil, err
+	}
+	if 0 < len(options.Sheet) || 0 < len(options.ComputedColumns) || 0 < options.LimitRead || 0 < flags.Head {
+		return nil, nil
+	}
+	fileInfo, err := NewFileInfo(tableIdentifier, options.Repository, options.Delimiter)
+	if err != nil {
+		return nil, nil
+	}
+	if isXlsxFile(fileInfo.Path) || ViewCache.Exists(fileInfo.Path) || Schemas.Exists(fileInfo.Path) {
+		return nil, nil
+	}
+	fileInfo.NoHeader = options.NoHeader
+	fileInfo.Encoding = options.Encoding
+
+	return &streamSource{
+		entity:          entity,
+		selectClause:    selectClause,
+		table:           table,
+		tableIdentifier: tableIdentifier,
+		fileInfo:        fileInfo,
+	}, nil
+}
+
+// openRecordStream returns nil without an error if the query cannot be evaluated record by record.
+func openRecordStream(query parser.SelectQuery, filter *Filter) (*recordStream, error) {
+	source, err := findStreamSource(query, filter)
+	if source == nil || err != nil {
+		return nil, err
+	}
+	table := source.table
+	tableIdentifier := source.tableIdentifier
+	fileInfo := source.fileInfo
+
+	fp, err := file.OpenToRead(fileInfo.Path)
+	if err != nil {
+		if _, ok := err.(*file.TimeoutError); ok {
+			return nil, NewFileLockTimeoutError(tableIdentifier, fileInfo.Path)
+		}
+		return nil, NewReadFileError(tableIdentifier, err.Error())
+	}
+
+	reader, enc, err := newCsvReader(fp, fileInfo.Delimiter, fileInfo.Encoding)
+	if err != nil {
+		file.Close(fp)
+		return nil, NewCsvParsingError(tableIdentifier, fileInfo.Path, err.Error())
+	}
+	fileInfo.Encoding = enc
+
+	var names []string
+	if !fileInfo.NoHeader {
+		names, err = reader.ReadHeader()
+		if err != nil && err != csv.EOF {
+			file.Close(fp)
+			return nil, NewCsvParsingError(tableIdentifier, fileInfo.Path, err.Error())
+		}
+	}
+
+	parent := *filter
+	parent.Variables = make(VariableScopes, len(filter.Variables))
+	for i, m := range filter.Variables {
+		parent.Variables[i] = make(VariableMap, len(m))
+		for k, v := range m {
+			parent.Variables[i][k] = v
+		}
+	}
+
+	node := parent.CreateNode()
+	if err = node.Aliases.Add(table.Name(), fileInfo.Path); err != nil {
+		file.Close(fp)
+		return nil, err
+	}
+
+	stream := &recordStream{
+		fp:           fp,
+		reader:       reader,
+		fileInfo:     fileInfo,
+		table:        tableIdentifier,
+		viewName:     table.Name().Literal,
+		selectClause: source.selectClause,
+		whereClause:  source.entity.WhereClause,
+		parent:       &parent,
+		filter:       node,
+	}
+	if names != nil {
+		stream.header = NewHeader(stream.viewName, names)
+	}
+
+	// Reads ahead one record so that errors in the query are reported when the cursor is opened.
+	if stream.buffer, err = stream.read(); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+// next returns the values of the next record that satisfies the query, or nil at the end of the file.
+func (s *recordStream) next() ([]value.Primary, error) {
+	values := s.buffer
+	if values == nil {
+		return nil, nil
+	}
+
+	var err error
+	if s.buffer, err = s.read(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func (s *recordStream) read() ([]value.Primary, error) {
+	flags := cmd.GetFlags()
+
+	for s.fp != nil {
+		fields, err := s.reader.Read()
+		if err == csv.EOF {
+			if 0 < s.reader.InvalidSequences {
+				Warnings.Add(s.table, fmt.Sprintf(WARNING_INVALID_BYTE_SEQUENCES, s.reader.InvalidSequences, s.fileInfo.Path))
+			}
+			s.close()
+			break
+		}
+		if err != nil {
+			s.close()
+			return nil, NewCsvParsingError(s.table, s.fileInfo.Path, err.Error())
+		}
+
+		if s.header == nil {
+			names := make([]string, len(fields))
+			for i := range names {
+				names[i] = "c" + strconv.Itoa(i+1)
+			}
+			s.header = NewHeader(s.viewName, names)
+		}
+
+		values := make([]value.Primary, len(fields))
+		for i, v := range fields {
+			values[i] = v.ToPrimary()
+			if flags.InferTypes == cmd.INFER_PER_CELL {
+				values[i] = inferValueType(values[i])
+			}
+		}
+
+		view := NewView()
+		view.Header = s.header.Copy()
+		view.RecordSet = RecordSet{NewRecord(values)}
+		view.FileInfo = s.fileInfo
+		view.Filter = s.filter
+
+		if s.whereClause != nil {
+			if err = view.Where(s.whereClause.(parser.WhereClause)); err != nil {
+				s.close()
+				return nil, err
+			}
+			if view.RecordLen() < 1 {
+				continue
+			}
+		}
+		if err = view.Select(s.selectClause); err != nil {
+			s.close()
+			return nil, err
+		}
+		view.Fix()
+
+		list := make([]value.Primary, len(view.RecordSet[0]))
+		for i, cell := range view.RecordSet[0] {
+			list[i] = cell.Value()
+		}
+		return list, nil
+	}
+	return nil, nil
+}
+
+func (s *recordStream) close() {
+	if s.fp != nil {
+		file.Close(s.fp)
+		s.fp = nil
+	}
+}
+
+// isStreamable reports whether the expression can be evaluated with only one record,
+// and its result does not depend on when it is evaluated.
+func isStreamable(expr parser.QueryExpression) bool {
+	if expr == nil {
+		return true
+	}
+
+	switch e := expr.(type) {
+	case parser.PrimitiveType, parser.FieldReference, parser.ColumnNumber, parser.Variable:
+		return true
+	case parser.Field:
+		return isStreamable(e.Object)
+	case parser.AllColumns:
+		return areStreamable(e.Replace)
+	case parser.Parentheses:
+		return isStreamable(e.Expr)
+	case parser.RowValue:
+		return isStreamable(e.Value)
+	case parser.ValueList:
+		return areStreamable(e.Values)
+	case parser.RowValueList:
+		return areStreamable(e.RowValues)
+	case parser.Comparison:
+		return isStreamable(e.LHS) && isStreamable(e.RHS)
+	case parser.Is:
+		return isStreamable(e.LHS) && isStreamable(e.RHS)
+	case parser.Between:
+		return isStreamable(e.LHS) && isStreamable(e.Low) && isStreamable(e.High)
+	case parser.In:
+		return isStreamable(e.LHS) && isStreamable(e.Values)
+	case parser.Like:
+		return isStreamable(e.LHS) && isStreamable(e.Pattern) && isStreamable(e.Escape)
+	case parser.Arithmetic:
+		return isStreamable(e.LHS) && isStreamable(e.RHS)
+	case parser.UnaryArithmetic:
+		return isStreamable(e.Operand)
+	case parser.Logic:
+		return isStreamable(e.LHS) && isStreamable(e.RHS)
+	case parser.UnaryLogic:
+		return isStreamable(e.Operand)
+	case parser.Concat:
+		return areStreamable(e.Items)
+	case parser.AtTimeZone:
+		return isStreamable(e.Value) && isStreamable(e.TimeZone)
+	case parser.CaseExpr:
+		return isStreamable(e.Value) && areStreamable(e.When) && isStreamable(e.Else)
+	case parser.CaseExprWhen:
+		return isStreamable(e.Condition) && isStreamable(e.Result)
+	case parser.CaseExprElse:
+		return isStreamable(e.Result)
+	case parser.Function:
+		name := strings.ToUpper(e.Name)
+		if _, ok := ExternalAggregateFunctions[name]; ok || name == "CALL" {
+			return false
+		}
+		if _, ok := Functions[name]; !ok || isVolatileFunction(name) {
+			return false
+		}
+		return areStreamable(e.Args)
+	}
+	return false
+}
+
+func areStreamable(exprs []parser.QueryExpression) bool {
+	for _, v := range exprs {
+		if !isStreamable(v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,255 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+var cursorStreamFetchTests = []struct {
+	Name      string
+	Position  int
+	Number    int
+	Streaming bool
+	Result    []value.Primary
+	InRange   ternary.Value
+}{
+	{
+		Name:      "Cursor Stream Fetch Next",
+		Position:  parser.NEXT,
+		Streaming: true,
+		Result:    []value.Primary{value.NewString("1"), value.NewString("str1")},
+		InRange:   ternary.TRUE,
+	},
+	{
+		Name:      "Cursor Stream Fetch Next Second",
+		Position:  parser.NEXT,
+		Streaming: true,
+		Result:    []value.Primary{value.NewString("2"), value.NewString("str2")},
+		InRange:   ternary.TRUE,
+	},
+	{
+		Name:     "Cursor Stream Fetch Prior Materializes Records",
+		Position: parser.PRIOR,
+		Result:   []value.Primary{value.NewString("1"), value.NewString("str1")},
+		InRange:  ternary.TRUE,
+	},
+	{
+		Name:     "Cursor Stream Fetch Relative After Materialization",
+		Position: parser.RELATIVE,
+		Number:   2,
+		Result:   []value.Primary{value.NewString("3"), value.NewString("str3")},
+		InRange:  ternary.TRUE,
+	},
+}
+
+func TestCursor_FetchFromStream(t *testing.T) {
+	initFlag()
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	ViewCache.Clean()
+	cur := NewCursor("cur", selectQueryForCursorTest, ternary.UNKNOWN)
+	if err := cur.Open(parser.Identifier{Literal: "cur"}, NewEmptyFilter()); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if cur.stream == nil {
+		t.Fatalf("cursor is not streaming records")
+	}
+
+	cursors := CursorMap{"CUR": cur}
+	for _, v := range cursorStreamFetchTests {
+		result, err := cursors.Fetch(parser.Identifier{Literal: "cur"}, v.Position, v.Number)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if (cur.stream != nil) != v.Streaming {
+			t.Errorf("%s: streaming = %t, want %t", v.Name, cur.stream != nil, v.Streaming)
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+		inRange, _ := cursors.IsInRange(parser.Identifier{Literal: "cur"})
+		if inRange != v.InRange {
+			t.Errorf("%s: in range = %s, want %s", v.Name, inRange, v.InRange)
+		}
+	}
+	cur.Close(parser.Identifier{Literal: "cur"})
+}
+
+func TestCursor_CloseStream(t *testing.T) {
+	initFlag()
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	ViewCache.Clean()
+	cur := NewCursor("cur", selectQueryForCursorTest, ternary.UNKNOWN)
+	if err := cur.Open(parser.Identifier{Literal: "cur"}, NewEmptyFilter()); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	fp := cur.stream.fp
+
+	cur.Close(parser.Identifier{Literal: "cur"})
+	if cur.stream != nil {
+		t.Errorf("stream is not released")
+	}
+	if _, err := fp.Stat(); err == nil {
+		t.Errorf("file handle is not closed")
+	}
+	if _, ok := cursorStreams[cur]; ok {
+		t.Errorf("cursor remains in the list of streaming cursors")
+	}
+}
+
+var isStreamableTests = []struct {
+	Name   string
+	Expr   parser.QueryExpression
+	Result bool
+}{
+	{
+		Name: "IsStreamable Field",
+		Expr: parser.Field{
+			Object: parser.Arithmetic{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				Operator: '+',
+				RHS:      parser.NewIntegerValue(1),
+			},
+		},
+		Result: true,
+	},
+	{
+		Name: "IsStreamable Built-in Function",
+		Expr: parser.Function{
+			Name: "upper",
+			Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+		},
+		Result: true,
+	},
+	{
+		Name: "IsStreamable Volatile Function",
+		Expr: parser.Comparison{
+			LHS:      parser.Function{Name: "rand"},
+			RHS:      parser.NewFloatValue(0.5),
+			Operator: "<",
+		},
+		Result: false,
+	},
+	{
+		Name: "IsStreamable Now Function",
+		Expr: parser.Function{
+			Name: "now",
+		},
+		Result: false,
+	},
+	{
+		Name: "IsStreamable User Defined Function",
+		Expr: parser.Function{
+			Name: "userfunc",
+		},
+		Result: false,
+	},
+	{
+		Name: "IsStreamable Aggregate Function",
+		Expr: parser.AggregateFunction{
+			Name: "count",
+			Args: []parser.QueryExpression{parser.AllColumns{}},
+		},
+		Result: false,
+	},
+	{
+		Name: "IsStreamable Subquery",
+		Expr: parser.In{
+			LHS:    parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			Values: parser.Subquery{Query: selectQueryForCursorTest},
+		},
+		Result: false,
+	},
+}
+
+func TestIsStreamable(t *testing.T) {
+	for _, v := range isStreamableTests {
+		result := isStreamable(v.Expr)
+		if result != v.Result {
+			t.Errorf("%s: result = %t, want %t", v.Name, result, v.Result)
+		}
+	}
+}
+
+var cursorScrollTests = []struct {
+	Name      string
+	Scroll    ternary.Value
+	Position  int
+	Streaming bool
+	Result    []value.Primary
+	Error     string
+}{
+	{
+		Name:     "Cursor Scroll Retrieves Records When Opened",
+		Scroll:   ternary.TRUE,
+		Position: parser.NEXT,
+		Result:   []value.Primary{value.NewString("1"), value.NewString("str1")},
+	},
+	{
+		Name:      "Cursor No Scroll Fetch Next",
+		Scroll:    ternary.FALSE,
+		Position:  parser.NEXT,
+		Streaming: true,
+		Result:    []value.Primary{value.NewString("1"), value.NewString("str1")},
+	},
+	{
+		Name:      "Cursor No Scroll Fetch Prior Error",
+		Scroll:    ternary.FALSE,
+		Position:  parser.PRIOR,
+		Streaming: true,
+		Error:     "[L:- C:-] cursor cur is declared with NO SCROLL and cannot fetch PRIOR",
+	},
+	{
+		Name:      "Cursor No Scroll Fetch First Error",
+		Scroll:    ternary.FALSE,
+		Position:  parser.FIRST,
+		Streaming: true,
+		Error:     "[L:- C:-] cursor cur is declared with NO SCROLL and cannot fetch FIRST",
+	},
+}
+
+func TestCursor_Scroll(t *testing.T) {
+	initFlag()
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	for _, v := range cursorScrollTests {
+		ViewCache.Clean()
+		cur := NewCursor("cur", selectQueryForCursorTest, v.Scroll)
+		if err := cur.Open(parser.Identifier{Literal: "cur"}, NewEmptyFilter()); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if (cur.stream != nil) != v.Streaming {
+			t.Errorf("%s: streaming = %t, want %t", v.Name, cur.stream != nil, v.Streaming)
+		}
+
+		result, err := cur.Fetch(parser.Identifier{Literal: "cur"}, v.Position, 0)
+		cur.Close(parser.Identifier{Literal: "cur"})
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
@@ -69,6 +69,29 @@ var cursorScopesDeclareTests = []struct {
 			{},
 		},
 	},
+	{
+		Name: "CursorScopes Declare No Scroll",
+		Expr: parser.CursorDeclaration{
+			Cursor:   parser.Identifier{Literal: "cur2"},
+			Negation: parser.Token{Token: parser.NO, Literal: "no"},
+			Scroll:   parser.Token{Token: parser.SCROLL, Literal: "scroll"},
+			Query:    selectQueryForCursorTest,
+		},
+		Result: CursorScopes{
+			{
+				"CUR": &Cursor{
+					name:  "cur",
+					query: selectQueryForCursorTest,
+				},
+				"CUR2": &Cursor{
+					name:   "cur2",
+					query:  selectQueryForCursorTest,
+					scroll: ternary.FALSE,
+				},
+			},
+			{},
+		},
+	},
 }
 
 func TestCursorScopes_Declare(t *testing.T) {
@@ -284,6 +307,7 @@ var cursorScopesOpenTests = []struct {
 							NoHeader:  false,
 							Encoding:  cmd.UTF8,
 							LineBreak: cmd.LF,
+							Columns:   []string{"COLUMN1", "COLUMN2"},
 						},
 					},
 					index: -1,
@@ -350,6 +374,11 @@ func TestCursorScopes_Open(t *testing.T) {
 			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
 			continue
 		}
+		for _, m := range list {
+			for _, cur := range m {
+				cur.materialize()
+			}
+		}
 		if !reflect.DeepEqual(list, v.Result) {
 			t.Errorf("%s: result = %v, want %v", v.Name, list, v.Result)
 		}
@@ -943,6 +972,7 @@ var cursorMapOpenTests = []struct {
 						NoHeader:  false,
 						Encoding:  cmd.UTF8,
 						LineBreak: cmd.LF,
+						Columns:   []string{"COLUMN1", "COLUMN2"},
 					},
 				},
 				index: -1,
@@ -1021,6 +1051,9 @@ func TestCursorMap_Open(t *testing.T) {
 			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
 			continue
 		}
+		for _, cur := range cursors {
+			cur.materialize()
+		}
 		if !reflect.DeepEqual(cursors, v.Result) {
 			t.Errorf("%s: result = %v, want %v", v.Name, cursors, v.Result)
 		}
@@ -1199,6 +1232,32 @@ var cursorMapFetchTests = []struct {
 		Number:   -2,
 		Result:   nil,
 	},
+	{
+		Name:     "CursorMap Fetch Relative from Prior to First",
+		CurName:  parser.Identifier{Literal: "cur"},
+		Position: parser.RELATIVE,
+		Number:   1,
+		Result: []value.Primary{
+			value.NewString("1"),
+			value.NewString("str1"),
+		},
+	},
+	{
+		Name:     "CursorMap Fetch Relative Later than Last",
+		CurName:  parser.Identifier{Literal: "cur"},
+		Position: parser.RELATIVE,
+		Number:   5,
+		Result:   nil,
+	},
+	{
+		Name:     "CursorMap Fetch Prior from Later than Last",
+		CurName:  parser.Identifier{Literal: "cur"},
+		Position: parser.PRIOR,
+		Result: []value.Primary{
+			value.NewString("3"),
+			value.NewString("str3"),
+		},
+	},
 	{
 		Name:     "CursorMap Fetch Later than Last",
 		CurName:  parser.Identifier{Literal: "cur"},
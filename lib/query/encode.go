@@ -1,8 +1,10 @@
 package query
 
 import (
+	"bytes"
 	"fmt"
-	"io/ioutil"
+	"io"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -130,11 +132,11 @@ func EncodeView(view *View, format cmd.Format, delimiter rune, withoutHeader boo
 
 	switch format {
 	case cmd.CSV, cmd.TSV:
-		s = encodeCSV(view, string(delimiter), withoutHeader)
+		s = encodeCSV(view, string(delimiter), withoutHeader, lineBreak)
 	case cmd.JSON:
-		s = encodeJson(view)
+		s = convertLineBreak(encodeJson(view), lineBreak)
 	default:
-		s = encodeText(view)
+		s = convertLineBreak(encodeText(view), lineBreak)
 	}
 
 	if encoding != cmd.UTF8 {
@@ -143,23 +145,60 @@ func EncodeView(view *View, format cmd.Format, delimiter rune, withoutHeader boo
 			return "", err
 		}
 	}
-	if lineBreak != cmd.LF {
-		s = convertLineBreak(s, lineBreak)
+
+	return s, nil
+}
+
+// EncodeResult encodes a view retrieved by a select query to write as the result.
+// A view with no records is encoded as specified by the empty-result flag.
+func EncodeResult(view *View, format cmd.Format, delimiter rune, withoutHeader bool, encoding cmd.Encoding, lineBreak cmd.LineBreak) (string, error) {
+	if 0 < view.RecordLen() || view.FieldLen() < 1 {
+		return EncodeView(view, format, delimiter, withoutHeader, encoding, lineBreak)
 	}
 
+	var s string
+	switch cmd.GetFlags().EmptyResult {
+	case cmd.EMPTY_HEADER:
+		switch format {
+		case cmd.CSV, cmd.TSV:
+			s = strings.TrimSuffix(encodeCSV(view, string(delimiter), withoutHeader, lineBreak), lineBreak.Value())
+		case cmd.JSON:
+			s = encodeJson(view)
+		default:
+			s = convertLineBreak(encodeTextTable(view), lineBreak)
+		}
+	case cmd.EMPTY_NONE:
+		return "", nil
+	case cmd.EMPTY_MESSAGE:
+		s = "Empty RecordSet"
+	default:
+		return EncodeView(view, format, delimiter, withoutHeader, encoding, lineBreak)
+	}
+
+	if encoding != cmd.UTF8 {
+		return encodeCharacterCode(s, encoding)
+	}
 	return s, nil
 }
 
 func encodeCharacterCode(str string, enc cmd.Encoding) (string, error) {
-	r := cmd.GetReader(strings.NewReader(str), enc)
-	bytes, err := ioutil.ReadAll(r)
-	if err != nil {
+	var buf bytes.Buffer
+	w := cmd.GetWriter(&buf, enc)
+	if _, err := io.WriteString(w, str); err != nil {
 		return "", err
 	}
-	return string(bytes), nil
+	if c, ok := w.(io.Closer); ok {
+		if err := c.Close(); err != nil {
+			return "", err
+		}
+	}
+	return buf.String(), nil
 }
 
 func convertLineBreak(str string, lb cmd.LineBreak) string {
+	if lb == cmd.LF {
+		return str
+	}
 	return strings.Replace(str, "\n", lb.Value(), -1)
 }
 
@@ -170,7 +209,11 @@ func encodeText(view *View) string {
 	if view.RecordLen() < 1 {
 		return "Empty RecordSet"
 	}
+	return encodeTextTable(view)
+}
 
+// encodeTextTable returns only the header box if the view has no records.
+func encodeTextTable(view *View) string {
 	header := make([]textField, view.FieldLen())
 	for i := range view.Header {
 		header[i] = NewTextField(view.Header[i].Column, -1)
@@ -204,6 +247,9 @@ func encodeText(view *View) string {
 	s[1] = formatRecord(header, fieldWidths)
 
 	s[2] = formatHR(fieldWidths)
+	if len(records) < 1 {
+		return strings.Join(s[:3], "\n")
+	}
 
 	for i, record := range records {
 		s[i+3] = formatRecord(record, fieldWidths)
@@ -289,7 +335,7 @@ func formatTextCell(c Cell) textField {
 	case value.Integer:
 		s = primary.(value.Integer).String()
 	case value.Float:
-		s = primary.(value.Float).String()
+		s = formatFloat(primary.(value.Float).Raw())
 	case value.Boolean:
 		s = primary.(value.Boolean).String()
 	case value.Ternary:
@@ -297,6 +343,9 @@ func formatTextCell(c Cell) textField {
 	case value.Datetime:
 		s = primary.(value.Datetime).Format(time.RFC3339Nano)
 		sign = -1
+	case value.Array:
+		s = primary.(value.Array).String()
+		sign = -1
 	case value.Null:
 		s = "NULL"
 	}
@@ -304,12 +353,26 @@ func formatTextCell(c Cell) textField {
 	return NewTextField(s, sign)
 }
 
-func encodeCSV(view *View, delimiter string, withoutHeader bool) string {
+// Line breaks in fields are written as they are, so that those in loaded files are preserved.
+// When the tsv-style flag is ESCAPE, tab-delimited fields are backslash-escaped instead of quoted.
+// Strings read from fields without quotes are written without quotes if it is safe.
+func encodeCSV(view *View, delimiter string, withoutHeader bool, lineBreak cmd.LineBreak) string {
+	escape := delimiter == "\t" && cmd.GetFlags().TsvStyle == cmd.ESCAPE
+
 	var header string
 	if !withoutHeader {
+		var unquotedHeader map[string]bool
+		if view.FileInfo != nil {
+			unquotedHeader = view.FileInfo.UnquotedHeader
+		}
+
 		h := make([]string, view.FieldLen())
 		for i := range view.Header {
-			h[i] = quote(escapeCSVString(view.Header[i].Column))
+			if unquotedHeader[view.Header[i].Column] && canWriteUnquoted(view.Header[i].Column, delimiter, escape) {
+				h[i] = view.Header[i].Column
+			} else {
+				h[i] = formatCSVString(view.Header[i].Column, escape)
+			}
 		}
 		header = strings.Join(h, delimiter)
 	}
@@ -318,30 +381,35 @@ func encodeCSV(view *View, delimiter string, withoutHeader bool) string {
 	for i, record := range view.RecordSet {
 		cells := make([]string, view.FieldLen())
 		for j, cell := range record {
-			cells[j] = formatCSVCell(cell)
+			cells[j] = formatCSVCell(cell, delimiter, escape)
 		}
 		records[i] = strings.Join(cells, delimiter)
 	}
 
-	s := strings.Join(records, "\n")
+	s := strings.Join(records, lineBreak.Value())
 	if !withoutHeader {
-		s = header + "\n" + s
+		s = header + lineBreak.Value() + s
 	}
 	return s
 }
 
-func formatCSVCell(c Cell) string {
+func formatCSVCell(c Cell, delimiter string, escape bool) string {
 	primary := c.Value()
 
 	var s string
 
 	switch primary.(type) {
 	case value.String:
-		s = quote(escapeCSVString(primary.(value.String).Raw()))
+		str := primary.(value.String)
+		if str.Unquoted() && canWriteUnquoted(str.Raw(), delimiter, escape) {
+			s = str.Raw()
+		} else {
+			s = formatCSVString(str.Raw(), escape)
+		}
 	case value.Integer:
 		s = primary.(value.Integer).String()
 	case value.Float:
-		s = primary.(value.Float).String()
+		s = formatFloat(primary.(value.Float).Raw())
 	case value.Boolean:
 		s = primary.(value.Boolean).String()
 	case value.Ternary:
@@ -352,7 +420,9 @@ func formatCSVCell(c Cell) string {
 			s = strconv.FormatBool(t.Ternary().ParseBool())
 		}
 	case value.Datetime:
-		s = quote(escapeCSVString(primary.(value.Datetime).Format(time.RFC3339Nano)))
+		s = formatCSVString(primary.(value.Datetime).Format(time.RFC3339Nano), escape)
+	case value.Array:
+		s = formatCSVString(primary.(value.Array).String(), escape)
 	case value.Null:
 		s = ""
 	}
@@ -360,10 +430,42 @@ func formatCSVCell(c Cell) string {
 	return s
 }
 
+func formatCSVString(s string, escape bool) string {
+	if escape {
+		return escapeTSVString(s)
+	}
+	return quote(escapeCSVString(s))
+}
+
+func canWriteUnquoted(s string, delimiter string, escape bool) bool {
+	if escape || strings.HasPrefix(s, "\"") {
+		return false
+	}
+	return !strings.Contains(s, delimiter) && !strings.ContainsAny(s, "\r\n")
+}
+
+func formatFloat(f float64) string {
+	switch cmd.GetFlags().NumberNotation {
+	case cmd.SCIENTIFIC:
+		return strconv.FormatFloat(f, 'e', -1, 64)
+	case cmd.AUTO_NOTATION:
+		if a := math.Abs(f); a != 0 && (1e21 <= a || a < 1e-6) {
+			return strconv.FormatFloat(f, 'e', -1, 64)
+		}
+	}
+	return value.Float64ToStr(f)
+}
+
 func escapeCSVString(s string) string {
 	return strings.Replace(s, "\"", "\"\"", -1)
 }
 
+var tsvEscaper = strings.NewReplacer("\\", "\\\\", "\t", "\\t", "\n", "\\n", "\r", "\\r")
+
+func escapeTSVString(s string) string {
+	return tsvEscaper.Replace(s)
+}
+
 func encodeJson(view *View) string {
 	records := make([]string, view.RecordLen())
 
@@ -379,8 +481,10 @@ func encodeJson(view *View) string {
 }
 
 func formatJsonCell(c Cell) string {
-	primary := c.Value()
+	return formatJsonValue(c.Value())
+}
 
+func formatJsonValue(primary value.Primary) string {
 	var s string
 
 	switch primary.(type) {
@@ -389,7 +493,7 @@ func formatJsonCell(c Cell) string {
 	case value.Integer:
 		s = primary.(value.Integer).String()
 	case value.Float:
-		s = primary.(value.Float).String()
+		s = formatFloat(primary.(value.Float).Raw())
 	case value.Boolean:
 		s = primary.(value.Boolean).String()
 	case value.Ternary:
@@ -401,6 +505,13 @@ func formatJsonCell(c Cell) string {
 		}
 	case value.Datetime:
 		s = quote(escapeJsonString(primary.(value.Datetime).Format(time.RFC3339Nano)))
+	case value.Array:
+		values := primary.(value.Array).Raw()
+		elems := make([]string, len(values))
+		for i, v := range values {
+			elems[i] = formatJsonValue(v)
+		}
+		s = "[" + strings.Join(elems, ",") + "]"
 	case value.Null:
 		s = "null"
 	}
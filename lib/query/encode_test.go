@@ -15,7 +15,7 @@ import (
 )
 
 func encodeToSJIS(str string) string {
-	r := transform.NewReader(strings.NewReader(str), japanese.ShiftJIS.NewDecoder())
+	r := transform.NewReader(strings.NewReader(str), japanese.ShiftJIS.NewEncoder())
 	bytes, _ := ioutil.ReadAll(r)
 	return string(bytes)
 }
@@ -28,6 +28,8 @@ var encodeViewTests = []struct {
 	Encoding       cmd.Encoding
 	WriteDelimiter rune
 	WithoutHeader  bool
+	NumberNotation cmd.NumberNotation
+	TsvStyle       cmd.TsvStyle
 	Result         string
 	Error          string
 }{
@@ -67,7 +69,7 @@ var encodeViewTests = []struct {
 			"|          | second line                        |        |\n" +
 			"+----------+------------------------------------+--------+\n" +
 			"|       -1 |                            UNKNOWN |   true |\n" +
-			"|   2.0123 | 2016-02-01T16:00:00.123456-07:00   | abcdef |\n" +
+			"|   2.0123 | 2016-02-01T23:00:00.123456Z        | abcdef |\n" +
 			"| 34567890 |  abcdefghijklmnopqrstuvwxyzabcdefg |   NULL |\n" +
 			"|          | hi\"jk日本語あアｱＡ（               |        |\n" +
 			"|          |                                    |        |\n" +
@@ -88,7 +90,7 @@ var encodeViewTests = []struct {
 		Result: "\"c1\",\"c2\nsecond line\",\"c3\"\n" +
 			"-1,,true\n" +
 			"-1,false,true\n" +
-			"2.0123,\"2016-02-01T16:00:00.123456-07:00\",\"abcdef\"\n" +
+			"2.0123,\"2016-02-01T23:00:00.123456Z\",\"abcdef\"\n" +
 			"34567890,\" abcdefghijklmnopqrstuvwxyzabcdefg\nhi\"\"jk\n\",",
 	},
 	{
@@ -105,9 +107,57 @@ var encodeViewTests = []struct {
 		WriteDelimiter: '\t',
 		Result: "\"c1\"\t\"c2\nsecond line\"\t\"c3\"\n" +
 			"-1\t\ttrue\n" +
-			"2.0123\t\"2016-02-01T16:00:00.123456-07:00\"\t\"abcdef\"\n" +
+			"2.0123\t\"2016-02-01T23:00:00.123456Z\"\t\"abcdef\"\n" +
 			"34567890\t\" abcdefghijklmnopqrstuvwxyzabcdefg\nhi\"\"jk\n\"\t",
 	},
+	{
+		Name: "TSV Escape Style",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2\tsecond", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewBoolean(true)}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewDatetimeFromString("2016-02-01T16:00:00.123456-07:00"), value.NewString("ab\\cd")}),
+				NewRecord([]value.Primary{value.NewInteger(34567890), value.NewString("a\tb\r\nhi\"jk\n"), value.NewNull()}),
+			},
+		},
+		Format:         cmd.TSV,
+		WriteDelimiter: '\t',
+		TsvStyle:       cmd.ESCAPE,
+		Result: "c1\tc2\\tsecond\tc3\n" +
+			"-1\t\ttrue\n" +
+			"2.0123\t2016-02-01T23:00:00.123456Z\tab\\\\cd\n" +
+			"34567890\ta\\tb\\r\\nhi\"jk\\n\t",
+	},
+	{
+		Name: "CSV Ignores TSV Escape Style",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("a\tb")}),
+			},
+		},
+		Format:   cmd.CSV,
+		TsvStyle: cmd.ESCAPE,
+		Result: "\"c1\"\n" +
+			"\"a\tb\"",
+	},
+	{
+		Name: "CSV Unquoted Strings",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewUnquotedString("abc"), value.NewString("def"), value.NewUnquotedString("a,b")}),
+				NewRecord([]value.Primary{value.NewUnquotedString("a\nb"), value.NewUnquotedString("\"abc"), value.NewUnquotedString("")}),
+			},
+			FileInfo: &FileInfo{
+				UnquotedHeader: map[string]bool{"c1": true, "c3": true},
+			},
+		},
+		Format: cmd.CSV,
+		Result: "c1,\"c2\",c3\n" +
+			"abc,\"def\",\"a,b\"\n" +
+			"\"a\nb\",\"\"\"abc\",",
+	},
 	{
 		Name: "CSV WithoutHeader",
 		View: &View{
@@ -121,7 +171,7 @@ var encodeViewTests = []struct {
 		Format:        cmd.CSV,
 		WithoutHeader: true,
 		Result: "-1,,true\n" +
-			"2.0123,\"2016-02-01T16:00:00.123456-07:00\",\"abcdef\"\n" +
+			"2.0123,\"2016-02-01T23:00:00.123456Z\",\"abcdef\"\n" +
 			"34567890,\" abcdefghijklmnopqrstuvwxyzabcdefg\nhi\"\"jk\n\",",
 	},
 	{
@@ -136,10 +186,24 @@ var encodeViewTests = []struct {
 		},
 		Format:    cmd.CSV,
 		LineBreak: cmd.CRLF,
-		Result: "\"c1\",\"c2\r\nsecond line\",\"c3\"\r\n" +
+		Result: "\"c1\",\"c2\nsecond line\",\"c3\"\r\n" +
 			"-1,,true\r\n" +
-			"2.0123,\"2016-02-01T16:00:00.123456-07:00\",\"abcdef\"\r\n" +
-			"34567890,\" abcdefghijklmnopqrstuvwxyzabcdefg\r\nhi\"\"jk\r\n\",",
+			"2.0123,\"2016-02-01T23:00:00.123456Z\",\"abcdef\"\r\n" +
+			"34567890,\" abcdefghijklmnopqrstuvwxyzabcdefg\nhi\"\"jk\n\",",
+	},
+	{
+		Name: "CSV Line Break LF with CRLF in Field",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("abc\r\ndef\rghi")}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewString("jkl")}),
+			},
+		},
+		Format: cmd.CSV,
+		Result: "\"c1\",\"c2\"\n" +
+			"1,\"abc\r\ndef\rghi\"\n" +
+			"2,\"jkl\"",
 	},
 	{
 		Name: "JSON",
@@ -166,7 +230,7 @@ var encodeViewTests = []struct {
 			"}," +
 			"{" +
 			"\"c1\":2.0123," +
-			"\"c2\\nsecond line\":\"2016-02-01T16:00:00.123456-07:00\"," +
+			"\"c2\\nsecond line\":\"2016-02-01T23:00:00.123456Z\"," +
 			"\"c3\":\"abcdef\"" +
 			"}," +
 			"{" +
@@ -192,9 +256,84 @@ var encodeViewTests = []struct {
 		Result: encodeToSJIS("\"c1\",\"c2\nsecond line\",\"c3\"\n" +
 			"-1,,true\n" +
 			"-1,false,true\n" +
-			"2.0123,\"2016-02-01T16:00:00.123456-07:00\",\"abcdef\"\n" +
+			"2.0123,\"2016-02-01T23:00:00.123456Z\",\"abcdef\"\n" +
 			"34567890,\" 日本語ghijklmnopqrstuvwxyzabcdefg\nhi\"\"jk\n\","),
 	},
+	{
+		Name: "CSV Encode UTF-16LE",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("a")}),
+			},
+		},
+		Format:   cmd.CSV,
+		Encoding: cmd.UTF16LE,
+		Result:   "\xff\xfe\"\x00c\x001\x00\"\x00\n\x00\"\x00a\x00\"\x00",
+	},
+	{
+		Name: "CSV Encode UTF-16BE",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("a")}),
+			},
+		},
+		Format:   cmd.CSV,
+		Encoding: cmd.UTF16BE,
+		Result:   "\xfe\xff\x00\"\x00c\x001\x00\"\x00\n\x00\"\x00a\x00\"",
+	},
+	{
+		Name: "CSV Encode UTF-16LE with Line Break CRLF",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("a")}),
+			},
+		},
+		Format:    cmd.CSV,
+		LineBreak: cmd.CRLF,
+		Encoding:  cmd.UTF16LE,
+		Result:    "\xff\xfe\"\x00c\x001\x00\"\x00\r\x00\n\x00\"\x00a\x00\"\x00",
+	},
+	{
+		Name: "CSV Fixed Notation",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewFloat(1000000), value.NewFloat(0.0000001), value.NewFloat(1.5)}),
+			},
+		},
+		Format: cmd.CSV,
+		Result: "\"c1\",\"c2\",\"c3\"\n" +
+			"1000000,0.0000001,1.5",
+	},
+	{
+		Name: "CSV Scientific Notation",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewFloat(1000000), value.NewFloat(0.0000001), value.NewFloat(1.5)}),
+			},
+		},
+		Format:         cmd.CSV,
+		NumberNotation: cmd.SCIENTIFIC,
+		Result: "\"c1\",\"c2\",\"c3\"\n" +
+			"1e+06,1e-07,1.5e+00",
+	},
+	{
+		Name: "CSV Auto Notation",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3", "c4"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewFloat(1000000), value.NewFloat(0.0000001), value.NewFloat(1.5), value.NewFloat(1e21)}),
+			},
+		},
+		Format:         cmd.CSV,
+		NumberNotation: cmd.AUTO_NOTATION,
+		Result: "\"c1\",\"c2\",\"c3\",\"c4\"\n" +
+			"1000000,1e-07,1.5,1e+21",
+	},
 }
 
 func TestEncodeView(t *testing.T) {
@@ -219,6 +358,8 @@ func TestEncodeView(t *testing.T) {
 		if v.WriteDelimiter != 0 {
 			flags.WriteDelimiter = v.WriteDelimiter
 		}
+		flags.NumberNotation = v.NumberNotation
+		flags.TsvStyle = v.TsvStyle
 
 		s, err := EncodeView(v.View, flags.Format, flags.WriteDelimiter, flags.WithoutHeader, flags.Encoding, flags.LineBreak)
 		if err != nil {
@@ -243,3 +384,125 @@ func TestEncodeView(t *testing.T) {
 	flags.WithoutHeader = false
 	flags.WriteDelimiter = ','
 }
+
+var encodeResultTests = []struct {
+	Name          string
+	View          *View
+	Format        cmd.Format
+	WithoutHeader bool
+	EmptyResult   cmd.EmptyResult
+	Result        string
+}{
+	{
+		Name: "Encode Result Auto Text",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{},
+		},
+		Format: cmd.TEXT,
+		Result: "Empty RecordSet",
+	},
+	{
+		Name: "Encode Result Auto CSV",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{},
+		},
+		Format: cmd.CSV,
+		Result: "\"c1\",\"c2\"\n",
+	},
+	{
+		Name: "Encode Result Header Text",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{},
+		},
+		Format:      cmd.TEXT,
+		EmptyResult: cmd.EMPTY_HEADER,
+		Result: "+----+----+\n" +
+			"| c1 | c2 |\n" +
+			"+----+----+",
+	},
+	{
+		Name: "Encode Result Header CSV",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{},
+		},
+		Format:      cmd.CSV,
+		EmptyResult: cmd.EMPTY_HEADER,
+		Result:      "\"c1\",\"c2\"",
+	},
+	{
+		Name: "Encode Result Header CSV Without Header",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{},
+		},
+		Format:        cmd.CSV,
+		WithoutHeader: true,
+		EmptyResult:   cmd.EMPTY_HEADER,
+		Result:        "",
+	},
+	{
+		Name: "Encode Result Header JSON",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{},
+		},
+		Format:      cmd.JSON,
+		EmptyResult: cmd.EMPTY_HEADER,
+		Result:      "[]",
+	},
+	{
+		Name: "Encode Result None",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{},
+		},
+		Format:      cmd.CSV,
+		EmptyResult: cmd.EMPTY_NONE,
+		Result:      "",
+	},
+	{
+		Name: "Encode Result Message",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{},
+		},
+		Format:      cmd.JSON,
+		EmptyResult: cmd.EMPTY_MESSAGE,
+		Result:      "Empty RecordSet",
+	},
+	{
+		Name: "Encode Result With Records",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+			},
+		},
+		Format:      cmd.CSV,
+		EmptyResult: cmd.EMPTY_MESSAGE,
+		Result:      "\"c1\",\"c2\"\n1,\"a\"",
+	},
+}
+
+func TestEncodeResult(t *testing.T) {
+	flags := cmd.GetFlags()
+
+	for _, v := range encodeResultTests {
+		flags.EmptyResult = v.EmptyResult
+
+		s, err := EncodeResult(v.View, v.Format, ',', v.WithoutHeader, cmd.UTF8, cmd.LF)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if s != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, s, v.Result)
+		}
+	}
+
+	flags.EmptyResult = cmd.EMPTY_AUTO
+}
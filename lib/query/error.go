@@ -1,6 +1,7 @@
 package query
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -43,6 +44,25 @@ const (
 	ERROR_PSEUDO_CURSOR                     = "cursor %s is a pseudo cursor"
 	ERROR_CURSOR_FETCH_LENGTH               = "fetching from cursor %s returns %s"
 	ERROR_INVALID_FETCH_POSITION            = "fetching position %s is not an integer value"
+	ERROR_CURSOR_NOT_SCROLLABLE             = "cursor %s is declared with NO SCROLL and cannot fetch %s"
+	ERROR_UNDEFINED_LOOP_LABEL              = "loop label %s is undefined"
+	ERROR_LOOP_ITERATIONS_EXCEEDED          = "loop exceeded the maximum number of iterations %d"
+	ERROR_RESULT_ROWS_EXCEEDED              = "number of records exceeded the maximum number of result rows %d"
+	ERROR_DROP_TEMPORARY_TABLE              = "temporary table %s cannot be dropped"
+	ERROR_RENAME_TEMPORARY_TABLE            = "temporary table %s cannot be renamed"
+	ERROR_COMBINED_SET_FIELD_TYPE           = "field %s of result sets to be combined has incompatible types %s and %s"
+	ERROR_VALUES_TABLE_ROW_VALUE_LENGTH     = "row value should contain exactly %s"
+	ERROR_TABLE_ALIAS_FIELD_LENGTH          = "%s should be given for table %s"
+	ERROR_UPDATE_COMPUTED_FIELD             = "field %s is a computed column and cannot be updated"
+	ERROR_ANALYTIC_FUNCTION_WITHOUT_OVER    = "function %s requires an OVER clause"
+	ERROR_SCALAR_FUNCTION_WITH_OVER         = "function %s is not an analytic function or an aggregate function and cannot be used with an OVER clause"
+	ERROR_SELECT_INTO_FIELD_LENGTH          = "select query into variables should return exactly %s"
+	ERROR_SELECT_INTO_TOO_MANY_RECORDS      = "select query into variables returns more than one record"
+	ERROR_SELECT_INTO_NO_RECORD             = "select query into variables returns no record"
+	ERROR_UNNEST_NOT_ARRAY                  = "%s is not an array for unnest"
+	ERROR_GLOB_HEADER_NOT_MATCH             = "header of file %s does not match the header of file %s"
+	ERROR_FLAG_NOT_SETTABLE                 = "SET: flag %s cannot be set, it is specified only by the command option"
+	ERROR_SKIPPED_LINES_NOT_UPDATABLE       = "file %s cannot be updated because some lines are skipped by the skip-lines or comment option"
 	ERROR_INLINE_TABLE_REDEFINED            = "inline table %s is redefined"
 	ERROR_UNDEFINED_INLINE_TABLE            = "inline table %s is undefined"
 	ERROR_INLINE_TABLE_FIELD_LENGTH         = "select query should return exactly %s for inline table %s"
@@ -75,12 +95,145 @@ const (
 	ERROR_SOURCE_FILE_UNABLE_TO_READ        = "SOURCE: file %s is unable to read"
 	ERROR_INVALID_FLAG_NAME                 = "flag name %s is invalid"
 	ERROR_INVALID_FLAG_VALUE                = "SET: flag value %s for %s is invalid"
+	ERROR_INVALID_ESCAPE_CHARACTER          = "escape character %s must be a single character"
+	ERROR_INVALID_REGEXP_PATTERN            = "pattern %s is an invalid regular expression: %s"
+	ERROR_FIELD_POSITION_OUT_OF_RANGE       = "field position %s is out of range"
+	ERROR_SAVEPOINT_NOT_EXIST               = "savepoint %s does not exist"
+	ERROR_READ_ONLY                         = "%s cannot be executed in read-only mode"
+	ERROR_EXPORT_INVALID_ARGUMENT           = "EXPORT: argument %s is not a string"
+	ERROR_EXPORT_INVALID_OPTION_NAME        = "EXPORT: option name %s is invalid"
+	ERROR_EXPORT_INVALID_OPTION_VALUE       = "EXPORT: option value %s for %s is invalid"
+	ERROR_INVALID_TABLE_OPTION              = "table option %s is invalid"
+	ERROR_TABLE_OPTION_NOT_APPLICABLE       = "table options cannot be specified for %s"
+	ERROR_TABLE_OPTIONS_CONFLICT            = "file %s is already loaded with different options"
+	ERROR_DIFF_DUPLICATE_KEY                = "DIFF: key values in table %s are duplicated"
+	ERROR_XLSX_PARSING                      = "xlsx parse error in file %s: %s"
+	ERROR_FILE_NOT_UPDATABLE                = "file %s cannot be updated"
+	ERROR_INVALID_ASOF_JOIN_CONDITION       = "ASOF JOIN condition %s must be a comparison with <, <=, > or >="
+	ERROR_FILE_MODIFIED                     = "file %s has been modified by another process since it was loaded"
+	ERROR_INVALID_TIME_ZONE                 = "timezone %s does not exist"
+	ERROR_INVALID_SCHEMA_TYPE               = "type %s is not supported in schemas"
+	ERROR_REPOSITORY_INVALID_ARGUMENT       = "USE REPOSITORY: argument %s is not a string"
+	ERROR_REPOSITORY_NOT_EXIST              = "USE REPOSITORY: directory %s does not exist"
 	ERROR_INTERNAL_RECORD_ID_NOT_EXIST      = "internal record id does not exist"
 	ERROR_INTERNAL_RECORD_ID_EMPTY          = "internal record id is empty"
 	ERROR_FIELD_LENGTH_NOT_MATCH            = "field length does not match"
 	ERROR_ROW_VALUE_LENGTH_NOT_MATCH        = "row value length does not match"
 	ERROR_ROW_VALUE_LENGTH_IN_LIST          = "row value length does not match at index %d"
 	ERROR_FORMAT_STRING_LENGTH_NOT_MATCH    = "number of replace values does not match"
+	ERROR_INVALID_FORMAT_TOKEN              = "format token %s is invalid"
+)
+
+const (
+	ERROR_CODE_USER_TRIGGERED                    = 1
+	ERROR_CODE_INVALID_SYNTAX                    = 2
+	ERROR_CODE_READ_FILE                         = 3
+	ERROR_CODE_CREATE_FILE                       = 4
+	ERROR_CODE_WRITE_FILE                        = 5
+	ERROR_CODE_WRITE_FILE_IN_AUTOCOMMIT          = 6
+	ERROR_CODE_FIELD_AMBIGUOUS                   = 7
+	ERROR_CODE_FIELD_NOT_EXIST                   = 8
+	ERROR_CODE_FIELD_NOT_GROUP_KEY               = 9
+	ERROR_CODE_DUPLICATE_FIELD_NAME              = 10
+	ERROR_CODE_NOT_GROUPING_RECORDS              = 11
+	ERROR_CODE_UNDECLARED_VARIABLE               = 12
+	ERROR_CODE_VARIABLE_REDECLARED               = 13
+	ERROR_CODE_FUNCTION_NOT_EXIST                = 14
+	ERROR_CODE_FUNCTION_ARGUMENT_LENGTH          = 15
+	ERROR_CODE_FUNCTION_INVALID_ARGUMENT         = 16
+	ERROR_CODE_UNPERMITTED_STATEMENT_FUNCTION    = 17
+	ERROR_CODE_NESTED_AGGREGATE_FUNCTIONS        = 18
+	ERROR_CODE_FUNCTION_REDECLARED               = 19
+	ERROR_CODE_BUILT_IN_FUNCTION_DECLARED        = 20
+	ERROR_CODE_DUPLICATE_PARAMETER               = 21
+	ERROR_CODE_SUBQUERY_TOO_MANY_RECORDS         = 22
+	ERROR_CODE_SUBQUERY_TOO_MANY_FIELDS          = 23
+	ERROR_CODE_CURSOR_REDECLARED                 = 24
+	ERROR_CODE_UNDECLARED_CURSOR                 = 25
+	ERROR_CODE_CURSOR_CLOSED                     = 26
+	ERROR_CODE_CURSOR_OPEN                       = 27
+	ERROR_CODE_PSEUDO_CURSOR                     = 28
+	ERROR_CODE_CURSOR_FETCH_LENGTH               = 29
+	ERROR_CODE_INVALID_FETCH_POSITION            = 30
+	ERROR_CODE_INLINE_TABLE_REDEFINED            = 31
+	ERROR_CODE_UNDEFINED_INLINE_TABLE            = 32
+	ERROR_CODE_INLINE_TABLE_FIELD_LENGTH         = 33
+	ERROR_CODE_FILE_NOT_EXIST                    = 34
+	ERROR_CODE_FILE_ALREADY_EXIST                = 35
+	ERROR_CODE_FILE_UNABLE_TO_READ               = 36
+	ERROR_CODE_FILE_LOCK_TIMEOUT                 = 37
+	ERROR_CODE_CSV_PARSING                       = 38
+	ERROR_CODE_TABLE_FIELD_LENGTH                = 39
+	ERROR_CODE_TEMPORARY_TABLE_REDECLARED        = 40
+	ERROR_CODE_UNDECLARED_TEMPORARY_TABLE        = 41
+	ERROR_CODE_TEMPORARY_TABLE_FIELD_LENGTH      = 42
+	ERROR_CODE_DUPLICATE_TABLE_NAME              = 43
+	ERROR_CODE_TABLE_NOT_LOADED                  = 44
+	ERROR_CODE_STDIN_EMPTY                       = 45
+	ERROR_CODE_ROW_VALUE_LENGTH_IN_COMPARISON    = 46
+	ERROR_CODE_SELECT_FIELD_LENGTH_IN_COMPARISON = 47
+	ERROR_CODE_INVALID_LIMIT_PERCENTAGE          = 48
+	ERROR_CODE_INVALID_LIMIT_NUMBER              = 49
+	ERROR_CODE_INVALID_OFFSET_NUMBER             = 50
+	ERROR_CODE_COMBINED_SET_FIELD_LENGTH         = 51
+	ERROR_CODE_INSERT_ROW_VALUE_LENGTH           = 52
+	ERROR_CODE_INSERT_SELECT_FIELD_LENGTH        = 53
+	ERROR_CODE_UPDATE_FIELD_NOT_EXIST            = 54
+	ERROR_CODE_UPDATE_VALUE_AMBIGUOUS            = 55
+	ERROR_CODE_DELETE_TABLE_NOT_SPECIFIED        = 56
+	ERROR_CODE_PRINTF_REPLACE_VALUE_LENGTH       = 57
+	ERROR_CODE_SOURCE_INVALID_ARGUMENT           = 58
+	ERROR_CODE_SOURCE_FILE_NOT_EXIST             = 59
+	ERROR_CODE_SOURCE_FILE_UNABLE_TO_READ        = 60
+	ERROR_CODE_INVALID_FLAG_NAME                 = 61
+	ERROR_CODE_INVALID_FLAG_VALUE                = 62
+	ERROR_CODE_INVALID_ESCAPE_CHARACTER          = 63
+	ERROR_CODE_INVALID_REGEXP_PATTERN            = 64
+	ERROR_CODE_FIELD_POSITION_OUT_OF_RANGE       = 65
+	ERROR_CODE_SAVEPOINT_NOT_EXIST               = 66
+	ERROR_CODE_READ_ONLY                         = 67
+	ERROR_CODE_EXPORT_INVALID_ARGUMENT           = 68
+	ERROR_CODE_EXPORT_INVALID_OPTION_NAME        = 69
+	ERROR_CODE_EXPORT_INVALID_OPTION_VALUE       = 70
+	ERROR_CODE_INVALID_TABLE_OPTION              = 71
+	ERROR_CODE_TABLE_OPTION_NOT_APPLICABLE       = 72
+	ERROR_CODE_TABLE_OPTIONS_CONFLICT            = 73
+	ERROR_CODE_DIFF_DUPLICATE_KEY                = 74
+	ERROR_CODE_XLSX_PARSING                      = 75
+	ERROR_CODE_FILE_NOT_UPDATABLE                = 76
+	ERROR_CODE_INVALID_ASOF_JOIN_CONDITION       = 77
+	ERROR_CODE_FILE_MODIFIED                     = 78
+	ERROR_CODE_INVALID_TIME_ZONE                 = 79
+	ERROR_CODE_INVALID_SCHEMA_TYPE               = 80
+	ERROR_CODE_REPOSITORY_INVALID_ARGUMENT       = 81
+	ERROR_CODE_REPOSITORY_NOT_EXIST              = 82
+	ERROR_CODE_CURSOR_NOT_SCROLLABLE             = 83
+	ERROR_CODE_UNDEFINED_LOOP_LABEL              = 84
+	ERROR_CODE_LOOP_ITERATIONS_EXCEEDED          = 85
+	ERROR_CODE_RESULT_ROWS_EXCEEDED              = 86
+	ERROR_CODE_DROP_TEMPORARY_TABLE              = 87
+	ERROR_CODE_RENAME_TEMPORARY_TABLE            = 88
+	ERROR_CODE_COMBINED_SET_FIELD_TYPE           = 89
+	ERROR_CODE_VALUES_TABLE_ROW_VALUE_LENGTH     = 90
+	ERROR_CODE_TABLE_ALIAS_FIELD_LENGTH          = 91
+	ERROR_CODE_UPDATE_COMPUTED_FIELD             = 92
+	ERROR_CODE_ANALYTIC_FUNCTION_WITHOUT_OVER    = 93
+	ERROR_CODE_SCALAR_FUNCTION_WITH_OVER         = 94
+	ERROR_CODE_SELECT_INTO_FIELD_LENGTH          = 95
+	ERROR_CODE_SELECT_INTO_TOO_MANY_RECORDS      = 96
+	ERROR_CODE_SELECT_INTO_NO_RECORD             = 97
+	ERROR_CODE_UNNEST_NOT_ARRAY                  = 98
+	ERROR_CODE_GLOB_HEADER_NOT_MATCH             = 99
+	ERROR_CODE_FLAG_NOT_SETTABLE                 = 100
+	ERROR_CODE_SKIPPED_LINES_NOT_UPDATABLE       = 101
+
+	ERROR_CODE_INTERNAL_RECORD_ID_NOT_EXIST   = 901
+	ERROR_CODE_INTERNAL_RECORD_ID_EMPTY       = 902
+	ERROR_CODE_FIELD_LENGTH_NOT_MATCH         = 903
+	ERROR_CODE_ROW_VALUE_LENGTH_NOT_MATCH     = 904
+	ERROR_CODE_ROW_VALUE_LENGTH_IN_LIST       = 905
+	ERROR_CODE_FORMAT_STRING_LENGTH_NOT_MATCH = 906
+	ERROR_CODE_INVALID_FORMAT_TOKEN           = 907
 )
 
 type Exit struct {
@@ -105,6 +258,9 @@ type AppError interface {
 	Error() string
 	ErrorMessage() string
 	GetCode() int
+	GetErrorCode() int
+	GetLine() int
+	GetChar() int
 }
 
 type BaseError struct {
@@ -113,6 +269,7 @@ type BaseError struct {
 	Char       int
 	Message    string
 	Code       int
+	ErrorCode  int
 }
 
 func (e BaseError) Error() string {
@@ -133,11 +290,52 @@ func (e BaseError) GetCode() int {
 	return e.Code
 }
 
-func NewBaseError(expr parser.Expression, message string) *BaseError {
-	return NewBaseErrorWithCode(expr, message, 1)
+func (e BaseError) GetErrorCode() int {
+	return e.ErrorCode
+}
+
+func (e BaseError) GetLine() int {
+	return e.Line
+}
+
+func (e BaseError) GetChar() int {
+	return e.Char
+}
+
+type errorReport struct {
+	Line    interface{} `json:"line"`
+	Column  interface{} `json:"column"`
+	Message string      `json:"message"`
+	Code    interface{} `json:"code"`
 }
 
-func NewBaseErrorWithCode(expr parser.Expression, message string, code int) *BaseError {
+func EncodeErrorToJson(err error) string {
+	report := errorReport{
+		Message: err.Error(),
+	}
+
+	switch err.(type) {
+	case AppError:
+		apperr := err.(AppError)
+		if 0 < apperr.GetLine() {
+			report.Line = apperr.GetLine()
+			report.Column = apperr.GetChar()
+		}
+		report.Message = apperr.ErrorMessage()
+		report.Code = apperr.GetErrorCode()
+	case *AutoCommitError:
+		report.Code = ERROR_CODE_WRITE_FILE_IN_AUTOCOMMIT
+	}
+
+	b, _ := json.Marshal(report)
+	return string(b)
+}
+
+func NewBaseError(expr parser.Expression, message string, errorCode int) *BaseError {
+	return NewBaseErrorWithCode(expr, message, errorCode, 1)
+}
+
+func NewBaseErrorWithCode(expr parser.Expression, message string, errorCode int, code int) *BaseError {
 	var sourceFile string
 	var line int
 	var char int
@@ -153,6 +351,7 @@ func NewBaseErrorWithCode(expr parser.Expression, message string, code int) *Bas
 		Char:       char,
 		Message:    message,
 		Code:       code,
+		ErrorCode:  errorCode,
 	}
 }
 
@@ -167,7 +366,7 @@ func NewUserTriggeredError(expr parser.Trigger, message string) error {
 	}
 
 	return &UserTriggeredError{
-		NewBaseErrorWithCode(expr, message, code),
+		NewBaseErrorWithCode(expr, message, ERROR_CODE_USER_TRIGGERED, code),
 	}
 }
 
@@ -183,13 +382,14 @@ func NewSyntaxError(message string, line int, char int, sourceFile string) error
 			Char:       char,
 			Message:    message,
 			Code:       1,
+			ErrorCode:  ERROR_CODE_INVALID_SYNTAX,
 		},
 	}
 }
 
 func NewSyntaxErrorFromExpr(expr parser.QueryExpression) error {
 	return &SyntaxError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_INVALID_SYNTAX, expr)),
+		NewBaseError(expr, fmt.Sprintf(ERROR_INVALID_SYNTAX, expr), ERROR_CODE_INVALID_SYNTAX),
 	}
 }
 
@@ -199,7 +399,7 @@ type ReadFileError struct {
 
 func NewReadFileError(expr parser.Expression, message string) error {
 	return &ReadFileError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_READ_FILE, message)),
+		NewBaseError(expr, fmt.Sprintf(ERROR_READ_FILE, message), ERROR_CODE_READ_FILE),
 	}
 }
 
@@ -209,7 +409,7 @@ type CreateFileError struct {
 
 func NewCreateFileError(expr parser.Expression, message string) error {
 	return &CreateFileError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_CREATE_FILE, message)),
+		NewBaseError(expr, fmt.Sprintf(ERROR_CREATE_FILE, message), ERROR_CODE_CREATE_FILE),
 	}
 }
 
@@ -219,7 +419,7 @@ type WriteFileError struct {
 
 func NewWriteFileError(expr parser.Expression, message string) error {
 	return &WriteFileError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_WRITE_FILE, message)),
+		NewBaseError(expr, fmt.Sprintf(ERROR_WRITE_FILE, message), ERROR_CODE_WRITE_FILE),
 	}
 }
 
@@ -243,7 +443,7 @@ type FieldAmbiguousError struct {
 
 func NewFieldAmbiguousError(field parser.QueryExpression) error {
 	return &FieldAmbiguousError{
-		NewBaseError(field, fmt.Sprintf(ERROR_FIELD_AMBIGUOUS, field)),
+		NewBaseError(field, fmt.Sprintf(ERROR_FIELD_AMBIGUOUS, field), ERROR_CODE_FIELD_AMBIGUOUS),
 	}
 }
 
@@ -253,7 +453,7 @@ type FieldNotExistError struct {
 
 func NewFieldNotExistError(field parser.QueryExpression) error {
 	return &FieldNotExistError{
-		NewBaseError(field, fmt.Sprintf(ERROR_FIELD_NOT_EXIST, field)),
+		NewBaseError(field, fmt.Sprintf(ERROR_FIELD_NOT_EXIST, field), ERROR_CODE_FIELD_NOT_EXIST),
 	}
 }
 
@@ -263,7 +463,7 @@ type FieldNotGroupKeyError struct {
 
 func NewFieldNotGroupKeyError(field parser.QueryExpression) error {
 	return &FieldNotGroupKeyError{
-		NewBaseError(field, fmt.Sprintf(ERROR_FIELD_NOT_GROUP_KEY, field)),
+		NewBaseError(field, fmt.Sprintf(ERROR_FIELD_NOT_GROUP_KEY, field), ERROR_CODE_FIELD_NOT_GROUP_KEY),
 	}
 }
 
@@ -273,7 +473,7 @@ type DuplicateFieldNameError struct {
 
 func NewDuplicateFieldNameError(fieldName parser.Identifier) error {
 	return &DuplicateFieldNameError{
-		NewBaseError(fieldName, fmt.Sprintf(ERROR_DUPLICATE_FIELD_NAME, fieldName)),
+		NewBaseError(fieldName, fmt.Sprintf(ERROR_DUPLICATE_FIELD_NAME, fieldName), ERROR_CODE_DUPLICATE_FIELD_NAME),
 	}
 }
 
@@ -283,7 +483,7 @@ type NotGroupingRecordsError struct {
 
 func NewNotGroupingRecordsError(expr parser.QueryExpression, funcname string) error {
 	return &NotGroupingRecordsError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_NOT_GROUPING_RECORDS, funcname)),
+		NewBaseError(expr, fmt.Sprintf(ERROR_NOT_GROUPING_RECORDS, funcname), ERROR_CODE_NOT_GROUPING_RECORDS),
 	}
 }
 
@@ -293,7 +493,7 @@ type UndeclaredVariableError struct {
 
 func NewUndeclaredVariableError(expr parser.Variable) error {
 	return &UndeclaredVariableError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_UNDECLARED_VARIABLE, expr)),
+		NewBaseError(expr, fmt.Sprintf(ERROR_UNDECLARED_VARIABLE, expr), ERROR_CODE_UNDECLARED_VARIABLE),
 	}
 }
 
@@ -303,7 +503,7 @@ type VariableRedeclaredError struct {
 
 func NewVariableRedeclaredError(expr parser.Variable) error {
 	return &VariableRedeclaredError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_VARIABLE_REDECLARED, expr)),
+		NewBaseError(expr, fmt.Sprintf(ERROR_VARIABLE_REDECLARED, expr), ERROR_CODE_VARIABLE_REDECLARED),
 	}
 }
 
@@ -313,7 +513,7 @@ type FunctionNotExistError struct {
 
 func NewFunctionNotExistError(expr parser.QueryExpression, funcname string) error {
 	return &FunctionNotExistError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_FUNCTION_NOT_EXIST, funcname)),
+		NewBaseError(expr, fmt.Sprintf(ERROR_FUNCTION_NOT_EXIST, funcname), ERROR_CODE_FUNCTION_NOT_EXIST),
 	}
 }
 
@@ -338,13 +538,13 @@ func NewFunctionArgumentLengthError(expr parser.QueryExpression, funcname string
 		}
 	}
 	return &FunctionArgumentLengthError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_FUNCTION_ARGUMENT_LENGTH, funcname, argstr)),
+		NewBaseError(expr, fmt.Sprintf(ERROR_FUNCTION_ARGUMENT_LENGTH, funcname, argstr), ERROR_CODE_FUNCTION_ARGUMENT_LENGTH),
 	}
 }
 
 func NewFunctionArgumentLengthErrorWithCustomArgs(expr parser.QueryExpression, funcname string, argstr string) error {
 	return &FunctionArgumentLengthError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_FUNCTION_ARGUMENT_LENGTH, funcname, argstr)),
+		NewBaseError(expr, fmt.Sprintf(ERROR_FUNCTION_ARGUMENT_LENGTH, funcname, argstr), ERROR_CODE_FUNCTION_ARGUMENT_LENGTH),
 	}
 }
 
@@ -354,7 +554,7 @@ type FunctionInvalidArgumentError struct {
 
 func NewFunctionInvalidArgumentError(function parser.QueryExpression, funcname string, message string) error {
 	return &FunctionInvalidArgumentError{
-		NewBaseError(function, fmt.Sprintf(ERROR_FUNCTION_INVALID_ARGUMENT, message, funcname)),
+		NewBaseError(function, fmt.Sprintf(ERROR_FUNCTION_INVALID_ARGUMENT, message, funcname), ERROR_CODE_FUNCTION_INVALID_ARGUMENT),
 	}
 }
 
@@ -364,7 +564,7 @@ type UnpermittedStatementFunctionError struct {
 
 func NewUnpermittedStatementFunctionError(expr parser.QueryExpression, funcname string) error {
 	return &UnpermittedStatementFunctionError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_UNPERMITTED_STATEMENT_FUNCTION, funcname)),
+		NewBaseError(expr, fmt.Sprintf(ERROR_UNPERMITTED_STATEMENT_FUNCTION, funcname), ERROR_CODE_UNPERMITTED_STATEMENT_FUNCTION),
 	}
 }
 
@@ -374,7 +574,7 @@ type NestedAggregateFunctionsError struct {
 
 func NewNestedAggregateFunctionsError(expr parser.QueryExpression) error {
 	return &NestedAggregateFunctionsError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_NESTED_AGGREGATE_FUNCTIONS, expr)),
+		NewBaseError(expr, fmt.Sprintf(ERROR_NESTED_AGGREGATE_FUNCTIONS, expr), ERROR_CODE_NESTED_AGGREGATE_FUNCTIONS),
 	}
 }
 
@@ -384,7 +584,7 @@ type FunctionRedeclaredError struct {
 
 func NewFunctionRedeclaredError(expr parser.Identifier) error {
 	return &FunctionRedeclaredError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_FUNCTION_REDECLARED, expr.Literal)),
+		NewBaseError(expr, fmt.Sprintf(ERROR_FUNCTION_REDECLARED, expr.Literal), ERROR_CODE_FUNCTION_REDECLARED),
 	}
 }
 
@@ -394,7 +594,7 @@ type BuiltInFunctionDeclaredError struct {
 
 func NewBuiltInFunctionDeclaredError(expr parser.Identifier) error {
 	return &BuiltInFunctionDeclaredError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_BUILT_IN_FUNCTION_DECLARED, expr.Literal)),
+		NewBaseError(expr, fmt.Sprintf(ERROR_BUILT_IN_FUNCTION_DECLARED, expr.Literal), ERROR_CODE_BUILT_IN_FUNCTION_DECLARED),
 	}
 }
 
@@ -404,7 +604,7 @@ type DuplicateParameterError struct {
 
 func NewDuplicateParameterError(expr parser.Variable) error {
 	return &DuplicateParameterError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_DUPLICATE_PARAMETER, expr.Name)),
+		NewBaseError(expr, fmt.Sprintf(ERROR_DUPLICATE_PARAMETER, expr.Name), ERROR_CODE_DUPLICATE_PARAMETER),
 	}
 }
 
@@ -414,7 +614,7 @@ type SubqueryTooManyRecordsError struct {
 
 func NewSubqueryTooManyRecordsError(expr parser.Subquery) error {
 	return &SubqueryTooManyRecordsError{
-		NewBaseError(expr, ERROR_SUBQUERY_TOO_MANY_RECORDS),
+		NewBaseError(expr, ERROR_SUBQUERY_TOO_MANY_RECORDS, ERROR_CODE_SUBQUERY_TOO_MANY_RECORDS),
 	}
 }
 
@@ -424,7 +624,7 @@ type SubqueryTooManyFieldsError struct {
 
 func NewSubqueryTooManyFieldsError(expr parser.Subquery) error {
 	return &SubqueryTooManyFieldsError{
-		NewBaseError(expr, ERROR_SUBQUERY_TOO_MANY_FIELDS),
+		NewBaseError(expr, ERROR_SUBQUERY_TOO_MANY_FIELDS, ERROR_CODE_SUBQUERY_TOO_MANY_FIELDS),
 	}
 }
 
@@ -434,7 +634,7 @@ type CursorRedeclaredError struct {
 
 func NewCursorRedeclaredError(cursor parser.Identifier) error {
 	return &CursorRedeclaredError{
-		NewBaseError(cursor, fmt.Sprintf(ERROR_CURSOR_REDECLARED, cursor)),
+		NewBaseError(cursor, fmt.Sprintf(ERROR_CURSOR_REDECLARED, cursor), ERROR_CODE_CURSOR_REDECLARED),
 	}
 }
 
@@ -444,7 +644,7 @@ type UndeclaredCursorError struct {
 
 func NewUndeclaredCursorError(cursor parser.Identifier) error {
 	return &UndeclaredCursorError{
-		NewBaseError(cursor, fmt.Sprintf(ERROR_UNDECLARED_CURSOR, cursor)),
+		NewBaseError(cursor, fmt.Sprintf(ERROR_UNDECLARED_CURSOR, cursor), ERROR_CODE_UNDECLARED_CURSOR),
 	}
 }
 
@@ -454,7 +654,7 @@ type CursorClosedError struct {
 
 func NewCursorClosedError(cursor parser.Identifier) error {
 	return &CursorClosedError{
-		NewBaseError(cursor, fmt.Sprintf(ERROR_CURSOR_CLOSED, cursor)),
+		NewBaseError(cursor, fmt.Sprintf(ERROR_CURSOR_CLOSED, cursor), ERROR_CODE_CURSOR_CLOSED),
 	}
 }
 
@@ -464,7 +664,7 @@ type CursorOpenError struct {
 
 func NewCursorOpenError(cursor parser.Identifier) error {
 	return &CursorOpenError{
-		NewBaseError(cursor, fmt.Sprintf(ERROR_CURSOR_OPEN, cursor)),
+		NewBaseError(cursor, fmt.Sprintf(ERROR_CURSOR_OPEN, cursor), ERROR_CODE_CURSOR_OPEN),
 	}
 }
 
@@ -474,7 +674,17 @@ type PseudoCursorError struct {
 
 func NewPseudoCursorError(cursor parser.Identifier) error {
 	return &PseudoCursorError{
-		NewBaseError(cursor, fmt.Sprintf(ERROR_PSEUDO_CURSOR, cursor)),
+		NewBaseError(cursor, fmt.Sprintf(ERROR_PSEUDO_CURSOR, cursor), ERROR_CODE_PSEUDO_CURSOR),
+	}
+}
+
+type CursorNotScrollableError struct {
+	*BaseError
+}
+
+func NewCursorNotScrollableError(cursor parser.Identifier, position int) error {
+	return &CursorNotScrollableError{
+		NewBaseError(cursor, fmt.Sprintf(ERROR_CURSOR_NOT_SCROLLABLE, cursor, parser.TokenLiteral(position)), ERROR_CODE_CURSOR_NOT_SCROLLABLE),
 	}
 }
 
@@ -484,7 +694,7 @@ type CursorFetchLengthError struct {
 
 func NewCursorFetchLengthError(cursor parser.Identifier, returnLen int) error {
 	return &CursorFetchLengthError{
-		NewBaseError(cursor, fmt.Sprintf(ERROR_CURSOR_FETCH_LENGTH, cursor, FormatCount(returnLen, "value"))),
+		NewBaseError(cursor, fmt.Sprintf(ERROR_CURSOR_FETCH_LENGTH, cursor, FormatCount(returnLen, "value")), ERROR_CODE_CURSOR_FETCH_LENGTH),
 	}
 }
 
@@ -494,7 +704,7 @@ type InvalidFetchPositionError struct {
 
 func NewInvalidFetchPositionError(position parser.FetchPosition) error {
 	return &InvalidFetchPositionError{
-		NewBaseError(position, fmt.Sprintf(ERROR_INVALID_FETCH_POSITION, position.Number)),
+		NewBaseError(position, fmt.Sprintf(ERROR_INVALID_FETCH_POSITION, position.Number), ERROR_CODE_INVALID_FETCH_POSITION),
 	}
 }
 
@@ -504,7 +714,7 @@ type InLineTableRedefinedError struct {
 
 func NewInLineTableRedefinedError(table parser.Identifier) error {
 	return &InLineTableRedefinedError{
-		NewBaseError(table, fmt.Sprintf(ERROR_INLINE_TABLE_REDEFINED, table)),
+		NewBaseError(table, fmt.Sprintf(ERROR_INLINE_TABLE_REDEFINED, table), ERROR_CODE_INLINE_TABLE_REDEFINED),
 	}
 }
 
@@ -514,7 +724,7 @@ type UndefinedInLineTableError struct {
 
 func NewUndefinedInLineTableError(table parser.Identifier) error {
 	return &UndefinedInLineTableError{
-		NewBaseError(table, fmt.Sprintf(ERROR_UNDEFINED_INLINE_TABLE, table)),
+		NewBaseError(table, fmt.Sprintf(ERROR_UNDEFINED_INLINE_TABLE, table), ERROR_CODE_UNDEFINED_INLINE_TABLE),
 	}
 }
 
@@ -526,7 +736,7 @@ func NewInlineTableFieldLengthError(query parser.SelectQuery, table parser.Ident
 	selectClause := searchSelectClause(query)
 
 	return &InlineTableFieldLengthError{
-		NewBaseError(selectClause, fmt.Sprintf(ERROR_INLINE_TABLE_FIELD_LENGTH, FormatCount(fieldLen, "field"), table)),
+		NewBaseError(selectClause, fmt.Sprintf(ERROR_INLINE_TABLE_FIELD_LENGTH, FormatCount(fieldLen, "field"), table), ERROR_CODE_INLINE_TABLE_FIELD_LENGTH),
 	}
 }
 
@@ -536,7 +746,7 @@ type FileNotExistError struct {
 
 func NewFileNotExistError(file parser.Identifier) error {
 	return &FileNotExistError{
-		NewBaseError(file, fmt.Sprintf(ERROR_FILE_NOT_EXIST, file)),
+		NewBaseError(file, fmt.Sprintf(ERROR_FILE_NOT_EXIST, file), ERROR_CODE_FILE_NOT_EXIST),
 	}
 }
 
@@ -546,7 +756,7 @@ type FileAlreadyExistError struct {
 
 func NewFileAlreadyExistError(file parser.Identifier) error {
 	return &FileAlreadyExistError{
-		NewBaseError(file, fmt.Sprintf(ERROR_FILE_ALREADY_EXIST, file)),
+		NewBaseError(file, fmt.Sprintf(ERROR_FILE_ALREADY_EXIST, file.Literal), ERROR_CODE_FILE_ALREADY_EXIST),
 	}
 }
 
@@ -556,7 +766,7 @@ type FileUnableToReadError struct {
 
 func NewFileUnableToReadError(file parser.Identifier) error {
 	return &FileUnableToReadError{
-		NewBaseError(file, fmt.Sprintf(ERROR_FILE_UNABLE_TO_READ, file)),
+		NewBaseError(file, fmt.Sprintf(ERROR_FILE_UNABLE_TO_READ, file), ERROR_CODE_FILE_UNABLE_TO_READ),
 	}
 }
 
@@ -566,7 +776,7 @@ type FileLockTimeoutError struct {
 
 func NewFileLockTimeoutError(file parser.Identifier, path string) error {
 	return &FileLockTimeoutError{
-		NewBaseError(file, fmt.Sprintf(ERROR_FILE_LOCK_TIMEOUT, path)),
+		NewBaseError(file, fmt.Sprintf(ERROR_FILE_LOCK_TIMEOUT, path), ERROR_CODE_FILE_LOCK_TIMEOUT),
 	}
 }
 
@@ -576,7 +786,7 @@ type CsvParsingError struct {
 
 func NewCsvParsingError(file parser.QueryExpression, filepath string, message string) error {
 	return &CsvParsingError{
-		NewBaseError(file, fmt.Sprintf(ERROR_CSV_PARSING, filepath, message)),
+		NewBaseError(file, fmt.Sprintf(ERROR_CSV_PARSING, filepath, message), ERROR_CODE_CSV_PARSING),
 	}
 }
 
@@ -588,7 +798,7 @@ func NewTableFieldLengthError(query parser.SelectQuery, table parser.Identifier,
 	selectClause := searchSelectClause(query)
 
 	return &TableFieldLengthError{
-		NewBaseError(selectClause, fmt.Sprintf(ERROR_TABLE_FIELD_LENGTH, FormatCount(fieldLen, "field"), table)),
+		NewBaseError(selectClause, fmt.Sprintf(ERROR_TABLE_FIELD_LENGTH, FormatCount(fieldLen, "field"), table), ERROR_CODE_TABLE_FIELD_LENGTH),
 	}
 }
 
@@ -598,7 +808,7 @@ type TemporaryTableRedeclaredError struct {
 
 func NewTemporaryTableRedeclaredError(table parser.Identifier) error {
 	return &TemporaryTableRedeclaredError{
-		NewBaseError(table, fmt.Sprintf(ERROR_TEMPORARY_TABLE_REDECLARED, table)),
+		NewBaseError(table, fmt.Sprintf(ERROR_TEMPORARY_TABLE_REDECLARED, table), ERROR_CODE_TEMPORARY_TABLE_REDECLARED),
 	}
 }
 
@@ -608,7 +818,7 @@ type UndeclaredTemporaryTableError struct {
 
 func NewUndeclaredTemporaryTableError(table parser.Identifier) error {
 	return &UndeclaredTemporaryTableError{
-		NewBaseError(table, fmt.Sprintf(ERROR_UNDECLARED_TEMPORARY_TABLE, table)),
+		NewBaseError(table, fmt.Sprintf(ERROR_UNDECLARED_TEMPORARY_TABLE, table), ERROR_CODE_UNDECLARED_TEMPORARY_TABLE),
 	}
 }
 
@@ -620,7 +830,7 @@ func NewTemporaryTableFieldLengthError(query parser.SelectQuery, table parser.Id
 	selectClause := searchSelectClause(query)
 
 	return &TemporaryTableFieldLengthError{
-		NewBaseError(selectClause, fmt.Sprintf(ERROR_TEMPORARY_TABLE_FIELD_LENGTH, FormatCount(fieldLen, "field"), table)),
+		NewBaseError(selectClause, fmt.Sprintf(ERROR_TEMPORARY_TABLE_FIELD_LENGTH, FormatCount(fieldLen, "field"), table), ERROR_CODE_TEMPORARY_TABLE_FIELD_LENGTH),
 	}
 }
 
@@ -630,7 +840,7 @@ type DuplicateTableNameError struct {
 
 func NewDuplicateTableNameError(table parser.Identifier) error {
 	return &DuplicateTableNameError{
-		NewBaseError(table, fmt.Sprintf(ERROR_DUPLICATE_TABLE_NAME, table)),
+		NewBaseError(table, fmt.Sprintf(ERROR_DUPLICATE_TABLE_NAME, table), ERROR_CODE_DUPLICATE_TABLE_NAME),
 	}
 }
 
@@ -640,7 +850,7 @@ type TableNotLoadedError struct {
 
 func NewTableNotLoadedError(table parser.Identifier) error {
 	return &TableNotLoadedError{
-		NewBaseError(table, fmt.Sprintf(ERROR_TABLE_NOT_LOADED, table)),
+		NewBaseError(table, fmt.Sprintf(ERROR_TABLE_NOT_LOADED, table), ERROR_CODE_TABLE_NOT_LOADED),
 	}
 }
 
@@ -650,7 +860,7 @@ type StdinEmptyError struct {
 
 func NewStdinEmptyError(stdin parser.Stdin) error {
 	return &StdinEmptyError{
-		NewBaseError(stdin, ERROR_STDIN_EMPTY),
+		NewBaseError(stdin, ERROR_STDIN_EMPTY, ERROR_CODE_STDIN_EMPTY),
 	}
 }
 
@@ -660,7 +870,7 @@ type RowValueLengthInComparisonError struct {
 
 func NewRowValueLengthInComparisonError(expr parser.QueryExpression, valueLen int) error {
 	return &RowValueLengthInComparisonError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_ROW_VALUE_LENGTH_IN_COMPARISON, FormatCount(valueLen, "value"))),
+		NewBaseError(expr, fmt.Sprintf(ERROR_ROW_VALUE_LENGTH_IN_COMPARISON, FormatCount(valueLen, "value")), ERROR_CODE_ROW_VALUE_LENGTH_IN_COMPARISON),
 	}
 }
 
@@ -670,7 +880,7 @@ type SelectFieldLengthInComparisonError struct {
 
 func NewSelectFieldLengthInComparisonError(query parser.Subquery, valueLen int) error {
 	return &SelectFieldLengthInComparisonError{
-		NewBaseError(query, fmt.Sprintf(ERROR_SELECT_FIELD_LENGTH_IN_COMPARISON, FormatCount(valueLen, "field"))),
+		NewBaseError(query, fmt.Sprintf(ERROR_SELECT_FIELD_LENGTH_IN_COMPARISON, FormatCount(valueLen, "field")), ERROR_CODE_SELECT_FIELD_LENGTH_IN_COMPARISON),
 	}
 }
 
@@ -680,7 +890,7 @@ type InvalidLimitPercentageError struct {
 
 func NewInvalidLimitPercentageError(clause parser.LimitClause) error {
 	return &InvalidLimitPercentageError{
-		NewBaseError(clause, fmt.Sprintf(ERROR_INVALID_LIMIT_PERCENTAGE, clause.Value)),
+		NewBaseError(clause, fmt.Sprintf(ERROR_INVALID_LIMIT_PERCENTAGE, clause.Value), ERROR_CODE_INVALID_LIMIT_PERCENTAGE),
 	}
 }
 
@@ -690,7 +900,7 @@ type InvalidLimitNumberError struct {
 
 func NewInvalidLimitNumberError(clause parser.LimitClause) error {
 	return &InvalidLimitNumberError{
-		NewBaseError(clause, fmt.Sprintf(ERROR_INVALID_LIMIT_NUMBER, clause.Value)),
+		NewBaseError(clause, fmt.Sprintf(ERROR_INVALID_LIMIT_NUMBER, clause.Value), ERROR_CODE_INVALID_LIMIT_NUMBER),
 	}
 }
 
@@ -700,7 +910,7 @@ type InvalidOffsetNumberError struct {
 
 func NewInvalidOffsetNumberError(clause parser.OffsetClause) error {
 	return &InvalidOffsetNumberError{
-		NewBaseError(clause, fmt.Sprintf(ERROR_INVALID_OFFSET_NUMBER, clause.Value)),
+		NewBaseError(clause, fmt.Sprintf(ERROR_INVALID_OFFSET_NUMBER, clause.Value), ERROR_CODE_INVALID_OFFSET_NUMBER),
 	}
 }
 
@@ -712,7 +922,7 @@ func NewCombinedSetFieldLengthError(selectEntity parser.QueryExpression, fieldLe
 	selectClause := searchSelectClauseInSelectEntity(selectEntity)
 
 	return &CombinedSetFieldLengthError{
-		NewBaseError(selectClause, fmt.Sprintf(ERROR_COMBINED_SET_FIELD_LENGTH, FormatCount(fieldLen, "field"))),
+		NewBaseError(selectClause, fmt.Sprintf(ERROR_COMBINED_SET_FIELD_LENGTH, FormatCount(fieldLen, "field")), ERROR_CODE_COMBINED_SET_FIELD_LENGTH),
 	}
 }
 
@@ -722,7 +932,7 @@ type InsertRowValueLengthError struct {
 
 func NewInsertRowValueLengthError(rowValue parser.RowValue, valueLen int) error {
 	return &InsertRowValueLengthError{
-		NewBaseError(rowValue, fmt.Sprintf(ERROR_INSERT_ROW_VALUE_LENGTH, FormatCount(valueLen, "value"))),
+		NewBaseError(rowValue, fmt.Sprintf(ERROR_INSERT_ROW_VALUE_LENGTH, FormatCount(valueLen, "value")), ERROR_CODE_INSERT_ROW_VALUE_LENGTH),
 	}
 }
 
@@ -734,7 +944,7 @@ func NewInsertSelectFieldLengthError(query parser.SelectQuery, fieldLen int) err
 	selectClause := searchSelectClause(query)
 
 	return &InsertSelectFieldLengthError{
-		NewBaseError(selectClause, fmt.Sprintf(ERROR_INSERT_SELECT_FIELD_LENGTH, FormatCount(fieldLen, "field"))),
+		NewBaseError(selectClause, fmt.Sprintf(ERROR_INSERT_SELECT_FIELD_LENGTH, FormatCount(fieldLen, "field")), ERROR_CODE_INSERT_SELECT_FIELD_LENGTH),
 	}
 }
 
@@ -744,7 +954,7 @@ type UpdateFieldNotExistError struct {
 
 func NewUpdateFieldNotExistError(field parser.QueryExpression) error {
 	return &UpdateFieldNotExistError{
-		NewBaseError(field, fmt.Sprintf(ERROR_UPDATE_FIELD_NOT_EXIST, field)),
+		NewBaseError(field, fmt.Sprintf(ERROR_UPDATE_FIELD_NOT_EXIST, field), ERROR_CODE_UPDATE_FIELD_NOT_EXIST),
 	}
 }
 
@@ -754,7 +964,7 @@ type UpdateValueAmbiguousError struct {
 
 func NewUpdateValueAmbiguousError(field parser.QueryExpression, value parser.QueryExpression) error {
 	return &UpdateValueAmbiguousError{
-		NewBaseError(field, fmt.Sprintf(ERROR_UPDATE_VALUE_AMBIGUOUS, value, field)),
+		NewBaseError(field, fmt.Sprintf(ERROR_UPDATE_VALUE_AMBIGUOUS, value, field), ERROR_CODE_UPDATE_VALUE_AMBIGUOUS),
 	}
 }
 
@@ -764,7 +974,7 @@ type DeleteTableNotSpecifiedError struct {
 
 func NewDeleteTableNotSpecifiedError(query parser.DeleteQuery) error {
 	return &DeleteTableNotSpecifiedError{
-		NewBaseError(query, ERROR_DELETE_TABLE_NOT_SPECIFIED),
+		NewBaseError(query, ERROR_DELETE_TABLE_NOT_SPECIFIED, ERROR_CODE_DELETE_TABLE_NOT_SPECIFIED),
 	}
 }
 
@@ -774,7 +984,7 @@ type PrintfReplaceValueLengthError struct {
 
 func NewPrintfReplaceValueLengthError(printf parser.Printf, message string) error {
 	return &PrintfReplaceValueLengthError{
-		NewBaseError(printf, fmt.Sprintf(ERROR_PRINTF_REPLACE_VALUE_LENGTH, message)),
+		NewBaseError(printf, fmt.Sprintf(ERROR_PRINTF_REPLACE_VALUE_LENGTH, message), ERROR_CODE_PRINTF_REPLACE_VALUE_LENGTH),
 	}
 }
 
@@ -784,7 +994,7 @@ type SourceInvalidArgumentError struct {
 
 func NewSourceInvalidArgumentError(source parser.Source, arg parser.QueryExpression) error {
 	return &SourceInvalidArgumentError{
-		NewBaseError(source, fmt.Sprintf(ERROR_SOURCE_INVALID_ARGUMENT, arg)),
+		NewBaseError(source, fmt.Sprintf(ERROR_SOURCE_INVALID_ARGUMENT, arg), ERROR_CODE_SOURCE_INVALID_ARGUMENT),
 	}
 }
 
@@ -794,7 +1004,7 @@ type SourceFileNotExistError struct {
 
 func NewSourceFileNotExistError(source parser.Source, fpath string) error {
 	return &SourceFileNotExistError{
-		NewBaseError(source, fmt.Sprintf(ERROR_SOURCE_FILE_NOT_EXIST, fpath)),
+		NewBaseError(source, fmt.Sprintf(ERROR_SOURCE_FILE_NOT_EXIST, fpath), ERROR_CODE_SOURCE_FILE_NOT_EXIST),
 	}
 }
 
@@ -804,7 +1014,7 @@ type SourceFileUnableToReadError struct {
 
 func NewSourceFileUnableToReadError(source parser.Source, fpath string) error {
 	return &SourceFileUnableToReadError{
-		NewBaseError(source, fmt.Sprintf(ERROR_SOURCE_FILE_UNABLE_TO_READ, fpath)),
+		NewBaseError(source, fmt.Sprintf(ERROR_SOURCE_FILE_UNABLE_TO_READ, fpath), ERROR_CODE_SOURCE_FILE_UNABLE_TO_READ),
 	}
 }
 
@@ -814,7 +1024,17 @@ type InvalidFlagNameError struct {
 
 func NewInvalidFlagNameError(expr parser.Expression, name string) error {
 	return &InvalidFlagNameError{
-		NewBaseError(expr, fmt.Sprintf(ERROR_INVALID_FLAG_NAME, name)),
+		NewBaseError(expr, fmt.Sprintf(ERROR_INVALID_FLAG_NAME, name), ERROR_CODE_INVALID_FLAG_NAME),
+	}
+}
+
+type FlagNotSettableError struct {
+	*BaseError
+}
+
+func NewFlagNotSettableError(setFlag parser.SetFlag) error {
+	return &FlagNotSettableError{
+		NewBaseError(setFlag, fmt.Sprintf(ERROR_FLAG_NOT_SETTABLE, setFlag.Name), ERROR_CODE_FLAG_NOT_SETTABLE),
 	}
 }
 
@@ -824,7 +1044,379 @@ type InvalidFlagValueError struct {
 
 func NewInvalidFlagValueError(setFlag parser.SetFlag) error {
 	return &InvalidFlagValueError{
-		NewBaseError(setFlag, fmt.Sprintf(ERROR_INVALID_FLAG_VALUE, setFlag.Value, setFlag.Name)),
+		NewBaseError(setFlag, fmt.Sprintf(ERROR_INVALID_FLAG_VALUE, setFlag.Value, setFlag.Name), ERROR_CODE_INVALID_FLAG_VALUE),
+	}
+}
+
+type InvalidEscapeCharacterError struct {
+	*BaseError
+}
+
+func NewInvalidEscapeCharacterError(expr parser.QueryExpression, escape value.Primary) error {
+	return &InvalidEscapeCharacterError{
+		NewBaseError(expr, fmt.Sprintf(ERROR_INVALID_ESCAPE_CHARACTER, escape), ERROR_CODE_INVALID_ESCAPE_CHARACTER),
+	}
+}
+
+type InvalidRegExpPatternError struct {
+	*BaseError
+}
+
+func NewInvalidRegExpPatternError(expr parser.QueryExpression, pattern value.Primary, message string) error {
+	return &InvalidRegExpPatternError{
+		NewBaseError(expr, fmt.Sprintf(ERROR_INVALID_REGEXP_PATTERN, pattern, message), ERROR_CODE_INVALID_REGEXP_PATTERN),
+	}
+}
+
+type FieldPositionOutOfRangeError struct {
+	*BaseError
+}
+
+func NewFieldPositionOutOfRangeError(expr parser.QueryExpression) error {
+	return &FieldPositionOutOfRangeError{
+		NewBaseError(expr, fmt.Sprintf(ERROR_FIELD_POSITION_OUT_OF_RANGE, expr), ERROR_CODE_FIELD_POSITION_OUT_OF_RANGE),
+	}
+}
+
+type SavepointNotExistError struct {
+	*BaseError
+}
+
+func NewSavepointNotExistError(name parser.Identifier) error {
+	return &SavepointNotExistError{
+		NewBaseError(name, fmt.Sprintf(ERROR_SAVEPOINT_NOT_EXIST, name), ERROR_CODE_SAVEPOINT_NOT_EXIST),
+	}
+}
+
+type ReadOnlyError struct {
+	*BaseError
+}
+
+func NewReadOnlyError(expr parser.Expression, statement string) error {
+	return &ReadOnlyError{
+		NewBaseError(expr, fmt.Sprintf(ERROR_READ_ONLY, statement), ERROR_CODE_READ_ONLY),
+	}
+}
+
+type ExportInvalidArgumentError struct {
+	*BaseError
+}
+
+func NewExportInvalidArgumentError(export parser.Export, arg parser.QueryExpression) error {
+	return &ExportInvalidArgumentError{
+		NewBaseError(export, fmt.Sprintf(ERROR_EXPORT_INVALID_ARGUMENT, arg), ERROR_CODE_EXPORT_INVALID_ARGUMENT),
+	}
+}
+
+type ExportInvalidOptionNameError struct {
+	*BaseError
+}
+
+func NewExportInvalidOptionNameError(option parser.ExportOption) error {
+	return &ExportInvalidOptionNameError{
+		NewBaseError(option.Name, fmt.Sprintf(ERROR_EXPORT_INVALID_OPTION_NAME, option.Name), ERROR_CODE_EXPORT_INVALID_OPTION_NAME),
+	}
+}
+
+type ExportInvalidOptionValueError struct {
+	*BaseError
+}
+
+func NewExportInvalidOptionValueError(option parser.ExportOption) error {
+	return &ExportInvalidOptionValueError{
+		NewBaseError(option.Name, fmt.Sprintf(ERROR_EXPORT_INVALID_OPTION_VALUE, option.Value, option.Name), ERROR_CODE_EXPORT_INVALID_OPTION_VALUE),
+	}
+}
+
+type InvalidTableOptionError struct {
+	*BaseError
+}
+
+func NewInvalidTableOptionError(option parser.TableOption) error {
+	return &InvalidTableOptionError{
+		NewBaseError(option.Name, fmt.Sprintf(ERROR_INVALID_TABLE_OPTION, option), ERROR_CODE_INVALID_TABLE_OPTION),
+	}
+}
+
+type TableOptionNotApplicableError struct {
+	*BaseError
+}
+
+func NewTableOptionNotApplicableError(table parser.Table) error {
+	return &TableOptionNotApplicableError{
+		NewBaseError(table.Object, fmt.Sprintf(ERROR_TABLE_OPTION_NOT_APPLICABLE, table.Object), ERROR_CODE_TABLE_OPTION_NOT_APPLICABLE),
+	}
+}
+
+type TableOptionsConflictError struct {
+	*BaseError
+}
+
+func NewTableOptionsConflictError(table parser.Table, path string) error {
+	return &TableOptionsConflictError{
+		NewBaseError(table.Object, fmt.Sprintf(ERROR_TABLE_OPTIONS_CONFLICT, path), ERROR_CODE_TABLE_OPTIONS_CONFLICT),
+	}
+}
+
+type DiffDuplicateKeyError struct {
+	*BaseError
+}
+
+func NewDiffDuplicateKeyError(table parser.QueryExpression) error {
+	return &DiffDuplicateKeyError{
+		NewBaseError(table, fmt.Sprintf(ERROR_DIFF_DUPLICATE_KEY, table), ERROR_CODE_DIFF_DUPLICATE_KEY),
+	}
+}
+
+type XlsxParsingError struct {
+	*BaseError
+}
+
+func NewXlsxParsingError(file parser.QueryExpression, filepath string, message string) error {
+	return &XlsxParsingError{
+		NewBaseError(file, fmt.Sprintf(ERROR_XLSX_PARSING, filepath, message), ERROR_CODE_XLSX_PARSING),
+	}
+}
+
+type FileNotUpdatableError struct {
+	*BaseError
+}
+
+func NewFileNotUpdatableError(file parser.QueryExpression, filepath string) error {
+	return &FileNotUpdatableError{
+		NewBaseError(file, fmt.Sprintf(ERROR_FILE_NOT_UPDATABLE, filepath), ERROR_CODE_FILE_NOT_UPDATABLE),
+	}
+}
+
+type SkippedLinesNotUpdatableError struct {
+	*BaseError
+}
+
+func NewSkippedLinesNotUpdatableError(file parser.QueryExpression, filepath string) error {
+	return &SkippedLinesNotUpdatableError{
+		NewBaseError(file, fmt.Sprintf(ERROR_SKIPPED_LINES_NOT_UPDATABLE, filepath), ERROR_CODE_SKIPPED_LINES_NOT_UPDATABLE),
+	}
+}
+
+type InvalidAsOfJoinConditionError struct {
+	*BaseError
+}
+
+func NewInvalidAsOfJoinConditionError(join parser.Join, condition parser.QueryExpression) error {
+	return &InvalidAsOfJoinConditionError{
+		NewBaseError(join, fmt.Sprintf(ERROR_INVALID_ASOF_JOIN_CONDITION, condition), ERROR_CODE_INVALID_ASOF_JOIN_CONDITION),
+	}
+}
+
+type FileModifiedError struct {
+	*BaseError
+}
+
+func NewFileModifiedError(expr parser.Expression, filepath string) error {
+	return &FileModifiedError{
+		NewBaseError(expr, fmt.Sprintf(ERROR_FILE_MODIFIED, filepath), ERROR_CODE_FILE_MODIFIED),
+	}
+}
+
+type InvalidTimeZoneError struct {
+	*BaseError
+}
+
+func NewInvalidTimeZoneError(expr parser.QueryExpression, timezone string) error {
+	return &InvalidTimeZoneError{
+		NewBaseError(expr, fmt.Sprintf(ERROR_INVALID_TIME_ZONE, timezone), ERROR_CODE_INVALID_TIME_ZONE),
+	}
+}
+
+type InvalidSchemaTypeError struct {
+	*BaseError
+}
+
+func NewInvalidSchemaTypeError(typeName parser.Identifier) error {
+	return &InvalidSchemaTypeError{
+		NewBaseError(typeName, fmt.Sprintf(ERROR_INVALID_SCHEMA_TYPE, typeName), ERROR_CODE_INVALID_SCHEMA_TYPE),
+	}
+}
+
+type RepositoryInvalidArgumentError struct {
+	*BaseError
+}
+
+func NewRepositoryInvalidArgumentError(expr parser.UseRepository, arg parser.QueryExpression) error {
+	return &RepositoryInvalidArgumentError{
+		NewBaseError(expr, fmt.Sprintf(ERROR_REPOSITORY_INVALID_ARGUMENT, arg), ERROR_CODE_REPOSITORY_INVALID_ARGUMENT),
+	}
+}
+
+type RepositoryNotExistError struct {
+	*BaseError
+}
+
+func NewRepositoryNotExistError(expr parser.UseRepository, dir string) error {
+	return &RepositoryNotExistError{
+		NewBaseError(expr, fmt.Sprintf(ERROR_REPOSITORY_NOT_EXIST, dir), ERROR_CODE_REPOSITORY_NOT_EXIST),
+	}
+}
+
+type UndefinedLoopLabelError struct {
+	*BaseError
+}
+
+func NewUndefinedLoopLabelError(label parser.Identifier) error {
+	return &UndefinedLoopLabelError{
+		NewBaseError(label, fmt.Sprintf(ERROR_UNDEFINED_LOOP_LABEL, label), ERROR_CODE_UNDEFINED_LOOP_LABEL),
+	}
+}
+
+type LoopIterationsExceededError struct {
+	*BaseError
+}
+
+func NewLoopIterationsExceededError(expr parser.Expression, max int) error {
+	return &LoopIterationsExceededError{
+		NewBaseError(expr, fmt.Sprintf(ERROR_LOOP_ITERATIONS_EXCEEDED, max), ERROR_CODE_LOOP_ITERATIONS_EXCEEDED),
+	}
+}
+
+type ResultRowsExceededError struct {
+	*BaseError
+}
+
+func NewResultRowsExceededError(expr parser.Expression, max int) error {
+	return &ResultRowsExceededError{
+		NewBaseError(expr, fmt.Sprintf(ERROR_RESULT_ROWS_EXCEEDED, max), ERROR_CODE_RESULT_ROWS_EXCEEDED),
+	}
+}
+
+type DropTemporaryTableError struct {
+	*BaseError
+}
+
+func NewDropTemporaryTableError(table parser.QueryExpression) error {
+	return &DropTemporaryTableError{
+		NewBaseError(table, fmt.Sprintf(ERROR_DROP_TEMPORARY_TABLE, table), ERROR_CODE_DROP_TEMPORARY_TABLE),
+	}
+}
+
+type RenameTemporaryTableError struct {
+	*BaseError
+}
+
+func NewRenameTemporaryTableError(table parser.QueryExpression) error {
+	return &RenameTemporaryTableError{
+		NewBaseError(table, fmt.Sprintf(ERROR_RENAME_TEMPORARY_TABLE, table), ERROR_CODE_RENAME_TEMPORARY_TABLE),
+	}
+}
+
+type CombinedSetFieldTypeError struct {
+	*BaseError
+}
+
+func NewCombinedSetFieldTypeError(selectEntity parser.QueryExpression, field string, ltype string, rtype string) error {
+	selectClause := searchSelectClauseInSelectEntity(selectEntity)
+
+	return &CombinedSetFieldTypeError{
+		NewBaseError(selectClause, fmt.Sprintf(ERROR_COMBINED_SET_FIELD_TYPE, field, ltype, rtype), ERROR_CODE_COMBINED_SET_FIELD_TYPE),
+	}
+}
+
+type ValuesTableRowValueLengthError struct {
+	*BaseError
+}
+
+func NewValuesTableRowValueLengthError(rowValue parser.RowValue, valueLen int) error {
+	return &ValuesTableRowValueLengthError{
+		NewBaseError(rowValue, fmt.Sprintf(ERROR_VALUES_TABLE_ROW_VALUE_LENGTH, FormatCount(valueLen, "value")), ERROR_CODE_VALUES_TABLE_ROW_VALUE_LENGTH),
+	}
+}
+
+type TableAliasFieldLengthError struct {
+	*BaseError
+}
+
+func NewTableAliasFieldLengthError(table parser.Table, fieldLen int) error {
+	return &TableAliasFieldLengthError{
+		NewBaseError(table.Alias, fmt.Sprintf(ERROR_TABLE_ALIAS_FIELD_LENGTH, FormatCount(fieldLen, "field name"), table.Alias), ERROR_CODE_TABLE_ALIAS_FIELD_LENGTH),
+	}
+}
+
+type UpdateComputedFieldError struct {
+	*BaseError
+}
+
+func NewUpdateComputedFieldError(field parser.QueryExpression) error {
+	return &UpdateComputedFieldError{
+		NewBaseError(field, fmt.Sprintf(ERROR_UPDATE_COMPUTED_FIELD, field), ERROR_CODE_UPDATE_COMPUTED_FIELD),
+	}
+}
+
+type AnalyticFunctionWithoutOverError struct {
+	*BaseError
+}
+
+func NewAnalyticFunctionWithoutOverError(expr parser.QueryExpression, funcname string) error {
+	return &AnalyticFunctionWithoutOverError{
+		NewBaseError(expr, fmt.Sprintf(ERROR_ANALYTIC_FUNCTION_WITHOUT_OVER, funcname), ERROR_CODE_ANALYTIC_FUNCTION_WITHOUT_OVER),
+	}
+}
+
+type ScalarFunctionWithOverError struct {
+	*BaseError
+}
+
+func NewScalarFunctionWithOverError(expr parser.QueryExpression, funcname string) error {
+	return &ScalarFunctionWithOverError{
+		NewBaseError(expr, fmt.Sprintf(ERROR_SCALAR_FUNCTION_WITH_OVER, funcname), ERROR_CODE_SCALAR_FUNCTION_WITH_OVER),
+	}
+}
+
+type SelectIntoFieldLengthError struct {
+	*BaseError
+}
+
+func NewSelectIntoFieldLengthError(expr parser.SelectInto) error {
+	return &SelectIntoFieldLengthError{
+		NewBaseError(expr, fmt.Sprintf(ERROR_SELECT_INTO_FIELD_LENGTH, FormatCount(len(expr.Variables), "field")), ERROR_CODE_SELECT_INTO_FIELD_LENGTH),
+	}
+}
+
+type SelectIntoTooManyRecordsError struct {
+	*BaseError
+}
+
+func NewSelectIntoTooManyRecordsError(expr parser.SelectInto) error {
+	return &SelectIntoTooManyRecordsError{
+		NewBaseError(expr, ERROR_SELECT_INTO_TOO_MANY_RECORDS, ERROR_CODE_SELECT_INTO_TOO_MANY_RECORDS),
+	}
+}
+
+type SelectIntoNoRecordError struct {
+	*BaseError
+}
+
+func NewSelectIntoNoRecordError(expr parser.SelectInto) error {
+	return &SelectIntoNoRecordError{
+		NewBaseError(expr, ERROR_SELECT_INTO_NO_RECORD, ERROR_CODE_SELECT_INTO_NO_RECORD),
+	}
+}
+
+type UnnestNotArrayError struct {
+	*BaseError
+}
+
+func NewUnnestNotArrayError(unnestTable parser.UnnestTable) error {
+	return &UnnestNotArrayError{
+		NewBaseError(unnestTable, fmt.Sprintf(ERROR_UNNEST_NOT_ARRAY, unnestTable.Value), ERROR_CODE_UNNEST_NOT_ARRAY),
+	}
+}
+
+type GlobHeaderNotMatchError struct {
+	*BaseError
+}
+
+func NewGlobHeaderNotMatchError(table parser.Identifier, path string, firstPath string) error {
+	return &GlobHeaderNotMatchError{
+		NewBaseError(table, fmt.Sprintf(ERROR_GLOB_HEADER_NOT_MATCH, path, firstPath), ERROR_CODE_GLOB_HEADER_NOT_MATCH),
 	}
 }
 
@@ -834,7 +1426,7 @@ type InternalRecordIdNotExistError struct {
 
 func NewInternalRecordIdNotExistError() error {
 	return &InternalRecordIdNotExistError{
-		NewBaseError(parser.NewNullValue(), ERROR_INTERNAL_RECORD_ID_NOT_EXIST),
+		NewBaseError(parser.NewNullValue(), ERROR_INTERNAL_RECORD_ID_NOT_EXIST, ERROR_CODE_INTERNAL_RECORD_ID_NOT_EXIST),
 	}
 }
 
@@ -844,7 +1436,7 @@ type InternalRecordIdEmptyError struct {
 
 func NewInternalRecordIdEmptyError() error {
 	return &InternalRecordIdEmptyError{
-		NewBaseError(parser.NewNullValue(), ERROR_INTERNAL_RECORD_ID_EMPTY),
+		NewBaseError(parser.NewNullValue(), ERROR_INTERNAL_RECORD_ID_EMPTY, ERROR_CODE_INTERNAL_RECORD_ID_EMPTY),
 	}
 }
 
@@ -854,7 +1446,7 @@ type FieldLengthNotMatchError struct {
 
 func NewFieldLengthNotMatchError() error {
 	return &FieldLengthNotMatchError{
-		NewBaseError(parser.NewNullValue(), ERROR_FIELD_LENGTH_NOT_MATCH),
+		NewBaseError(parser.NewNullValue(), ERROR_FIELD_LENGTH_NOT_MATCH, ERROR_CODE_FIELD_LENGTH_NOT_MATCH),
 	}
 }
 
@@ -864,7 +1456,7 @@ type RowValueLengthNotMatchError struct {
 
 func NewRowValueLengthNotMatchError() error {
 	return &RowValueLengthNotMatchError{
-		NewBaseError(parser.NewNullValue(), ERROR_ROW_VALUE_LENGTH_NOT_MATCH),
+		NewBaseError(parser.NewNullValue(), ERROR_ROW_VALUE_LENGTH_NOT_MATCH, ERROR_CODE_ROW_VALUE_LENGTH_NOT_MATCH),
 	}
 }
 
@@ -875,7 +1467,7 @@ type RowValueLengthInListError struct {
 
 func NewRowValueLengthInListError(i int) error {
 	return &RowValueLengthInListError{
-		BaseError: NewBaseError(parser.NewNullValue(), fmt.Sprintf(ERROR_ROW_VALUE_LENGTH_IN_LIST, i)),
+		BaseError: NewBaseError(parser.NewNullValue(), fmt.Sprintf(ERROR_ROW_VALUE_LENGTH_IN_LIST, i), ERROR_CODE_ROW_VALUE_LENGTH_IN_LIST),
 		Index:     i,
 	}
 }
@@ -886,7 +1478,17 @@ type FormatStringLengthNotMatchError struct {
 
 func NewFormatStringLengthNotMatchError() error {
 	return &FormatStringLengthNotMatchError{
-		BaseError: NewBaseError(parser.NewNullValue(), ERROR_FORMAT_STRING_LENGTH_NOT_MATCH),
+		BaseError: NewBaseError(parser.NewNullValue(), ERROR_FORMAT_STRING_LENGTH_NOT_MATCH, ERROR_CODE_FORMAT_STRING_LENGTH_NOT_MATCH),
+	}
+}
+
+type InvalidFormatTokenError struct {
+	*BaseError
+}
+
+func NewInvalidFormatTokenError(token string) error {
+	return &InvalidFormatTokenError{
+		BaseError: NewBaseError(parser.NewNullValue(), fmt.Sprintf(ERROR_INVALID_FORMAT_TOKEN, token), ERROR_CODE_INVALID_FORMAT_TOKEN),
 	}
 }
 
@@ -0,0 +1,52 @@
+package query
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var encodeErrorToJsonTests = []struct {
+	Name   string
+	Error  error
+	Result string
+}{
+	{
+		Name: "Error With Position",
+		Error: NewFieldNotExistError(parser.FieldReference{
+			BaseExpr: parser.NewBaseExpr(parser.Token{Line: 2, Char: 5}),
+			Column:   parser.Identifier{Literal: "notexist"},
+		}),
+		Result: "{\"line\":2,\"column\":5,\"message\":\"field notexist does not exist\",\"code\":8}",
+	},
+	{
+		Name:   "Error Without Position",
+		Error:  NewFieldNotExistError(parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}),
+		Result: "{\"line\":null,\"column\":null,\"message\":\"field notexist does not exist\",\"code\":8}",
+	},
+	{
+		Name:   "Syntax Error",
+		Error:  NewSyntaxError("syntax error: unexpected FROM", 1, 8, ""),
+		Result: "{\"line\":1,\"column\":8,\"message\":\"syntax error: unexpected FROM\",\"code\":2}",
+	},
+	{
+		Name:   "Auto Commit Error",
+		Error:  NewAutoCommitError("error"),
+		Result: "{\"line\":null,\"column\":null,\"message\":\"[Auto-Commit] failed to write to file: error\",\"code\":6}",
+	},
+	{
+		Name:   "Other Error",
+		Error:  errors.New("error"),
+		Result: "{\"line\":null,\"column\":null,\"message\":\"error\",\"code\":null}",
+	},
+}
+
+func TestEncodeErrorToJson(t *testing.T) {
+	for _, v := range encodeErrorToJsonTests {
+		result := EncodeErrorToJson(v.Error)
+		if result != v.Result {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
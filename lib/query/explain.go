@@ -0,0 +1,490 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+// PlanNode is an operation in the plan of a query. Children are evaluated before their parent.
+type PlanNode struct {
+	Operation string
+	Strategy  string
+	Detail    string
+	Children  []*PlanNode
+}
+
+func (node *PlanNode) String() string {
+	return strings.Join(node.lines(nil, ""), "\n")
+}
+
+func (node *PlanNode) lines(lines []string, indent string) []string {
+	s := node.Operation
+	if 0 < len(node.Strategy) {
+		s += " (" + node.Strategy + ")"
+	}
+	if 0 < len(node.Detail) {
+		s += ": " + node.Detail
+	}
+	lines = append(lines, indent+s)
+
+	if 0 < len(indent) {
+		indent = strings.Repeat(" ", len(indent))
+	}
+	for _, child := range node.Children {
+		lines = child.lines(lines, indent+"  -> ")
+	}
+	return lines
+}
+
+func Explain(expr parser.Explain, filter *Filter) (*PlanNode, error) {
+	planner := &queryPlanner{
+		filter:       filter,
+		inlineTables: make(map[string]bool),
+	}
+
+	node, err := planner.selectQuery(expr.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &PlanNode{
+		Operation: "Result",
+		Strategy:  "materialized",
+		Children:  []*PlanNode{node},
+	}
+	if source, err := findStreamSource(expr.Query, filter); err != nil {
+		return nil, err
+	} else if source != nil {
+		root.Strategy = "streamed in cursors"
+	}
+	return root, nil
+}
+
+// queryPlanner describes how a query is evaluated without loading any tables.
+type queryPlanner struct {
+	filter        *Filter
+	inlineTables  map[string]bool
+	recursiveName string
+}
+
+func (p *queryPlanner) selectQuery(query parser.SelectQuery) (*PlanNode, error) {
+	var inlineTables []*PlanNode
+	if query.WithClause != nil {
+		for _, v := range query.WithClause.(parser.WithClause).InlineTables {
+			it := v.(parser.InlineTable)
+			node, err := p.inlineTable(it)
+			if err != nil {
+				return nil, err
+			}
+			inlineTables = append(inlineTables, node)
+		}
+	}
+
+	node, err := p.selectEntity(query.SelectEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.OrderByClause != nil {
+		node = &PlanNode{
+			Operation: "Sort",
+			Strategy:  "stable sort",
+			Detail:    query.OrderByClause.String(),
+			Children:  []*PlanNode{node},
+		}
+	}
+	if query.OffsetClause != nil {
+		node = &PlanNode{Operation: "Offset", Detail: query.OffsetClause.String(), Children: []*PlanNode{node}}
+	}
+	if query.LimitClause != nil {
+		node = &PlanNode{Operation: "Limit", Detail: query.LimitClause.String(), Children: []*PlanNode{node}}
+	}
+	if query.ForUpdate {
+		node = &PlanNode{Operation: "Lock", Detail: "files to be updated", Children: []*PlanNode{node}}
+	}
+
+	if inlineTables != nil {
+		node = &PlanNode{Operation: "With", Children: append(inlineTables, node)}
+	}
+	return node, nil
+}
+
+func (p *queryPlanner) inlineTable(it parser.InlineTable) (*PlanNode, error) {
+	node := &PlanNode{
+		Operation: "Inline Table",
+		Detail:    it.Name.Literal,
+	}
+
+	recursiveName := p.recursiveName
+	if it.IsRecursive() {
+		node.Strategy = "recursive"
+		p.recursiveName = it.Name.Literal
+	}
+	child, err := p.selectQuery(it.Query)
+	p.recursiveName = recursiveName
+	if err != nil {
+		return nil, err
+	}
+
+	p.inlineTables[strings.ToUpper(it.Name.Literal)] = true
+	node.Children = []*PlanNode{child}
+	return node, nil
+}
+
+func (p *queryPlanner) selectEntity(expr parser.QueryExpression) (*PlanNode, error) {
+	if set, ok := expr.(parser.SelectSet); ok {
+		return p.selectSet(set)
+	}
+	if subquery, ok := expr.(parser.Subquery); ok {
+		return p.selectQuery(subquery.Query)
+	}
+
+	entity := expr.(parser.SelectEntity)
+	selectClause := entity.SelectClause.(parser.SelectClause)
+
+	node, err := p.fromClause(entity.FromClause)
+	if err != nil {
+		return nil, err
+	}
+
+	if entity.WhereClause != nil {
+		node = &PlanNode{Operation: "Filter", Detail: entity.WhereClause.String(), Children: []*PlanNode{node}}
+	}
+
+	if entity.GroupByClause != nil {
+		node = &PlanNode{Operation: "Group", Strategy: "hash", Detail: entity.GroupByClause.String(), Children: []*PlanNode{node}}
+	} else {
+		exprs := append([]parser.QueryExpression{}, selectClause.Fields...)
+		if entity.HavingClause != nil {
+			exprs = append(exprs, entity.HavingClause.(parser.HavingClause).Filter)
+		}
+		if 0 < len(searchPlanExpressions(exprs, p.isAggregateFunction)) {
+			node = &PlanNode{Operation: "Group", Strategy: "all records", Children: []*PlanNode{node}}
+		}
+	}
+
+	if entity.HavingClause != nil {
+		node = &PlanNode{Operation: "Filter", Detail: entity.HavingClause.String(), Children: []*PlanNode{node}}
+	}
+
+	for _, fn := range searchPlanExpressions(selectClause.Fields, isAnalyticFunction) {
+		strategy := "hash partitioning"
+		if fn.(parser.AnalyticFunction).AnalyticClause.OrderByClause != nil {
+			strategy += ", stable sort"
+		}
+		node = &PlanNode{Operation: "Analytic Function", Strategy: strategy, Detail: fn.String(), Children: []*PlanNode{node}}
+	}
+
+	fields := make([]string, len(selectClause.Fields))
+	for i, f := range selectClause.Fields {
+		fields[i] = f.String()
+	}
+	node = &PlanNode{Operation: "Select", Detail: strings.Join(fields, ", "), Children: []*PlanNode{node}}
+	if selectClause.IsDistinct() {
+		node.Strategy = "distinct by hash"
+	}
+	return node, nil
+}
+
+func (p *queryPlanner) selectSet(set parser.SelectSet) (*PlanNode, error) {
+	lhs, err := p.selectEntity(set.LHS)
+	if err != nil {
+		return nil, err
+	}
+	rhs, err := p.selectEntity(set.RHS)
+	if err != nil {
+		return nil, err
+	}
+
+	operation := set.Operator.Literal
+	if !set.All.IsEmpty() {
+		operation += " " + set.All.Literal
+	}
+	node := &PlanNode{
+		Operation: strings.ToUpper(operation),
+		Strategy:  "hash",
+		Children:  []*PlanNode{lhs, rhs},
+	}
+	if 0 < len(p.recursiveName) {
+		node.Strategy = "repeat until no records are added"
+	}
+	return node, nil
+}
+
+func (p *queryPlanner) fromClause(expr parser.QueryExpression) (*PlanNode, error) {
+	var tables []parser.QueryExpression
+	if expr != nil {
+		tables = expr.(parser.FromClause).Tables
+	}
+	if tables == nil {
+		if cmd.IsReadableFromPipeOrRedirection() {
+			return &PlanNode{Operation: "Read Stdin"}, nil
+		}
+		return &PlanNode{Operation: "Dual"}, nil
+	}
+
+	node, err := p.table(tables[0])
+	if err != nil {
+		return nil, err
+	}
+	for i := 1; i < len(tables); i++ {
+		joinNode, err := p.table(tables[i])
+		if err != nil {
+			return nil, err
+		}
+		node = &PlanNode{Operation: "Cross Join", Children: []*PlanNode{node, joinNode}}
+	}
+	return node, nil
+}
+
+func (p *queryPlanner) table(expr parser.QueryExpression) (*PlanNode, error) {
+	if parentheses, ok := expr.(parser.Parentheses); ok {
+		return p.table(parentheses.Expr)
+	}
+
+	table := expr.(parser.Table)
+	var node *PlanNode
+
+	switch obj := table.Object.(type) {
+	case parser.Dual:
+		node = &PlanNode{Operation: "Dual"}
+	case parser.Stdin:
+		node = &PlanNode{Operation: "Read Stdin", Detail: obj.String()}
+	case parser.Identifier:
+		node = &PlanNode{Detail: obj.Literal}
+		if 0 < len(p.recursiveName) && strings.EqualFold(obj.Literal, p.recursiveName) {
+			node.Operation = "Recursive Table"
+		} else if p.isInlineTable(obj) {
+			node.Operation = "Inline Table"
+		} else if p.filter.TempViews.Exists(obj.Literal) {
+			node.Operation = "Temporary Table"
+		} else {
+			options, err := NewTableOptions(table.Options)
+			if err != nil {
+				return nil, err
+			}
+			fileInfo, err := NewFileInfo(obj, options.Repository, options.Delimiter)
+			if err != nil {
+				return nil, err
+			}
+
+			node.Operation = "Read File"
+			node.Detail = fileInfo.Path
+			switch {
+			case ViewCache.Exists(fileInfo.Path):
+				node.Strategy = "cached"
+			case isXlsxFile(fileInfo.Path):
+				node.Strategy = "xlsx"
+			}
+			if len(options.ComputedColumns) < 1 {
+				break
+			}
+
+			names := make([]string, len(options.ComputedColumns))
+			for i, c := range options.ComputedColumns {
+				names[i] = c.Name.Literal
+			}
+			node = &PlanNode{Operation: "Compute Columns", Detail: strings.Join(names, ", "), Children: []*PlanNode{node}}
+		}
+	case parser.Subquery:
+		child, err := p.selectQuery(obj.Query)
+		if err != nil {
+			return nil, err
+		}
+		node = &PlanNode{Operation: "Subquery", Children: []*PlanNode{child}}
+	case parser.ValuesTable:
+		node = &PlanNode{Operation: "Values", Detail: FormatCount(len(obj.RowValues), "row")}
+	case parser.UnnestTable:
+		node = &PlanNode{Operation: "Unnest", Detail: obj.Value.String()}
+	case parser.Join:
+		return p.join(obj)
+	}
+
+	if table.Alias != nil {
+		alias := table.Alias.(parser.Identifier).Literal
+		if 0 < len(node.Detail) {
+			node.Detail += " AS " + alias
+		} else {
+			node.Detail = alias
+		}
+	}
+	return node, nil
+}
+
+func (p *queryPlanner) join(join parser.Join) (*PlanNode, error) {
+	lhs, err := p.table(join.Table)
+	if err != nil {
+		return nil, err
+	}
+	rhs, err := p.table(join.JoinTable)
+	if err != nil {
+		return nil, err
+	}
+
+	node := &PlanNode{Children: []*PlanNode{lhs, rhs}}
+
+	var condition parser.QueryExpression
+	if join.Condition != nil {
+		node.Detail = join.Condition.String()
+		condition = join.Condition.(parser.JoinCondition).On
+	} else if !join.Natural.IsEmpty() {
+		node.Detail = join.Natural.Literal
+	}
+
+	joinType := join.JoinType.Token
+	if join.JoinType.IsEmpty() {
+		if join.Direction.IsEmpty() {
+			joinType = parser.INNER
+		} else {
+			joinType = parser.OUTER
+		}
+	}
+
+	switch {
+	case !join.AsOf.IsEmpty():
+		node.Operation = "As-Of Join"
+		node.Strategy = "binary search in sorted partitions"
+		if !join.Direction.IsEmpty() {
+			node.Operation = "As-Of Left Join"
+		}
+		if join.Partition != nil {
+			node.Detail = join.Partition.String() + " " + node.Detail
+		}
+		return node, nil
+	case joinType == parser.CROSS:
+		node.Operation = "Cross Join"
+		return node, nil
+	case joinType == parser.INNER:
+		node.Operation = "Inner Join"
+		if join.Condition == nil && join.Natural.IsEmpty() {
+			node.Operation = "Cross Join"
+			return node, nil
+		}
+	default:
+		direction := parser.LEFT
+		if !join.Direction.IsEmpty() {
+			direction = join.Direction.Token
+		}
+		switch direction {
+		case parser.RIGHT:
+			node.Operation = "Right Outer Join"
+		case parser.FULL:
+			node.Operation = "Full Outer Join"
+		default:
+			node.Operation = "Left Outer Join"
+		}
+	}
+
+	node.Strategy = "nested loop"
+	if condition != nil && 1 < len(rangeJoinRelations(condition)) {
+		node.Strategy = "range index"
+	}
+	return node, nil
+}
+
+func (p *queryPlanner) isInlineTable(name parser.Identifier) bool {
+	if p.inlineTables[strings.ToUpper(name.Literal)] {
+		return true
+	}
+	_, err := p.filter.InlineTables.Get(name)
+	return err == nil
+}
+
+func (p *queryPlanner) isAggregateFunction(expr parser.QueryExpression) bool {
+	switch e := expr.(type) {
+	case parser.AggregateFunction, parser.ListAgg:
+		return true
+	case parser.Function:
+		name := strings.ToUpper(e.Name)
+		if _, ok := ExternalAggregateFunctions[name]; ok {
+			return true
+		}
+		if fn, err := p.filter.Functions.Get(e, name); err == nil && fn.IsAggregate {
+			return true
+		}
+	}
+	return false
+}
+
+func isAnalyticFunction(expr parser.QueryExpression) bool {
+	_, ok := expr.(parser.AnalyticFunction)
+	return ok
+}
+
+// searchPlanExpressions returns the expressions that match in the order of evaluation.
+// Subqueries and the arguments of matched expressions are not searched.
+func searchPlanExpressions(exprs []parser.QueryExpression, match func(parser.QueryExpression) bool) []parser.QueryExpression {
+	var result []parser.QueryExpression
+	for _, expr := range exprs {
+		if expr == nil {
+			continue
+		}
+		if match(expr) {
+			result = append(result, expr)
+			continue
+		}
+		result = append(result, searchPlanExpressions(planSubexpressions(expr), match)...)
+	}
+	return result
+}
+
+func planSubexpressions(expr parser.QueryExpression) []parser.QueryExpression {
+	switch e := expr.(type) {
+	case parser.Field:
+		return []parser.QueryExpression{e.Object}
+	case parser.AllColumns:
+		return e.Replace
+	case parser.Parentheses:
+		return []parser.QueryExpression{e.Expr}
+	case parser.RowValue:
+		return []parser.QueryExpression{e.Value}
+	case parser.ValueList:
+		return e.Values
+	case parser.RowValueList:
+		return e.RowValues
+	case parser.Comparison:
+		return []parser.QueryExpression{e.LHS, e.RHS}
+	case parser.Is:
+		return []parser.QueryExpression{e.LHS, e.RHS}
+	case parser.Between:
+		return []parser.QueryExpression{e.LHS, e.Low, e.High}
+	case parser.In:
+		return []parser.QueryExpression{e.LHS, e.Values}
+	case parser.All:
+		return []parser.QueryExpression{e.LHS, e.Values}
+	case parser.Any:
+		return []parser.QueryExpression{e.LHS, e.Values}
+	case parser.Like:
+		return []parser.QueryExpression{e.LHS, e.Pattern, e.Escape}
+	case parser.SimilarTo:
+		return []parser.QueryExpression{e.LHS, e.Pattern, e.Escape}
+	case parser.RegExpMatch:
+		return []parser.QueryExpression{e.LHS, e.Pattern}
+	case parser.Arithmetic:
+		return []parser.QueryExpression{e.LHS, e.RHS}
+	case parser.UnaryArithmetic:
+		return []parser.QueryExpression{e.Operand}
+	case parser.Logic:
+		return []parser.QueryExpression{e.LHS, e.RHS}
+	case parser.UnaryLogic:
+		return []parser.QueryExpression{e.Operand}
+	case parser.Concat:
+		return e.Items
+	case parser.AtTimeZone:
+		return []parser.QueryExpression{e.Value, e.TimeZone}
+	case parser.CaseExpr:
+		exprs := make([]parser.QueryExpression, 0, len(e.When)+2)
+		exprs = append(exprs, e.Value)
+		exprs = append(exprs, e.When...)
+		return append(exprs, e.Else)
+	case parser.CaseExprWhen:
+		return []parser.QueryExpression{e.Condition, e.Result}
+	case parser.CaseExprElse:
+		return []parser.QueryExpression{e.Result}
+	case parser.Function:
+		return e.Args
+	}
+	return nil
+}
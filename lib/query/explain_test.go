@@ -0,0 +1,214 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var explainTests = []struct {
+	Name   string
+	Query  parser.SelectQuery
+	Result string
+	Error  string
+}{
+	{
+		Name: "Explain Streamable Query",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Where: "where",
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						RHS:      parser.NewIntegerValue(1),
+						Operator: ">",
+					},
+				},
+			},
+		},
+		Result: "Result (streamed in cursors)\n" +
+			"  -> Select: column1\n" +
+			"       -> Filter: where column1 > 1\n" +
+			"            -> Read File: " + GetTestFilePath("table1.csv"),
+	},
+	{
+		Name: "Explain Joins and Aggregation",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AggregateFunction{Name: "COUNT", Args: []parser.QueryExpression{parser.AllColumns{}}}},
+						parser.Field{Object: parser.AnalyticFunction{Name: "ROW_NUMBER", Over: "OVER", AnalyticClause: parser.AnalyticClause{}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{
+							Object: parser.Join{
+								Table: parser.Table{
+									Object: parser.Identifier{Literal: "table1"},
+									Alias:  parser.Identifier{Literal: "t1"},
+								},
+								JoinTable: parser.Table{
+									Object: parser.Identifier{Literal: "table2"},
+									Alias:  parser.Identifier{Literal: "t2"},
+								},
+								JoinType: parser.Token{Token: parser.INNER, Literal: "inner"},
+								Condition: parser.JoinCondition{
+									Literal: "ON",
+									On: parser.Between{
+										Between: "BETWEEN",
+										And:     "AND",
+										LHS:     parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "column1"}},
+										Low:     parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "column3"}},
+										High:    parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "column4"}},
+									},
+								},
+							},
+						},
+						parser.Table{
+							Object: parser.Subquery{
+								Query: parser.SelectQuery{
+									SelectEntity: parser.SelectEntity{
+										SelectClause: parser.SelectClause{
+											Fields: []parser.QueryExpression{
+												parser.Field{Object: parser.NewIntegerValue(1)},
+											},
+										},
+									},
+								},
+							},
+							Alias: parser.Identifier{Literal: "s"},
+						},
+					},
+				},
+			},
+			OrderByClause: parser.OrderByClause{
+				OrderBy: "ORDER BY",
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.NewIntegerValue(1)},
+				},
+			},
+		},
+		Result: "Result (materialized)\n" +
+			"  -> Sort (stable sort): ORDER BY 1\n" +
+			"       -> Select: COUNT(*), ROW_NUMBER() OVER ()\n" +
+			"            -> Analytic Function (hash partitioning): ROW_NUMBER() OVER ()\n" +
+			"                 -> Group (all records)\n" +
+			"                      -> Cross Join\n" +
+			"                           -> Inner Join (range index): ON t1.column1 BETWEEN t2.column3 AND t2.column4\n" +
+			"                                -> Read File: " + GetTestFilePath("table1.csv") + " AS t1\n" +
+			"                                -> Read File: " + GetTestFilePath("table2.csv") + " AS t2\n" +
+			"                           -> Subquery: s\n" +
+			"                                -> Select: 1\n" +
+			"                                     -> Dual",
+	},
+	{
+		Name: "Explain Inline Tables",
+		Query: parser.SelectQuery{
+			WithClause: parser.WithClause{
+				InlineTables: []parser.QueryExpression{
+					parser.InlineTable{
+						Name: parser.Identifier{Literal: "it"},
+						Query: parser.SelectQuery{
+							SelectEntity: parser.SelectEntity{
+								SelectClause: parser.SelectClause{
+									Fields: []parser.QueryExpression{
+										parser.Field{Object: parser.NewIntegerValue(1)},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			SelectEntity: parser.SelectSet{
+				LHS: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.AllColumns{}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "it"}},
+						},
+					},
+				},
+				Operator: parser.Token{Token: parser.UNION, Literal: "union"},
+				RHS: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewIntegerValue(2)},
+						},
+					},
+				},
+			},
+		},
+		Result: "Result (materialized)\n" +
+			"  -> With\n" +
+			"       -> Inline Table: it\n" +
+			"            -> Select: 1\n" +
+			"                 -> Dual\n" +
+			"       -> UNION (hash)\n" +
+			"            -> Select: *\n" +
+			"                 -> Inline Table: it\n" +
+			"            -> Select: 2\n" +
+			"                 -> Dual",
+	},
+	{
+		Name: "Explain File Does Not Exist Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "notexist"}},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] file notexist does not exist",
+	},
+}
+
+func TestExplain(t *testing.T) {
+	initFlag()
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	for _, v := range explainTests {
+		ViewCache.Clean()
+		result, err := Explain(parser.Explain{Query: v.Query}, NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result.String() != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, result.String(), v.Result)
+		}
+	}
+}
@@ -3,10 +3,13 @@ package query
 import (
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
 )
 
 type FileInfo struct {
@@ -15,13 +18,129 @@ type FileInfo struct {
 	NoHeader  bool
 	Encoding  cmd.Encoding
 	LineBreak cmd.LineBreak
+	Sheet     string
+	LimitRead int
+	Columns   []string
 	File      *os.File
 
+	ModTime time.Time
+	Size    int64
+
+	UnquotedHeader map[string]bool
+
 	IsTemporary      bool
 	InitialHeader    Header
 	InitialRecordSet RecordSet
 }
 
+type TableOptions struct {
+	NoHeader   bool
+	Delimiter  rune
+	Encoding   cmd.Encoding
+	Sheet      string
+	Repository string
+	LimitRead  int
+
+	ComputedColumns []parser.ComputedColumn
+}
+
+func NewTableOptions(options []parser.TableOption) (TableOptions, error) {
+	flags := cmd.GetFlags()
+
+	opts := TableOptions{
+		NoHeader:   flags.NoHeader,
+		Delimiter:  flags.Delimiter,
+		Encoding:   flags.Encoding,
+		Repository: flags.Repository,
+	}
+
+	for _, o := range options {
+		switch strings.ToUpper(o.Name.Literal) {
+		case "HEADER":
+			if o.Value != nil {
+				return opts, NewInvalidTableOptionError(o)
+			}
+			opts.NoHeader = false
+		case "NO":
+			if i, ok := o.Value.(parser.Identifier); !ok || !strings.EqualFold(i.Literal, "HEADER") {
+				return opts, NewInvalidTableOptionError(o)
+			}
+			opts.NoHeader = true
+		case "DELIMITER":
+			s, ok := tableOptionString(o)
+			if !ok {
+				return opts, NewInvalidTableOptionError(o)
+			}
+			runes := []rune(cmd.UnescapeString(s))
+			if len(runes) != 1 {
+				return opts, NewInvalidTableOptionError(o)
+			}
+			opts.Delimiter = runes[0]
+		case "ENCODING":
+			s, ok := tableOptionString(o)
+			if !ok {
+				return opts, NewInvalidTableOptionError(o)
+			}
+			if strings.EqualFold(s, cmd.AUTO.String()) {
+				opts.Encoding = cmd.AUTO
+			} else {
+				enc, err := cmd.ParseEncoding(s)
+				if err != nil || len(s) < 1 {
+					return opts, NewInvalidTableOptionError(o)
+				}
+				opts.Encoding = enc
+			}
+		case "SHEET":
+			s, ok := tableOptionString(o)
+			if !ok || len(s) < 1 {
+				return opts, NewInvalidTableOptionError(o)
+			}
+			opts.Sheet = s
+		case "REPOSITORY":
+			s, ok := tableOptionString(o)
+			if !ok || len(s) < 1 {
+				return opts, NewInvalidTableOptionError(o)
+			}
+			opts.Repository = s
+		case "LIMIT_READ":
+			pt, ok := o.Value.(parser.PrimitiveType)
+			if !ok {
+				return opts, NewInvalidTableOptionError(o)
+			}
+			i := value.ToInteger(pt.Value)
+			if value.IsNull(i) || i.(value.Integer).Raw() < 1 {
+				return opts, NewInvalidTableOptionError(o)
+			}
+			opts.LimitRead = int(i.(value.Integer).Raw())
+		case "ADD":
+			c, ok := o.Value.(parser.ComputedColumn)
+			if !ok || !strings.EqualFold(c.Column.Literal, "COLUMN") {
+				return opts, NewInvalidTableOptionError(o)
+			}
+			opts.ComputedColumns = append(opts.ComputedColumns, c)
+		default:
+			return opts, NewInvalidTableOptionError(o)
+		}
+	}
+	return opts, nil
+}
+
+func tableOptionString(option parser.TableOption) (string, bool) {
+	var p value.Primary
+	switch option.Value.(type) {
+	case parser.Identifier:
+		p = value.NewString(option.Value.(parser.Identifier).Literal)
+	case parser.PrimitiveType:
+		p = value.ToString(option.Value.(parser.PrimitiveType).Value)
+	default:
+		return "", false
+	}
+	if value.IsNull(p) {
+		return "", false
+	}
+	return p.(value.String).Raw(), true
+}
+
 func NewFileInfo(filename parser.Identifier, repository string, delimiter rune) (*FileInfo, error) {
 	fpath := filename.Literal
 	if !filepath.IsAbs(fpath) {
@@ -64,6 +183,73 @@ func NewFileInfo(filename parser.Identifier, repository string, delimiter rune)
 	}, nil
 }
 
+// cachedFilePath returns the path of the file specified without its extension that exists only in the cache.
+// Files renamed in the transaction are found in this way until they are written at commit.
+func cachedFilePath(fpath string) (string, bool) {
+	for _, ext := range []string{cmd.CSV_EXT, cmd.TSV_EXT} {
+		if ViewCache.Exists(fpath + ext) {
+			return fpath + ext, true
+		}
+	}
+	return "", false
+}
+
+// Records the modification time and the size of the loaded file.
+func (f *FileInfo) SetFileState(fp *os.File) error {
+	info, err := fp.Stat()
+	if err != nil {
+		return err
+	}
+	f.ModTime = info.ModTime()
+	f.Size = info.Size()
+	return nil
+}
+
+// Reports whether the file has been modified, replaced or removed since the state was recorded.
+// If no state has been recorded, the file is regarded as not modified.
+func (f *FileInfo) IsModified() bool {
+	if f.ModTime.IsZero() {
+		return false
+	}
+
+	info, err := os.Stat(f.Path)
+	if err != nil {
+		return true
+	}
+	if f.File != nil {
+		if opened, err := f.File.Stat(); err == nil && !os.SameFile(info, opened) {
+			return true
+		}
+	}
+	return !info.ModTime().Equal(f.ModTime) || info.Size() != f.Size
+}
+
+func isGlobPattern(fpath string) bool {
+	return strings.ContainsAny(filepath.Base(fpath), "*?[")
+}
+
+// globFilePaths returns the paths of the files matching the pattern, sorted by the paths.
+// Directories are not included.
+func globFilePaths(pattern string) ([]string, error) {
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(matches))
+	for _, fpath := range matches {
+		if info, err := os.Stat(fpath); err == nil && !info.IsDir() {
+			paths = append(paths, fpath)
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func isXlsxFile(fpath string) bool {
+	return strings.EqualFold(filepath.Ext(fpath), cmd.XLSX_EXT)
+}
+
 func NewFileInfoForCreate(finename parser.Identifier, repository string, delimiter rune) (*FileInfo, error) {
 	fpath := finename.Literal
 	if !filepath.IsAbs(fpath) {
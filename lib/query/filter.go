@@ -3,6 +3,7 @@ package query
 import (
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
@@ -33,7 +34,16 @@ type Filter struct {
 	RecursiveTmpView  *View
 	tmpViewIsAccessed bool
 
-	Now time.Time
+	Now             time.Time
+	functionResults *FunctionResultCache
+
+	// Names of the columns that tables are loaded with. Nil means all columns.
+	referencedColumns []string
+	loadCondition     *loadCondition
+
+	// Whether aggregate functions without a GROUP BY clause make one group for no record.
+	// This is not inherited by child nodes.
+	aggregatesEmptySet bool
 }
 
 func NewFilter(variableScopes VariableScopes, tempViewScopes TemporaryViewScopes, cursorScopes CursorScopes, functionScopes UserDefinedFunctionScopes) *Filter {
@@ -90,6 +100,7 @@ func (f *Filter) Merge(filter *Filter) {
 	f.InlineTables = filter.InlineTables
 	f.Aliases = filter.Aliases
 	f.Now = filter.Now
+	f.functionResults = filter.functionResults
 }
 
 func (f *Filter) CreateChildScope() *Filter {
@@ -101,6 +112,12 @@ func (f *Filter) CreateChildScope() *Filter {
 	)
 }
 
+// Statements in functions are executed with the time when the calling statement started.
+func (f *Filter) inheritStatementContext(filter *Filter) {
+	f.Now = filter.Now
+	f.functionResults = filter.functionResults
+}
+
 func (f *Filter) ResetCurrentScope() {
 	for k := range f.Variables[0] {
 		delete(f.Variables[0], k)
@@ -108,7 +125,8 @@ func (f *Filter) ResetCurrentScope() {
 	for k := range f.TempViews[0] {
 		delete(f.TempViews[0], k)
 	}
-	for k := range f.Cursors[0] {
+	for k, cur := range f.Cursors[0] {
+		cur.closeStream()
 		delete(f.Cursors[0], k)
 	}
 	for k := range f.Functions[0] {
@@ -128,10 +146,12 @@ func (f *Filter) CreateNode() *Filter {
 		RecursiveTable:   f.RecursiveTable,
 		RecursiveTmpView: f.RecursiveTmpView,
 		Now:              f.Now,
+		functionResults:  f.functionResults,
 	}
 
 	if filter.Now.IsZero() {
 		filter.Now = cmd.Now()
+		filter.functionResults = NewFunctionResultCache()
 	}
 
 	return filter
@@ -162,6 +182,8 @@ func (f *Filter) Evaluate(expr parser.QueryExpression) (value.Primary, error) {
 		val, err = f.evalUnaryArithmetic(expr.(parser.UnaryArithmetic))
 	case parser.Concat:
 		val, err = f.evalConcat(expr.(parser.Concat))
+	case parser.AtTimeZone:
+		val, err = f.evalAtTimeZone(expr.(parser.AtTimeZone))
 	case parser.Comparison:
 		val, err = f.evalComparison(expr.(parser.Comparison))
 	case parser.Is:
@@ -170,6 +192,10 @@ func (f *Filter) Evaluate(expr parser.QueryExpression) (value.Primary, error) {
 		val, err = f.evalBetween(expr.(parser.Between))
 	case parser.Like:
 		val, err = f.evalLike(expr.(parser.Like))
+	case parser.SimilarTo:
+		val, err = f.evalSimilarTo(expr.(parser.SimilarTo))
+	case parser.RegExpMatch:
+		val, err = f.evalRegExpMatch(expr.(parser.RegExpMatch))
 	case parser.In:
 		val, err = f.evalIn(expr.(parser.In))
 	case parser.Any:
@@ -304,6 +330,32 @@ func (f *Filter) evalConcat(expr parser.Concat) (value.Primary, error) {
 	return value.NewString(strings.Join(items, "")), nil
 }
 
+func (f *Filter) evalAtTimeZone(expr parser.AtTimeZone) (value.Primary, error) {
+	p, err := f.Evaluate(expr.Value)
+	if err != nil {
+		return nil, err
+	}
+	tz, err := f.Evaluate(expr.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := value.ToDatetime(p)
+	if value.IsNull(dt) {
+		return value.NewNull(), nil
+	}
+	tz = value.ToString(tz)
+	if value.IsNull(tz) {
+		return value.NewNull(), nil
+	}
+
+	loc, err := time.LoadLocation(tz.(value.String).Raw())
+	if err != nil {
+		return nil, NewInvalidTimeZoneError(expr, tz.(value.String).Raw())
+	}
+	return value.NewDatetime(dt.(value.Datetime).Raw().In(loc)), nil
+}
+
 func (f *Filter) evalComparison(expr parser.Comparison) (value.Primary, error) {
 	var t ternary.Value
 
@@ -379,7 +431,7 @@ func (f *Filter) evalBetween(expr parser.Between) (value.Primary, error) {
 			return nil, NewRowValueLengthInComparisonError(expr.Low.(parser.RowValue), len(lhs))
 		}
 
-		if lowResult == ternary.FALSE {
+		if lowResult == ternary.FALSE && !expr.IsSymmetric() {
 			t = ternary.FALSE
 		} else {
 			high, err := f.evalRowValue(expr.High.(parser.RowValue))
@@ -393,6 +445,12 @@ func (f *Filter) evalBetween(expr parser.Between) (value.Primary, error) {
 			}
 
 			t = ternary.And(lowResult, highResult)
+
+			if expr.IsSymmetric() && t != ternary.TRUE {
+				r1, _ := value.CompareRowValues(lhs, high, ">=")
+				r2, _ := value.CompareRowValues(lhs, low, "<=")
+				t = ternary.Or(t, ternary.And(r1, r2))
+			}
 		}
 	default:
 		lhs, err := f.Evaluate(expr.LHS)
@@ -408,7 +466,7 @@ func (f *Filter) evalBetween(expr parser.Between) (value.Primary, error) {
 			}
 
 			lowResult := value.GreaterOrEqual(lhs, low)
-			if lowResult == ternary.FALSE {
+			if lowResult == ternary.FALSE && !expr.IsSymmetric() {
 				t = ternary.FALSE
 			} else {
 				high, err := f.Evaluate(expr.High)
@@ -418,6 +476,10 @@ func (f *Filter) evalBetween(expr parser.Between) (value.Primary, error) {
 
 				highResult := value.LessOrEqual(lhs, high)
 				t = ternary.And(lowResult, highResult)
+
+				if expr.IsSymmetric() && t != ternary.TRUE {
+					t = ternary.Or(t, ternary.And(value.GreaterOrEqual(lhs, high), value.LessOrEqual(lhs, low)))
+				}
 			}
 		}
 	}
@@ -543,14 +605,78 @@ func (f *Filter) evalLike(expr parser.Like) (value.Primary, error) {
 	if err != nil {
 		return nil, err
 	}
+	escape, err := f.evalEscapeCharacter(expr.Escape)
+	if err != nil {
+		return nil, err
+	}
 
-	t := Like(lhs, pattern)
+	t := LikeWithEscape(lhs, pattern, escape)
 	if expr.IsNegated() {
 		t = ternary.Not(t)
 	}
 	return value.NewTernary(t), nil
 }
 
+func (f *Filter) evalSimilarTo(expr parser.SimilarTo) (value.Primary, error) {
+	lhs, err := f.Evaluate(expr.LHS)
+	if err != nil {
+		return nil, err
+	}
+	pattern, err := f.Evaluate(expr.Pattern)
+	if err != nil {
+		return nil, err
+	}
+	escape, err := f.evalEscapeCharacter(expr.Escape)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := SimilarTo(lhs, pattern, escape)
+	if err != nil {
+		return nil, NewInvalidRegExpPatternError(expr, pattern, err.Error())
+	}
+	if expr.IsNegated() {
+		t = ternary.Not(t)
+	}
+	return value.NewTernary(t), nil
+}
+
+func (f *Filter) evalRegExpMatch(expr parser.RegExpMatch) (value.Primary, error) {
+	lhs, err := f.Evaluate(expr.LHS)
+	if err != nil {
+		return nil, err
+	}
+	pattern, err := f.Evaluate(expr.Pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := RegExpMatch(lhs, pattern, expr.IsCaseInsensitive())
+	if err != nil {
+		return nil, NewInvalidRegExpPatternError(expr, pattern, err.Error())
+	}
+	if expr.IsNegated() {
+		t = ternary.Not(t)
+	}
+	return value.NewTernary(t), nil
+}
+
+func (f *Filter) evalEscapeCharacter(expr parser.QueryExpression) (rune, error) {
+	if expr == nil {
+		return '\\', nil
+	}
+
+	p, err := f.Evaluate(expr)
+	if err != nil {
+		return 0, err
+	}
+	s := value.ToString(p)
+	if value.IsNull(s) || utf8.RuneCountInString(s.(value.String).Raw()) != 1 {
+		return 0, NewInvalidEscapeCharacterError(expr, p)
+	}
+	return []rune(s.(value.String).Raw())[0], nil
+}
+
 func (f *Filter) evalExists(expr parser.Exists) (value.Primary, error) {
 	view, err := Select(expr.Query.Query, f)
 	if err != nil {
@@ -565,7 +691,20 @@ func (f *Filter) evalExists(expr parser.Exists) (value.Primary, error) {
 func (f *Filter) evalFunction(expr parser.Function) (value.Primary, error) {
 	name := strings.ToUpper(expr.Name)
 
-	if _, ok := Functions[name]; !ok && name != "NOW" {
+	if _, ok := AnalyticFunctions[name]; ok {
+		return nil, NewAnalyticFunctionWithoutOverError(expr, expr.Name)
+	}
+
+	if _, ok := ExternalAggregateFunctions[name]; ok {
+		aggrdcl := parser.AggregateFunction{
+			BaseExpr: expr.BaseExpr,
+			Name:     expr.Name,
+			Args:     expr.Args,
+		}
+		return f.evalAggregateFunction(aggrdcl)
+	}
+
+	if _, ok := Functions[name]; !ok && !isNowFunction(name) {
 		udfn, err := f.Functions.Get(expr, name)
 		if err != nil {
 			return nil, NewFunctionNotExistError(expr, expr.Name)
@@ -584,8 +723,23 @@ func (f *Filter) evalFunction(expr parser.Function) (value.Primary, error) {
 		}
 	}
 
-	args := make([]value.Primary, len(expr.Args))
-	for i, v := range expr.Args {
+	switch name {
+	case "COALESCE":
+		return f.evalCoalesce(expr)
+	case "NVL2":
+		return f.evalNvl2(expr)
+	}
+
+	argExprs := expr.Args
+	if name == "ROW_HASH" {
+		var err error
+		if argExprs, err = f.expandRowHashArgs(expr); err != nil {
+			return nil, err
+		}
+	}
+
+	args := make([]value.Primary, len(argExprs))
+	for i, v := range argExprs {
 		arg, err := f.Evaluate(v)
 		if err != nil {
 			return nil, err
@@ -593,7 +747,7 @@ func (f *Filter) evalFunction(expr parser.Function) (value.Primary, error) {
 		args[i] = arg
 	}
 
-	if name == "NOW" {
+	if isNowFunction(name) {
 		return Now(expr, args, f)
 	}
 
@@ -602,11 +756,78 @@ func (f *Filter) evalFunction(expr parser.Function) (value.Primary, error) {
 	}
 
 	udfn, _ := f.Functions.Get(expr, name)
+	if udfn.IsDeterministic && f.functionResults != nil {
+		return f.functionResults.Execute(udfn, args, f)
+	}
 	return udfn.Execute(args, f)
 }
 
+// Arguments after the first non-null value are not evaluated.
+func (f *Filter) evalCoalesce(expr parser.Function) (value.Primary, error) {
+	args := make([]value.Primary, 0, len(expr.Args))
+	for _, v := range expr.Args {
+		arg, err := f.Evaluate(v)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if !value.IsNull(arg) {
+			break
+		}
+	}
+	return Coalesce(expr, args)
+}
+
+// Only one of the second and the third arguments is evaluated.
+func (f *Filter) evalNvl2(expr parser.Function) (value.Primary, error) {
+	if len(expr.Args) != 3 {
+		return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{3})
+	}
+
+	p, err := f.Evaluate(expr.Args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	args := []value.Primary{p, value.NewNull(), value.NewNull()}
+	idx := 1
+	if value.IsNull(p) {
+		idx = 2
+	}
+	if args[idx], err = f.Evaluate(expr.Args[idx]); err != nil {
+		return nil, err
+	}
+	return Nvl2(expr, args)
+}
+
+func (f *Filter) expandRowHashArgs(expr parser.Function) ([]parser.QueryExpression, error) {
+	list := make([]parser.QueryExpression, 0, len(expr.Args))
+	for _, v := range expr.Args {
+		allColumns, ok := v.(parser.AllColumns)
+		if !ok {
+			list = append(list, v)
+			continue
+		}
+
+		if len(f.Records) < 1 {
+			return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "wildcard requires a table")
+		}
+		fields, err := f.Records[0].View.expandAllColumns(allColumns)
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range fields {
+			list = append(list, field.(parser.Field).Object)
+		}
+	}
+	return list, nil
+}
+
 func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Primary, error) {
 	var aggfn func([]value.Primary) value.Primary
+	var argfn ArgAggregateFunction
+	var fracfn FractionAggregateFunction
+	var newAggregator func() Aggregator
 	var udfn *UserDefinedFunction
 	var useUserDefined bool
 	var err error
@@ -614,6 +835,12 @@ func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Pri
 	uname := strings.ToUpper(expr.Name)
 	if fn, ok := AggregateFunctions[uname]; ok {
 		aggfn = fn
+	} else if fn, ok := ArgAggregateFunctions[uname]; ok {
+		argfn = fn
+	} else if fn, ok := FractionAggregateFunctions[uname]; ok {
+		fracfn = fn
+	} else if fn, ok := ExternalAggregateFunctions[uname]; ok {
+		newAggregator = fn
 	} else {
 		if udfn, err = f.Functions.Get(expr, uname); err != nil || !udfn.IsAggregate {
 			return nil, NewFunctionNotExistError(expr, expr.Name)
@@ -625,6 +852,14 @@ func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Pri
 		if err = udfn.CheckArgsLen(expr, expr.Name, len(expr.Args)-1); err != nil {
 			return nil, err
 		}
+	} else if uname == "COUNT" && expr.IsDistinct() {
+		if len(expr.Args) < 1 {
+			return nil, NewFunctionArgumentLengthErrorWithCustomArgs(expr, expr.Name, "at least "+FormatCount(1, "argument"))
+		}
+	} else if argfn != nil || fracfn != nil {
+		if len(expr.Args) != 2 {
+			return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{2})
+		}
 	} else {
 		if len(expr.Args) != 1 {
 			return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{1})
@@ -639,19 +874,52 @@ func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Pri
 		return nil, NewNotGroupingRecordsError(expr, expr.Name)
 	}
 
-	listExpr := expr.Args[0]
-	if _, ok := listExpr.(parser.AllColumns); ok {
-		listExpr = parser.NewIntegerValue(1)
+	listExprs := aggregateListExprs(uname, expr.IsDistinct(), expr.Args)
+	if _, ok := listExprs[0].(parser.AllColumns); ok {
+		listExprs = []parser.QueryExpression{parser.NewIntegerValue(1)}
 	}
 
-	if uname == "COUNT" {
-		if _, ok := listExpr.(parser.PrimitiveType); ok {
+	if uname == "COUNT" && !expr.IsDistinct() {
+		if _, ok := listExprs[0].(parser.PrimitiveType); ok {
 			return value.NewInteger(int64(f.Records[0].View.RecordSet[f.Records[0].RecordIndex].GroupLen())), nil
 		}
 	}
 
 	view := NewViewFromGroupedRecord(f.Records[0])
-	list, err := view.ListValuesForAggregateFunctions(expr, listExpr, expr.IsDistinct(), f)
+
+	if argfn != nil {
+		list, err := view.ListValuesForAggregateFunctions(expr, expr.Args[:1], false, f)
+		if err != nil {
+			return nil, err
+		}
+		keys, err := view.ListValuesForAggregateFunctions(expr, expr.Args[1:], false, f)
+		if err != nil {
+			return nil, err
+		}
+		return argfn(list, keys), nil
+	}
+
+	if fracfn != nil {
+		fraction, err := evalAggregateFraction(expr, expr.Name, expr.Args[1], f)
+		if err != nil {
+			return nil, err
+		}
+		list, err := view.ListValuesForAggregateFunctions(expr, expr.Args[:1], expr.IsDistinct(), f)
+		if err != nil {
+			return nil, err
+		}
+		return fracfn(list, fraction), nil
+	}
+
+	if uname == "COUNT" && expr.IsDistinct() {
+		list, err := view.ListValuesForAggregateFunctions(expr, listExprs, false, f)
+		if err != nil {
+			return nil, err
+		}
+		return CountDistinct(list), nil
+	}
+
+	list, err := view.ListValuesForAggregateFunctions(expr, listExprs, expr.IsDistinct(), f)
 	if err != nil {
 		return nil, err
 	}
@@ -669,6 +937,9 @@ func (f *Filter) evalAggregateFunction(expr parser.AggregateFunction) (value.Pri
 		return udfn.ExecuteAggregate(list, args, f)
 	}
 
+	if newAggregator != nil {
+		return aggregateWithAggregator(expr, expr.Name, newAggregator, list)
+	}
 	return aggfn(list), nil
 }
 
@@ -706,7 +977,7 @@ func (f *Filter) evalListAgg(expr parser.ListAgg) (value.Primary, error) {
 		}
 	}
 
-	list, err := view.ListValuesForAggregateFunctions(expr, expr.Args[0], expr.IsDistinct(), f)
+	list, err := view.ListValuesForAggregateFunctions(expr, expr.Args[:1], expr.IsDistinct(), f)
 	if err != nil {
 		return nil, err
 	}
@@ -957,7 +1228,7 @@ func (f *Filter) evalSubqueryForSingleFieldRowValues(expr parser.Subquery) ([]va
 }
 
 func (f *Filter) evalSubqueryForSingleValue(expr parser.Subquery) (value.Primary, error) {
-	view, err := Select(expr.Query, f)
+	view, err := selectScalarSubquery(expr.Query, f)
 	if err != nil {
 		return nil, err
 	}
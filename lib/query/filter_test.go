@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
@@ -351,6 +352,46 @@ var filterEvaluateTests = []struct {
 		},
 		Result: value.NewNull(),
 	},
+	{
+		Name: "AtTimeZone",
+		Expr: parser.AtTimeZone{
+			Value:    parser.NewDatetimeValue(time.Date(2012, 2, 3, 18, 18, 15, 0, time.FixedZone("JST", 9*60*60))),
+			TimeZone: parser.NewStringValue("UTC"),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+	},
+	{
+		Name: "AtTimeZone Value from String",
+		Expr: parser.AtTimeZone{
+			Value:    parser.NewStringValue("2012-02-03T18:18:15+09:00"),
+			TimeZone: parser.NewStringValue("UTC"),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+	},
+	{
+		Name: "AtTimeZone Null",
+		Expr: parser.AtTimeZone{
+			Value:    parser.NewNullValue(),
+			TimeZone: parser.NewStringValue("UTC"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "AtTimeZone FieldNotExist Error",
+		Expr: parser.AtTimeZone{
+			Value:    parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			TimeZone: parser.NewStringValue("UTC"),
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "AtTimeZone Invalid TimeZone Error",
+		Expr: parser.AtTimeZone{
+			Value:    parser.NewDatetimeValue(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+			TimeZone: parser.NewStringValue("Notexist/Zone"),
+		},
+		Error: "[L:- C:-] timezone Notexist/Zone does not exist",
+	},
 	{
 		Name: "Comparison",
 		Expr: parser.Comparison{
@@ -721,6 +762,77 @@ var filterEvaluateTests = []struct {
 		},
 		Result: value.NewTernary(ternary.TRUE),
 	},
+	{
+		Name: "Between Symmetric",
+		Expr: parser.Between{
+			LHS:       parser.NewIntegerValue(2),
+			Low:       parser.NewIntegerValue(3),
+			High:      parser.NewIntegerValue(1),
+			Symmetric: parser.Token{Token: parser.SYMMETRIC, Literal: "symmetric"},
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "Between Symmetric Not Matched",
+		Expr: parser.Between{
+			LHS:       parser.NewIntegerValue(5),
+			Low:       parser.NewIntegerValue(3),
+			High:      parser.NewIntegerValue(1),
+			Symmetric: parser.Token{Token: parser.SYMMETRIC, Literal: "symmetric"},
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "Between Symmetric Low Is Null",
+		Expr: parser.Between{
+			LHS:       parser.NewIntegerValue(0),
+			Low:       parser.NewNullValue(),
+			High:      parser.NewIntegerValue(1),
+			Symmetric: parser.Token{Token: parser.SYMMETRIC, Literal: "symmetric"},
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "Between Symmetric High Is Null",
+		Expr: parser.Between{
+			LHS:       parser.NewIntegerValue(5),
+			Low:       parser.NewIntegerValue(1),
+			High:      parser.NewNullValue(),
+			Symmetric: parser.Token{Token: parser.SYMMETRIC, Literal: "symmetric"},
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "Between Symmetric with Row Values",
+		Expr: parser.Between{
+			LHS: parser.RowValue{
+				Value: parser.ValueList{
+					Values: []parser.QueryExpression{
+						parser.NewIntegerValue(1),
+						parser.NewIntegerValue(2),
+					},
+				},
+			},
+			Low: parser.RowValue{
+				Value: parser.ValueList{
+					Values: []parser.QueryExpression{
+						parser.NewIntegerValue(1),
+						parser.NewIntegerValue(3),
+					},
+				},
+			},
+			High: parser.RowValue{
+				Value: parser.ValueList{
+					Values: []parser.QueryExpression{
+						parser.NewIntegerValue(1),
+						parser.NewIntegerValue(1),
+					},
+				},
+			},
+			Symmetric: parser.Token{Token: parser.SYMMETRIC, Literal: "symmetric"},
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
 	{
 		Name: "Between with Row Values LHS Error",
 		Expr: parser.Between{
@@ -1718,6 +1830,178 @@ var filterEvaluateTests = []struct {
 		},
 		Error: "[L:- C:-] field notexist does not exist",
 	},
+	{
+		Name: "ILike",
+		Expr: parser.Like{
+			Like:    "ilike",
+			LHS:     parser.NewStringValue("ABCDEFG"),
+			Pattern: parser.NewStringValue("_bc%"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "Like With Escape",
+		Expr: parser.Like{
+			LHS:       parser.NewStringValue("100%"),
+			Pattern:   parser.NewStringValue("%0!%"),
+			EscapeLit: "escape",
+			Escape:    parser.NewStringValue("!"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "Like With Escape Not Matched",
+		Expr: parser.Like{
+			LHS:       parser.NewStringValue("1000"),
+			Pattern:   parser.NewStringValue("%0!%"),
+			EscapeLit: "escape",
+			Escape:    parser.NewStringValue("!"),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "Like Escape Evaluation Error",
+		Expr: parser.Like{
+			LHS:       parser.NewStringValue("100%"),
+			Pattern:   parser.NewStringValue("%0!%"),
+			EscapeLit: "escape",
+			Escape:    parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Like Invalid Escape Error",
+		Expr: parser.Like{
+			LHS:       parser.NewStringValue("100%"),
+			Pattern:   parser.NewStringValue("%0!%"),
+			EscapeLit: "escape",
+			Escape:    parser.NewStringValue("!!"),
+		},
+		Error: "[L:- C:-] escape character '!!' must be a single character",
+	},
+	{
+		Name: "Similar To",
+		Expr: parser.SimilarTo{
+			LHS:      parser.NewStringValue("abcd"),
+			Pattern:  parser.NewStringValue("a(b|x)%"),
+			Negation: parser.Token{Token: parser.NOT, Literal: "not"},
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "Similar To With Escape",
+		Expr: parser.SimilarTo{
+			LHS:       parser.NewStringValue("100%"),
+			Pattern:   parser.NewStringValue("[0-9]+!%"),
+			EscapeLit: "escape",
+			Escape:    parser.NewStringValue("!"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "Similar To LHS Error",
+		Expr: parser.SimilarTo{
+			LHS:     parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			Pattern: parser.NewStringValue("a%"),
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Similar To Pattern Error",
+		Expr: parser.SimilarTo{
+			LHS:     parser.NewStringValue("abcd"),
+			Pattern: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Similar To Invalid Escape Error",
+		Expr: parser.SimilarTo{
+			LHS:       parser.NewStringValue("abcd"),
+			Pattern:   parser.NewStringValue("a%"),
+			EscapeLit: "escape",
+			Escape:    parser.NewStringValue(""),
+		},
+		Error: "[L:- C:-] escape character '' must be a single character",
+	},
+	{
+		Name: "Similar To Invalid Pattern Error",
+		Expr: parser.SimilarTo{
+			LHS:     parser.NewStringValue("abcd"),
+			Pattern: parser.NewStringValue("a(b%"),
+		},
+		Error: "[L:- C:-] pattern 'a(b%' is an invalid regular expression: error parsing regexp: missing closing ): `(?is)^(?:a(b.*)$`",
+	},
+	{
+		Name: "RegExp Match",
+		Expr: parser.RegExpMatch{
+			LHS:      parser.NewStringValue("ABCD"),
+			Operator: "~",
+			Pattern:  parser.NewStringValue("^ab"),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "RegExp Match Case Insensitive",
+		Expr: parser.RegExpMatch{
+			LHS:      parser.NewStringValue("ABCD"),
+			Operator: "~*",
+			Pattern:  parser.NewStringValue("^ab"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "RegExp Match Negation",
+		Expr: parser.RegExpMatch{
+			LHS:      parser.NewStringValue("ABCD"),
+			Operator: "!~",
+			Pattern:  parser.NewStringValue("^ab"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "RegExp Match LHS Error",
+		Expr: parser.RegExpMatch{
+			LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			Operator: "~",
+			Pattern:  parser.NewStringValue("^ab"),
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "RegExp Match Pattern Error",
+		Expr: parser.RegExpMatch{
+			LHS:      parser.NewStringValue("ABCD"),
+			Operator: "~",
+			Pattern:  parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "RegExp Match Invalid Pattern Error",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1"}),
+						RecordSet: []Record{
+							NewRecordWithId(1, []value.Primary{
+								value.NewString("[a-"),
+							}),
+						},
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.RegExpMatch{
+			BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 10}),
+			LHS:      parser.NewStringValue("ABCD"),
+			Operator: "~",
+			Pattern:  parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+		},
+		Error: "[L:1 C:10] pattern '[a-' is an invalid regular expression: error parsing regexp: missing closing ]: `[a-`",
+	},
 	{
 		Name: "Exists",
 		Filter: &Filter{
@@ -1979,6 +2263,60 @@ var filterEvaluateTests = []struct {
 		},
 		Result: value.NewDatetime(NowForTest),
 	},
+	{
+		Name: "Function Current Timestamp",
+		Filter: &Filter{
+			Variables: VariableScopes{{}},
+			TempViews: TemporaryViewScopes{{}},
+			Cursors:   CursorScopes{{}},
+			Functions: UserDefinedFunctionScopes{{}},
+			Now:       time.Date(2013, 2, 3, 0, 0, 0, 0, GetTestLocation()),
+		},
+		Expr: parser.Function{
+			Name: "current_timestamp",
+		},
+		Result: value.NewDatetime(time.Date(2013, 2, 3, 0, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		Name: "Function Row Hash",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							NewRecordWithId(1, []value.Primary{
+								value.NewInteger(1),
+								value.NewString("str"),
+							}),
+							NewRecordWithId(2, []value.Primary{
+								value.NewInteger(2),
+								value.NewNull(),
+							}),
+						},
+					},
+					RecordIndex: 1,
+				},
+			},
+		},
+		Expr: parser.Function{
+			Name: "row_hash",
+			Args: []parser.QueryExpression{
+				parser.AllColumns{},
+			},
+		},
+		Result: value.NewString("f34516a8bec3c7c042987259317dde77da39c7d02d69816d92b36ed685869b25"),
+	},
+	{
+		Name: "Function Row Hash Without Table Error",
+		Expr: parser.Function{
+			Name: "row_hash",
+			Args: []parser.QueryExpression{
+				parser.AllColumns{},
+			},
+		},
+		Error: "[L:- C:-] wildcard requires a table for function row_hash",
+	},
 	{
 		Name: "User Defined Function",
 		Filter: &Filter{
@@ -2040,6 +2378,13 @@ var filterEvaluateTests = []struct {
 		},
 		Error: "[L:- C:-] function notexist does not exist",
 	},
+	{
+		Name: "Function Analytic Function Without Over Clause Error",
+		Expr: parser.Function{
+			Name: "row_number",
+		},
+		Error: "[L:- C:-] function row_number requires an OVER clause",
+	},
 	{
 		Name: "Function Evaluate Error",
 		Expr: parser.Function{
@@ -2051,6 +2396,70 @@ var filterEvaluateTests = []struct {
 		},
 		Error: "[L:- C:-] field notexist does not exist",
 	},
+	{
+		Name: "Function Coalesce Short-Circuit",
+		Expr: parser.Function{
+			Name: "coalesce",
+			Args: []parser.QueryExpression{
+				parser.NewStringValue("str"),
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			},
+		},
+		Result: value.NewString("str"),
+	},
+	{
+		Name: "Function Coalesce Arguments Error",
+		Expr: parser.Function{
+			Name: "coalesce",
+		},
+		Error: "[L:- C:-] function coalesce takes at least 1 argument",
+	},
+	{
+		Name: "Function Nvl2 Not Null",
+		Expr: parser.Function{
+			Name: "nvl2",
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValue(1),
+				parser.NewStringValue("not null"),
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			},
+		},
+		Result: value.NewString("not null"),
+	},
+	{
+		Name: "Function Nvl2 Null",
+		Expr: parser.Function{
+			Name: "nvl2",
+			Args: []parser.QueryExpression{
+				parser.NewNullValue(),
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+				parser.NewStringValue("null"),
+			},
+		},
+		Result: value.NewString("null"),
+	},
+	{
+		Name: "Function Nvl2 Evaluate Error",
+		Expr: parser.Function{
+			Name: "nvl2",
+			Args: []parser.QueryExpression{
+				parser.NewNullValue(),
+				parser.NewStringValue("not null"),
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Function Nvl2 Arguments Error",
+		Expr: parser.Function{
+			Name: "nvl2",
+			Args: []parser.QueryExpression{
+				parser.NewNullValue(),
+			},
+		},
+		Error: "[L:- C:-] function nvl2 takes exactly 3 arguments",
+	},
 	{
 		Name: "Aggregate Function",
 		Filter: &Filter{
@@ -2092,6 +2501,430 @@ var filterEvaluateTests = []struct {
 		},
 		Result: value.NewInteger(2),
 	},
+	{
+		Name: "Aggregate Function BoolAnd With Predicate",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewNull(),
+									value.NewInteger(3),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("str1"),
+									value.NewString("str2"),
+									value.NewString("str3"),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name:     "bool_and",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValue(0),
+					Operator: ">",
+				},
+			},
+		},
+		Result: value.NewBoolean(true),
+	},
+	{
+		Name: "Aggregate Function Count Distinct",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+									value.NewInteger(5),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(2),
+									value.NewNull(),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("a"),
+									value.NewString("a"),
+									value.NewString("a"),
+									value.NewString("b"),
+									value.NewString("c"),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name:     "count",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "Aggregate Function Count Distinct Multiple Columns",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+									value.NewInteger(5),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(2),
+									value.NewNull(),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("a"),
+									value.NewString("a"),
+									value.NewString("a"),
+									value.NewString("b"),
+									value.NewString("c"),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name:     "count",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name: "Aggregate Function ArgMax",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+									value.NewInteger(5),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(2),
+									value.NewNull(),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("a"),
+									value.NewString("b"),
+									value.NewString("c"),
+									value.NewString("d"),
+									value.NewString("e"),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "arg_max",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Result: value.NewString("c"),
+	},
+	{
+		Name: "Aggregate Function CountIf",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("a"),
+									value.NewString("b"),
+									value.NewNull(),
+									value.NewString("a"),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "count_if",
+			Args: []parser.QueryExpression{
+				parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.NewStringValue("a"),
+					Operator: "=",
+				},
+			},
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "Aggregate Function SumIf",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+									value.NewInteger(4),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("a"),
+									value.NewString("b"),
+									value.NewNull(),
+									value.NewString("a"),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "sum_if",
+			Args: []parser.QueryExpression{
+				parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.NewStringValue("a"),
+					Operator: "=",
+				},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Result: value.NewInteger(5),
+	},
+	{
+		Name: "Aggregate Function Percentile",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(10),
+									value.NewInteger(20),
+									value.NewNull(),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "percentile",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.NewFloatValue(0.25),
+			},
+		},
+		Result: value.NewFloat(12.5),
+	},
+	{
+		Name: "Aggregate Function Percentile Fraction Out Of Range Error",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(10),
+									value.NewInteger(20),
+									value.NewNull(),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "percentile",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.NewFloatValue(1.5),
+			},
+		},
+		Error: "[L:- C:-] the second argument must be a constant number between 0 and 1 for function percentile",
+	},
+	{
+		Name: "Aggregate Function Percentile Fraction Not Constant Error",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+									value.NewInteger(2),
+									value.NewInteger(3),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(10),
+									value.NewInteger(20),
+									value.NewNull(),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "percentile",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Error: "[L:- C:-] the second argument must be a constant number between 0 and 1 for function percentile",
+	},
+	{
+		Name: "Aggregate Function ArgMax Argument Length Error",
+		Filter: &Filter{
+			Records: []FilterRecord{
+				{
+					View: &View{
+						Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							{
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewInteger(1),
+								}),
+								NewGroupCell([]value.Primary{
+									value.NewString("a"),
+								}),
+							},
+						},
+						isGrouped: true,
+					},
+					RecordIndex: 0,
+				},
+			},
+		},
+		Expr: parser.AggregateFunction{
+			Name: "arg_max",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Error: "[L:- C:-] function arg_max takes exactly 2 arguments",
+	},
 	{
 		Name: "Aggregate Function Argument Length Error",
 		Filter: &Filter{
@@ -1,6 +1,7 @@
 package query
 
 import (
+	"bytes"
 	"crypto/hmac"
 	"crypto/md5"
 	"crypto/sha1"
@@ -8,6 +9,7 @@ import (
 	"crypto/sha512"
 	"encoding/base64"
 	"encoding/hex"
+	"fmt"
 	"hash"
 	"math"
 	"os/exec"
@@ -28,6 +30,7 @@ var Functions = map[string]func(parser.Function, []value.Primary) (value.Primary
 	"COALESCE":         Coalesce,
 	"IF":               If,
 	"IFNULL":           Ifnull,
+	"NVL2":             Nvl2,
 	"NULLIF":           Nullif,
 	"CEIL":             Ceil,
 	"FLOOR":            Floor,
@@ -58,6 +61,8 @@ var Functions = map[string]func(parser.Function, []value.Primary) (value.Primary
 	"HEX":              Hex,
 	"ENOTATION":        Enotation,
 	"RAND":             Rand,
+	"RANDOM_UUID":      RandomUUID,
+	"WIDTH_BUCKET":     WidthBucket,
 	"TRIM":             Trim,
 	"LTRIM":            Ltrim,
 	"RTRIM":            Rtrim,
@@ -76,6 +81,7 @@ var Functions = map[string]func(parser.Function, []value.Primary) (value.Primary
 	"LIST_ELEM":        ListElem,
 	"REPLACE":          Replace,
 	"FORMAT":           Format,
+	"TO_CHAR":          ToChar,
 	"MD5":              Md5,
 	"SHA1":             Sha1,
 	"SHA256":           Sha256,
@@ -84,7 +90,11 @@ var Functions = map[string]func(parser.Function, []value.Primary) (value.Primary
 	"SHA1_HMAC":        Sha1Hmac,
 	"SHA256_HMAC":      Sha256Hmac,
 	"SHA512_HMAC":      Sha512Hmac,
+	"ROW_HASH":         RowHash,
+	"CLOCK_TIMESTAMP":  ClockTimestamp,
 	"DATETIME_FORMAT":  DatetimeFormat,
+	"STRFTIME":         Strftime,
+	"STRPTIME":         Strptime,
 	"YEAR":             Year,
 	"MONTH":            Month,
 	"DAY":              Day,
@@ -97,8 +107,13 @@ var Functions = map[string]func(parser.Function, []value.Primary) (value.Primary
 	"WEEKDAY":          Weekday,
 	"UNIX_TIME":        UnixTime,
 	"UNIX_NANO_TIME":   UnixNanoTime,
+	"UNIX_TIMESTAMP":   UnixTimestamp,
+	"TO_TIMESTAMP":     ToTimestamp,
 	"DAY_OF_YEAR":      DayOfYear,
 	"WEEK_OF_YEAR":     WeekOfYear,
+	"ISOWEEK":          WeekOfYear,
+	"DAYNAME":          DayName,
+	"MONTHNAME":        MonthName,
 	"ADD_YEAR":         AddYear,
 	"ADD_MONTH":        AddMonth,
 	"ADD_DAY":          AddDay,
@@ -120,7 +135,12 @@ var Functions = map[string]func(parser.Function, []value.Primary) (value.Primary
 	"DATE_DIFF":        DateDiff,
 	"TIME_DIFF":        TimeDiff,
 	"TIME_NANO_DIFF":   TimeNanoDiff,
+	"DURATION_FORMAT":  DurationFormat,
 	"UTC":              UTC,
+	"ARRAY_LENGTH":     ArrayLength,
+	"ARRAY_CONTAINS":   ArrayContains,
+	"STRING_TO_ARRAY":  StringToArray,
+	"ARRAY_TO_STRING":  ArrayToString,
 	"STRING":           String,
 	"INTEGER":          Integer,
 	"FLOAT":            Float,
@@ -130,6 +150,68 @@ var Functions = map[string]func(parser.Function, []value.Primary) (value.Primary
 	"CALL":             Call,
 }
 
+// volatileFunctions are functions that can return different results for the same arguments.
+// Functions registered with RegisterFunction are added to them because their behaviors are unknown.
+var volatileFunctions = map[string]bool{
+	"RAND":            true,
+	"RANDOM_UUID":     true,
+	"CLOCK_TIMESTAMP": true,
+}
+
+func isVolatileFunction(uname string) bool {
+	return volatileFunctions[uname] || isNowFunction(uname)
+}
+
+// RegisterFunction registers a scalar function implemented in Go so that it can be called in queries.
+// Function names are case-insensitive, and names that are already used by other functions cannot be registered.
+func RegisterFunction(name string, fn func([]value.Primary) (value.Primary, error)) error {
+	uname := strings.ToUpper(name)
+	if len(uname) < 1 {
+		return fmt.Errorf("function name is empty")
+	}
+	if fn == nil {
+		return fmt.Errorf("function %s is nil", name)
+	}
+	if isReservedFunctionName(uname) {
+		return fmt.Errorf("function %s already exists", name)
+	}
+
+	volatileFunctions[uname] = true
+	Functions[uname] = func(expr parser.Function, args []value.Primary) (value.Primary, error) {
+		p, err := fn(args)
+		if err != nil {
+			return nil, NewFunctionInvalidArgumentError(expr, expr.Name, err.Error())
+		}
+		if p == nil {
+			return value.NewNull(), nil
+		}
+		return p, nil
+	}
+	return nil
+}
+
+func isReservedFunctionName(uname string) bool {
+	if _, ok := Functions[uname]; ok || isNowFunction(uname) {
+		return true
+	}
+	if _, ok := AggregateFunctions[uname]; ok {
+		return true
+	}
+	if _, ok := ArgAggregateFunctions[uname]; ok {
+		return true
+	}
+	if _, ok := FractionAggregateFunctions[uname]; ok {
+		return true
+	}
+	if _, ok := ExternalAggregateFunctions[uname]; ok {
+		return true
+	}
+	if _, ok := AnalyticFunctions[uname]; ok {
+		return true
+	}
+	return false
+}
+
 func Coalesce(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	if len(args) < 1 {
 		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least 1 argument")
@@ -165,6 +247,17 @@ func Ifnull(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return args[0], nil
 }
 
+func Nvl2(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) != 3 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{3})
+	}
+
+	if value.IsNull(args[0]) {
+		return args[2], nil
+	}
+	return args[1], nil
+}
+
 func Nullif(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	if len(args) != 2 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
@@ -482,6 +575,79 @@ func Rand(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return value.NewInteger(r.Int63n(delta) + low), nil
 }
 
+// RandomUUID returns a version 4 UUID generated by the same source as RAND.
+func RandomUUID(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if 0 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0})
+	}
+
+	r := cmd.GetRand()
+
+	b := make([]byte, 16)
+	for i := 0; i < len(b); i += 8 {
+		n := r.Uint64()
+		for j := 0; j < 8; j++ {
+			b[i+j] = byte(n >> uint(j*8))
+		}
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	s := hex.EncodeToString(b)
+	return value.NewString(s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:32]), nil
+}
+
+func WidthBucket(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) != 4 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{4})
+	}
+
+	p := value.ToFloat(args[0])
+	if value.IsNull(p) {
+		return value.NewNull(), nil
+	}
+	minp := value.ToFloat(args[1])
+	if value.IsNull(minp) {
+		return value.NewNull(), nil
+	}
+	maxp := value.ToFloat(args[2])
+	if value.IsNull(maxp) {
+		return value.NewNull(), nil
+	}
+	if value.IsNull(args[3]) {
+		return value.NewNull(), nil
+	}
+	countp := value.ToInteger(args[3])
+	if value.IsNull(countp) || countp.(value.Integer).Raw() < 1 {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the fourth argument must be a positive integer")
+	}
+
+	v := p.(value.Float).Raw()
+	low := minp.(value.Float).Raw()
+	high := maxp.(value.Float).Raw()
+	count := countp.(value.Integer).Raw()
+	if low == high {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument and the third argument must be different")
+	}
+
+	if high < low {
+		v, low, high = -v, -low, -high
+	}
+
+	switch {
+	case v < low:
+		return value.NewInteger(0), nil
+	case high <= v:
+		return value.NewInteger(count + 1), nil
+	}
+
+	bucket := int64(float64(count)*(v-low)/(high-low)) + 1
+	if count < bucket {
+		bucket = count
+	}
+	return value.NewInteger(bucket), nil
+}
+
 func execStrings1Arg(fn parser.Function, args []value.Primary, stringsf func(string) string) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
@@ -851,6 +1017,44 @@ func Format(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return value.NewString(str), nil
 }
 
+func ToChar(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	if value.IsNull(args[0]) {
+		return value.NewNull(), nil
+	}
+	format := value.ToString(args[1])
+	if value.IsNull(format) {
+		return value.NewNull(), nil
+	}
+	pattern := format.(value.String).Raw()
+
+	var str string
+	var err error
+	switch args[0].(type) {
+	case value.Integer, value.Float:
+		str, err = FormatNumberWithPattern(args[0], pattern)
+	case value.Datetime:
+		str, err = FormatDatetimeWithPattern(args[0].(value.Datetime).Raw(), pattern)
+	default:
+		if p := value.ToInteger(args[0]); !value.IsNull(p) {
+			str, err = FormatNumberWithPattern(p, pattern)
+		} else if p := value.ToFloat(args[0]); !value.IsNull(p) {
+			str, err = FormatNumberWithPattern(p, pattern)
+		} else if p := value.ToDatetime(args[0]); !value.IsNull(p) {
+			str, err = FormatDatetimeWithPattern(p.(value.Datetime).Raw(), pattern)
+		} else {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the first argument must be a number or a datetime")
+		}
+	}
+	if err != nil {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, err.(AppError).ErrorMessage())
+	}
+	return value.NewString(str), nil
+}
+
 func Md5(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return execCrypto(fn, args, md5.New)
 }
@@ -867,6 +1071,16 @@ func Sha512(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return execCrypto(fn, args, sha512.New)
 }
 
+func RowHash(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) < 1 {
+		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least "+FormatCount(1, "argument"))
+	}
+
+	h := sha256.New()
+	h.Write([]byte(SerializeComparisonKeys(args)))
+	return value.NewString(hex.EncodeToString(h.Sum(nil))), nil
+}
+
 func Md5Hmac(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return execCryptoHMAC(fn, args, md5.New)
 }
@@ -883,6 +1097,14 @@ func Sha512Hmac(fn parser.Function, args []value.Primary) (value.Primary, error)
 	return execCryptoHMAC(fn, args, sha512.New)
 }
 
+// ClockTimestamp returns the current time each time it is called.
+func ClockTimestamp(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if 0 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0})
+	}
+	return value.NewDatetime(cmd.Now()), nil
+}
+
 func DatetimeFormat(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	if len(args) != 2 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
@@ -901,6 +1123,45 @@ func DatetimeFormat(fn parser.Function, args []value.Primary) (value.Primary, er
 	return value.NewString(dt.Format(value.DatetimeFormats.Get(format.(value.String).Raw()))), nil
 }
 
+func Strftime(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	p := value.ToDatetime(args[0])
+	if value.IsNull(p) {
+		return value.NewNull(), nil
+	}
+	format := value.ToString(args[1])
+	if value.IsNull(format) {
+		return value.NewNull(), nil
+	}
+
+	dt := p.(value.Datetime)
+	return value.NewString(dt.Format(value.StrftimeFormats.Get(format.(value.String).Raw()))), nil
+}
+
+func Strptime(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return value.NewNull(), nil
+	}
+	format := value.ToString(args[1])
+	if value.IsNull(format) {
+		return value.NewNull(), nil
+	}
+
+	t, err := time.ParseInLocation(value.StrftimeFormats.Get(format.(value.String).Raw()), s.(value.String).Raw(), cmd.GetLocation())
+	if err != nil {
+		return value.NewNull(), nil
+	}
+	return value.NewDatetime(t.In(cmd.GetLocation())), nil
+}
+
 func execDatetimeToInt(fn parser.Function, args []value.Primary, timef func(time.Time) int64) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
@@ -915,6 +1176,19 @@ func execDatetimeToInt(fn parser.Function, args []value.Primary, timef func(time
 	return value.NewInteger(result), nil
 }
 
+func execDatetimeToName(fn parser.Function, args []value.Primary, namef func(time.Time, cmd.Locale) string) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	dt := value.ToDatetime(args[0])
+	if value.IsNull(dt) {
+		return value.NewNull(), nil
+	}
+
+	return value.NewString(namef(dt.(value.Datetime).Raw(), cmd.GetFlags().Locale)), nil
+}
+
 func execDatetimeAdd(fn parser.Function, args []value.Primary, timef func(time.Time, int) time.Time) (value.Primary, error) {
 	if len(args) != 2 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
@@ -991,6 +1265,38 @@ func weekOfYear(t time.Time) int64 {
 	return int64(w)
 }
 
+var dayNames = map[cmd.Locale][7]string{
+	cmd.EN: {"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	cmd.JA: {"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+	cmd.DE: {"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	cmd.FR: {"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	cmd.ES: {"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+}
+
+var monthNames = map[cmd.Locale][12]string{
+	cmd.EN: {"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	cmd.JA: {"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	cmd.DE: {"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	cmd.FR: {"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	cmd.ES: {"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+}
+
+func dayName(t time.Time, locale cmd.Locale) string {
+	names, ok := dayNames[locale]
+	if !ok {
+		names = dayNames[cmd.EN]
+	}
+	return names[t.Weekday()]
+}
+
+func monthName(t time.Time, locale cmd.Locale) string {
+	names, ok := monthNames[locale]
+	if !ok {
+		names = monthNames[cmd.EN]
+	}
+	return names[t.Month()-1]
+}
+
 func addYear(t time.Time, duration int) time.Time {
 	return t.AddDate(duration, 0, 0)
 }
@@ -1081,6 +1387,69 @@ func UnixNanoTime(fn parser.Function, args []value.Primary) (value.Primary, erro
 	return execDatetimeToInt(fn, args, unixNanoTime)
 }
 
+func epochScale(fn parser.Function, args []value.Primary) (int64, error) {
+	if len(args) < 2 {
+		return 1, nil
+	}
+
+	p := value.ToInteger(args[1])
+	if value.IsNull(p) || p.(value.Integer).Raw() < 0 || 9 < p.(value.Integer).Raw() {
+		return 0, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument must be an integer from 0 to 9")
+	}
+
+	scale := int64(1)
+	for i := int64(0); i < p.(value.Integer).Raw(); i++ {
+		scale = scale * 10
+	}
+	return scale, nil
+}
+
+func UnixTimestamp(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) < 1 || 2 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2})
+	}
+
+	scale, err := epochScale(fn, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if value.IsNull(args[0]) {
+		return value.NewNull(), nil
+	}
+	dt := value.ToDatetime(args[0])
+	if value.IsNull(dt) {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the first argument must be a datetime")
+	}
+
+	t := dt.(value.Datetime).Raw()
+	return value.NewInteger(t.Unix()*scale + int64(t.Nanosecond())/(int64(time.Second)/scale)), nil
+}
+
+func ToTimestamp(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) < 1 || 2 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2})
+	}
+
+	scale, err := epochScale(fn, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if value.IsNull(args[0]) {
+		return value.NewNull(), nil
+	}
+	if i := value.ToInteger(args[0]); !value.IsNull(i) {
+		epoch := i.(value.Integer).Raw()
+		return value.NewDatetime(time.Unix(epoch/scale, (epoch%scale)*(int64(time.Second)/scale)).In(cmd.GetLocation())), nil
+	}
+	f := value.ToFloat(args[0])
+	if value.IsNull(f) {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the first argument must be a number")
+	}
+	return value.NewDatetime(value.Float64ToTime(f.(value.Float).Raw() / float64(scale)).In(cmd.GetLocation())), nil
+}
+
 func DayOfYear(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return execDatetimeToInt(fn, args, dayOfYear)
 }
@@ -1089,6 +1458,14 @@ func WeekOfYear(fn parser.Function, args []value.Primary) (value.Primary, error)
 	return execDatetimeToInt(fn, args, weekOfYear)
 }
 
+func DayName(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return execDatetimeToName(fn, args, dayName)
+}
+
+func MonthName(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	return execDatetimeToName(fn, args, monthName)
+}
+
 func AddYear(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return execDatetimeAdd(fn, args, addYear)
 }
@@ -1253,6 +1630,78 @@ func TimeNanoDiff(fn parser.Function, args []value.Primary) (value.Primary, erro
 	return timeDiff(fn, args, durationNanoseconds)
 }
 
+func DurationFormat(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	p := value.ToFloat(args[0])
+	if value.IsNull(p) {
+		return value.NewNull(), nil
+	}
+	format := value.ToString(args[1])
+	if value.IsNull(format) {
+		return value.NewNull(), nil
+	}
+
+	dur := time.Duration(math.Round(p.(value.Float).Raw() * float64(time.Second)))
+	return value.NewString(formatDuration(dur, format.(value.String).Raw())), nil
+}
+
+func formatDuration(dur time.Duration, format string) string {
+	sign := ""
+	if dur < 0 {
+		sign = "-"
+		dur = -dur
+	}
+
+	nanoseconds := int64(dur % time.Second)
+	seconds := int64(dur / time.Second)
+	hours := seconds / 3600
+
+	var buf bytes.Buffer
+	buf.WriteString(sign)
+
+	escaped := false
+	for _, r := range format {
+		if !escaped {
+			if r == '%' {
+				escaped = true
+			} else {
+				buf.WriteRune(r)
+			}
+			continue
+		}
+
+		switch r {
+		case 'd':
+			buf.WriteString(strconv.FormatInt(hours/24, 10))
+		case 'H':
+			buf.WriteString(fmt.Sprintf("%02d", hours%24))
+		case 'h':
+			buf.WriteString(fmt.Sprintf("%02d", hours))
+		case 'i':
+			buf.WriteString(fmt.Sprintf("%02d", seconds/60%60))
+		case 's':
+			buf.WriteString(fmt.Sprintf("%02d", seconds%60))
+		case 'f':
+			buf.WriteString(fmt.Sprintf(".%06d", nanoseconds/1000))
+		case 'F':
+			if micro := strings.TrimRight(fmt.Sprintf("%06d", nanoseconds/1000), "0"); 0 < len(micro) {
+				buf.WriteString("." + micro)
+			}
+		default:
+			buf.WriteRune(r)
+		}
+		escaped = false
+	}
+	if escaped {
+		buf.WriteRune('%')
+	}
+
+	return buf.String()
+}
+
 func UTC(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
@@ -1266,20 +1715,135 @@ func UTC(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return value.NewDatetime(dt.(value.Datetime).Raw().UTC()), nil
 }
 
+func ArrayLength(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	a, ok := args[0].(value.Array)
+	if !ok {
+		return value.NewNull(), nil
+	}
+	return value.NewInteger(int64(a.Len())), nil
+}
+
+func ArrayContains(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	a, ok := args[0].(value.Array)
+	if !ok {
+		return value.NewTernary(ternary.UNKNOWN), nil
+	}
+
+	result := ternary.FALSE
+	for _, v := range a.Raw() {
+		switch value.Equal(v, args[1]) {
+		case ternary.TRUE:
+			return value.NewTernary(ternary.TRUE), nil
+		case ternary.UNKNOWN:
+			result = ternary.UNKNOWN
+		}
+	}
+	return value.NewTernary(result), nil
+}
+
+// StringToArray splits a string into an array of strings.
+// If the third argument is specified, elements equal to it are converted to nulls.
+func StringToArray(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) < 2 || 3 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return value.NewNull(), nil
+	}
+
+	sep := value.ToString(args[1])
+	if value.IsNull(sep) {
+		return value.NewNull(), nil
+	}
+
+	var nullStr value.Primary = value.NewNull()
+	if 3 == len(args) {
+		nullStr = value.ToString(args[2])
+	}
+
+	str := s.(value.String).Raw()
+	if len(str) < 1 {
+		return value.NewArray([]value.Primary{}), nil
+	}
+
+	list := strings.Split(str, sep.(value.String).Raw())
+	values := make([]value.Primary, len(list))
+	for i, elem := range list {
+		if !value.IsNull(nullStr) && elem == nullStr.(value.String).Raw() {
+			values[i] = value.NewNull()
+		} else {
+			values[i] = value.NewString(elem)
+		}
+	}
+	return value.NewArray(values), nil
+}
+
+// ArrayToString joins the elements of an array with a separator.
+// Null elements are skipped unless the third argument is specified, in which case they are replaced with it.
+func ArrayToString(fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if len(args) < 2 || 3 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3})
+	}
+
+	a, ok := args[0].(value.Array)
+	if !ok {
+		return value.NewNull(), nil
+	}
+
+	sep := value.ToString(args[1])
+	if value.IsNull(sep) {
+		return value.NewNull(), nil
+	}
+
+	var nullStr value.Primary = value.NewNull()
+	if 3 == len(args) {
+		nullStr = value.ToString(args[2])
+	}
+
+	list := make([]string, 0, a.Len())
+	for _, v := range a.Raw() {
+		s := castToString(v)
+		if value.IsNull(s) {
+			if value.IsNull(nullStr) {
+				continue
+			}
+			s = nullStr
+		}
+		list = append(list, s.(value.String).Raw())
+	}
+	return value.NewString(strings.Join(list, sep.(value.String).Raw())), nil
+}
+
 func String(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
 	}
 
-	switch args[0].(type) {
+	return castToString(args[0]), nil
+}
+
+func castToString(p value.Primary) value.Primary {
+	switch p.(type) {
 	case value.Boolean:
-		return value.NewString(strconv.FormatBool(args[0].(value.Boolean).Raw())), nil
+		return value.NewString(strconv.FormatBool(p.(value.Boolean).Raw()))
 	case value.Ternary:
-		return value.NewString(args[0].(value.Ternary).Ternary().String()), nil
+		return value.NewString(p.(value.Ternary).Ternary().String())
 	case value.Datetime:
-		return value.NewString(args[0].(value.Datetime).Format(time.RFC3339Nano)), nil
+		return value.NewString(p.(value.Datetime).Format(time.RFC3339Nano))
+	case value.Array:
+		return value.NewString(p.(value.Array).String())
 	default:
-		return value.ToString(args[0]), nil
+		return value.ToString(p)
 	}
 }
 
@@ -1292,19 +1856,41 @@ func Integer(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	case value.Integer:
 		return args[0], nil
 	case value.Float:
-		return value.NewInteger(int64(round(args[0].(value.Float).Raw(), 0))), nil
+		return roundToInteger(fn, args[0], args[0].(value.Float).Raw()), nil
 	case value.String:
 		s := strings.TrimSpace(args[0].(value.String).Raw())
 		if i, e := strconv.ParseInt(s, 10, 64); e == nil {
 			return value.NewInteger(i), nil
 		}
 		if f, e := strconv.ParseFloat(s, 64); e == nil {
-			return value.NewInteger(int64(round(f, 0))), nil
+			return roundToInteger(fn, args[0], f), nil
 		}
 	case value.Datetime:
 		return value.NewInteger(args[0].(value.Datetime).Raw().Unix()), nil
+	case value.Null:
+		return args[0], nil
 	}
-	return value.NewNull(), nil
+	return castFailed(fn, args[0]), nil
+}
+
+func roundToInteger(fn parser.Function, p value.Primary, f float64) value.Primary {
+	i := value.NewInteger(int64(round(f, 0)))
+	if float64(i.Raw()) != f {
+		Warnings.Add(fn, fmt.Sprintf(WARNING_CAST_ROUNDED, p, i, fn.Name))
+	}
+	return i
+}
+
+func castFailed(fn parser.Function, p value.Primary) value.Primary {
+	Warnings.Add(fn, fmt.Sprintf(WARNING_CAST_FAILED, p, fn.Name))
+	return value.NewNull()
+}
+
+func castResult(fn parser.Function, p value.Primary, result value.Primary) value.Primary {
+	if value.IsNull(result) && !value.IsNull(p) {
+		return castFailed(fn, p)
+	}
+	return result
 }
 
 func Float(fn parser.Function, args []value.Primary) (value.Primary, error) {
@@ -1321,7 +1907,7 @@ func Float(fn parser.Function, args []value.Primary) (value.Primary, error) {
 		}
 		return value.NewFloat(f), nil
 	default:
-		return value.ToFloat(args[0]), nil
+		return castResult(fn, args[0], value.ToFloat(args[0])), nil
 	}
 }
 
@@ -1330,7 +1916,7 @@ func Boolean(fn parser.Function, args []value.Primary) (value.Primary, error) {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
 	}
 
-	return value.ToBoolean(args[0]), nil
+	return castResult(fn, args[0], value.ToBoolean(args[0])), nil
 }
 
 func Ternary(fn parser.Function, args []value.Primary) (value.Primary, error) {
@@ -1346,7 +1932,7 @@ func Datetime(fn parser.Function, args []value.Primary) (value.Primary, error) {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
 	}
 
-	return value.ToDatetime(args[0]), nil
+	return castResult(fn, args[0], value.ToDatetime(args[0])), nil
 }
 
 func Call(fn parser.Function, args []value.Primary) (value.Primary, error) {
@@ -1370,6 +1956,8 @@ func Call(fn parser.Function, args []value.Primary) (value.Primary, error) {
 			s = v.(value.Ternary).String()
 		case value.Datetime:
 			s = v.(value.Datetime).Format(time.RFC3339Nano)
+		case value.Array:
+			s = v.(value.Array).String()
 		case value.Null:
 			s = ""
 		}
@@ -1383,6 +1971,11 @@ func Call(fn parser.Function, args []value.Primary) (value.Primary, error) {
 	return value.NewString(string(buf)), nil
 }
 
+func isNowFunction(uname string) bool {
+	return uname == "NOW" || uname == "CURRENT_TIMESTAMP"
+}
+
+// Now returns the time when the statement started.
 func Now(fn parser.Function, args []value.Primary, filter *Filter) (value.Primary, error) {
 	if 0 < len(args) {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0})
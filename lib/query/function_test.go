@@ -1,10 +1,13 @@
 package query
 
 import (
+	"errors"
 	"reflect"
+	"regexp"
 	"testing"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 
@@ -40,6 +43,56 @@ func testFunction(t *testing.T, f func(parser.Function, []value.Primary) (value.
 	}
 }
 
+func TestRegisterFunction(t *testing.T) {
+	err := RegisterFunction("registered_func", func(args []value.Primary) (value.Primary, error) {
+		if len(args) != 1 {
+			return nil, errors.New("one argument is required")
+		}
+		return value.NewString("registered:" + args[0].(value.String).Raw()), nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer func() {
+		delete(Functions, "REGISTERED_FUNC")
+		delete(volatileFunctions, "REGISTERED_FUNC")
+	}()
+
+	if isStreamable(parser.Function{Name: "registered_func"}) {
+		t.Errorf("registered function is regarded as streamable")
+	}
+
+	filter := NewEmptyFilter()
+
+	result, err := filter.Evaluate(parser.Function{
+		Name: "Registered_Func",
+		Args: []parser.QueryExpression{parser.NewStringValue("str")},
+	})
+	if err != nil {
+		t.Errorf("unexpected error %q", err)
+	} else if !reflect.DeepEqual(result, value.NewString("registered:str")) {
+		t.Errorf("result = %s, want %s", result, value.NewString("registered:str"))
+	}
+
+	_, err = filter.Evaluate(parser.Function{Name: "registered_func"})
+	expectErr := "[L:- C:-] one argument is required for function registered_func"
+	if err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q", err.Error(), expectErr)
+	}
+
+	fn := func(args []value.Primary) (value.Primary, error) { return nil, nil }
+	for _, name := range []string{"registered_func", "coalesce", "count", "row_number", "now", ""} {
+		if err = RegisterFunction(name, fn); err == nil {
+			t.Errorf("no error, want error for function name %q", name)
+		}
+	}
+	if err = RegisterFunction("nil_func", nil); err == nil {
+		t.Errorf("no error, want error for nil function")
+	}
+}
+
 var coalesceTests = []functionTest{
 	{
 		Name: "Coalesce",
@@ -157,6 +210,48 @@ func TestIfnull(t *testing.T) {
 	testFunction(t, Ifnull, ifnullTests)
 }
 
+var nvl2Tests = []functionTest{
+	{
+		Name: "Nvl2 Not Null",
+		Function: parser.Function{
+			Name: "nvl2",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+			value.NewString("not null"),
+			value.NewString("null"),
+		},
+		Result: value.NewString("not null"),
+	},
+	{
+		Name: "Nvl2 Null",
+		Function: parser.Function{
+			Name: "nvl2",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("not null"),
+			value.NewString("null"),
+		},
+		Result: value.NewString("null"),
+	},
+	{
+		Name: "Nvl2 Arguments Error",
+		Function: parser.Function{
+			Name: "nvl2",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+		},
+		Error: "[L:- C:-] function nvl2 takes exactly 3 arguments",
+	},
+}
+
+func TestNvl2(t *testing.T) {
+	testFunction(t, Nvl2, nvl2Tests)
+}
+
 var nullifTests = []functionTest{
 	{
 		Name: "Nullif True",
@@ -1035,6 +1130,181 @@ func TestRand(t *testing.T) {
 	}
 }
 
+func TestRandomUUID(t *testing.T) {
+	fn := parser.Function{Name: "random_uuid"}
+
+	result, err := RandomUUID(fn, nil)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	uuid := result.(value.String).Raw()
+	if !regexp.MustCompile("^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$").MatchString(uuid) {
+		t.Errorf("result = %q, want a version 4 uuid", uuid)
+	}
+
+	result2, _ := RandomUUID(fn, nil)
+	if result2.(value.String).Raw() == uuid {
+		t.Errorf("result = %q, want a different uuid from the previous one", uuid)
+	}
+
+	expectErr := "[L:- C:-] function random_uuid takes no argument"
+	_, err = RandomUUID(fn, []value.Primary{value.NewInteger(1)})
+	if err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q", err.Error(), expectErr)
+	}
+}
+
+var widthBucketTests = []functionTest{
+	{
+		Name: "WidthBucket",
+		Function: parser.Function{
+			Name: "width_bucket",
+		},
+		Args: []value.Primary{
+			value.NewFloat(5.35),
+			value.NewFloat(0.024),
+			value.NewFloat(10.06),
+			value.NewInteger(5),
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name: "WidthBucket Lower Bound",
+		Function: parser.Function{
+			Name: "width_bucket",
+		},
+		Args: []value.Primary{
+			value.NewInteger(0),
+			value.NewInteger(0),
+			value.NewInteger(100),
+			value.NewInteger(10),
+		},
+		Result: value.NewInteger(1),
+	},
+	{
+		Name: "WidthBucket Upper Bound",
+		Function: parser.Function{
+			Name: "width_bucket",
+		},
+		Args: []value.Primary{
+			value.NewInteger(100),
+			value.NewInteger(0),
+			value.NewInteger(100),
+			value.NewInteger(10),
+		},
+		Result: value.NewInteger(11),
+	},
+	{
+		Name: "WidthBucket Below Range",
+		Function: parser.Function{
+			Name: "width_bucket",
+		},
+		Args: []value.Primary{
+			value.NewInteger(-1),
+			value.NewInteger(0),
+			value.NewInteger(100),
+			value.NewInteger(10),
+		},
+		Result: value.NewInteger(0),
+	},
+	{
+		Name: "WidthBucket Reversed Range",
+		Function: parser.Function{
+			Name: "width_bucket",
+		},
+		Args: []value.Primary{
+			value.NewInteger(10),
+			value.NewInteger(100),
+			value.NewInteger(0),
+			value.NewInteger(10),
+		},
+		Result: value.NewInteger(10),
+	},
+	{
+		Name: "WidthBucket Reversed Range Above",
+		Function: parser.Function{
+			Name: "width_bucket",
+		},
+		Args: []value.Primary{
+			value.NewInteger(101),
+			value.NewInteger(100),
+			value.NewInteger(0),
+			value.NewInteger(10),
+		},
+		Result: value.NewInteger(0),
+	},
+	{
+		Name: "WidthBucket Numeric String",
+		Function: parser.Function{
+			Name: "width_bucket",
+		},
+		Args: []value.Primary{
+			value.NewString("55"),
+			value.NewInteger(0),
+			value.NewInteger(100),
+			value.NewInteger(10),
+		},
+		Result: value.NewInteger(6),
+	},
+	{
+		Name: "WidthBucket Null",
+		Function: parser.Function{
+			Name: "width_bucket",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewInteger(0),
+			value.NewInteger(100),
+			value.NewInteger(10),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "WidthBucket Arguments Error",
+		Function: parser.Function{
+			Name: "width_bucket",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(0),
+			value.NewInteger(100),
+		},
+		Error: "[L:- C:-] function width_bucket takes exactly 4 arguments",
+	},
+	{
+		Name: "WidthBucket Count Error",
+		Function: parser.Function{
+			Name: "width_bucket",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(0),
+			value.NewInteger(100),
+			value.NewInteger(0),
+		},
+		Error: "[L:- C:-] the fourth argument must be a positive integer for function width_bucket",
+	},
+	{
+		Name: "WidthBucket Range Error",
+		Function: parser.Function{
+			Name: "width_bucket",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(10),
+			value.NewInteger(10),
+			value.NewInteger(5),
+		},
+		Error: "[L:- C:-] the second argument and the third argument must be different for function width_bucket",
+	},
+}
+
+func TestWidthBucket(t *testing.T) {
+	testFunction(t, WidthBucket, widthBucketTests)
+}
+
 var trimTests = []functionTest{
 	{
 		Name: "Trim",
@@ -1778,155 +2048,363 @@ func TestFormat(t *testing.T) {
 	testFunction(t, Format, formatTests)
 }
 
-var md5Tests = []functionTest{
+var toCharTests = []functionTest{
 	{
-		Name: "Md5",
+		Name: "ToChar Integer",
 		Function: parser.Function{
-			Name: "md5",
+			Name: "to_char",
 		},
 		Args: []value.Primary{
-			value.NewString("foo"),
+			value.NewInteger(1234567),
+			value.NewString("999,999,999.00"),
 		},
-		Result: value.NewString("acbd18db4cc2f85cedef654fccc4a4d8"),
+		Result: value.NewString("   1,234,567.00"),
 	},
 	{
-		Name: "Md5 Null",
+		Name: "ToChar Negative Float",
 		Function: parser.Function{
-			Name: "md5",
+			Name: "to_char",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
+			value.NewFloat(-1234.567),
+			value.NewString("999,999.99"),
 		},
-		Result: value.NewNull(),
+		Result: value.NewString("  -1,234.57"),
 	},
 	{
-		Name: "Md5 Arguments Error",
+		Name: "ToChar Leading Zeros",
 		Function: parser.Function{
-			Name: "md5",
+			Name: "to_char",
 		},
-		Args:  []value.Primary{},
-		Error: "[L:- C:-] function md5 takes exactly 1 argument",
+		Args: []value.Primary{
+			value.NewInteger(42),
+			value.NewString("00000"),
+		},
+		Result: value.NewString(" 00042"),
 	},
-}
-
-func TestMd5(t *testing.T) {
-	testFunction(t, Md5, md5Tests)
-}
-
-var sha1Tests = []functionTest{
 	{
-		Name: "Sha1",
+		Name: "ToChar Zero",
 		Function: parser.Function{
-			Name: "sha1",
+			Name: "to_char",
 		},
 		Args: []value.Primary{
-			value.NewString("foo"),
+			value.NewInteger(0),
+			value.NewString("999"),
 		},
-		Result: value.NewString("0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33"),
+		Result: value.NewString("   0"),
 	},
-}
-
-func TestSha1(t *testing.T) {
-	testFunction(t, Sha1, sha1Tests)
-}
-
-var sha256Tests = []functionTest{
 	{
-		Name: "Sha256",
+		Name: "ToChar Fill Mode",
 		Function: parser.Function{
-			Name: "sha256",
+			Name: "to_char",
 		},
 		Args: []value.Primary{
-			value.NewString("foo"),
+			value.NewFloat(1234.5),
+			value.NewString("FM999,999.99"),
 		},
-		Result: value.NewString("2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"),
+		Result: value.NewString("1,234.5"),
 	},
-}
-
-func TestSha256(t *testing.T) {
-	testFunction(t, Sha256, sha256Tests)
-}
-
-var sha512Tests = []functionTest{
 	{
-		Name: "Sha512",
+		Name: "ToChar Number Overflow",
 		Function: parser.Function{
-			Name: "sha512",
+			Name: "to_char",
 		},
 		Args: []value.Primary{
-			value.NewString("foo"),
+			value.NewInteger(12345),
+			value.NewString("999"),
 		},
-		Result: value.NewString("f7fbba6e0636f890e56fbbf3283e524c6fa3204ae298382d624741d0dc6638326e282c41be5e4254d8820772c5518a2c5a8c0c7f7eda19594a7eb539453e1ed7"),
+		Result: value.NewString("####"),
 	},
-}
-
-func TestSha512(t *testing.T) {
-	testFunction(t, Sha512, sha512Tests)
-}
-
-var md5HmacTests = []functionTest{
 	{
-		Name: "Md5Hmac",
+		Name: "ToChar Numeric String",
 		Function: parser.Function{
-			Name: "md5_hmac",
+			Name: "to_char",
 		},
 		Args: []value.Primary{
-			value.NewString("foo"),
-			value.NewString("bar"),
+			value.NewString("12.5"),
+			value.NewString("90.00"),
 		},
-		Result: value.NewString("31b6db9e5eb4addb42f1a6ca07367adc"),
+		Result: value.NewString(" 12.50"),
 	},
 	{
-		Name: "Md5Hmac String is Null",
+		Name: "ToChar Datetime",
 		Function: parser.Function{
-			Name: "md5_hmac",
+			Name: "to_char",
 		},
 		Args: []value.Primary{
-			value.NewNull(),
-			value.NewString("bar"),
+			value.NewDatetime(time.Date(2012, 2, 3, 15, 8, 5, 123456789, GetTestLocation())),
+			value.NewString("YYYY-MM-DD HH24:MI:SS.FF3"),
 		},
-		Result: value.NewNull(),
+		Result: value.NewString("2012-02-03 15:08:05.123"),
 	},
 	{
-		Name: "Md5Hmac Key is Null",
+		Name: "ToChar Datetime Names",
 		Function: parser.Function{
-			Name: "md5_hmac",
+			Name: "to_char",
 		},
 		Args: []value.Primary{
-			value.NewString("foo"),
-			value.NewNull(),
+			value.NewDatetime(time.Date(2012, 2, 3, 15, 8, 5, 123456789, GetTestLocation())),
+			value.NewString("Day, DD MONTH YY HH12:MI pm \"(\"dy\")\""),
 		},
-		Result: value.NewNull(),
+		Result: value.NewString("Friday, 03 FEBRUARY 12 03:08 pm (fri)"),
 	},
 	{
-		Name: "Md5Hmac Arguments Error",
+		Name: "ToChar Datetime String",
 		Function: parser.Function{
-			Name: "md5_hmac",
+			Name: "to_char",
 		},
-		Args:  []value.Primary{},
-		Error: "[L:- C:-] function md5_hmac takes exactly 2 arguments",
+		Args: []value.Primary{
+			value.NewString("2012-02-03 09:18:15"),
+			value.NewString("DDD Mon HH:MI AM"),
+		},
+		Result: value.NewString("034 Feb 09:18 AM"),
 	},
-}
-
-func TestMd5Hmac(t *testing.T) {
-	testFunction(t, Md5Hmac, md5HmacTests)
-}
-
-var sha1HmacTests = []functionTest{
 	{
-		Name: "Sha1Hmac",
+		Name: "ToChar Null",
 		Function: parser.Function{
-			Name: "sha1_hmac",
+			Name: "to_char",
 		},
 		Args: []value.Primary{
-			value.NewString("foo"),
-			value.NewString("bar"),
+			value.NewNull(),
+			value.NewString("999"),
 		},
-		Result: value.NewString("85d155c55ed286a300bd1cf124de08d87e914f3a"),
+		Result: value.NewNull(),
 	},
-}
-
-func TestSha1Hmac(t *testing.T) {
+	{
+		Name: "ToChar Argument Length Error",
+		Function: parser.Function{
+			Name: "to_char",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+		},
+		Error: "[L:- C:-] function to_char takes exactly 2 arguments",
+	},
+	{
+		Name: "ToChar Invalid Number Format Error",
+		Function: parser.Function{
+			Name: "to_char",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+			value.NewString("99X9"),
+		},
+		Error: "[L:- C:-] format token X is invalid for function to_char",
+	},
+	{
+		Name: "ToChar Invalid Datetime Format Error",
+		Function: parser.Function{
+			Name: "to_char",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 15, 8, 5, 123456789, GetTestLocation())),
+			value.NewString("YYYY-Q"),
+		},
+		Error: "[L:- C:-] format token Q is invalid for function to_char",
+	},
+	{
+		Name: "ToChar Argument Value Error",
+		Function: parser.Function{
+			Name: "to_char",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewString("999"),
+		},
+		Error: "[L:- C:-] the first argument must be a number or a datetime for function to_char",
+	},
+}
+
+func TestToChar(t *testing.T) {
+	testFunction(t, ToChar, toCharTests)
+}
+
+var md5Tests = []functionTest{
+	{
+		Name: "Md5",
+		Function: parser.Function{
+			Name: "md5",
+		},
+		Args: []value.Primary{
+			value.NewString("foo"),
+		},
+		Result: value.NewString("acbd18db4cc2f85cedef654fccc4a4d8"),
+	},
+	{
+		Name: "Md5 Null",
+		Function: parser.Function{
+			Name: "md5",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Md5 Arguments Error",
+		Function: parser.Function{
+			Name: "md5",
+		},
+		Args:  []value.Primary{},
+		Error: "[L:- C:-] function md5 takes exactly 1 argument",
+	},
+}
+
+func TestMd5(t *testing.T) {
+	testFunction(t, Md5, md5Tests)
+}
+
+var rowHashTests = []functionTest{
+	{
+		Name: "RowHash",
+		Function: parser.Function{
+			Name: "row_hash",
+		},
+		Args: []value.Primary{
+			value.NewInteger(2),
+			value.NewNull(),
+		},
+		Result: value.NewString("f34516a8bec3c7c042987259317dde77da39c7d02d69816d92b36ed685869b25"),
+	},
+	{
+		Name: "RowHash Equivalent Values",
+		Function: parser.Function{
+			Name: "row_hash",
+		},
+		Args: []value.Primary{
+			value.NewString("2"),
+			value.NewNull(),
+		},
+		Result: value.NewString("f34516a8bec3c7c042987259317dde77da39c7d02d69816d92b36ed685869b25"),
+	},
+	{
+		Name: "RowHash Arguments Error",
+		Function: parser.Function{
+			Name: "row_hash",
+		},
+		Args:  []value.Primary{},
+		Error: "[L:- C:-] function row_hash takes at least 1 argument",
+	},
+}
+
+func TestRowHash(t *testing.T) {
+	testFunction(t, RowHash, rowHashTests)
+}
+
+var sha1Tests = []functionTest{
+	{
+		Name: "Sha1",
+		Function: parser.Function{
+			Name: "sha1",
+		},
+		Args: []value.Primary{
+			value.NewString("foo"),
+		},
+		Result: value.NewString("0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33"),
+	},
+}
+
+func TestSha1(t *testing.T) {
+	testFunction(t, Sha1, sha1Tests)
+}
+
+var sha256Tests = []functionTest{
+	{
+		Name: "Sha256",
+		Function: parser.Function{
+			Name: "sha256",
+		},
+		Args: []value.Primary{
+			value.NewString("foo"),
+		},
+		Result: value.NewString("2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"),
+	},
+}
+
+func TestSha256(t *testing.T) {
+	testFunction(t, Sha256, sha256Tests)
+}
+
+var sha512Tests = []functionTest{
+	{
+		Name: "Sha512",
+		Function: parser.Function{
+			Name: "sha512",
+		},
+		Args: []value.Primary{
+			value.NewString("foo"),
+		},
+		Result: value.NewString("f7fbba6e0636f890e56fbbf3283e524c6fa3204ae298382d624741d0dc6638326e282c41be5e4254d8820772c5518a2c5a8c0c7f7eda19594a7eb539453e1ed7"),
+	},
+}
+
+func TestSha512(t *testing.T) {
+	testFunction(t, Sha512, sha512Tests)
+}
+
+var md5HmacTests = []functionTest{
+	{
+		Name: "Md5Hmac",
+		Function: parser.Function{
+			Name: "md5_hmac",
+		},
+		Args: []value.Primary{
+			value.NewString("foo"),
+			value.NewString("bar"),
+		},
+		Result: value.NewString("31b6db9e5eb4addb42f1a6ca07367adc"),
+	},
+	{
+		Name: "Md5Hmac String is Null",
+		Function: parser.Function{
+			Name: "md5_hmac",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("bar"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Md5Hmac Key is Null",
+		Function: parser.Function{
+			Name: "md5_hmac",
+		},
+		Args: []value.Primary{
+			value.NewString("foo"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Md5Hmac Arguments Error",
+		Function: parser.Function{
+			Name: "md5_hmac",
+		},
+		Args:  []value.Primary{},
+		Error: "[L:- C:-] function md5_hmac takes exactly 2 arguments",
+	},
+}
+
+func TestMd5Hmac(t *testing.T) {
+	testFunction(t, Md5Hmac, md5HmacTests)
+}
+
+var sha1HmacTests = []functionTest{
+	{
+		Name: "Sha1Hmac",
+		Function: parser.Function{
+			Name: "sha1_hmac",
+		},
+		Args: []value.Primary{
+			value.NewString("foo"),
+			value.NewString("bar"),
+		},
+		Result: value.NewString("85d155c55ed286a300bd1cf124de08d87e914f3a"),
+	},
+}
+
+func TestSha1Hmac(t *testing.T) {
 	testFunction(t, Sha1Hmac, sha1HmacTests)
 }
 
@@ -2016,6 +2494,128 @@ func TestDatetimeFormat(t *testing.T) {
 	testFunction(t, DatetimeFormat, datetimeFormatTests)
 }
 
+var strftimeTests = []functionTest{
+	{
+		Name: "Strftime",
+		Function: parser.Function{
+			Name: "strftime",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 15, 8, 5, 123456789, GetTestLocation())),
+			value.NewString("%Y-%m-%d %H:%M:%S.%f"),
+		},
+		Result: value.NewString("2012-02-03 15:08:05.123456"),
+	},
+	{
+		Name: "Strftime Names",
+		Function: parser.Function{
+			Name: "strftime",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 15, 8, 5, 123456789, GetTestLocation())),
+			value.NewString("%A, %e %B %Y %I %p"),
+		},
+		Result: value.NewString("Friday,  3 February 2012 03 PM"),
+	},
+	{
+		Name: "Strftime Datetime Null",
+		Function: parser.Function{
+			Name: "strftime",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("%Y"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Strftime Format Null",
+		Function: parser.Function{
+			Name: "strftime",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 15, 8, 5, 123456789, GetTestLocation())),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Strftime Arguments Error",
+		Function: parser.Function{
+			Name: "strftime",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 15, 8, 5, 123456789, GetTestLocation())),
+		},
+		Error: "[L:- C:-] function strftime takes exactly 2 arguments",
+	},
+}
+
+func TestStrftime(t *testing.T) {
+	testFunction(t, Strftime, strftimeTests)
+}
+
+var strptimeTests = []functionTest{
+	{
+		Name: "Strptime",
+		Function: parser.Function{
+			Name: "strptime",
+		},
+		Args: []value.Primary{
+			value.NewString("03/02/2012 15:08"),
+			value.NewString("%d/%m/%Y %H:%M"),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 15, 8, 0, 0, GetTestLocation())),
+	},
+	{
+		Name: "Strptime Fractional Seconds",
+		Function: parser.Function{
+			Name: "strptime",
+		},
+		Args: []value.Primary{
+			value.NewString("2012-02-03 15:08:05.123456"),
+			value.NewString("%F %T.%f"),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 15, 8, 5, 123456000, GetTestLocation())),
+	},
+	{
+		Name: "Strptime Mismatch",
+		Function: parser.Function{
+			Name: "strptime",
+		},
+		Args: []value.Primary{
+			value.NewString("2012-02-03"),
+			value.NewString("%d/%m/%Y"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Strptime String Null",
+		Function: parser.Function{
+			Name: "strptime",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("%Y"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Strptime Arguments Error",
+		Function: parser.Function{
+			Name: "strptime",
+		},
+		Args: []value.Primary{
+			value.NewString("2012"),
+		},
+		Error: "[L:- C:-] function strptime takes exactly 2 arguments",
+	},
+}
+
+func TestStrptime(t *testing.T) {
+	testFunction(t, Strptime, strptimeTests)
+}
+
 var yearTests = []functionTest{
 	{
 		Name: "Year",
@@ -2183,15 +2783,238 @@ var nanosecondTests = []functionTest{
 	},
 }
 
-func TestNanosecond(t *testing.T) {
-	testFunction(t, Nanosecond, nanosecondTests)
+func TestNanosecond(t *testing.T) {
+	testFunction(t, Nanosecond, nanosecondTests)
+}
+
+var weekdayTests = []functionTest{
+	{
+		Name: "Weekday",
+		Function: parser.Function{
+			Name: "weekday",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Result: value.NewInteger(5),
+	},
+}
+
+func TestWeekday(t *testing.T) {
+	testFunction(t, Weekday, weekdayTests)
+}
+
+var unixTimeTests = []functionTest{
+	{
+		Name: "UnixTime",
+		Function: parser.Function{
+			Name: "unix_time",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Result: value.NewInteger(1328260695),
+	},
+}
+
+func TestUnixTime(t *testing.T) {
+	testFunction(t, UnixTime, unixTimeTests)
+}
+
+var unixNanoTimeTests = []functionTest{
+	{
+		Name: "UnixNanoTime",
+		Function: parser.Function{
+			Name: "unix_nano_time",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewInteger(1328260695123456789),
+	},
+}
+
+func TestUnixNanoTime(t *testing.T) {
+	testFunction(t, UnixNanoTime, unixNanoTimeTests)
+}
+
+var unixTimestampTests = []functionTest{
+	{
+		Name: "UnixTimestamp",
+		Function: parser.Function{
+			Name: "unix_timestamp",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewInteger(1328260695),
+	},
+	{
+		Name: "UnixTimestamp with Scale",
+		Function: parser.Function{
+			Name: "unix_timestamp",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewInteger(3),
+		},
+		Result: value.NewInteger(1328260695123),
+	},
+	{
+		Name: "UnixTimestamp Null",
+		Function: parser.Function{
+			Name: "unix_timestamp",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "UnixTimestamp Arguments Error",
+		Function: parser.Function{
+			Name: "unix_timestamp",
+		},
+		Args:  []value.Primary{},
+		Error: "[L:- C:-] function unix_timestamp takes 1 or 2 arguments",
+	},
+	{
+		Name: "UnixTimestamp Invalid First Argument Error",
+		Function: parser.Function{
+			Name: "unix_timestamp",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+		},
+		Error: "[L:- C:-] the first argument must be a datetime for function unix_timestamp",
+	},
+	{
+		Name: "UnixTimestamp Invalid Scale Error",
+		Function: parser.Function{
+			Name: "unix_timestamp",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewInteger(10),
+		},
+		Error: "[L:- C:-] the second argument must be an integer from 0 to 9 for function unix_timestamp",
+	},
+}
+
+func TestUnixTimestamp(t *testing.T) {
+	testFunction(t, UnixTimestamp, unixTimestampTests)
+}
+
+var toTimestampTests = []functionTest{
+	{
+		Name: "ToTimestamp",
+		Function: parser.Function{
+			Name: "to_timestamp",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1328260695),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+	},
+	{
+		Name: "ToTimestamp with Scale",
+		Function: parser.Function{
+			Name: "to_timestamp",
+		},
+		Args: []value.Primary{
+			value.NewString("1328260695123"),
+			value.NewInteger(3),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123000000, GetTestLocation())),
+	},
+	{
+		Name: "ToTimestamp Negative with Scale",
+		Function: parser.Function{
+			Name: "to_timestamp",
+		},
+		Args: []value.Primary{
+			value.NewInteger(-1500),
+			value.NewInteger(3),
+		},
+		Result: value.NewDatetime(time.Date(1969, 12, 31, 23, 59, 58, 500000000, GetTestLocation())),
+	},
+	{
+		Name: "ToTimestamp Float",
+		Function: parser.Function{
+			Name: "to_timestamp",
+		},
+		Args: []value.Primary{
+			value.NewFloat(1328260695.5),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 500000000, GetTestLocation())),
+	},
+	{
+		Name: "ToTimestamp Null",
+		Function: parser.Function{
+			Name: "to_timestamp",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ToTimestamp Arguments Error",
+		Function: parser.Function{
+			Name: "to_timestamp",
+		},
+		Args:  []value.Primary{},
+		Error: "[L:- C:-] function to_timestamp takes 1 or 2 arguments",
+	},
+	{
+		Name: "ToTimestamp Invalid First Argument Error",
+		Function: parser.Function{
+			Name: "to_timestamp",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+		},
+		Error: "[L:- C:-] the first argument must be a number for function to_timestamp",
+	},
+	{
+		Name: "ToTimestamp Invalid Scale Error",
+		Function: parser.Function{
+			Name: "to_timestamp",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1328260695),
+			value.NewNull(),
+		},
+		Error: "[L:- C:-] the second argument must be an integer from 0 to 9 for function to_timestamp",
+	},
+}
+
+func TestToTimestamp(t *testing.T) {
+	testFunction(t, ToTimestamp, toTimestampTests)
+}
+
+var dayOfYearTests = []functionTest{
+	{
+		Name: "DayOfYear",
+		Function: parser.Function{
+			Name: "day_of_year",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Result: value.NewInteger(34),
+	},
+}
+
+func TestDayOfYear(t *testing.T) {
+	testFunction(t, DayOfYear, dayOfYearTests)
 }
 
-var weekdayTests = []functionTest{
+var weekOfYearTests = []functionTest{
 	{
-		Name: "Weekday",
+		Name: "WeekOfYear",
 		Function: parser.Function{
-			Name: "weekday",
+			Name: "week_of_year",
 		},
 		Args: []value.Primary{
 			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
@@ -2200,76 +3023,102 @@ var weekdayTests = []functionTest{
 	},
 }
 
-func TestWeekday(t *testing.T) {
-	testFunction(t, Weekday, weekdayTests)
+func TestWeekOfYear(t *testing.T) {
+	testFunction(t, WeekOfYear, weekOfYearTests)
 }
 
-var unixTimeTests = []functionTest{
+var dayNameTests = []functionTest{
 	{
-		Name: "UnixTime",
+		Name: "DayName",
 		Function: parser.Function{
-			Name: "unix_time",
+			Name: "dayname",
 		},
 		Args: []value.Primary{
 			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
 		},
-		Result: value.NewInteger(1328260695),
+		Result: value.NewString("Friday"),
 	},
-}
-
-func TestUnixTime(t *testing.T) {
-	testFunction(t, UnixTime, unixTimeTests)
-}
-
-var unixNanoTimeTests = []functionTest{
 	{
-		Name: "UnixNanoTime",
+		Name: "DayName Argument Error",
 		Function: parser.Function{
-			Name: "unix_nano_time",
+			Name: "dayname",
+		},
+		Args:  []value.Primary{},
+		Error: "[L:- C:-] function dayname takes exactly 1 argument",
+	},
+	{
+		Name: "DayName Argument Is Null",
+		Function: parser.Function{
+			Name: "dayname",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewString("abc"),
 		},
-		Result: value.NewInteger(1328260695123456789),
+		Result: value.NewNull(),
 	},
 }
 
-func TestUnixNanoTime(t *testing.T) {
-	testFunction(t, UnixNanoTime, unixNanoTimeTests)
+func TestDayName(t *testing.T) {
+	testFunction(t, DayName, dayNameTests)
+
+	flags := cmd.GetFlags()
+	flags.Locale = cmd.FR
+	testFunction(t, DayName, []functionTest{
+		{
+			Name: "DayName With Locale",
+			Function: parser.Function{
+				Name: "dayname",
+			},
+			Args: []value.Primary{
+				value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			},
+			Result: value.NewString("vendredi"),
+		},
+	})
+	flags.Locale = cmd.EN
 }
 
-var dayOfYearTests = []functionTest{
+var monthNameTests = []functionTest{
 	{
-		Name: "DayOfYear",
+		Name: "MonthName",
 		Function: parser.Function{
-			Name: "day_of_year",
+			Name: "monthname",
 		},
 		Args: []value.Primary{
 			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
 		},
-		Result: value.NewInteger(34),
+		Result: value.NewString("February"),
 	},
-}
-
-func TestDayOfYear(t *testing.T) {
-	testFunction(t, DayOfYear, dayOfYearTests)
-}
-
-var weekOfYearTests = []functionTest{
 	{
-		Name: "WeekOfYear",
+		Name: "MonthName Argument Is Null",
 		Function: parser.Function{
-			Name: "week_of_year",
+			Name: "monthname",
 		},
 		Args: []value.Primary{
-			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewString("abc"),
 		},
-		Result: value.NewInteger(5),
+		Result: value.NewNull(),
 	},
 }
 
-func TestWeekOfYear(t *testing.T) {
-	testFunction(t, WeekOfYear, weekOfYearTests)
+func TestMonthName(t *testing.T) {
+	testFunction(t, MonthName, monthNameTests)
+
+	flags := cmd.GetFlags()
+	flags.Locale = cmd.JA
+	testFunction(t, MonthName, []functionTest{
+		{
+			Name: "MonthName With Locale",
+			Function: parser.Function{
+				Name: "monthname",
+			},
+			Args: []value.Primary{
+				value.NewDatetime(time.Date(2012, 12, 3, 9, 18, 15, 0, GetTestLocation())),
+			},
+			Result: value.NewString("12月"),
+		},
+	})
+	flags.Locale = cmd.EN
 }
 
 var addYearTests = []functionTest{
@@ -2750,6 +3599,67 @@ func TestTimeNanoDiff(t *testing.T) {
 	testFunction(t, TimeNanoDiff, timeNanoDiffTests)
 }
 
+var durationFormatTests = []functionTest{
+	{
+		Name: "DurationFormat",
+		Function: parser.Function{
+			Name: "duration_format",
+		},
+		Args: []value.Primary{
+			value.NewFloat(95415.1234),
+			value.NewString("%d days %H:%i:%s%f, %h hours, %F, 100%%"),
+		},
+		Result: value.NewString("1 days 02:30:15.123400, 26 hours, .1234, 100%"),
+	},
+	{
+		Name: "DurationFormat Negative Value",
+		Function: parser.Function{
+			Name: "duration_format",
+		},
+		Args: []value.Primary{
+			value.NewInteger(-90),
+			value.NewString("%h:%i:%s%F"),
+		},
+		Result: value.NewString("-00:01:30"),
+	},
+	{
+		Name: "DurationFormat Argument Error",
+		Function: parser.Function{
+			Name: "duration_format",
+		},
+		Args: []value.Primary{
+			value.NewInteger(90),
+		},
+		Error: "[L:- C:-] function duration_format takes exactly 2 arguments",
+	},
+	{
+		Name: "DurationFormat Argument Is Null",
+		Function: parser.Function{
+			Name: "duration_format",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewString("%h:%i:%s"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "DurationFormat Format Is Null",
+		Function: parser.Function{
+			Name: "duration_format",
+		},
+		Args: []value.Primary{
+			value.NewInteger(90),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestDurationFormat(t *testing.T) {
+	testFunction(t, DurationFormat, durationFormatTests)
+}
+
 var utcTests = []functionTest{
 	{
 		Name: "UTC",
@@ -2785,6 +3695,237 @@ func TestUTC(t *testing.T) {
 	testFunction(t, UTC, utcTests)
 }
 
+var arrayLengthTests = []functionTest{
+	{
+		Name: "ArrayLength",
+		Function: parser.Function{
+			Name: "array_length",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewInteger(1), value.NewNull(), value.NewString("a")}),
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name: "ArrayLength Argument Is Not Array",
+		Function: parser.Function{
+			Name: "array_length",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ArrayLength Argument Error",
+		Function: parser.Function{
+			Name: "array_length",
+		},
+		Args:  []value.Primary{},
+		Error: "[L:- C:-] function array_length takes exactly 1 argument",
+	},
+}
+
+func TestArrayLength(t *testing.T) {
+	testFunction(t, ArrayLength, arrayLengthTests)
+}
+
+var arrayContainsTests = []functionTest{
+	{
+		Name: "ArrayContains",
+		Function: parser.Function{
+			Name: "array_contains",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewInteger(1), value.NewNull(), value.NewString("2")}),
+			value.NewInteger(2),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "ArrayContains False",
+		Function: parser.Function{
+			Name: "array_contains",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewInteger(1), value.NewString("2")}),
+			value.NewInteger(3),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "ArrayContains Unknown",
+		Function: parser.Function{
+			Name: "array_contains",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewInteger(1), value.NewNull()}),
+			value.NewInteger(3),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "ArrayContains Argument Is Not Array",
+		Function: parser.Function{
+			Name: "array_contains",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewInteger(3),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "ArrayContains Argument Error",
+		Function: parser.Function{
+			Name: "array_contains",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Error: "[L:- C:-] function array_contains takes exactly 2 arguments",
+	},
+}
+
+func TestArrayContains(t *testing.T) {
+	testFunction(t, ArrayContains, arrayContainsTests)
+}
+
+var stringToArrayTests = []functionTest{
+	{
+		Name: "StringToArray",
+		Function: parser.Function{
+			Name: "string_to_array",
+		},
+		Args: []value.Primary{
+			value.NewString("a,b,,c"),
+			value.NewString(","),
+		},
+		Result: value.NewArray([]value.Primary{value.NewString("a"), value.NewString("b"), value.NewString(""), value.NewString("c")}),
+	},
+	{
+		Name: "StringToArray With Null String",
+		Function: parser.Function{
+			Name: "string_to_array",
+		},
+		Args: []value.Primary{
+			value.NewString("a,b,,c"),
+			value.NewString(","),
+			value.NewString(""),
+		},
+		Result: value.NewArray([]value.Primary{value.NewString("a"), value.NewString("b"), value.NewNull(), value.NewString("c")}),
+	},
+	{
+		Name: "StringToArray Empty String",
+		Function: parser.Function{
+			Name: "string_to_array",
+		},
+		Args: []value.Primary{
+			value.NewString(""),
+			value.NewString(","),
+		},
+		Result: value.NewArray([]value.Primary{}),
+	},
+	{
+		Name: "StringToArray String Is Null",
+		Function: parser.Function{
+			Name: "string_to_array",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString(","),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "StringToArray Separator Is Null",
+		Function: parser.Function{
+			Name: "string_to_array",
+		},
+		Args: []value.Primary{
+			value.NewString("a,b"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "StringToArray Argument Error",
+		Function: parser.Function{
+			Name: "string_to_array",
+		},
+		Args: []value.Primary{
+			value.NewString("a,b"),
+		},
+		Error: "[L:- C:-] function string_to_array takes 2 or 3 arguments",
+	},
+}
+
+func TestStringToArray(t *testing.T) {
+	testFunction(t, StringToArray, stringToArrayTests)
+}
+
+var arrayToStringTests = []functionTest{
+	{
+		Name: "ArrayToString",
+		Function: parser.Function{
+			Name: "array_to_string",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewString("a"), value.NewNull(), value.NewInteger(1), value.NewBoolean(true)}),
+			value.NewString(","),
+		},
+		Result: value.NewString("a,1,true"),
+	},
+	{
+		Name: "ArrayToString With Null String",
+		Function: parser.Function{
+			Name: "array_to_string",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewString("a"), value.NewNull(), value.NewInteger(1)}),
+			value.NewString(","),
+			value.NewString(""),
+		},
+		Result: value.NewString("a,,1"),
+	},
+	{
+		Name: "ArrayToString Argument Is Not Array",
+		Function: parser.Function{
+			Name: "array_to_string",
+		},
+		Args: []value.Primary{
+			value.NewString("a"),
+			value.NewString(","),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ArrayToString Separator Is Null",
+		Function: parser.Function{
+			Name: "array_to_string",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewString("a")}),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ArrayToString Argument Error",
+		Function: parser.Function{
+			Name: "array_to_string",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewString("a")}),
+		},
+		Error: "[L:- C:-] function array_to_string takes 2 or 3 arguments",
+	},
+}
+
+func TestArrayToString(t *testing.T) {
+	testFunction(t, ArrayToString, arrayToStringTests)
+}
+
 var stringTests = []functionTest{
 	{
 		Name: "String from Integer",
@@ -3093,6 +4234,30 @@ var nowTests = []struct {
 	},
 }
 
+var clockTimestampTests = []functionTest{
+	{
+		Name: "ClockTimestamp",
+		Function: parser.Function{
+			Name: "clock_timestamp",
+		},
+		Result: value.NewDatetime(NowForTest),
+	},
+	{
+		Name: "ClockTimestamp Arguments Error",
+		Function: parser.Function{
+			Name: "clock_timestamp",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+		},
+		Error: "[L:- C:-] function clock_timestamp takes no argument",
+	},
+}
+
+func TestClockTimestamp(t *testing.T) {
+	testFunction(t, ClockTimestamp, clockTimestampTests)
+}
+
 func TestNow(t *testing.T) {
 	for _, v := range nowTests {
 		result, err := Now(v.Function, v.Args, v.Filter)
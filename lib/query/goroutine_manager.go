@@ -9,11 +9,15 @@ import (
 var goroutineCount int
 var goroutineCountMutex sync.Mutex
 
+// When the seed for random numbers is specified, records are processed sequentially to make the results reproducible.
 func useParallelRoutine(recordLen int, minimumRequired int) (int, int) {
 	cpu := cmd.GetFlags().CPU
 	if 2 < cpu {
 		cpu = cpu - 1
 	}
+	if cmd.GetFlags().Seed != 0 {
+		cpu = 1
+	}
 
 	goroutineCountMutex.Lock()
 	defer goroutineCountMutex.Unlock()
@@ -16,6 +16,7 @@ type HeaderField struct {
 	IsFromTable  bool
 	IsJoinColumn bool
 	IsGroupKey   bool
+	IsComputed   bool
 }
 
 type Header []HeaderField
@@ -18,7 +18,7 @@ func (list InlineTableNodes) Get(name parser.Identifier) (view *View, err error)
 			return
 		}
 	}
-	NewUndefinedInLineTableError(name)
+	err = NewUndefinedInLineTableError(name)
 	return
 }
 
@@ -1,7 +1,13 @@
 package query
 
 import (
+	"math"
+	"sort"
+	"sync/atomic"
+
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/ternary"
 )
@@ -100,6 +106,33 @@ func ParseJoinCondition(join parser.Join, view *View, joinView *View) (parser.Qu
 	return logic, includeFields, excludeFields, nil
 }
 
+// resultRowCounter counts records produced by goroutines to stop joining
+// once the number of records exceeds the --max-result-rows flag.
+type resultRowCounter struct {
+	max   int
+	count int64
+}
+
+func newResultRowCounter() *resultRowCounter {
+	return &resultRowCounter{
+		max: cmd.GetFlags().MaxResultRows,
+	}
+}
+
+func (c *resultRowCounter) Add() bool {
+	if c.max < 1 {
+		return true
+	}
+	return atomic.AddInt64(&c.count, 1) <= int64(c.max)
+}
+
+func checkResultRows(expr parser.Expression, recordLen int) error {
+	if max := cmd.GetFlags().MaxResultRows; 0 < max && max < recordLen {
+		return NewResultRowsExceededError(expr, max)
+	}
+	return nil
+}
+
 func CrossJoin(view *View, joinView *View) {
 	mergedHeader := MergeHeader(view.Header, joinView.Header)
 	records := make(RecordSet, view.RecordLen()*joinView.RecordLen())
@@ -135,10 +168,12 @@ func InnerJoin(view *View, joinView *View, condition parser.QueryExpression, par
 	}
 
 	mergedHeader := MergeHeader(view.Header, joinView.Header)
+	candidates := rangeJoinCandidates(view, joinView, condition, parentFilter)
+	counter := newResultRowCounter()
 
 	var gm *GoroutineManager
 	var splitLeft bool
-	if joinView.RecordLen() < view.RecordLen() {
+	if candidates != nil || joinView.RecordLen() < view.RecordLen() {
 		gm = NewGoroutineManager(view.RecordLen(), 150)
 		splitLeft = true
 	} else {
@@ -176,11 +211,21 @@ func InnerJoin(view *View, joinView *View, condition parser.QueryExpression, par
 
 		InnerJoinLoop:
 			for i := lstart; i < lend; i++ {
-				for j := rstart; j < rend; j++ {
+				n := rend - rstart
+				if candidates != nil {
+					n = len(candidates[i])
+				}
+
+				for k := 0; k < n; k++ {
 					if gm.HasError() {
 						break InnerJoinLoop
 					}
 
+					j := rstart + k
+					if candidates != nil {
+						j = candidates[i][k]
+					}
+
 					mergedRecord := MergeRecord(view.RecordSet[i], joinView.RecordSet[j])
 					filter.Records[0].View.RecordSet[0] = mergedRecord
 
@@ -190,6 +235,10 @@ func InnerJoin(view *View, joinView *View, condition parser.QueryExpression, par
 						break InnerJoinLoop
 					}
 					if primary.Ternary() == ternary.TRUE {
+						if !counter.Add() {
+							gm.SetError(NewResultRowsExceededError(condition, counter.max))
+							break InnerJoinLoop
+						}
 						records = append(records, mergedRecord)
 					}
 				}
@@ -224,10 +273,12 @@ func OuterJoin(view *View, joinView *View, condition parser.QueryExpression, dir
 
 	viewEmptyRecord := NewEmptyRecord(view.FieldLen())
 	joinViewEmptyRecord := NewEmptyRecord(joinView.FieldLen())
+	candidates := rangeJoinCandidates(view, joinView, condition, parentFilter)
+	counter := newResultRowCounter()
 
 	var gm *GoroutineManager
 	var splitLeft bool
-	if joinView.RecordLen() < view.RecordLen() {
+	if candidates != nil || joinView.RecordLen() < view.RecordLen() {
 		gm = NewGoroutineManager(view.RecordLen(), 150)
 		splitLeft = true
 	} else {
@@ -269,11 +320,22 @@ func OuterJoin(view *View, joinView *View, condition parser.QueryExpression, dir
 		OuterJoinLoop:
 			for i := lstart; i < lend; i++ {
 				match := false
-				for j := rstart; j < rend; j++ {
+
+				n := rend - rstart
+				if candidates != nil {
+					n = len(candidates[i])
+				}
+
+				for k := 0; k < n; k++ {
 					if gm.HasError() {
 						break OuterJoinLoop
 					}
 
+					j := rstart + k
+					if candidates != nil {
+						j = candidates[i][k]
+					}
+
 					var mergedRecord Record
 					switch direction {
 					case parser.RIGHT:
@@ -292,12 +354,21 @@ func OuterJoin(view *View, joinView *View, condition parser.QueryExpression, dir
 						if direction == parser.FULL && !joinViewMatches[j] {
 							joinViewMatches[j] = true
 						}
+						if !counter.Add() {
+							gm.SetError(NewResultRowsExceededError(condition, counter.max))
+							break OuterJoinLoop
+						}
 						records = append(records, mergedRecord)
 						match = true
 					}
 				}
 
 				if !match {
+					if !counter.Add() {
+						gm.SetError(NewResultRowsExceededError(condition, counter.max))
+						break OuterJoinLoop
+					}
+
 					var record Record
 					switch direction {
 					case parser.RIGHT:
@@ -331,6 +402,9 @@ func OuterJoin(view *View, joinView *View, condition parser.QueryExpression, dir
 				}
 			}
 			if !match {
+				if !counter.Add() {
+					return NewResultRowsExceededError(condition, counter.max)
+				}
 				record := MergeRecord(viewEmptyRecord, joinView.RecordSet[i])
 				recordsList[len(recordsList)-1] = append(recordsList[len(recordsList)-1], record)
 			}
@@ -346,3 +420,456 @@ func OuterJoin(view *View, joinView *View, condition parser.QueryExpression, dir
 	view.FileInfo = nil
 	return nil
 }
+
+func AsOfJoin(view *View, joinView *View, join parser.Join, parentFilter *Filter) error {
+	condition := join.Condition.(parser.JoinCondition).On
+	comp, ok := condition.(parser.Comparison)
+	if !ok {
+		return NewInvalidAsOfJoinConditionError(join, condition)
+	}
+	switch comp.Operator {
+	case "<", "<=", ">", ">=":
+	default:
+		return NewInvalidAsOfJoinConditionError(join, condition)
+	}
+
+	var partitionValues []parser.QueryExpression
+	if join.Partition != nil {
+		partitionValues = join.Partition.(parser.PartitionClause).Values
+	}
+
+	partitionKeys, matchValues, err := asOfJoinKeys(view, comp.LHS, partitionValues, parentFilter)
+	if err != nil {
+		return err
+	}
+	joinPartitionKeys, joinMatchValues, err := asOfJoinKeys(joinView, comp.RHS, partitionValues, parentFilter)
+	if err != nil {
+		return err
+	}
+
+	joinSortValues := make([]*SortValue, joinView.RecordLen())
+	partitions := make(map[string][]int)
+	for i, key := range joinPartitionKeys {
+		if value.IsNull(joinMatchValues[i]) {
+			continue
+		}
+		joinSortValues[i] = NewSortValue(joinMatchValues[i])
+		partitions[key] = append(partitions[key], i)
+	}
+	for _, partition := range partitions {
+		p := partition
+		sort.SliceStable(p, func(i, j int) bool {
+			return joinSortValues[p[i]].Less(joinSortValues[p[j]]) == ternary.TRUE
+		})
+	}
+
+	matches := make([]int, view.RecordLen())
+	gm := NewGoroutineManager(view.RecordLen(), 150)
+	for i := 0; i < gm.CPU; i++ {
+		gm.Add()
+		go func(thIdx int) {
+			start, end := gm.RecordRange(thIdx)
+
+			for i := start; i < end; i++ {
+				matches[i] = -1
+
+				partition, ok := partitions[partitionKeys[i]]
+				if !ok || value.IsNull(matchValues[i]) {
+					continue
+				}
+
+				switch comp.Operator {
+				case ">", ">=":
+					idx := sort.Search(len(partition), func(j int) bool {
+						return value.Compare(matchValues[i], joinMatchValues[partition[j]], comp.Operator) != ternary.TRUE
+					})
+					if 0 < idx {
+						matches[i] = partition[idx-1]
+					}
+				default:
+					idx := sort.Search(len(partition), func(j int) bool {
+						return value.Compare(matchValues[i], joinMatchValues[partition[j]], comp.Operator) == ternary.TRUE
+					})
+					if idx < len(partition) {
+						matches[i] = partition[idx]
+					}
+				}
+			}
+
+			gm.Done()
+		}(i)
+	}
+	gm.Wait()
+
+	joinViewEmptyRecord := NewEmptyRecord(joinView.FieldLen())
+	records := make(RecordSet, 0, view.RecordLen())
+	for i, match := range matches {
+		if -1 < match {
+			records = append(records, MergeRecord(view.RecordSet[i], joinView.RecordSet[match]))
+		} else if join.Direction.Token == parser.LEFT {
+			records = append(records, MergeRecord(view.RecordSet[i], joinViewEmptyRecord))
+		}
+	}
+
+	view.Header = MergeHeader(view.Header, joinView.Header)
+	view.RecordSet = records
+	view.FileInfo = nil
+	return nil
+}
+
+// Evaluates the partition values and the value to be matched on each record of the view.
+func asOfJoinKeys(view *View, matchExpr parser.QueryExpression, partitionValues []parser.QueryExpression, parentFilter *Filter) ([]string, []value.Primary, error) {
+	partitionKeys := make([]string, view.RecordLen())
+	matchValues := make([]value.Primary, view.RecordLen())
+
+	gm := NewGoroutineManager(view.RecordLen(), 150)
+	for i := 0; i < gm.CPU; i++ {
+		gm.Add()
+		go func(thIdx int) {
+			start, end := gm.RecordRange(thIdx)
+			filter := NewFilterForSequentialEvaluation(view, parentFilter)
+			sortValues := make(SortValues, len(partitionValues))
+
+		AsOfJoinKeysLoop:
+			for i := start; i < end; i++ {
+				if gm.HasError() {
+					break AsOfJoinKeysLoop
+				}
+
+				filter.Records[0].RecordIndex = i
+
+				for j, expr := range partitionValues {
+					p, e := filter.Evaluate(expr)
+					if e != nil {
+						gm.SetError(e)
+						break AsOfJoinKeysLoop
+					}
+					sortValues[j] = NewSortValue(p)
+				}
+				partitionKeys[i] = sortValues.Serialize()
+
+				p, e := filter.Evaluate(matchExpr)
+				if e != nil {
+					gm.SetError(e)
+					break AsOfJoinKeysLoop
+				}
+				matchValues[i] = p
+			}
+
+			gm.Done()
+		}(i)
+	}
+	gm.Wait()
+
+	if gm.HasError() {
+		return nil, nil, gm.Error()
+	}
+	return partitionKeys, matchValues, nil
+}
+
+const (
+	rangeJoinSideNone = iota
+	rangeJoinSideView
+	rangeJoinSideJoinView
+)
+
+// A relation that a lesser value is less than or equal to a greater value.
+type rangeJoinRelation struct {
+	Lesser  parser.QueryExpression
+	Greater parser.QueryExpression
+}
+
+func rangeJoinRelations(condition parser.QueryExpression) []rangeJoinRelation {
+	switch expr := condition.(type) {
+	case parser.Parentheses:
+		return rangeJoinRelations(expr.Expr)
+	case parser.Logic:
+		if expr.Operator.Token == parser.AND {
+			return append(rangeJoinRelations(expr.LHS), rangeJoinRelations(expr.RHS)...)
+		}
+	case parser.Between:
+		if expr.Negation.IsEmpty() && expr.Symmetric.IsEmpty() {
+			return []rangeJoinRelation{
+				{Lesser: expr.Low, Greater: expr.LHS},
+				{Lesser: expr.LHS, Greater: expr.High},
+			}
+		}
+	case parser.Comparison:
+		switch expr.Operator {
+		case "<", "<=":
+			return []rangeJoinRelation{{Lesser: expr.LHS, Greater: expr.RHS}}
+		case ">", ">=":
+			return []rangeJoinRelation{{Lesser: expr.RHS, Greater: expr.LHS}}
+		}
+	}
+	return nil
+}
+
+func rangeJoinSide(expr parser.QueryExpression, view *View, joinView *View, parentFilter *Filter) int {
+	evaluable := func(v *View) bool {
+		filter := NewFilterForSequentialEvaluation(v, parentFilter)
+		filter.Records[0].RecordIndex = 0
+		_, err := filter.Evaluate(expr)
+		return err == nil
+	}
+
+	inView := evaluable(view)
+	inJoinView := evaluable(joinView)
+	switch {
+	case inView && !inJoinView:
+		return rangeJoinSideView
+	case !inView && inJoinView:
+		return rangeJoinSideJoinView
+	}
+	return rangeJoinSideNone
+}
+
+// Returns, for each record of the view, the ascending indices of the records of the joinView
+// that can satisfy the condition.
+// Nil is returned if the condition does not restrict the records to ranges that can be indexed,
+// then all the combinations of the records must be evaluated.
+func rangeJoinCandidates(view *View, joinView *View, condition parser.QueryExpression, parentFilter *Filter) [][]int {
+	if view.RecordLen() < 1 || joinView.RecordLen() < 1 {
+		return nil
+	}
+
+	relations := rangeJoinRelations(condition)
+	if len(relations) < 2 {
+		return nil
+	}
+
+	lesserSides := make([]int, len(relations))
+	greaterSides := make([]int, len(relations))
+	for i, rel := range relations {
+		lesserSides[i] = rangeJoinSide(rel.Lesser, view, joinView, parentFilter)
+		greaterSides[i] = rangeJoinSide(rel.Greater, view, joinView, parentFilter)
+	}
+
+	// Records of the indexed side have ranges [low, high], and records of the other side
+	// search the ranges overlapping [queryLow, queryHigh], that is low <= queryHigh and queryLow <= high.
+	for _, indexedSide := range []int{rangeJoinSideJoinView, rangeJoinSideView} {
+		querySide := rangeJoinSideView
+		if indexedSide == rangeJoinSideView {
+			querySide = rangeJoinSideJoinView
+		}
+
+		lowIdx, highIdx := -1, -1
+		for i := range relations {
+			if lowIdx < 0 && lesserSides[i] == indexedSide && greaterSides[i] == querySide {
+				lowIdx = i
+			} else if highIdx < 0 && lesserSides[i] == querySide && greaterSides[i] == indexedSide {
+				highIdx = i
+			}
+		}
+		if lowIdx < 0 || highIdx < 0 {
+			continue
+		}
+
+		indexedView, queryView := joinView, view
+		if indexedSide == rangeJoinSideView {
+			indexedView, queryView = view, joinView
+		}
+
+		ranges, ok := rangeJoinKeys(indexedView, relations[lowIdx].Lesser, relations[highIdx].Greater, parentFilter)
+		if !ok {
+			return nil
+		}
+		queries, ok := rangeJoinKeys(queryView, relations[highIdx].Lesser, relations[lowIdx].Greater, parentFilter)
+		if !ok {
+			return nil
+		}
+		if kind := rangeKeyKindOf(ranges, queries); kind == rangeKeyUnsupported {
+			return nil
+		}
+
+		index := newIntervalIndex(ranges)
+		candidates := make([][]int, view.RecordLen())
+		if indexedSide == rangeJoinSideJoinView {
+			for i, q := range queries {
+				if q != nil {
+					candidates[i] = index.Search(q.Low, q.High)
+				}
+			}
+		} else {
+			for j, q := range queries {
+				if q != nil {
+					for _, i := range index.Search(q.Low, q.High) {
+						candidates[i] = append(candidates[i], j)
+					}
+				}
+			}
+		}
+		return candidates
+	}
+
+	return nil
+}
+
+const (
+	rangeKeyNone = iota
+	rangeKeyNumber
+	rangeKeyDatetime
+	rangeKeyUnsupported
+)
+
+type rangeKey struct {
+	Kind int
+	Low  float64
+	High float64
+}
+
+func rangeKeyValue(p value.Primary) (int, float64) {
+	if f := value.ToFloat(p); !value.IsNull(f) {
+		v := f.(value.Float).Raw()
+		if math.IsNaN(v) {
+			return rangeKeyUnsupported, 0
+		}
+		return rangeKeyNumber, v
+	}
+	if dt := value.ToDatetime(p); !value.IsNull(dt) {
+		return rangeKeyDatetime, float64(dt.(value.Datetime).Raw().UnixNano())
+	}
+	return rangeKeyUnsupported, 0
+}
+
+// Evaluates the range of each record of the view.
+// The range of a record is nil if either of the values is null.
+func rangeJoinKeys(view *View, low parser.QueryExpression, high parser.QueryExpression, parentFilter *Filter) ([]*rangeKey, bool) {
+	keys := make([]*rangeKey, view.RecordLen())
+
+	gm := NewGoroutineManager(view.RecordLen(), 150)
+	for i := 0; i < gm.CPU; i++ {
+		gm.Add()
+		go func(thIdx int) {
+			start, end := gm.RecordRange(thIdx)
+			filter := NewFilterForSequentialEvaluation(view, parentFilter)
+
+		RangeJoinKeysLoop:
+			for i := start; i < end; i++ {
+				if gm.HasError() {
+					break RangeJoinKeysLoop
+				}
+
+				filter.Records[0].RecordIndex = i
+
+				lowValue, e := filter.Evaluate(low)
+				if e != nil {
+					gm.SetError(e)
+					break RangeJoinKeysLoop
+				}
+				highValue, e := filter.Evaluate(high)
+				if e != nil {
+					gm.SetError(e)
+					break RangeJoinKeysLoop
+				}
+				if value.IsNull(lowValue) || value.IsNull(highValue) {
+					continue
+				}
+
+				lowKind, lowKey := rangeKeyValue(lowValue)
+				highKind, highKey := rangeKeyValue(highValue)
+				kind := lowKind
+				if lowKind != highKind {
+					kind = rangeKeyUnsupported
+				}
+				keys[i] = &rangeKey{Kind: kind, Low: lowKey, High: highKey}
+			}
+
+			gm.Done()
+		}(i)
+	}
+	gm.Wait()
+
+	if gm.HasError() {
+		return nil, false
+	}
+	return keys, true
+}
+
+// Returns the kind of the keys if all the keys are comparable with each other as the same kind.
+func rangeKeyKindOf(keyLists ...[]*rangeKey) int {
+	kind := rangeKeyNone
+	for _, keys := range keyLists {
+		for _, key := range keys {
+			if key == nil {
+				continue
+			}
+			if key.Kind == rangeKeyUnsupported || (kind != rangeKeyNone && key.Kind != kind) {
+				return rangeKeyUnsupported
+			}
+			kind = key.Kind
+		}
+	}
+	return kind
+}
+
+// An interval tree built on the ranges sorted by lower bounds, holding the maximum upper bound of each subtree.
+type intervalIndex struct {
+	lows     []float64
+	highs    []float64
+	indices  []int
+	maxHighs []float64
+}
+
+func newIntervalIndex(ranges []*rangeKey) *intervalIndex {
+	indices := make([]int, 0, len(ranges))
+	for i, r := range ranges {
+		if r != nil {
+			indices = append(indices, i)
+		}
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return ranges[indices[i]].Low < ranges[indices[j]].Low
+	})
+
+	index := &intervalIndex{
+		lows:     make([]float64, len(indices)),
+		highs:    make([]float64, len(indices)),
+		indices:  indices,
+		maxHighs: make([]float64, 4*len(indices)),
+	}
+	for i, idx := range indices {
+		index.lows[i] = ranges[idx].Low
+		index.highs[i] = ranges[idx].High
+	}
+	if 0 < len(indices) {
+		index.build(1, 0, len(indices))
+	}
+	return index
+}
+
+func (index *intervalIndex) build(node int, start int, end int) float64 {
+	if end-start == 1 {
+		index.maxHighs[node] = index.highs[start]
+	} else {
+		mid := (start + end) / 2
+		index.maxHighs[node] = math.Max(index.build(node*2, start, mid), index.build(node*2+1, mid, end))
+	}
+	return index.maxHighs[node]
+}
+
+// Returns the ascending indices of the ranges overlapping the range from low to high.
+func (index *intervalIndex) Search(low float64, high float64) []int {
+	end := sort.Search(len(index.lows), func(i int) bool {
+		return high < index.lows[i]
+	})
+
+	var result []int
+	if 0 < end {
+		result = index.collect(result, 1, 0, len(index.lows), end, low)
+	}
+	sort.Ints(result)
+	return result
+}
+
+func (index *intervalIndex) collect(result []int, node int, start int, end int, limit int, low float64) []int {
+	if limit <= start || index.maxHighs[node] < low {
+		return result
+	}
+	if end-start == 1 {
+		return append(result, index.indices[start])
+	}
+	mid := (start + end) / 2
+	result = index.collect(result, node*2, start, mid, limit, low)
+	return index.collect(result, node*2+1, mid, end, limit, low)
+}
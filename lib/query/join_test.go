@@ -974,6 +974,364 @@ var outerJoinTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Right Outer Join with Range Condition",
+		View: &View{
+			Header: NewHeader("t2", []string{"start", "end"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(0),
+					value.NewInteger(4),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(4),
+					value.NewInteger(6),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewInteger(3),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewInteger(12),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeader("t1", []string{"ts"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(13),
+				}),
+			},
+		},
+		Condition: parser.Between{
+			LHS:  parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "ts"}},
+			Low:  parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "start"}},
+			High: parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "end"}},
+		},
+		Direction: parser.RIGHT,
+		Result: &View{
+			Header: []HeaderField{
+				{View: "t2", Column: "start", Number: 1, IsFromTable: true},
+				{View: "t2", Column: "end", Number: 2, IsFromTable: true},
+				{View: "t1", Column: "ts", Number: 1, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(0),
+					value.NewInteger(4),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(4),
+					value.NewInteger(6),
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewInteger(12),
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewNull(),
+					value.NewInteger(13),
+				}),
+			},
+		},
+	},
+}
+
+var rangeJoinCandidatesTests = []struct {
+	Name      string
+	View      *View
+	JoinView  *View
+	Condition parser.QueryExpression
+	Result    [][]int
+}{
+	{
+		Name: "Range Join Candidates with Between",
+		View: &View{
+			Header: NewHeader("t1", []string{"ts"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(10),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeader("t2", []string{"start", "end"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(0),
+					value.NewInteger(4),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(4),
+					value.NewInteger(6),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewInteger(3),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(7),
+					value.NewInteger(9),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewInteger(12),
+				}),
+			},
+		},
+		Condition: parser.Between{
+			LHS:  parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "ts"}},
+			Low:  parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "start"}},
+			High: parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "end"}},
+		},
+		Result: [][]int{{0}, {1, 4}, nil, {4}},
+	},
+	{
+		Name: "Range Join Candidates with Comparisons",
+		View: &View{
+			Header: NewHeader("t1", []string{"ts"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(10),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeader("t2", []string{"start", "end"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(0),
+					value.NewInteger(4),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(4),
+					value.NewInteger(6),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewInteger(3),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(7),
+					value.NewInteger(9),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewInteger(12),
+				}),
+			},
+		},
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "ts"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "start"}},
+				Operator: ">=",
+			},
+			RHS: parser.Parentheses{
+				Expr: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "ts"}},
+					RHS:      parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "end"}},
+					Operator: "<",
+				},
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "and"},
+		},
+		Result: [][]int{{0}, {1, 4}, nil, {4}},
+	},
+	{
+		Name: "Range Join Candidates Indexing the View",
+		View: &View{
+			Header: NewHeader("t2", []string{"start", "end"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(0),
+					value.NewInteger(4),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(4),
+					value.NewInteger(6),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewInteger(3),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(7),
+					value.NewInteger(9),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewInteger(12),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeader("t1", []string{"ts"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(10),
+				}),
+			},
+		},
+		Condition: parser.Between{
+			LHS:  parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "ts"}},
+			Low:  parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "start"}},
+			High: parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "end"}},
+		},
+		Result: [][]int{{0}, {1}, nil, nil, {1, 3}},
+	},
+	{
+		Name: "Range Join Candidates Not Range Condition",
+		View: &View{
+			Header: NewHeader("t1", []string{"ts"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(10),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeader("t2", []string{"start", "end"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(0),
+					value.NewInteger(4),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(4),
+					value.NewInteger(6),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewInteger(3),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(7),
+					value.NewInteger(9),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewInteger(12),
+				}),
+			},
+		},
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "ts"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "start"}},
+			Operator: "=",
+		},
+		Result: nil,
+	},
+	{
+		Name: "Range Join Candidates Incomparable Values",
+		View: &View{
+			Header: NewHeader("t1", []string{"ts"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("abc"),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeader("t2", []string{"start", "end"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(0),
+					value.NewInteger(4),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(4),
+					value.NewInteger(6),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewInteger(3),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(7),
+					value.NewInteger(9),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewInteger(12),
+				}),
+			},
+		},
+		Condition: parser.Between{
+			LHS:  parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "ts"}},
+			Low:  parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "start"}},
+			High: parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "end"}},
+		},
+		Result: nil,
+	},
+}
+
+func TestRangeJoinCandidates(t *testing.T) {
+	for _, v := range rangeJoinCandidatesTests {
+		result := rangeJoinCandidates(v.View, v.JoinView, v.Condition, NewEmptyFilter())
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
 }
 
 func TestOuterJoin(t *testing.T) {
@@ -1003,6 +1361,379 @@ func TestOuterJoin(t *testing.T) {
 	}
 }
 
+var asOfJoinTests = []struct {
+	Name     string
+	CPU      int
+	View     *View
+	JoinView *View
+	Join     parser.Join
+	Result   *View
+	Error    string
+}{
+	{
+		Name: "AsOf Join",
+		View: &View{
+			Header: NewHeader("table1", []string{"sym", "time"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("B"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("C"),
+					value.NewInteger(3),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeader("table2", []string{"sym", "time", "bid"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(4),
+					value.NewString("a4"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("B"),
+					value.NewInteger(3),
+					value.NewString("b3"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(0),
+					value.NewString("a0"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewNull(),
+					value.NewString("anull"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(4),
+					value.NewString("a4-2"),
+				}),
+			},
+		},
+		Join: parser.Join{
+			AsOf: parser.Token{Token: parser.ASOF, Literal: "asof"},
+			Partition: parser.PartitionClause{
+				PartitionBy: "partition by",
+				Values: []parser.QueryExpression{
+					parser.FieldReference{Column: parser.Identifier{Literal: "sym"}},
+				},
+			},
+			Condition: parser.JoinCondition{
+				Literal: "on",
+				On: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "time"}},
+					RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "time"}},
+					Operator: ">=",
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: "sym", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "time", Number: 2, IsFromTable: true},
+				{View: "table2", Column: "sym", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "time", Number: 2, IsFromTable: true},
+				{View: "table2", Column: "bid", Number: 3, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(1),
+					value.NewString("A"),
+					value.NewInteger(0),
+					value.NewString("a0"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(5),
+					value.NewString("A"),
+					value.NewInteger(4),
+					value.NewString("a4-2"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "AsOf Left Join in Multi Threading",
+		CPU:  2,
+		View: &View{
+			Header: NewHeader("table1", []string{"sym", "time"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("B"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("C"),
+					value.NewInteger(3),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeader("table2", []string{"sym", "time", "bid"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(4),
+					value.NewString("a4"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("B"),
+					value.NewInteger(3),
+					value.NewString("b3"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(0),
+					value.NewString("a0"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewNull(),
+					value.NewString("anull"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(4),
+					value.NewString("a4-2"),
+				}),
+			},
+		},
+		Join: parser.Join{
+			AsOf:      parser.Token{Token: parser.ASOF, Literal: "asof"},
+			Direction: parser.Token{Token: parser.LEFT, Literal: "left"},
+			Condition: parser.JoinCondition{
+				Literal: "on",
+				On: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "time"}},
+					RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "time"}},
+					Operator: "<",
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: "sym", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "time", Number: 2, IsFromTable: true},
+				{View: "table2", Column: "sym", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "time", Number: 2, IsFromTable: true},
+				{View: "table2", Column: "bid", Number: 3, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(1),
+					value.NewString("B"),
+					value.NewInteger(3),
+					value.NewString("b3"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(5),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("B"),
+					value.NewInteger(2),
+					value.NewString("B"),
+					value.NewInteger(3),
+					value.NewString("b3"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("C"),
+					value.NewInteger(3),
+					value.NewString("A"),
+					value.NewInteger(4),
+					value.NewString("a4"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "AsOf Join Invalid Condition Error",
+		View: &View{
+			Header: NewHeader("table1", []string{"sym", "time"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("B"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("C"),
+					value.NewInteger(3),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeader("table2", []string{"sym", "time", "bid"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(4),
+					value.NewString("a4"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("B"),
+					value.NewInteger(3),
+					value.NewString("b3"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(0),
+					value.NewString("a0"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewNull(),
+					value.NewString("anull"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(4),
+					value.NewString("a4-2"),
+				}),
+			},
+		},
+		Join: parser.Join{
+			AsOf: parser.Token{Token: parser.ASOF, Literal: "asof"},
+			Condition: parser.JoinCondition{
+				Literal: "on",
+				On: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "time"}},
+					RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "time"}},
+					Operator: "=",
+				},
+			},
+		},
+		Error: "[L:- C:-] ASOF JOIN condition table1.time = table2.time must be a comparison with <, <=, > or >=",
+	},
+	{
+		Name: "AsOf Join Evaluation Error",
+		View: &View{
+			Header: NewHeader("table1", []string{"sym", "time"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("B"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("C"),
+					value.NewInteger(3),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeader("table2", []string{"sym", "time", "bid"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(4),
+					value.NewString("a4"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("B"),
+					value.NewInteger(3),
+					value.NewString("b3"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(0),
+					value.NewString("a0"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewNull(),
+					value.NewString("anull"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("A"),
+					value.NewInteger(4),
+					value.NewString("a4-2"),
+				}),
+			},
+		},
+		Join: parser.Join{
+			AsOf: parser.Token{Token: parser.ASOF, Literal: "asof"},
+			Condition: parser.JoinCondition{
+				Literal: "on",
+				On: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "time"}},
+					RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "time"}},
+					Operator: ">",
+				},
+			},
+		},
+		Error: "[L:- C:-] field table2.time does not exist",
+	},
+}
+
+func TestAsOfJoin(t *testing.T) {
+	flags := cmd.GetFlags()
+
+	for _, v := range asOfJoinTests {
+		flags.CPU = 1
+		if v.CPU != 0 {
+			flags.CPU = v.CPU
+		}
+
+		err := AsOfJoin(v.View, v.JoinView, v.Join, NewEmptyFilter())
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(v.View, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, v.View, v.Result)
+		}
+	}
+}
+
 func BenchmarkCrossJoin(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		view := GenerateBenchView("t1", 100)
@@ -0,0 +1,116 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+
+	"github.com/mithrandie/ternary"
+)
+
+// loadCondition is a where clause evaluated for each record while a file is loaded,
+// so that records that do not satisfy the condition are discarded before the view is built.
+type loadCondition struct {
+	expr      parser.QueryExpression
+	tableName string
+	columns   []string
+
+	parentFilter *Filter
+	filter       *Filter
+
+	// applied is set if all records of the loaded file are filtered with the condition.
+	applied bool
+}
+
+// newLoadCondition returns the condition of the where clause if the from clause has only one file
+// and the condition can be evaluated with each record of the file.
+// Conditions that contain volatile functions are not returned, since they must be evaluated only once for each record.
+func newLoadCondition(entity parser.SelectEntity, filter *Filter) *loadCondition {
+	if entity.WhereClause == nil || entity.FromClause == nil {
+		return nil
+	}
+
+	tables := entity.FromClause.(parser.FromClause).Tables
+	if len(tables) != 1 {
+		return nil
+	}
+	table, ok := tables[0].(parser.Table)
+	if !ok {
+		return nil
+	}
+	if _, ok := table.Object.(parser.Identifier); !ok {
+		return nil
+	}
+
+	expr := entity.WhereClause.(parser.WhereClause).Filter
+	if !isStreamable(expr) {
+		return nil
+	}
+
+	c := &columnCollector{
+		names: make(map[string]bool),
+		views: make(map[string]bool),
+	}
+	if !c.collect(expr) {
+		return nil
+	}
+	tableName := table.Name().Literal
+	for name := range c.views {
+		if !strings.EqualFold(name, tableName) {
+			return nil
+		}
+	}
+
+	columns := make([]string, 0, len(c.names))
+	for name := range c.names {
+		columns = append(columns, name)
+	}
+
+	return &loadCondition{
+		expr:         expr,
+		tableName:    tableName,
+		columns:      columns,
+		parentFilter: filter,
+	}
+}
+
+// isApplicable reports whether the condition can be evaluated while the file is loaded.
+// Files whose values depend on other records, such as with type inference per column, are excluded.
+func (c *loadCondition) isApplicable(fileInfo *FileInfo, options TableOptions) bool {
+	return !isXlsxFile(fileInfo.Path) &&
+		!Schemas.Exists(fileInfo.Path) &&
+		len(options.ComputedColumns) < 1 &&
+		cmd.GetFlags().InferTypes != cmd.INFER_PER_COLUMN
+}
+
+// prepare sets up the condition to be evaluated with records that have the fields.
+// If any column referenced in the condition is not unique in the fields, then returns false.
+func (c *loadCondition) prepare(fields []string) bool {
+	for _, name := range c.columns {
+		cnt := 0
+		for _, f := range fields {
+			if strings.EqualFold(name, f) {
+				cnt++
+			}
+		}
+		if cnt != 1 {
+			return false
+		}
+	}
+
+	view := NewView()
+	view.Header = NewHeader(c.tableName, fields)
+	view.RecordSet = make(RecordSet, 1)
+	c.filter = NewFilterForSequentialEvaluation(view, c.parentFilter)
+	return true
+}
+
+func (c *loadCondition) match(record Record) (bool, error) {
+	c.filter.Records[0].View.RecordSet[0] = record
+	p, err := c.filter.Evaluate(c.expr)
+	if err != nil {
+		return false, err
+	}
+	return p.Ternary() == ternary.TRUE, nil
+}
@@ -0,0 +1,182 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var newLoadConditionTests = []struct {
+	Name   string
+	Entity parser.SelectEntity
+	Result bool
+}{
+	{
+		Name: "NewLoadCondition",
+		Entity: parser.SelectEntity{
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Logic{
+					LHS: parser.Comparison{
+						LHS:      parser.FieldReference{View: parser.Identifier{Literal: "t"}, Column: parser.Identifier{Literal: "column1"}},
+						RHS:      parser.NewIntegerValue(1),
+						Operator: "=",
+					},
+					RHS: parser.Function{
+						Name: "coalesce",
+						Args: []parser.QueryExpression{
+							parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						},
+					},
+					Operator: parser.Token{Token: parser.AND, Literal: "and"},
+				},
+			},
+		},
+		Result: true,
+	},
+	{
+		Name: "NewLoadCondition Without Where Clause",
+		Entity: parser.SelectEntity{
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Identifier{Literal: "table1"}},
+				},
+			},
+		},
+		Result: false,
+	},
+	{
+		Name: "NewLoadCondition Multiple Tables",
+		Entity: parser.SelectEntity{
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					parser.Table{Object: parser.Identifier{Literal: "table2"}},
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValue(1),
+					Operator: "=",
+				},
+			},
+		},
+		Result: false,
+	},
+	{
+		Name: "NewLoadCondition Subquery",
+		Entity: parser.SelectEntity{
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Identifier{Literal: "table1"}},
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Exists{
+					Query: parser.Subquery{
+						Query: parser.SelectQuery{
+							SelectEntity: parser.SelectEntity{
+								SelectClause: parser.SelectClause{
+									Fields: []parser.QueryExpression{
+										parser.Field{Object: parser.NewIntegerValue(1)},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: false,
+	},
+	{
+		Name: "NewLoadCondition Other Table",
+		Entity: parser.SelectEntity{
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Identifier{Literal: "table1"}},
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValue(1),
+					Operator: "=",
+				},
+			},
+		},
+		Result: false,
+	},
+	{
+		Name: "NewLoadCondition Volatile Function",
+		Entity: parser.SelectEntity{
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Identifier{Literal: "table1"}},
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.Function{Name: "rand"},
+					RHS:      parser.NewFloatValue(0.5),
+					Operator: "<",
+				},
+			},
+		},
+		Result: false,
+	},
+}
+
+func TestNewLoadCondition(t *testing.T) {
+	for _, v := range newLoadConditionTests {
+		result := newLoadCondition(v.Entity, NewEmptyFilter()) != nil
+		if result != v.Result {
+			t.Errorf("%s: result = %t, want %t", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestSelect_LoadCondition(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	ViewCache.Clean()
+	Warnings.Clear()
+	defer Warnings.Clear()
+
+	entity := parser.SelectEntity{
+		SelectClause: parser.SelectClause{
+			Fields: []parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+			},
+		},
+		FromClause: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			},
+		},
+		WhereClause: parser.WhereClause{
+			Filter: parser.Is{
+				LHS: parser.Function{Name: "integer", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}}},
+				RHS: parser.NewNullValue(),
+			},
+		},
+	}
+
+	view, err := Select(parser.SelectQuery{SelectEntity: entity}, NewEmptyFilter())
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if view.RecordLen() != 3 {
+		t.Errorf("record length = %d, want %d", view.RecordLen(), 3)
+	}
+	// The condition is evaluated only once for each record.
+	if Warnings.Len() != 3 {
+		t.Errorf("warnings = %v, want %d warnings", Warnings.List(), 3)
+	}
+}
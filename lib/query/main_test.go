@@ -61,8 +61,12 @@ func setup() {
 	copyfile(filepath.Join(TestDir, "table1.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	copyfile(filepath.Join(TestDir, "table1b.csv"), filepath.Join(TestDataDir, "table1b.csv"))
 	copyfile(filepath.Join(TestDir, "table2.csv"), filepath.Join(TestDataDir, "table2.csv"))
+	copyfile(filepath.Join(TestDir, "table3.tsv"), filepath.Join(TestDataDir, "table3.tsv"))
 	copyfile(filepath.Join(TestDir, "table4.csv"), filepath.Join(TestDataDir, "table4.csv"))
 	copyfile(filepath.Join(TestDir, "group_table.csv"), filepath.Join(TestDataDir, "group_table.csv"))
+	copyfile(filepath.Join(TestDir, "glob_1.csv"), filepath.Join(TestDataDir, "glob_1.csv"))
+	copyfile(filepath.Join(TestDir, "glob_2.csv"), filepath.Join(TestDataDir, "glob_2.csv"))
+	copyfile(filepath.Join(TestDir, "table_book.xlsx"), filepath.Join(TestDataDir, "table_book.xlsx"))
 	copyfile(filepath.Join(TestDir, "insert_query.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	copyfile(filepath.Join(TestDir, "update_query.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	copyfile(filepath.Join(TestDir, "delete_query.csv"), filepath.Join(TestDataDir, "table1.csv"))
@@ -86,12 +90,33 @@ func initFlag() {
 	flags := cmd.GetFlags()
 	flags.Delimiter = cmd.UNDEF
 	flags.Encoding = cmd.UTF8
+	flags.EncodingFallback = true
 	flags.LineBreak = cmd.LF
 	flags.Repository = "."
 	flags.DatetimeFormat = ""
 	flags.NoHeader = false
 	flags.WithoutNull = false
+	flags.TrimTrailingDelimiter = false
+	flags.PreserveQuoting = false
+	flags.PreserveLineBreak = true
+	flags.SkipLines = 0
+	flags.Head = 0
+	flags.CommentPrefix = ""
+	flags.Locale = cmd.EN
+	cmd.SetBooleanLiterals("")
+	flags.InferTypes = cmd.INFER_OFF
+	flags.SchemaNullOnError = false
+	flags.MaxErrors = 0
+	flags.MaxIterations = 0
+	flags.ApproxPrecision = cmd.DEFAULT_APPROX_PRECISION
+	flags.MaxResultRows = 0
+	flags.StrictUnionTypes = false
+	flags.StrictSelectInto = false
+	flags.Quiet = false
 	flags.Stats = false
+	flags.Profile = false
+	flags.ReadOnly = false
+	flags.BackupSuffix = ".bak"
 }
 
 func copyfile(dstfile string, srcfile string) error {
@@ -2,6 +2,7 @@ package query
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -15,23 +16,37 @@ type Procedure struct {
 	Filter           *Filter
 	ReturnVal        value.Primary
 	MeasurementStart time.Time
+
+	loops *loopContext
+}
+
+// loopContext holds the labels of the running loops and the label targeted by a BREAK or CONTINUE statement.
+type loopContext struct {
+	labels []string
+	target string
 }
 
 func NewProcedure() *Procedure {
 	return &Procedure{
 		Filter: NewEmptyFilter(),
+		loops:  &loopContext{},
 	}
 }
 
 func (proc *Procedure) NewChildProcedure() *Procedure {
 	return &Procedure{
 		Filter: proc.Filter.CreateChildScope(),
+		loops:  proc.loops,
 	}
 }
 
 func (proc *Procedure) ExecuteChild(statements []parser.Statement) (StatementFlow, error) {
 	child := proc.NewChildProcedure()
-	return child.Execute(statements)
+	flow, err := child.Execute(statements)
+	if flow == RETURN {
+		proc.ReturnVal = child.ReturnVal
+	}
+	return flow, err
 }
 
 func (proc *Procedure) Execute(statements []parser.Statement) (StatementFlow, error) {
@@ -56,11 +71,23 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 
 	var err error
 
+	// Operations on files are reported only in the summary on commit when the summary is output as JSON.
+	quiet := flags.Quiet || flags.Summary == cmd.JSON
+
 	var results []Result
 	var view *View
 	var views []*View
 	var printstr string
 
+	if proc.Filter.Now.IsZero() {
+		proc.Filter.Now = cmd.Now()
+		proc.Filter.functionResults = NewFunctionResultCache()
+		defer func() {
+			proc.Filter.Now = time.Time{}
+			proc.Filter.functionResults = nil
+		}()
+	}
+
 	switch stmt.(type) {
 	case parser.SetFlag:
 		err = SetFlag(stmt.(parser.SetFlag))
@@ -89,38 +116,72 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		err = DeclareView(stmt.(parser.ViewDeclaration), proc.Filter)
 	case parser.DisposeView:
 		err = proc.Filter.TempViews.Dispose(stmt.(parser.DisposeView).View)
+	case parser.SchemaDeclaration:
+		err = DeclareSchema(stmt.(parser.SchemaDeclaration))
 	case parser.FunctionDeclaration:
 		err = proc.Filter.Functions.Declare(stmt.(parser.FunctionDeclaration))
 	case parser.DisposeFunction:
 		err = proc.Filter.Functions.Dispose(stmt.(parser.DisposeFunction).Name)
 	case parser.AggregateDeclaration:
 		err = proc.Filter.Functions.DeclareAggregate(stmt.(parser.AggregateDeclaration))
-	case parser.SelectQuery:
+	case parser.SelectQuery, parser.Diff:
 		if flags.Stats {
 			proc.MeasurementStart = time.Now()
 		}
-		if view, err = Select(stmt.(parser.SelectQuery), proc.Filter); err == nil {
+		if startProfile() {
+			defer finishProfile(stmt)
+		}
+		if query, ok := stmt.(parser.SelectQuery); ok {
+			view, err = Select(query, proc.Filter)
+		} else {
+			view, err = Diff(stmt.(parser.Diff), proc.Filter)
+		}
+		if err == nil {
+			enterPhase(PROFILE_WRITING)
 			var viewstr string
 			var lineBreak = cmd.LF
+			var encoding = flags.WriteEncoding
 			if 0 < len(flags.OutFile) {
 				lineBreak = flags.LineBreak
+				encoding = cmd.UTF8
 			}
-			viewstr, err = EncodeView(view, flags.Format, flags.WriteDelimiter, flags.WithoutHeader, flags.WriteEncoding, lineBreak)
-			if err == nil {
+			viewstr, err = EncodeResult(view, flags.Format, flags.WriteDelimiter, flags.WithoutHeader, encoding, lineBreak)
+			// Results with no records are not written at all unless they have something to write or the empty-result flag is AUTO.
+			if err == nil && (0 < view.RecordLen() || 0 < len(viewstr) || flags.EmptyResult == cmd.EMPTY_AUTO) {
 				if 0 < len(flags.OutFile) {
 					AddSelectLog(viewstr)
 				} else {
 					Log(viewstr, false)
 				}
 			}
+			leavePhase(PROFILE_WRITING)
 		}
 		if flags.Stats {
 			proc.showExecutionTime()
 		}
+	case parser.SelectInto:
+		if flags.Stats {
+			proc.MeasurementStart = time.Now()
+		}
+		if startProfile() {
+			defer finishProfile(stmt)
+		}
+		err = SelectInto(stmt.(parser.SelectInto), proc.Filter)
+		if flags.Stats {
+			proc.showExecutionTime()
+		}
+	case parser.Explain:
+		var plan *PlanNode
+		if plan, err = Explain(stmt.(parser.Explain), proc.Filter); err == nil {
+			Log(plan.String(), false)
+		}
 	case parser.InsertQuery:
 		if flags.Stats {
 			proc.MeasurementStart = time.Now()
 		}
+		if startProfile() {
+			defer finishProfile(stmt)
+		}
 		if view, err = Insert(stmt.(parser.InsertQuery), proc.Filter); err == nil {
 			results = []Result{
 				{
@@ -129,7 +190,7 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 					OperatedCount: view.OperatedRecords,
 				},
 			}
-			Log(fmt.Sprintf("%s inserted on %q.", FormatCount(view.OperatedRecords, "record"), view.FileInfo.Path), flags.Quiet)
+			Log(fmt.Sprintf("%s inserted on %q.", FormatCount(view.OperatedRecords, "record"), view.FileInfo.Path), quiet)
 
 			view.OperatedRecords = 0
 		}
@@ -140,6 +201,9 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		if flags.Stats {
 			proc.MeasurementStart = time.Now()
 		}
+		if startProfile() {
+			defer finishProfile(stmt)
+		}
 		if views, err = Update(stmt.(parser.UpdateQuery), proc.Filter); err == nil {
 			results = make([]Result, len(views))
 			for i, v := range views {
@@ -148,7 +212,7 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 					FileInfo:      v.FileInfo,
 					OperatedCount: v.OperatedRecords,
 				}
-				Log(fmt.Sprintf("%s updated on %q.", FormatCount(v.OperatedRecords, "record"), v.FileInfo.Path), flags.Quiet)
+				Log(fmt.Sprintf("%s updated on %q.", FormatCount(v.OperatedRecords, "record"), v.FileInfo.Path), quiet)
 
 				v.OperatedRecords = 0
 			}
@@ -160,6 +224,9 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		if flags.Stats {
 			proc.MeasurementStart = time.Now()
 		}
+		if startProfile() {
+			defer finishProfile(stmt)
+		}
 		if views, err = Delete(stmt.(parser.DeleteQuery), proc.Filter); err == nil {
 			results = make([]Result, len(views))
 			for i, v := range views {
@@ -168,7 +235,7 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 					FileInfo:      v.FileInfo,
 					OperatedCount: v.OperatedRecords,
 				}
-				Log(fmt.Sprintf("%s deleted on %q.", FormatCount(v.OperatedRecords, "record"), v.FileInfo.Path), flags.Quiet)
+				Log(fmt.Sprintf("%s deleted on %q.", FormatCount(v.OperatedRecords, "record"), v.FileInfo.Path), quiet)
 
 				v.OperatedRecords = 0
 			}
@@ -177,16 +244,43 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 			proc.showExecutionTime()
 		}
 	case parser.CreateTable:
-		if view, err = CreateTable(stmt.(parser.CreateTable), proc.Filter); err == nil {
+		var created bool
+		if view, created, err = CreateTable(stmt.(parser.CreateTable), proc.Filter); err == nil {
+			if created {
+				results = []Result{
+					{
+						Type:     CREATE_TABLE,
+						FileInfo: view.FileInfo,
+					},
+				}
+				Log(fmt.Sprintf("file %q is created.", view.FileInfo.Path), quiet)
+			} else {
+				Log(fmt.Sprintf("file %q already exists.", view.FileInfo.Path), quiet)
+			}
+
+			view.OperatedRecords = 0
+		}
+	case parser.DropTable:
+		if view, err = DropTable(stmt.(parser.DropTable), proc.Filter); err == nil {
 			results = []Result{
 				{
-					Type:     CREATE_TABLE,
+					Type:     DROP_TABLE,
 					FileInfo: view.FileInfo,
 				},
 			}
-			Log(fmt.Sprintf("file %q is created.", view.FileInfo.Path), flags.Quiet)
-
-			view.OperatedRecords = 0
+			Log(fmt.Sprintf("file %q is dropped.", view.FileInfo.Path), quiet)
+		}
+	case parser.RenameTable:
+		var oldPath string
+		if view, oldPath, err = RenameTable(stmt.(parser.RenameTable), proc.Filter); err == nil {
+			results = []Result{
+				{
+					Type:     RENAME_TABLE,
+					FileInfo: view.FileInfo,
+					OldPath:  oldPath,
+				},
+			}
+			Log(fmt.Sprintf("file %q is renamed to %q.", oldPath, view.FileInfo.Path), quiet)
 		}
 	case parser.AddColumns:
 		if view, err = AddColumns(stmt.(parser.AddColumns), proc.Filter); err == nil {
@@ -197,7 +291,7 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 					OperatedCount: view.OperatedFields,
 				},
 			}
-			Log(fmt.Sprintf("%s added on %q.", FormatCount(view.OperatedFields, "field"), view.FileInfo.Path), flags.Quiet)
+			Log(fmt.Sprintf("%s added on %q.", FormatCount(view.OperatedFields, "field"), view.FileInfo.Path), quiet)
 
 			view.OperatedRecords = 0
 		}
@@ -210,7 +304,7 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 					OperatedCount: view.OperatedFields,
 				},
 			}
-			Log(fmt.Sprintf("%s dropped on %q.", FormatCount(view.OperatedFields, "field"), view.FileInfo.Path), flags.Quiet)
+			Log(fmt.Sprintf("%s dropped on %q.", FormatCount(view.OperatedFields, "field"), view.FileInfo.Path), quiet)
 
 			view.OperatedRecords = 0
 		}
@@ -223,23 +317,58 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 					OperatedCount: view.OperatedFields,
 				},
 			}
-			Log(fmt.Sprintf("%s renamed on %q.", FormatCount(view.OperatedFields, "field"), view.FileInfo.Path), flags.Quiet)
+			Log(fmt.Sprintf("%s renamed on %q.", FormatCount(view.OperatedFields, "field"), view.FileInfo.Path), quiet)
+
+			view.OperatedRecords = 0
+		}
+	case parser.Deduplicate:
+		if flags.Stats {
+			proc.MeasurementStart = time.Now()
+		}
+		if startProfile() {
+			defer finishProfile(stmt)
+		}
+		if view, err = Deduplicate(stmt.(parser.Deduplicate), proc.Filter); err == nil {
+			results = []Result{
+				{
+					Type:          DEDUPLICATE,
+					FileInfo:      view.FileInfo,
+					OperatedCount: view.OperatedRecords,
+				},
+			}
+			Log(fmt.Sprintf("%s removed on %q.", FormatCount(view.OperatedRecords, "duplicate record"), view.FileInfo.Path), quiet)
 
 			view.OperatedRecords = 0
 		}
+		if flags.Stats {
+			proc.showExecutionTime()
+		}
 	case parser.TransactionControl:
-		switch stmt.(parser.TransactionControl).Token {
+		tc := stmt.(parser.TransactionControl)
+		switch tc.Token {
 		case parser.COMMIT:
 			err = Commit(stmt.(parser.Expression), proc.Filter)
 		case parser.ROLLBACK:
-			Rollback(proc.Filter)
+			if 0 < len(tc.Savepoint.Literal) {
+				err = RollbackToSavepoint(tc.Savepoint, proc.Filter)
+			} else {
+				Rollback(proc.Filter)
+			}
+		case parser.SAVEPOINT:
+			SetSavepoint(tc.Savepoint, proc.Filter)
 		}
 	case parser.FlowControl:
-		switch stmt.(parser.FlowControl).Token {
-		case parser.CONTINUE:
-			flow = CONTINUE
-		case parser.BREAK:
-			flow = BREAK
+		fc := stmt.(parser.FlowControl)
+		if 0 < len(fc.Label.Literal) && !InStrSliceWithCaseInsensitive(fc.Label.Literal, proc.loops.labels) {
+			err = NewUndefinedLoopLabelError(fc.Label)
+		} else {
+			proc.loops.target = fc.Label.Literal
+			switch fc.Token {
+			case parser.CONTINUE:
+				flow = CONTINUE
+			case parser.BREAK:
+				flow = BREAK
+			}
 		}
 	case parser.Exit:
 		ex := stmt.(parser.Exit)
@@ -267,6 +396,8 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		flow, err = proc.While(stmt.(parser.While))
 	case parser.WhileInCursor:
 		flow, err = proc.WhileInCursor(stmt.(parser.WhileInCursor))
+	case parser.Loop:
+		flow, err = proc.Loop(stmt.(parser.Loop))
 	case parser.Print:
 		if printstr, err = Print(stmt.(parser.Print), proc.Filter); err == nil {
 			Log(printstr, false)
@@ -283,10 +414,16 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 		if externalStatements, err = Source(source, proc.Filter); err == nil {
 			flow, err = proc.Execute(externalStatements)
 		}
+	case parser.UseRepository:
+		err = UseRepository(stmt.(parser.UseRepository), proc.Filter)
 	case parser.ShowObjects:
 		if printstr, err = ShowObjects(stmt.(parser.ShowObjects), proc.Filter); err == nil {
 			Log(printstr, false)
 		}
+	case parser.Export:
+		if printstr, err = Export(stmt.(parser.Export), proc.Filter); err == nil {
+			Log(printstr, flags.Quiet)
+		}
 	case parser.ShowFields:
 		if printstr, err = ShowFields(stmt.(parser.ShowFields), proc.Filter); err == nil {
 			Log(printstr, false)
@@ -315,6 +452,13 @@ func (proc *Procedure) ExecuteStatement(stmt parser.Statement) (StatementFlow, e
 	}
 
 	if results != nil {
+		statement := 1
+		if 0 < len(Results) {
+			statement = Results[len(Results)-1].Statement + 1
+		}
+		for i := range results {
+			results[i].Statement = statement
+		}
 		Results = append(Results, results...)
 	}
 
@@ -386,9 +530,12 @@ func (proc *Procedure) Case(stmt parser.Case) (StatementFlow, error) {
 }
 
 func (proc *Procedure) While(stmt parser.While) (StatementFlow, error) {
+	flags := cmd.GetFlags()
 	childProc := proc.NewChildProcedure()
+	proc.enterLoop(stmt.Label)
+	defer proc.exitLoop()
 
-	for {
+	for i := 0; ; i++ {
 		childProc.Filter.ResetCurrentScope()
 		p, err := proc.Filter.Evaluate(stmt.Condition)
 		if err != nil {
@@ -397,34 +544,85 @@ func (proc *Procedure) While(stmt parser.While) (StatementFlow, error) {
 		if p.Ternary() != ternary.TRUE {
 			break
 		}
+		if 0 < flags.MaxIterations && flags.MaxIterations <= i {
+			return ERROR, NewLoopIterationsExceededError(stmt, flags.MaxIterations)
+		}
 
 		f, err := childProc.Execute(stmt.Statements)
 		if err != nil {
 			return ERROR, err
 		}
 
-		if f == BREAK {
-			return TERMINATE, nil
+		if f == BREAK || f == CONTINUE {
+			if !proc.isLoopTarget(stmt.Label) {
+				return f, nil
+			}
+			if f == BREAK {
+				return TERMINATE, nil
+			}
 		}
 		if f == EXIT {
 			return EXIT, nil
 		}
+		if f == RETURN {
+			proc.ReturnVal = childProc.ReturnVal
+			return RETURN, nil
+		}
 	}
 	return TERMINATE, nil
 }
 
+func (proc *Procedure) Loop(stmt parser.Loop) (StatementFlow, error) {
+	flags := cmd.GetFlags()
+	childProc := proc.NewChildProcedure()
+	proc.enterLoop(stmt.Label)
+	defer proc.exitLoop()
+
+	for i := 0; ; i++ {
+		childProc.Filter.ResetCurrentScope()
+		if 0 < flags.MaxIterations && flags.MaxIterations <= i {
+			return ERROR, NewLoopIterationsExceededError(stmt, flags.MaxIterations)
+		}
+
+		f, err := childProc.Execute(stmt.Statements)
+		if err != nil {
+			return ERROR, err
+		}
+
+		if f == BREAK || f == CONTINUE {
+			if !proc.isLoopTarget(stmt.Label) {
+				return f, nil
+			}
+			if f == BREAK {
+				return TERMINATE, nil
+			}
+		}
+		if f == EXIT {
+			return EXIT, nil
+		}
+		if f == RETURN {
+			proc.ReturnVal = childProc.ReturnVal
+			return RETURN, nil
+		}
+	}
+}
+
 func (proc *Procedure) WhileInCursor(stmt parser.WhileInCursor) (StatementFlow, error) {
+	flags := cmd.GetFlags()
 	fetchPosition := parser.FetchPosition{
 		Position: parser.Token{Token: parser.NEXT},
 	}
 
 	childProc := proc.NewChildProcedure()
-	for {
+	proc.enterLoop(stmt.Label)
+	defer proc.exitLoop()
+
+	for i := 0; ; i++ {
 		childProc.Filter.ResetCurrentScope()
 		if stmt.WithDeclaration {
 			assigns := make([]parser.VariableAssignment, len(stmt.Variables))
-			for i, v := range stmt.Variables {
-				assigns[i] = parser.VariableAssignment{Variable: v}
+			for j, v := range stmt.Variables {
+				assigns[j] = parser.VariableAssignment{Variable: v}
 			}
 			decl := parser.VariableDeclaration{Assignments: assigns}
 			childProc.Filter.Variables.Declare(decl, childProc.Filter)
@@ -437,23 +635,56 @@ func (proc *Procedure) WhileInCursor(stmt parser.WhileInCursor) (StatementFlow,
 		if !success {
 			break
 		}
+		if 0 < flags.MaxIterations && flags.MaxIterations <= i {
+			return ERROR, NewLoopIterationsExceededError(stmt, flags.MaxIterations)
+		}
 
 		f, err := childProc.Execute(stmt.Statements)
 		if err != nil {
 			return ERROR, err
 		}
 
-		if f == BREAK {
-			return TERMINATE, nil
+		if f == BREAK || f == CONTINUE {
+			if !proc.isLoopTarget(stmt.Label) {
+				return f, nil
+			}
+			if f == BREAK {
+				return TERMINATE, nil
+			}
 		}
 		if f == EXIT {
 			return EXIT, nil
 		}
+		if f == RETURN {
+			proc.ReturnVal = childProc.ReturnVal
+			return RETURN, nil
+		}
 	}
 
 	return TERMINATE, nil
 }
 
+func (proc *Procedure) enterLoop(label parser.Identifier) {
+	proc.loops.labels = append(proc.loops.labels, label.Literal)
+}
+
+func (proc *Procedure) exitLoop() {
+	proc.loops.labels = proc.loops.labels[:len(proc.loops.labels)-1]
+}
+
+// isLoopTarget reports whether the loop with the label receives the last BREAK or CONTINUE flow.
+// The flow without any label is received by the innermost loop.
+func (proc *Procedure) isLoopTarget(label parser.Identifier) bool {
+	if len(proc.loops.target) < 1 {
+		return true
+	}
+	if strings.EqualFold(proc.loops.target, label.Literal) {
+		proc.loops.target = ""
+		return true
+	}
+	return false
+}
+
 func (proc *Procedure) showExecutionTime() {
 	exectime := cmd.HumarizeNumber(fmt.Sprintf("%f", time.Since(proc.MeasurementStart).Seconds()))
 	stats := fmt.Sprintf("Query Execution Time: %s seconds", exectime)
@@ -6,6 +6,7 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
@@ -37,6 +38,22 @@ var procedureExecuteStatementTests = []struct {
 		},
 		Logs: TestDir + "\n",
 	},
+	{
+		Input: parser.Explain{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewIntegerValueFromString("1")},
+						},
+					},
+				},
+			},
+		},
+		Logs: "Result (materialized)\n" +
+			"  -> Select: 1\n" +
+			"       -> Dual\n",
+	},
 	{
 		Input: parser.VariableDeclaration{
 			Assignments: []parser.VariableAssignment{
@@ -390,6 +407,7 @@ var procedureExecuteStatementTests = []struct {
 					LineBreak: cmd.LF,
 				},
 				OperatedCount: 2,
+				Statement:     1,
 			},
 		},
 		Logs: fmt.Sprintf("2 records inserted on %q.\n", GetTestFilePath("table1.csv")),
@@ -424,6 +442,7 @@ var procedureExecuteStatementTests = []struct {
 					LineBreak: cmd.LF,
 				},
 				OperatedCount: 1,
+				Statement:     1,
 			},
 		},
 		Logs: fmt.Sprintf("1 record updated on %q.\n", GetTestFilePath("table1.csv")),
@@ -456,6 +475,7 @@ var procedureExecuteStatementTests = []struct {
 					LineBreak: cmd.LF,
 				},
 				OperatedCount: 1,
+				Statement:     1,
 			},
 		},
 		Logs: fmt.Sprintf("1 record deleted on %q.\n", GetTestFilePath("table1.csv")),
@@ -478,6 +498,7 @@ var procedureExecuteStatementTests = []struct {
 					Encoding:  cmd.UTF8,
 					LineBreak: cmd.LF,
 				},
+				Statement: 1,
 			},
 		},
 		Logs: fmt.Sprintf("file %q is created.\n", GetTestFilePath("newtable.csv")),
@@ -502,6 +523,7 @@ var procedureExecuteStatementTests = []struct {
 					LineBreak: cmd.LF,
 				},
 				OperatedCount: 1,
+				Statement:     1,
 			},
 		},
 		Logs: fmt.Sprintf("1 field added on %q.\n", GetTestFilePath("table1.csv")),
@@ -524,6 +546,7 @@ var procedureExecuteStatementTests = []struct {
 					LineBreak: cmd.LF,
 				},
 				OperatedCount: 1,
+				Statement:     1,
 			},
 		},
 		Logs: fmt.Sprintf("1 field dropped on %q.\n", GetTestFilePath("table1.csv")),
@@ -545,10 +568,31 @@ var procedureExecuteStatementTests = []struct {
 					LineBreak: cmd.LF,
 				},
 				OperatedCount: 1,
+				Statement:     1,
 			},
 		},
 		Logs: fmt.Sprintf("1 field renamed on %q.\n", GetTestFilePath("table1.csv")),
 	},
+	{
+		Input: parser.Deduplicate{
+			Table: parser.Identifier{Literal: "table1"},
+		},
+		Result: []Result{
+			{
+				Type: DEDUPLICATE,
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("table1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  cmd.UTF8,
+					LineBreak: cmd.LF,
+				},
+				OperatedCount: 0,
+				Statement:     1,
+			},
+		},
+		Logs: fmt.Sprintf("no duplicate record removed on %q.\n", GetTestFilePath("table1.csv")),
+	},
 	{
 		Input: parser.Case{
 			When: []parser.CaseWhen{
@@ -706,6 +750,8 @@ func TestProcedure_ExecuteStatement(t *testing.T) {
 					file.Close(r.FileInfo.File)
 					r.FileInfo.File = nil
 				}
+				r.FileInfo.ModTime = time.Time{}
+				r.FileInfo.Size = 0
 			}
 
 			if !reflect.DeepEqual(Results, v.Result) {
@@ -758,6 +804,49 @@ var procedureIfStmtTests = []struct {
 		ResultFlow: TERMINATE,
 		Result:     "",
 	},
+	{
+		Name: "If Statement Shadowing Variable",
+		Stmt: parser.If{
+			Condition: parser.NewTernaryValue(ternary.TRUE),
+			Statements: []parser.Statement{
+				parser.VariableDeclaration{
+					Assignments: []parser.VariableAssignment{
+						{
+							Variable: parser.Variable{Name: "@if_scope"},
+							Value:    parser.NewIntegerValue(1),
+						},
+					},
+				},
+				parser.If{
+					Condition: parser.NewTernaryValue(ternary.TRUE),
+					Statements: []parser.Statement{
+						parser.VariableDeclaration{
+							Assignments: []parser.VariableAssignment{
+								{
+									Variable: parser.Variable{Name: "@if_scope"},
+									Value:    parser.NewIntegerValue(2),
+								},
+							},
+						},
+						parser.Print{Value: parser.Variable{Name: "@if_scope"}},
+					},
+				},
+				parser.Print{Value: parser.Variable{Name: "@if_scope"}},
+			},
+		},
+		ResultFlow: TERMINATE,
+		Result:     "2\n1\n",
+	},
+	{
+		Name: "If Statement Variable Discarded on Exit",
+		Stmt: parser.If{
+			Condition: parser.NewTernaryValue(ternary.TRUE),
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.Variable{Name: "@if_scope"}},
+			},
+		},
+		Error: "[L:- C:-] variable @if_scope is undeclared",
+	},
 	{
 		Name: "If Statement Execute ElseIf",
 		Stmt: parser.If{
@@ -1043,11 +1132,12 @@ func TestProcedure_Case(t *testing.T) {
 }
 
 var procedureWhileTests = []struct {
-	Name       string
-	Stmt       parser.While
-	ResultFlow StatementFlow
-	Result     string
-	Error      string
+	Name          string
+	Stmt          parser.While
+	MaxIterations int
+	ResultFlow    StatementFlow
+	Result        string
+	Error         string
 }{
 	{
 		Name: "While Statement",
@@ -1245,10 +1335,30 @@ var procedureWhileTests = []struct {
 		},
 		Error: "[L:- C:-] field notexist does not exist",
 	},
+	{
+		Name: "While Statement Max Iterations Exceeded Error",
+		Stmt: parser.While{
+			BaseExpr:  parser.NewBaseExpr(parser.Token{Line: 1, Char: 1}),
+			Condition: parser.NewTernaryValueFromString("true"),
+			Statements: []parser.Statement{
+				parser.VariableSubstitution{
+					Variable: parser.Variable{Name: "@while_test"},
+					Value: parser.Arithmetic{
+						LHS:      parser.Variable{Name: "@while_test"},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: '+',
+					},
+				},
+			},
+		},
+		MaxIterations: 10,
+		Error:         "[L:1 C:1] loop exceeded the maximum number of iterations 10",
+	},
 }
 
 func TestProcedure_While(t *testing.T) {
 	cmd.SetQuiet(true)
+	flags := cmd.GetFlags()
 	proc := NewProcedure()
 
 	for _, v := range procedureWhileTests {
@@ -1261,6 +1371,7 @@ func TestProcedure_While(t *testing.T) {
 			proc.Filter.Variables[0].Add(parser.Variable{Name: "@while_test_count"}, value.NewInteger(0))
 		}
 		proc.Filter.Variables[0].Set(parser.Variable{Name: "@while_test_count"}, value.NewInteger(0))
+		flags.MaxIterations = v.MaxIterations
 
 		oldStdout := os.Stdout
 
@@ -1293,14 +1404,163 @@ func TestProcedure_While(t *testing.T) {
 			t.Errorf("%s: result = %q, want %q", v.Name, string(log), v.Result)
 		}
 	}
+	flags.MaxIterations = 0
+}
+
+var procedureLoopTests = []struct {
+	Name          string
+	Stmt          parser.Loop
+	MaxIterations int
+	ResultFlow    StatementFlow
+	Result        string
+	Error         string
+}{
+	{
+		Name: "Loop Statement Break",
+		Stmt: parser.Loop{
+			Statements: []parser.Statement{
+				parser.VariableSubstitution{
+					Variable: parser.Variable{Name: "@while_test_count"},
+					Value: parser.Arithmetic{
+						LHS:      parser.Variable{Name: "@while_test_count"},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: '+',
+					},
+				},
+				parser.If{
+					Condition: parser.Comparison{
+						LHS:      parser.Variable{Name: "@while_test_count"},
+						RHS:      parser.NewIntegerValueFromString("3"),
+						Operator: "=",
+					},
+					Statements: []parser.Statement{
+						parser.FlowControl{Token: parser.BREAK},
+					},
+				},
+				parser.Print{Value: parser.Variable{Name: "@while_test_count"}},
+			},
+		},
+		ResultFlow: TERMINATE,
+		Result:     "1\n2\n",
+	},
+	{
+		Name: "Loop Statement Labeled Break and Continue",
+		Stmt: parser.Loop{
+			Label: parser.Identifier{Literal: "lbl"},
+			Statements: []parser.Statement{
+				parser.VariableSubstitution{
+					Variable: parser.Variable{Name: "@while_test_count"},
+					Value: parser.Arithmetic{
+						LHS:      parser.Variable{Name: "@while_test_count"},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: '+',
+					},
+				},
+				parser.While{
+					Condition: parser.NewTernaryValueFromString("true"),
+					Statements: []parser.Statement{
+						parser.If{
+							Condition: parser.Comparison{
+								LHS:      parser.Variable{Name: "@while_test_count"},
+								RHS:      parser.NewIntegerValueFromString("3"),
+								Operator: "=",
+							},
+							Statements: []parser.Statement{
+								parser.FlowControl{Token: parser.BREAK, Label: parser.Identifier{Literal: "lbl"}},
+							},
+						},
+						parser.Print{Value: parser.Variable{Name: "@while_test_count"}},
+						parser.FlowControl{Token: parser.CONTINUE, Label: parser.Identifier{Literal: "LBL"}},
+					},
+				},
+				parser.Print{Value: parser.NewStringValue("not reached")},
+			},
+		},
+		ResultFlow: TERMINATE,
+		Result:     "1\n2\n",
+	},
+	{
+		Name: "Loop Statement Undefined Label Error",
+		Stmt: parser.Loop{
+			Label: parser.Identifier{Literal: "lbl"},
+			Statements: []parser.Statement{
+				parser.FlowControl{Token: parser.BREAK, Label: parser.Identifier{Literal: "notexist"}},
+			},
+		},
+		Error: "[L:- C:-] loop label notexist is undefined",
+	},
+	{
+		Name: "Loop Statement Max Iterations Exceeded Error",
+		Stmt: parser.Loop{
+			Statements: []parser.Statement{
+				parser.VariableSubstitution{
+					Variable: parser.Variable{Name: "@while_test_count"},
+					Value: parser.Arithmetic{
+						LHS:      parser.Variable{Name: "@while_test_count"},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: '+',
+					},
+				},
+			},
+		},
+		MaxIterations: 10,
+		Error:         "[L:- C:-] loop exceeded the maximum number of iterations 10",
+	},
+}
+
+func TestProcedure_Loop(t *testing.T) {
+	cmd.SetQuiet(true)
+	flags := cmd.GetFlags()
+	proc := NewProcedure()
+
+	for _, v := range procedureLoopTests {
+		if _, err := proc.Filter.Variables[0].Get(parser.Variable{Name: "@while_test_count"}); err != nil {
+			proc.Filter.Variables[0].Add(parser.Variable{Name: "@while_test_count"}, value.NewInteger(0))
+		}
+		proc.Filter.Variables[0].Set(parser.Variable{Name: "@while_test_count"}, value.NewInteger(0))
+		flags.MaxIterations = v.MaxIterations
+
+		oldStdout := os.Stdout
+
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		flow, err := proc.Loop(v.Stmt)
+
+		w.Close()
+		os.Stdout = oldStdout
+
+		log, _ := ioutil.ReadAll(r)
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if flow != v.ResultFlow {
+			t.Errorf("%s: result flow = %q, want %q", v.Name, flow, v.ResultFlow)
+		}
+		if string(log) != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, string(log), v.Result)
+		}
+	}
+	flags.MaxIterations = 0
 }
 
 var procedureWhileInCursorTests = []struct {
-	Name       string
-	Stmt       parser.WhileInCursor
-	ResultFlow StatementFlow
-	Result     string
-	Error      string
+	Name          string
+	Stmt          parser.WhileInCursor
+	MaxIterations int
+	ResultFlow    StatementFlow
+	Result        string
+	Error         string
 }{
 	{
 		Name: "While In Cursor",
@@ -1453,6 +1713,22 @@ var procedureWhileInCursorTests = []struct {
 		},
 		Error: "[L:- C:-] field notexist does not exist",
 	},
+	{
+		Name: "While In Cursor Max Iterations Exceeded Error",
+		Stmt: parser.WhileInCursor{
+			BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 1}),
+			Variables: []parser.Variable{
+				{Name: "@var1"},
+				{Name: "@var2"},
+			},
+			Cursor: parser.Identifier{Literal: "cur"},
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.Variable{Name: "@var1"}},
+			},
+		},
+		MaxIterations: 2,
+		Error:         "[L:1 C:1] loop exceeded the maximum number of iterations 2",
+	},
 }
 
 func TestProcedure_WhileInCursor(t *testing.T) {
@@ -1473,6 +1749,7 @@ func TestProcedure_WhileInCursor(t *testing.T) {
 		}
 		ViewCache.Clean()
 		proc.Filter.Cursors.Open(parser.Identifier{Literal: "cur"}, proc.Filter)
+		tf.MaxIterations = v.MaxIterations
 
 		oldStdout := os.Stdout
 		r, w, _ := os.Pipe()
@@ -1504,4 +1781,5 @@ func TestProcedure_WhileInCursor(t *testing.T) {
 			t.Errorf("%s: result = %q, want %q", v.Name, string(log), v.Result)
 		}
 	}
+	tf.MaxIterations = 0
 }
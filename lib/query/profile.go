@@ -0,0 +1,120 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+type profilePhase int
+
+const (
+	PROFILE_LOADING profilePhase = iota
+	PROFILE_FILTERING
+	PROFILE_JOINING
+	PROFILE_GROUPING
+	PROFILE_ANALYTIC
+	PROFILE_SORTING
+	PROFILE_WRITING
+)
+
+var profilePhaseNames = []string{
+	"Loading",
+	"Filtering",
+	"Joining",
+	"Grouping",
+	"Analytic",
+	"Sorting",
+	"Writing",
+}
+
+// statementProfile accumulates the wall time spent in each phase of a statement.
+// Phases entered again before they are left, as in subqueries or parallel evaluation, are measured once.
+type statementProfile struct {
+	mtx     *sync.Mutex
+	start   time.Time
+	depth   []int
+	since   []time.Time
+	elapsed []time.Duration
+}
+
+// Profile of the running statement. Nil unless the --profile option is specified.
+var profile *statementProfile
+
+func startProfile() bool {
+	if profile != nil || !cmd.GetFlags().Profile {
+		return false
+	}
+
+	profile = &statementProfile{
+		mtx:     &sync.Mutex{},
+		start:   time.Now(),
+		depth:   make([]int, len(profilePhaseNames)),
+		since:   make([]time.Time, len(profilePhaseNames)),
+		elapsed: make([]time.Duration, len(profilePhaseNames)),
+	}
+	return true
+}
+
+func finishProfile(stmt parser.Statement) {
+	p := profile
+	profile = nil
+	cmd.ToStderr(p.String(stmt, time.Since(p.start)))
+}
+
+func enterPhase(phase profilePhase) {
+	if profile == nil {
+		return
+	}
+
+	profile.mtx.Lock()
+	if profile.depth[phase] == 0 {
+		profile.since[phase] = time.Now()
+	}
+	profile.depth[phase]++
+	profile.mtx.Unlock()
+}
+
+func leavePhase(phase profilePhase) {
+	if profile == nil {
+		return
+	}
+
+	profile.mtx.Lock()
+	profile.depth[phase]--
+	if profile.depth[phase] == 0 {
+		profile.elapsed[phase] += time.Since(profile.since[phase])
+	}
+	profile.mtx.Unlock()
+}
+
+func (p *statementProfile) String(stmt parser.Statement, total time.Duration) string {
+	seconds := func(d time.Duration) string {
+		return cmd.HumarizeNumber(fmt.Sprintf("%f", d.Seconds()))
+	}
+
+	values := make([]string, len(p.elapsed))
+	width := len(seconds(total))
+	for i, d := range p.elapsed {
+		values[i] = seconds(d)
+		if width < len(values[i]) {
+			width = len(values[i])
+		}
+	}
+	w := strconv.Itoa(width)
+
+	s := "Profile"
+	if e, ok := stmt.(parser.Expression); ok && e.HasParseInfo() {
+		s += fmt.Sprintf(" [L:%d C:%d]", e.Line(), e.Char())
+	}
+	s += "\n"
+	for i, name := range profilePhaseNames {
+		s += fmt.Sprintf("%10s: %"+w+"s seconds\n", name, values[i])
+	}
+	s += fmt.Sprintf("%10s: %"+w+"s seconds\n", "Total", seconds(total))
+	return s
+}
@@ -0,0 +1,51 @@
+package query
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+func TestStartProfile(t *testing.T) {
+	initFlag()
+	defer func() { profile = nil }()
+
+	if startProfile() {
+		t.Errorf("profile is started without the profile flag")
+	}
+
+	cmd.GetFlags().Profile = true
+	defer func() { cmd.GetFlags().Profile = false }()
+
+	if !startProfile() {
+		t.Fatalf("profile is not started")
+	}
+	if startProfile() {
+		t.Errorf("profile is started in the running profile")
+	}
+
+	enterPhase(PROFILE_SORTING)
+	enterPhase(PROFILE_SORTING)
+	time.Sleep(time.Millisecond)
+	leavePhase(PROFILE_SORTING)
+	if profile.elapsed[PROFILE_SORTING] != 0 {
+		t.Errorf("elapsed time is added before the outermost phase is left")
+	}
+	leavePhase(PROFILE_SORTING)
+	if profile.elapsed[PROFILE_SORTING] < time.Millisecond {
+		t.Errorf("elapsed time = %s, want at least %s", profile.elapsed[PROFILE_SORTING], time.Millisecond)
+	}
+	if profile.elapsed[PROFILE_LOADING] != 0 {
+		t.Errorf("elapsed time is added to the phase that is not entered")
+	}
+
+	s := profile.String(parser.SelectQuery{}, time.Second)
+	for _, name := range append(profilePhaseNames, "Total") {
+		if !strings.Contains(s, name+": ") {
+			t.Errorf("profile %q does not contain the phase %s", s, name)
+		}
+	}
+}
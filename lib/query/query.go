@@ -1,8 +1,12 @@
 package query
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
+	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -22,6 +26,12 @@ const (
 	RETURN
 )
 
+const (
+	DiffAdded   = "added"
+	DiffRemoved = "removed"
+	DiffChanged = "changed"
+)
+
 type OperationType int
 
 const (
@@ -32,23 +42,104 @@ const (
 	ADD_COLUMNS
 	DROP_COLUMNS
 	RENAME_COLUMN
+	DEDUPLICATE
+	DROP_TABLE
+	RENAME_TABLE
 )
 
+var operationTypeLiterals = map[OperationType]string{
+	INSERT:        "INSERT",
+	UPDATE:        "UPDATE",
+	DELETE:        "DELETE",
+	CREATE_TABLE:  "CREATE TABLE",
+	ADD_COLUMNS:   "ADD COLUMNS",
+	DROP_COLUMNS:  "DROP COLUMNS",
+	RENAME_COLUMN: "RENAME COLUMN",
+	DEDUPLICATE:   "DEDUPLICATE",
+	DROP_TABLE:    "DROP TABLE",
+	RENAME_TABLE:  "RENAME TABLE",
+}
+
+func (t OperationType) String() string {
+	return operationTypeLiterals[t]
+}
+
 type Result struct {
 	Type          OperationType
 	FileInfo      *FileInfo
 	OperatedCount int
+
+	// Path of the file before it is renamed by a RENAME TABLE statement.
+	OldPath string
+
+	// Sequential number of the statement in the transaction. Results of the same statement have the same number.
+	Statement int
+}
+
+type resultSummary struct {
+	Statement int    `json:"statement"`
+	File      string `json:"file"`
+	Affected  int    `json:"affected"`
+	Type      string `json:"type"`
+	From      string `json:"from,omitempty"`
+}
+
+// EncodeResultSummary returns the results as JSON objects, one per line.
+func EncodeResultSummary(results []Result) string {
+	lines := make([]string, 0, len(results))
+	for _, result := range results {
+		summary := resultSummary{
+			Statement: result.Statement,
+			Affected:  result.OperatedCount,
+			Type:      result.Type.String(),
+			From:      result.OldPath,
+		}
+		if result.FileInfo != nil {
+			summary.File = result.FileInfo.Path
+		}
+		b, _ := json.Marshal(summary)
+		lines = append(lines, string(b))
+	}
+	return strings.Join(lines, "\n")
+}
+
+type Savepoint struct {
+	Name      string
+	ResultLen int
+	Views     ViewMap
+	TempViews TemporaryViewScopes
 }
 
 var ViewCache = ViewMap{}
+var Schemas = SchemaMap{}
 var Results = []Result{}
+var Savepoints = []Savepoint{}
+var BackupFiles = map[string]bool{}
+var committedBackupFiles = map[string]bool{}
 var SelectLogs = []string{}
+var Warnings = NewWarningList()
 
 func ReleaseResources() {
 	ViewCache.Clean()
 	file.UnlockAll()
 }
 
+// Creates a backup of the file once in a transaction.
+// Backup files created by the process in committed transactions are overwritten,
+// and the other existing files are not.
+// Backup files newly created in the current transaction are removed by rollback.
+func BackupFile(fp *os.File, path string) error {
+	if _, ok := BackupFiles[path]; ok {
+		return nil
+	}
+	overwrite := committedBackupFiles[path]
+	if err := file.Backup(fp, path, overwrite); err != nil {
+		return err
+	}
+	BackupFiles[path] = !overwrite
+	return nil
+}
+
 func Log(log string, quiet bool) {
 	if !quiet {
 		cmd.ToStdout(log + "\n")
@@ -59,12 +150,17 @@ func AddSelectLog(log string) {
 	SelectLogs = append(SelectLogs, log)
 }
 
-func ReadSelectLog() string {
+func ReadSelectLog() (string, error) {
 	if len(SelectLogs) < 1 {
-		return ""
+		return "", nil
+	}
+	flags := cmd.GetFlags()
+	lb := flags.LineBreak
+	log := strings.Join(SelectLogs, lb.Value()) + lb.Value()
+	if flags.WriteEncoding != cmd.UTF8 {
+		return encodeCharacterCode(log, flags.WriteEncoding)
 	}
-	lb := cmd.GetFlags().LineBreak
-	return strings.Join(SelectLogs, lb.Value()) + lb.Value()
+	return log, nil
 }
 
 func FetchCursor(name parser.Identifier, fetchPosition parser.FetchPosition, vars []parser.Variable, filter *Filter) (bool, error) {
@@ -105,6 +201,42 @@ func FetchCursor(name parser.Identifier, fetchPosition parser.FetchPosition, var
 	return true, nil
 }
 
+// SelectInto assigns the values of the record returned by the select query to the variables.
+// If the query returns no record, then nulls are assigned unless the strict-select-into flag is set.
+func SelectInto(expr parser.SelectInto, filter *Filter) error {
+	view, err := Select(expr.Query, filter)
+	if err != nil {
+		return err
+	}
+	if view.FieldLen() != len(expr.Variables) {
+		return NewSelectIntoFieldLengthError(expr)
+	}
+
+	primaries := make([]value.Primary, len(expr.Variables))
+	switch view.RecordLen() {
+	case 0:
+		if cmd.GetFlags().StrictSelectInto {
+			return NewSelectIntoNoRecordError(expr)
+		}
+		for i := range primaries {
+			primaries[i] = value.NewNull()
+		}
+	case 1:
+		for i, cell := range view.RecordSet[0] {
+			primaries[i] = cell.Value()
+		}
+	default:
+		return NewSelectIntoTooManyRecordsError(expr)
+	}
+
+	for i, v := range expr.Variables {
+		if _, err := filter.Variables.SubstituteDirectly(v, primaries[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func DeclareView(expr parser.ViewDeclaration, filter *Filter) error {
 	if filter.TempViews.Exists(expr.View.Literal) {
 		return NewTemporaryTableRedeclaredError(expr.View)
@@ -153,8 +285,49 @@ func DeclareView(expr parser.ViewDeclaration, filter *Filter) error {
 	return err
 }
 
+func DeclareSchema(expr parser.SchemaDeclaration) error {
+	fileInfo, err := NewFileInfo(expr.Table, cmd.GetFlags().Repository, cmd.UNDEF)
+	if err != nil {
+		return err
+	}
+
+	schema := &Schema{
+		Fields: make([]string, len(expr.Fields)),
+		Types:  make([]string, len(expr.Fields)),
+	}
+	for i, f := range expr.Fields {
+		if InStrSliceWithCaseInsensitive(f.Column.Literal, schema.Fields[:i]) {
+			return NewDuplicateFieldNameError(f.Column)
+		}
+		t := strings.ToUpper(f.Type.Literal)
+		if _, ok := schemaTypeConverters[t]; !ok {
+			return NewInvalidSchemaTypeError(f.Type)
+		}
+		schema.Fields[i] = f.Column.Literal
+		schema.Types[i] = t
+	}
+
+	Schemas.Set(fileInfo.Path, schema)
+	return nil
+}
+
 func Select(query parser.SelectQuery, parentFilter *Filter) (*View, error) {
+	return selectQuery(query, parentFilter.CreateNode())
+}
+
+// In a scalar subquery, aggregate functions without a GROUP BY clause return one record even for an empty set.
+func selectScalarSubquery(query parser.SelectQuery, parentFilter *Filter) (*View, error) {
 	filter := parentFilter.CreateNode()
+	filter.aggregatesEmptySet = true
+	return selectQuery(query, filter)
+}
+
+func selectQuery(query parser.SelectQuery, filter *Filter) (*View, error) {
+	if query.ForUpdate && cmd.GetFlags().ReadOnly {
+		return nil, NewReadOnlyError(query, "SELECT FOR UPDATE")
+	}
+
+	filter.referencedColumns = referencedColumns(query)
 
 	if query.WithClause != nil {
 		if err := filter.LoadInlineTable(query.WithClause.(parser.WithClause)); err != nil {
@@ -162,7 +335,7 @@ func Select(query parser.SelectQuery, parentFilter *Filter) (*View, error) {
 		}
 	}
 
-	view, err := selectEntity(query.SelectEntity, filter)
+	view, err := selectEntity(query.SelectEntity, filter, query.ForUpdate)
 	if err != nil {
 		return nil, err
 	}
@@ -185,34 +358,62 @@ func Select(query parser.SelectQuery, parentFilter *Filter) (*View, error) {
 		}
 	}
 
+	if err := checkResultRows(selectQueryExpr(query), view.RecordLen()); err != nil {
+		return nil, err
+	}
+
 	view.Fix()
 
 	return view, nil
 }
 
-func selectEntity(expr parser.QueryExpression, filter *Filter) (*View, error) {
+// selectQueryExpr returns the expression that carries the position of a select query.
+// The position of a SelectQuery is set only when it has a FOR UPDATE clause,
+// so the SELECT clause is used for a single select entity.
+func selectQueryExpr(query parser.SelectQuery) parser.QueryExpression {
+	if entity, ok := query.SelectEntity.(parser.SelectEntity); ok && entity.SelectClause != nil {
+		if clause := entity.SelectClause.(parser.SelectClause); clause.HasParseInfo() {
+			return clause
+		}
+	}
+	return query
+}
+
+func selectEntity(expr parser.QueryExpression, filter *Filter, forUpdate bool) (*View, error) {
 	entity, ok := expr.(parser.SelectEntity)
 	if !ok {
-		return selectSet(expr.(parser.SelectSet), filter)
+		return selectSet(expr.(parser.SelectSet), filter, forUpdate)
 	}
 
 	if entity.FromClause == nil {
 		entity.FromClause = parser.FromClause{}
 	}
 	view := NewView()
+	view.ForUpdate = forUpdate
+	var condition *loadCondition
+	if !forUpdate {
+		condition = newLoadCondition(entity, filter)
+		filter.loadCondition = condition
+	}
 	err := view.Load(entity.FromClause.(parser.FromClause), filter)
+	filter.loadCondition = nil
 	if err != nil {
 		return nil, err
 	}
 
-	if entity.WhereClause != nil {
+	// The where clause is not evaluated again if the records have been filtered while the file was loaded.
+	if entity.WhereClause != nil && (condition == nil || !condition.applied) {
 		if err := view.Where(entity.WhereClause.(parser.WhereClause)); err != nil {
 			return nil, err
 		}
 	}
 
 	if entity.GroupByClause != nil {
-		if err := view.GroupBy(entity.GroupByClause.(parser.GroupByClause)); err != nil {
+		groupBy := entity.GroupByClause.(parser.GroupByClause)
+		if groupBy.Items, err = view.replaceFieldPositions(groupBy.Items, entity.SelectClause.(parser.SelectClause)); err != nil {
+			return nil, err
+		}
+		if err := view.GroupBy(groupBy); err != nil {
 			return nil, err
 		}
 	}
@@ -230,12 +431,12 @@ func selectEntity(expr parser.QueryExpression, filter *Filter) (*View, error) {
 	return view, nil
 }
 
-func selectSetEntity(expr parser.QueryExpression, filter *Filter) (*View, error) {
+func selectSetEntity(expr parser.QueryExpression, filter *Filter, forUpdate bool) (*View, error) {
 	if subquery, ok := expr.(parser.Subquery); ok {
 		return Select(subquery.Query, filter)
 	}
 
-	view, err := selectEntity(expr, filter)
+	view, err := selectEntity(expr, filter, forUpdate)
 	if err != nil {
 		return nil, err
 	}
@@ -243,20 +444,20 @@ func selectSetEntity(expr parser.QueryExpression, filter *Filter) (*View, error)
 	return view, nil
 }
 
-func selectSet(set parser.SelectSet, filter *Filter) (*View, error) {
-	lview, err := selectSetEntity(set.LHS, filter)
+func selectSet(set parser.SelectSet, filter *Filter, forUpdate bool) (*View, error) {
+	lview, err := selectSetEntity(set.LHS, filter, forUpdate)
 	if err != nil {
 		return nil, err
 	}
 
 	if filter.RecursiveTable != nil {
 		filter.RecursiveTmpView = nil
-		err := selectSetForRecursion(lview, set, filter)
+		err := selectSetForRecursion(lview, set, filter, forUpdate)
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		rview, err := selectSetEntity(set.RHS, filter)
+		rview, err := selectSetEntity(set.RHS, filter, forUpdate)
 		if err != nil {
 			return nil, err
 		}
@@ -264,6 +465,9 @@ func selectSet(set parser.SelectSet, filter *Filter) (*View, error) {
 		if lview.FieldLen() != rview.FieldLen() {
 			return nil, NewCombinedSetFieldLengthError(set.RHS, lview.FieldLen())
 		}
+		if err := checkCombinedSetFieldTypes(set, lview, rview); err != nil {
+			return nil, err
+		}
 
 		switch set.Operator.Token {
 		case parser.UNION:
@@ -273,6 +477,10 @@ func selectSet(set parser.SelectSet, filter *Filter) (*View, error) {
 		case parser.INTERSECT:
 			lview.Intersect(rview, !set.All.IsEmpty())
 		}
+
+		if err := checkResultRows(set, lview.RecordLen()); err != nil {
+			return nil, err
+		}
 	}
 
 	lview.SelectAllColumns()
@@ -280,7 +488,7 @@ func selectSet(set parser.SelectSet, filter *Filter) (*View, error) {
 	return lview, nil
 }
 
-func selectSetForRecursion(view *View, set parser.SelectSet, filter *Filter) error {
+func selectSetForRecursion(view *View, set parser.SelectSet, filter *Filter, forUpdate bool) error {
 	tmpViewName := strings.ToUpper(filter.RecursiveTable.Name.Literal)
 
 	if filter.RecursiveTmpView == nil {
@@ -291,13 +499,16 @@ func selectSetForRecursion(view *View, set parser.SelectSet, filter *Filter) err
 		filter.RecursiveTmpView = view
 	}
 
-	rview, err := selectSetEntity(set.RHS, filter.CreateNode())
+	rview, err := selectSetEntity(set.RHS, filter.CreateNode(), forUpdate)
 	if err != nil {
 		return err
 	}
 	if view.FieldLen() != rview.FieldLen() {
 		return NewCombinedSetFieldLengthError(set.RHS, view.FieldLen())
 	}
+	if err := checkCombinedSetFieldTypes(set, view, rview); err != nil {
+		return err
+	}
 
 	if rview.RecordLen() < 1 {
 		return nil
@@ -314,7 +525,208 @@ func selectSetForRecursion(view *View, set parser.SelectSet, filter *Filter) err
 		view.Intersect(rview, !set.All.IsEmpty())
 	}
 
-	return selectSetForRecursion(view, set, filter)
+	if err := checkResultRows(set, view.RecordLen()); err != nil {
+		return err
+	}
+
+	return selectSetForRecursion(view, set, filter, forUpdate)
+}
+
+func checkCombinedSetFieldTypes(set parser.SelectSet, lview *View, rview *View) error {
+	if !cmd.GetFlags().StrictUnionTypes {
+		return nil
+	}
+
+	for i := 0; i < lview.FieldLen(); i++ {
+		ltype := inferredTypeOfColumn(lview.RecordSet, i)
+		rtype := inferredTypeOfColumn(rview.RecordSet, i)
+		if !isCompatibleInferredType(ltype, rtype) {
+			return NewCombinedSetFieldTypeError(set.RHS, lview.Header[i].Column, ltype.String(), rtype.String())
+		}
+	}
+	return nil
+}
+
+func Diff(query parser.Diff, parentFilter *Filter) (*View, error) {
+	filter := parentFilter.CreateNode()
+
+	view := NewView()
+	if err := view.LoadFromTableIdentifier(query.Table, filter.CreateNode()); err != nil {
+		return nil, err
+	}
+	against := NewView()
+	if err := against.LoadFromTableIdentifier(query.Against, filter.CreateNode()); err != nil {
+		return nil, err
+	}
+
+	keys := make([]parser.QueryExpression, len(query.Keys))
+	for i, v := range query.Keys {
+		ident := v.(parser.Identifier)
+		keys[i] = parser.FieldReference{BaseExpr: ident.BaseExpr, Column: ident}
+	}
+	keyIndices, err := view.FieldIndices(keys)
+	if err != nil {
+		return nil, err
+	}
+	againstKeyIndices, err := against.FieldIndices(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := make([]int, 0, view.FieldLen())
+	againstIndices := make([]int, 0, view.FieldLen())
+	for i, f := range view.Header {
+		if !f.IsFromTable || InIntSlice(i, keyIndices) {
+			continue
+		}
+		idx, err := against.FieldIndex(parser.FieldReference{Column: parser.Identifier{Literal: f.Column}})
+		if err != nil {
+			Warnings.Add(query.Table, fmt.Sprintf(WARNING_DIFF_COLUMN_IGNORED, f.Column, query.Table, query.Against))
+			continue
+		}
+		if InIntSlice(idx, againstKeyIndices) {
+			continue
+		}
+		indices = append(indices, i)
+		againstIndices = append(againstIndices, idx)
+	}
+	for i, f := range against.Header {
+		if !f.IsFromTable || InIntSlice(i, againstKeyIndices) || InIntSlice(i, againstIndices) {
+			continue
+		}
+		if _, err := view.FieldIndex(parser.FieldReference{Column: parser.Identifier{Literal: f.Column}}); err != nil {
+			Warnings.Add(query.Against, fmt.Sprintf(WARNING_DIFF_COLUMN_IGNORED, f.Column, query.Against, query.Table))
+		}
+	}
+
+	header := NewEmptyHeader(1 + len(keyIndices) + len(indices)*2)
+	header[0].Column = "status"
+	for i, idx := range keyIndices {
+		header[1+i] = HeaderField{View: view.Header[idx].View, Column: view.Header[idx].Column}
+	}
+	for i := range indices {
+		f := view.Header[indices[i]]
+		header[1+len(keyIndices)+i*2] = HeaderField{View: f.View, Column: f.View + "." + f.Column}
+		f = against.Header[againstIndices[i]]
+		header[1+len(keyIndices)+i*2+1] = HeaderField{View: f.View, Column: f.View + "." + f.Column}
+	}
+
+	againstKeys := make(map[string]int, against.RecordLen())
+	for i, record := range against.RecordSet {
+		key := SerializeComparisonKeys(recordValues(record, againstKeyIndices))
+		if _, ok := againstKeys[key]; ok {
+			return nil, NewDiffDuplicateKeyError(query.Against)
+		}
+		againstKeys[key] = i
+	}
+
+	newRecord := func(status string, keyValues []value.Primary, values []value.Primary, againstValues []value.Primary) Record {
+		record := make(Record, 0, header.Len())
+		record = append(record, NewCell(value.NewString(status)))
+		for _, v := range keyValues {
+			record = append(record, NewCell(v))
+		}
+		for i := range indices {
+			v := value.Primary(value.NewNull())
+			if values != nil {
+				v = values[i]
+			}
+			av := value.Primary(value.NewNull())
+			if againstValues != nil {
+				av = againstValues[i]
+			}
+			record = append(record, NewCell(v), NewCell(av))
+		}
+		return record
+	}
+
+	records := make(RecordSet, 0, view.RecordLen()+against.RecordLen())
+	keySet := make(map[string]bool, view.RecordLen())
+	matched := make([]bool, against.RecordLen())
+	for _, record := range view.RecordSet {
+		keyValues := recordValues(record, keyIndices)
+		key := SerializeComparisonKeys(keyValues)
+		if keySet[key] {
+			return nil, NewDiffDuplicateKeyError(query.Table)
+		}
+		keySet[key] = true
+
+		values := recordValues(record, indices)
+		i, ok := againstKeys[key]
+		if !ok {
+			records = append(records, newRecord(DiffRemoved, keyValues, values, nil))
+			continue
+		}
+		matched[i] = true
+
+		againstValues := recordValues(against.RecordSet[i], againstIndices)
+		if !identicalValues(values, againstValues) {
+			records = append(records, newRecord(DiffChanged, keyValues, values, againstValues))
+		}
+	}
+	for i, record := range against.RecordSet {
+		if !matched[i] {
+			records = append(records, newRecord(DiffAdded, recordValues(record, againstKeyIndices), nil, recordValues(record, againstIndices)))
+		}
+	}
+
+	result := NewView()
+	result.Header = header
+	result.RecordSet = records
+	return result, nil
+}
+
+// identicalValues reports whether the values have the same types and the same representations.
+func identicalValues(values1 []value.Primary, values2 []value.Primary) bool {
+	for i := range values1 {
+		if reflect.TypeOf(values1[i]) != reflect.TypeOf(values2[i]) || values1[i].String() != values2[i].String() {
+			return false
+		}
+	}
+	return true
+}
+
+func recordValues(record Record, indices []int) []value.Primary {
+	values := make([]value.Primary, len(indices))
+	for i, idx := range indices {
+		values[i] = record[idx].Value()
+	}
+	return values
+}
+
+// fileTable returns the object of the first table in the tables that is loaded from a file.
+// Temporary views, inline tables and the standard input are not written to files, so they can be
+// modified in read-only mode.
+func fileTable(tables []parser.QueryExpression, filter *Filter) parser.QueryExpression {
+	for _, table := range tables {
+		if obj := fileTableObject(table, filter); obj != nil {
+			return obj
+		}
+	}
+	return nil
+}
+
+func fileTableObject(expr parser.QueryExpression, filter *Filter) parser.QueryExpression {
+	switch e := expr.(type) {
+	case parser.Parentheses:
+		return fileTableObject(e.Expr, filter)
+	case parser.Table:
+		return fileTableObject(e.Object, filter)
+	case parser.Join:
+		if obj := fileTableObject(e.Table, filter); obj != nil {
+			return obj
+		}
+		return fileTableObject(e.JoinTable, filter)
+	case parser.Identifier:
+		if filter.TempViews.Exists(e.Literal) {
+			return nil
+		}
+		if _, err := filter.InlineTables.Get(e); err == nil {
+			return nil
+		}
+		return e
+	}
+	return nil
 }
 
 func Insert(query parser.InsertQuery, parentFilter *Filter) (*View, error) {
@@ -326,6 +738,12 @@ func Insert(query parser.InsertQuery, parentFilter *Filter) (*View, error) {
 		}
 	}
 
+	if cmd.GetFlags().ReadOnly {
+		if obj := fileTable([]parser.QueryExpression{query.Table}, filter); obj != nil {
+			return nil, NewReadOnlyError(obj, "INSERT")
+		}
+	}
+
 	fromClause := parser.FromClause{
 		Tables: []parser.QueryExpression{
 			query.Table,
@@ -378,6 +796,12 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*View, error) {
 		query.FromClause = parser.FromClause{Tables: query.Tables}
 	}
 
+	if cmd.GetFlags().ReadOnly {
+		if obj := fileTable(query.FromClause.(parser.FromClause).Tables, filter); obj != nil {
+			return nil, NewReadOnlyError(obj, "UPDATE")
+		}
+	}
+
 	view := NewView()
 	view.ForUpdate = true
 	view.UseInternalId = true
@@ -393,6 +817,9 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*View, error) {
 	}
 
 	viewsToUpdate := make(map[string]*View)
+	fileKeys := make(map[string]string)
+	fileViews := make(map[string]*View)
+	fileOrder := make([]string, 0, len(query.Tables))
 	updatedCount := make(map[string]int)
 	for _, v := range query.Tables {
 		table := v.(parser.Table)
@@ -401,6 +828,7 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*View, error) {
 			return nil, err
 		}
 		viewKey := strings.ToUpper(table.Name().Literal)
+		fileKey := strings.ToUpper(fpath)
 
 		if filter.TempViews.Exists(fpath) {
 			viewsToUpdate[viewKey], _ = filter.TempViews.Get(parser.Identifier{Literal: fpath})
@@ -408,6 +836,15 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*View, error) {
 			viewsToUpdate[viewKey], _ = ViewCache.Get(parser.Identifier{Literal: fpath})
 		}
 		viewsToUpdate[viewKey].Header.Update(table.Name().Literal, nil)
+
+		// Tables referring to the same file share the records, so that the updates are written back together.
+		if fview, ok := fileViews[fileKey]; ok {
+			viewsToUpdate[viewKey].RecordSet = fview.RecordSet
+		} else {
+			fileViews[fileKey] = viewsToUpdate[viewKey]
+			fileOrder = append(fileOrder, fileKey)
+		}
+		fileKeys[viewKey] = fileKey
 	}
 
 	updatesList := make(map[string]map[int][]int)
@@ -422,11 +859,14 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*View, error) {
 				return nil, err
 			}
 
-			viewref, err := view.FieldViewName(uset.Field)
+			idx, err := view.FieldIndex(uset.Field)
 			if err != nil {
 				return nil, err
 			}
-			viewref = strings.ToUpper(viewref)
+			if view.Header[idx].IsComputed {
+				return nil, NewUpdateComputedFieldError(uset.Field)
+			}
+			viewref := strings.ToUpper(view.Header[idx].View)
 
 			if _, ok := viewsToUpdate[viewref]; !ok {
 				return nil, NewUpdateFieldNotExistError(uset.Field)
@@ -446,23 +886,25 @@ func Update(query parser.UpdateQuery, parentFilter *Filter) ([]*View, error) {
 			}
 
 			fieldIdx, _ := viewsToUpdate[viewref].FieldIndex(uset.Field)
-			if _, ok := updatesList[viewref]; !ok {
-				updatesList[viewref] = make(map[int][]int)
+			fileKey := fileKeys[viewref]
+			if _, ok := updatesList[fileKey]; !ok {
+				updatesList[fileKey] = make(map[int][]int)
 			}
-			if _, ok := updatesList[viewref][internalId]; !ok {
-				updatesList[viewref][internalId] = []int{}
-				updatedCount[viewref]++
+			if _, ok := updatesList[fileKey][internalId]; !ok {
+				updatesList[fileKey][internalId] = []int{}
+				updatedCount[fileKey]++
 			}
-			if InIntSlice(fieldIdx, updatesList[viewref][internalId]) {
+			if InIntSlice(fieldIdx, updatesList[fileKey][internalId]) {
 				return nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
 			}
-			updatesList[viewref][internalId] = append(updatesList[viewref][internalId], fieldIdx)
+			updatesList[fileKey][internalId] = append(updatesList[fileKey][internalId], fieldIdx)
 			viewsToUpdate[viewref].RecordSet[internalId][fieldIdx] = NewCell(val)
 		}
 	}
 
 	views := []*View{}
-	for k, v := range viewsToUpdate {
+	for _, k := range fileOrder {
+		v := fileViews[k]
 		v.RestoreHeaderReferences()
 		v.OperatedRecords = updatedCount[k]
 
@@ -487,6 +929,12 @@ func Delete(query parser.DeleteQuery, parentFilter *Filter) ([]*View, error) {
 		}
 	}
 
+	if cmd.GetFlags().ReadOnly {
+		if obj := fileTable(query.FromClause.Tables, filter); obj != nil {
+			return nil, NewReadOnlyError(obj, "DELETE")
+		}
+	}
+
 	fromClause := query.FromClause
 	if query.Tables == nil {
 		if 1 < len(fromClause.Tables) {
@@ -571,7 +1019,12 @@ func Delete(query parser.DeleteQuery, parentFilter *Filter) ([]*View, error) {
 	return views, nil
 }
 
-func CreateTable(query parser.CreateTable, parentFilter *Filter) (*View, error) {
+// CreateTable returns false as the second value when IF NOT EXISTS is specified and the table is found instead of being created.
+func CreateTable(query parser.CreateTable, parentFilter *Filter) (*View, bool, error) {
+	if cmd.GetFlags().ReadOnly {
+		return nil, false, NewReadOnlyError(query.Table, "CREATE TABLE")
+	}
+
 	filter := parentFilter.CreateNode()
 
 	var view *View
@@ -580,42 +1033,60 @@ func CreateTable(query parser.CreateTable, parentFilter *Filter) (*View, error)
 	flags := cmd.GetFlags()
 	fileInfo, err := NewFileInfoForCreate(query.Table, flags.Repository, flags.Delimiter)
 	if err != nil {
-		return nil, err
+		return nil, false, err
+	}
+
+	if query.IfNotExists {
+		if ViewCache.HasColumns(fileInfo.Path, nil) {
+			view, _ = ViewCache.Get(parser.Identifier{Literal: fileInfo.Path})
+			return view, false, nil
+		}
+		if _, err := os.Stat(fileInfo.Path); err == nil {
+			view = NewView()
+			if err = view.LoadFromTableIdentifier(query.Table, filter); err != nil {
+				return nil, false, err
+			}
+			return view, false, nil
+		}
 	}
+
 	if _, err := os.Stat(fileInfo.Path); err == nil {
-		return nil, NewFileAlreadyExistError(query.Table)
+		return nil, false, NewFileAlreadyExistError(query.Table)
 	}
 	if file.IsLockedByOtherProcess(fileInfo.Path) {
-		return nil, NewFileAlreadyExistError(query.Table)
+		return nil, false, NewFileAlreadyExistError(query.Table)
 	}
 	if err := file.TryLock(fileInfo.Path); err != nil {
-		return nil, NewCreateFileError(query.Table, err.Error())
+		return nil, false, NewCreateFileError(query.Table, err.Error())
 	}
 	if err := cmd.TryCreateFile(fileInfo.Path); err != nil {
-		return nil, NewCreateFileError(query.Table, err.Error())
+		return nil, false, NewCreateFileError(query.Table, err.Error())
 	}
 
 	fileInfo.Encoding = flags.Encoding
+	if fileInfo.Encoding == cmd.AUTO {
+		fileInfo.Encoding = cmd.UTF8
+	}
 	fileInfo.LineBreak = flags.LineBreak
 
 	if query.Query != nil {
 		view, err = Select(query.Query.(parser.SelectQuery), filter)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		if err = view.Header.Update(parser.FormatTableName(fileInfo.Path), query.Fields); err != nil {
 			if _, ok := err.(*FieldLengthNotMatchError); ok {
-				return nil, NewTableFieldLengthError(query.Query.(parser.SelectQuery), query.Table, len(query.Fields))
+				return nil, false, NewTableFieldLengthError(query.Query.(parser.SelectQuery), query.Table, len(query.Fields))
 			}
-			return nil, err
+			return nil, false, err
 		}
 	} else {
 		fields := make([]string, len(query.Fields))
 		for i, v := range query.Fields {
 			f, _ := v.(parser.Identifier)
 			if InStrSliceWithCaseInsensitive(f.Literal, fields) {
-				return nil, NewDuplicateFieldNameError(f)
+				return nil, false, NewDuplicateFieldNameError(f)
 			}
 			fields[i] = f.Literal
 		}
@@ -630,12 +1101,118 @@ func CreateTable(query parser.CreateTable, parentFilter *Filter) (*View, error)
 
 	ViewCache.Set(view)
 
+	return view, true, nil
+}
+
+// DropTable loads the file to be locked, and the file is deleted on commit.
+func DropTable(query parser.DropTable, parentFilter *Filter) (*View, error) {
+	if cmd.GetFlags().ReadOnly {
+		return nil, NewReadOnlyError(query.Table, "DROP TABLE")
+	}
+
+	filter := parentFilter.CreateNode()
+
+	view := NewView()
+	view.ForUpdate = true
+	if err := view.LoadFromTableIdentifier(query.Table, filter); err != nil {
+		return nil, err
+	}
+
+	if view.FileInfo.IsTemporary {
+		return nil, NewDropTemporaryTableError(query.Table)
+	}
+
 	return view, nil
 }
 
+// RenameTable loads the file to be locked, and the file is renamed on commit.
+// The view in the cache is moved to the new path, and the original path is returned.
+func RenameTable(query parser.RenameTable, parentFilter *Filter) (*View, string, error) {
+	if cmd.GetFlags().ReadOnly {
+		return nil, "", NewReadOnlyError(query.Table, "RENAME TABLE")
+	}
+
+	filter := parentFilter.CreateNode()
+
+	view := NewView()
+	view.ForUpdate = true
+	if err := view.LoadFromTableIdentifier(query.Table, filter); err != nil {
+		return nil, "", err
+	}
+
+	if view.FileInfo.IsTemporary {
+		return nil, "", NewRenameTemporaryTableError(query.Table)
+	}
+	oldPath := view.FileInfo.Path
+
+	flags := cmd.GetFlags()
+	fileInfo, err := NewFileInfoForCreate(query.NewName, flags.Repository, flags.Delimiter)
+	if err != nil {
+		return nil, "", err
+	}
+	newPath := fileInfo.Path
+
+	if strings.EqualFold(newPath, oldPath) {
+		return nil, "", NewFileAlreadyExistError(query.NewName)
+	}
+	exists := ViewCache.Exists(newPath)
+	if _, err := os.Stat(newPath); err == nil {
+		exists = true
+	}
+	if exists && !isRemovedFile(newPath) && !query.Overwrite {
+		return nil, "", NewFileAlreadyExistError(query.NewName)
+	}
+	ViewCache.Dispose(newPath)
+	if file.IsLockedByOtherProcess(newPath) {
+		return nil, "", NewFileAlreadyExistError(query.NewName)
+	}
+	if !file.LockFiles.Exists(newPath) {
+		if err := file.TryLock(newPath); err != nil {
+			return nil, "", NewCreateFileError(query.NewName, err.Error())
+		}
+	}
+
+	view, _ = ViewCache.Get(parser.Identifier{Literal: oldPath})
+	renamed := *view.FileInfo
+	renamed.Path = newPath
+	view.FileInfo = &renamed
+	view.Header.Update(parser.FormatTableName(newPath), nil)
+
+	delete(ViewCache, strings.ToUpper(oldPath))
+	ViewCache.Set(view)
+
+	return view, oldPath, nil
+}
+
+// isRemovedFile reports whether the file is dropped or renamed to another path in the current transaction.
+func isRemovedFile(path string) bool {
+	removed := false
+	for _, result := range Results {
+		switch result.Type {
+		case DROP_TABLE:
+			if strings.EqualFold(result.FileInfo.Path, path) {
+				removed = true
+			}
+		case RENAME_TABLE:
+			if strings.EqualFold(result.OldPath, path) {
+				removed = true
+			} else if strings.EqualFold(result.FileInfo.Path, path) {
+				removed = false
+			}
+		}
+	}
+	return removed
+}
+
 func AddColumns(query parser.AddColumns, parentFilter *Filter) (*View, error) {
 	filter := parentFilter.CreateNode()
 
+	if cmd.GetFlags().ReadOnly {
+		if obj := fileTable([]parser.QueryExpression{query.Table}, filter); obj != nil {
+			return nil, NewReadOnlyError(obj, "ALTER TABLE")
+		}
+	}
+
 	if query.Position == nil {
 		query.Position = parser.ColumnPosition{
 			Position: parser.Token{Token: parser.LAST, Literal: parser.TokenLiteral(parser.LAST)},
@@ -770,6 +1347,12 @@ func AddColumns(query parser.AddColumns, parentFilter *Filter) (*View, error) {
 func DropColumns(query parser.DropColumns, parentFilter *Filter) (*View, error) {
 	filter := parentFilter.CreateNode()
 
+	if cmd.GetFlags().ReadOnly {
+		if obj := fileTable([]parser.QueryExpression{query.Table}, filter); obj != nil {
+			return nil, NewReadOnlyError(obj, "ALTER TABLE")
+		}
+	}
+
 	view := NewView()
 	view.ForUpdate = true
 	err := view.LoadFromTableIdentifier(query.Table, filter)
@@ -809,6 +1392,12 @@ func DropColumns(query parser.DropColumns, parentFilter *Filter) (*View, error)
 func RenameColumn(query parser.RenameColumn, parentFilter *Filter) (*View, error) {
 	filter := parentFilter.CreateNode()
 
+	if cmd.GetFlags().ReadOnly {
+		if obj := fileTable([]parser.QueryExpression{query.Table}, filter); obj != nil {
+			return nil, NewReadOnlyError(obj, "ALTER TABLE")
+		}
+	}
+
 	view := NewView()
 	view.ForUpdate = true
 	err := view.LoadFromTableIdentifier(query.Table, filter)
@@ -839,15 +1428,117 @@ func RenameColumn(query parser.RenameColumn, parentFilter *Filter) (*View, error
 	return view, nil
 }
 
+func Deduplicate(query parser.Deduplicate, parentFilter *Filter) (*View, error) {
+	filter := parentFilter.CreateNode()
+
+	if cmd.GetFlags().ReadOnly {
+		if obj := fileTable([]parser.QueryExpression{query.Table}, filter); obj != nil {
+			return nil, NewReadOnlyError(obj, "DEDUPLICATE")
+		}
+	}
+
+	view := NewView()
+	view.ForUpdate = true
+	err := view.LoadFromTableIdentifier(query.Table, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(RecordSet, 0, view.RecordLen())
+	keys := make(map[string]bool, view.RecordLen())
+	for _, record := range view.RecordSet {
+		key := identicalRecordKey(record)
+		if !keys[key] {
+			keys[key] = true
+			records = append(records, record)
+		}
+	}
+
+	view.OperatedRecords = view.RecordLen() - len(records)
+	view.RecordSet = records
+	view.Filter = nil
+
+	if view.FileInfo.IsTemporary {
+		filter.TempViews.Replace(view)
+	} else {
+		ViewCache.Replace(view)
+	}
+
+	return view, nil
+}
+
+// identicalRecordKey returns a key that is the same only for records whose values have the same types and representations.
+func identicalRecordKey(record Record) string {
+	buf := &bytes.Buffer{}
+	for _, cell := range record {
+		p := cell.Value()
+		s := p.String()
+		buf.WriteString(reflect.TypeOf(p).String())
+		buf.WriteByte(':')
+		buf.WriteString(strconv.Itoa(len(s)))
+		buf.WriteByte(':')
+		buf.WriteString(s)
+	}
+	return buf.String()
+}
+
 func Commit(expr parser.Expression, filter *Filter) error {
+	flags := cmd.GetFlags()
+	quiet := flags.Quiet || flags.Summary == cmd.JSON
+
 	var createFiles = map[string]*FileInfo{}
 	var updateFiles = map[string]*FileInfo{}
+	var dropFiles = map[string]*FileInfo{}
+
+	// Files to be renamed, keyed by the original paths.
+	var renameFiles = map[string]*FileInfo{}
+	originalPath := func(path string) (string, bool) {
+		for filename, fileinfo := range renameFiles {
+			if fileinfo.Path == path {
+				return filename, true
+			}
+		}
+		return "", false
+	}
 
 	for _, result := range Results {
 		if result.FileInfo != nil {
 			switch result.Type {
 			case CREATE_TABLE:
 				createFiles[result.FileInfo.Path] = result.FileInfo
+			case DROP_TABLE:
+				if _, ok := createFiles[result.FileInfo.Path]; ok {
+					delete(createFiles, result.FileInfo.Path)
+				} else if filename, ok := originalPath(result.FileInfo.Path); ok {
+					delete(renameFiles, filename)
+					dropFiles[filename] = result.FileInfo
+				} else {
+					dropFiles[result.FileInfo.Path] = result.FileInfo
+				}
+				delete(updateFiles, result.FileInfo.Path)
+			case RENAME_TABLE:
+				newPath := result.FileInfo.Path
+
+				// The file existing at the new path is replaced.
+				delete(createFiles, newPath)
+				delete(updateFiles, newPath)
+				if filename, ok := originalPath(newPath); ok {
+					dropFiles[filename] = renameFiles[filename]
+					delete(renameFiles, filename)
+				}
+
+				if _, ok := createFiles[result.OldPath]; ok {
+					delete(createFiles, result.OldPath)
+					createFiles[newPath] = result.FileInfo
+				} else if filename, ok := originalPath(result.OldPath); ok {
+					renameFiles[filename] = result.FileInfo
+				} else {
+					renameFiles[result.OldPath] = result.FileInfo
+				}
+				if _, ok := updateFiles[result.OldPath]; ok {
+					delete(updateFiles, result.OldPath)
+					updateFiles[newPath] = result.FileInfo
+				}
 			default:
 				if !result.FileInfo.IsTemporary && 0 < result.OperatedCount {
 					if _, ok := createFiles[result.FileInfo.Path]; !ok {
@@ -860,6 +1551,30 @@ func Commit(expr parser.Expression, filter *Filter) error {
 		}
 	}
 
+	// Renamed files are checked with their original paths.
+	var checkFiles = map[string]*FileInfo{}
+	for filename, fileinfo := range updateFiles {
+		if path, ok := originalPath(filename); ok {
+			filename = path
+		}
+		checkFiles[filename] = fileinfo
+	}
+	for _, files := range []map[string]*FileInfo{dropFiles, renameFiles} {
+		for filename, fileinfo := range files {
+			checkFiles[filename] = fileinfo
+		}
+	}
+	for filename, fileinfo := range checkFiles {
+		current := *fileinfo
+		current.Path = filename
+		if current.IsModified() {
+			if expr == nil {
+				return NewAutoCommitError(fmt.Sprintf(ERROR_FILE_MODIFIED, filename))
+			}
+			return NewFileModifiedError(expr, filename)
+		}
+	}
+
 	if 0 < len(createFiles) {
 		for filename, fileinfo := range createFiles {
 			view, _ := ViewCache.Get(parser.Identifier{Literal: filename})
@@ -874,14 +1589,19 @@ func Commit(expr parser.Expression, filter *Filter) error {
 				}
 				return NewWriteFileError(expr, err.Error())
 			}
-			Log(fmt.Sprintf("Commit: file %q is created.", filename), cmd.GetFlags().Quiet)
+			Log(fmt.Sprintf("Commit: file %q is created.", filename), quiet)
 		}
 	}
 
 	if 0 < len(updateFiles) {
 		for filename, fileinfo := range updateFiles {
+			lineBreak := fileinfo.LineBreak
+			if !flags.PreserveLineBreak {
+				lineBreak = flags.LineBreak
+			}
+
 			view, _ := ViewCache.Get(parser.Identifier{Literal: filename})
-			viewstr, err := EncodeView(view, cmd.CSV, fileinfo.Delimiter, fileinfo.NoHeader, fileinfo.Encoding, fileinfo.LineBreak)
+			viewstr, err := EncodeView(view, cmd.CSV, fileinfo.Delimiter, fileinfo.NoHeader, fileinfo.Encoding, lineBreak)
 			if err != nil {
 				return err
 			}
@@ -892,11 +1612,65 @@ func Commit(expr parser.Expression, filter *Filter) error {
 				}
 				return NewWriteFileError(expr, err.Error())
 			}
-			Log(fmt.Sprintf("Commit: file %q is updated.", filename), cmd.GetFlags().Quiet)
+			Log(fmt.Sprintf("Commit: file %q is updated.", filename), quiet)
+		}
+	}
+
+	if 0 < len(dropFiles) {
+		for filename, fileinfo := range dropFiles {
+			ViewCache.Dispose(fileinfo.Path)
+			if err := os.Remove(filename); err != nil {
+				if expr == nil {
+					return NewAutoCommitError(err.Error())
+				}
+				return NewWriteFileError(expr, err.Error())
+			}
+			Log(fmt.Sprintf("Commit: file %q is deleted.", filename), quiet)
+		}
+	}
+
+	if 0 < len(renameFiles) {
+		// Files replaced by other renamed files are moved aside first so that files can be swapped.
+		sources := make(map[string]string, len(renameFiles))
+		for filename, fileinfo := range renameFiles {
+			ViewCache.Dispose(fileinfo.Path)
+			sources[filename] = filename
+		}
+		for _, fileinfo := range renameFiles {
+			if _, ok := sources[fileinfo.Path]; ok {
+				tmp, err := cmd.MoveAside(fileinfo.Path)
+				if err != nil {
+					if expr == nil {
+						return NewAutoCommitError(err.Error())
+					}
+					return NewWriteFileError(expr, err.Error())
+				}
+				sources[fileinfo.Path] = tmp
+			}
+		}
+
+		for filename, fileinfo := range renameFiles {
+			if err := os.Rename(sources[filename], fileinfo.Path); err != nil {
+				if expr == nil {
+					return NewAutoCommitError(err.Error())
+				}
+				return NewWriteFileError(expr, err.Error())
+			}
+			Log(fmt.Sprintf("Commit: file %q is renamed to %q.", filename, fileinfo.Path), quiet)
 		}
 	}
 
+	if flags.Summary == cmd.JSON && 0 < len(Results) {
+		Log(EncodeResultSummary(Results), false)
+	}
+
+	for path := range BackupFiles {
+		committedBackupFiles[path] = true
+	}
+	BackupFiles = map[string]bool{}
+
 	Results = []Result{}
+	Savepoints = []Savepoint{}
 	ReleaseResources()
 	if expr != nil {
 		filter.TempViews.Store()
@@ -909,13 +1683,28 @@ func Rollback(filter *Filter) {
 	var createFiles = map[string]*FileInfo{}
 	var updateFiles = map[string]*FileInfo{}
 
+	// Paths to which files are renamed. The files are restored with their original paths.
+	var renamedFiles = map[string]bool{}
+
 	for _, result := range Results {
 		if result.FileInfo != nil {
 			switch result.Type {
 			case CREATE_TABLE:
 				createFiles[result.FileInfo.Path] = result.FileInfo
+			case DROP_TABLE:
+				if _, ok := createFiles[result.FileInfo.Path]; !ok && !renamedFiles[result.FileInfo.Path] {
+					updateFiles[result.FileInfo.Path] = result.FileInfo
+				}
+			case RENAME_TABLE:
+				if fileinfo, ok := createFiles[result.OldPath]; ok {
+					delete(createFiles, result.OldPath)
+					createFiles[result.FileInfo.Path] = fileinfo
+				} else if !renamedFiles[result.OldPath] {
+					updateFiles[result.OldPath] = result.FileInfo
+				}
+				renamedFiles[result.FileInfo.Path] = true
 			default:
-				if !result.FileInfo.IsTemporary && 0 < result.OperatedCount {
+				if !result.FileInfo.IsTemporary && 0 < result.OperatedCount && !renamedFiles[result.FileInfo.Path] {
 					if _, ok := createFiles[result.FileInfo.Path]; !ok {
 						if _, ok := updateFiles[result.FileInfo.Path]; !ok {
 							updateFiles[result.FileInfo.Path] = result.FileInfo
@@ -938,8 +1727,98 @@ func Rollback(filter *Filter) {
 		}
 	}
 
+	for path, created := range BackupFiles {
+		if created {
+			os.Remove(path)
+		}
+	}
+	BackupFiles = map[string]bool{}
+
 	Results = []Result{}
+	Savepoints = []Savepoint{}
 	ReleaseResources()
 	filter.TempViews.Restore()
 	return
 }
+
+func SetSavepoint(name parser.Identifier, filter *Filter) {
+	views := ViewMap{}
+	for k, v := range ViewCache {
+		views[k] = v.Copy()
+	}
+
+	tempViews := make(TemporaryViewScopes, len(filter.TempViews))
+	for i, m := range filter.TempViews {
+		tempViews[i] = ViewMap{}
+		for k, v := range m {
+			tempViews[i][k] = v.Copy()
+		}
+	}
+
+	Savepoints = append(Savepoints, Savepoint{
+		Name:      name.Literal,
+		ResultLen: len(Results),
+		Views:     views,
+		TempViews: tempViews,
+	})
+	Log(fmt.Sprintf("Savepoint: savepoint %q is created.", name.Literal), cmd.GetFlags().Quiet)
+}
+
+func RollbackToSavepoint(name parser.Identifier, filter *Filter) error {
+	spIdx := -1
+	for i := len(Savepoints) - 1; 0 <= i; i-- {
+		if strings.EqualFold(Savepoints[i].Name, name.Literal) {
+			spIdx = i
+			break
+		}
+	}
+	if spIdx < 0 {
+		return NewSavepointNotExistError(name)
+	}
+	sp := Savepoints[spIdx]
+
+	for i := len(Results) - 1; sp.ResultLen <= i; i-- {
+		if Results[i].Type == RENAME_TABLE {
+			renamed := strings.ToUpper(Results[i].FileInfo.Path)
+			if view, ok := ViewCache[renamed]; ok {
+				delete(ViewCache, renamed)
+				ViewCache.Dispose(Results[i].OldPath)
+				ViewCache[strings.ToUpper(Results[i].OldPath)] = view
+			}
+		}
+	}
+
+	for k, current := range ViewCache {
+		if view, ok := sp.Views[k]; ok {
+			view = view.Copy()
+			if strings.EqualFold(current.FileInfo.Path, view.FileInfo.Path) {
+				view.FileInfo = current.FileInfo
+			} else {
+				fileInfo := *current.FileInfo
+				fileInfo.Path = view.FileInfo.Path
+				view.FileInfo = &fileInfo
+			}
+			view.ForUpdate = current.ForUpdate
+			ViewCache[k] = view
+		} else {
+			ViewCache.Dispose(k)
+		}
+	}
+
+	offset := len(filter.TempViews) - len(sp.TempViews)
+	for i, m := range sp.TempViews {
+		if i+offset < 0 {
+			continue
+		}
+		for k, view := range m {
+			if _, ok := filter.TempViews[i+offset][k]; ok {
+				filter.TempViews[i+offset][k] = view.Copy()
+			}
+		}
+	}
+
+	Results = Results[:sp.ResultLen]
+	Savepoints = Savepoints[:spIdx+1]
+	Log(fmt.Sprintf("Rollback: changes after savepoint %q are discarded.", sp.Name), cmd.GetFlags().Quiet)
+	return nil
+}
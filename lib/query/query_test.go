@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
@@ -14,6 +15,61 @@ import (
 	"github.com/mithrandie/csvq/lib/value"
 )
 
+var readSelectLogTests = []struct {
+	Name          string
+	SelectLogs    []string
+	LineBreak     cmd.LineBreak
+	WriteEncoding cmd.Encoding
+	Result        string
+}{
+	{
+		Name:   "Empty Logs",
+		Result: "",
+	},
+	{
+		Name:       "Join Logs",
+		SelectLogs: []string{"a", "b"},
+		LineBreak:  cmd.CRLF,
+		Result:     "a\r\nb\r\n",
+	},
+	{
+		Name:          "Encode Logs",
+		SelectLogs:    []string{"a", "b"},
+		LineBreak:     cmd.LF,
+		WriteEncoding: cmd.UTF16LE,
+		Result:        "\xff\xfea\x00\n\x00b\x00\n\x00",
+	},
+}
+
+func TestReadSelectLog(t *testing.T) {
+	flags := cmd.GetFlags()
+
+	for _, v := range readSelectLogTests {
+		SelectLogs = v.SelectLogs
+		flags.LineBreak = cmd.LF
+		if v.LineBreak != "" {
+			flags.LineBreak = v.LineBreak
+		}
+		flags.WriteEncoding = cmd.UTF8
+		if v.WriteEncoding != "" {
+			flags.WriteEncoding = v.WriteEncoding
+		}
+
+		result, err := ReadSelectLog()
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if result != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, v.Result)
+		}
+	}
+
+	SelectLogs = []string{}
+	flags.LineBreak = cmd.LF
+	flags.WriteEncoding = cmd.UTF8
+}
+
 var fetchCursorTests = []struct {
 	Name          string
 	CurName       parser.Identifier
@@ -199,6 +255,253 @@ func TestFetchCursor(t *testing.T) {
 	}
 }
 
+var selectIntoTests = []struct {
+	Name       string
+	Expr       parser.SelectInto
+	Strict     bool
+	ResultVars VariableMap
+	Error      string
+}{
+	{
+		Name: "SelectInto",
+		Expr: parser.SelectInto{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+					WhereClause: parser.WhereClause{
+						Filter: parser.Comparison{
+							LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+							RHS:      parser.NewIntegerValue(2),
+							Operator: "=",
+						},
+					},
+				},
+			},
+			Variables: []parser.Variable{
+				{Name: "@var1"},
+				{Name: "@var2"},
+			},
+		},
+		ResultVars: VariableMap{
+			"@var1": value.NewString("2"),
+			"@var2": value.NewString("str2"),
+		},
+	},
+	{
+		Name: "SelectInto No Record",
+		Expr: parser.SelectInto{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+					WhereClause: parser.WhereClause{
+						Filter: parser.Comparison{
+							LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+							RHS:      parser.NewIntegerValue(9),
+							Operator: "=",
+						},
+					},
+				},
+			},
+			Variables: []parser.Variable{
+				{Name: "@var1"},
+				{Name: "@var2"},
+			},
+		},
+		ResultVars: VariableMap{
+			"@var1": value.NewNull(),
+			"@var2": value.NewNull(),
+		},
+	},
+	{
+		Name: "SelectInto No Record Error",
+		Expr: parser.SelectInto{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+					WhereClause: parser.WhereClause{
+						Filter: parser.Comparison{
+							LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+							RHS:      parser.NewIntegerValue(9),
+							Operator: "=",
+						},
+					},
+				},
+			},
+			Variables: []parser.Variable{
+				{Name: "@var1"},
+				{Name: "@var2"},
+			},
+		},
+		Strict: true,
+		Error:  "[L:- C:-] select query into variables returns no record",
+	},
+	{
+		Name: "SelectInto Too Many Records Error",
+		Expr: parser.SelectInto{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+					WhereClause: parser.WhereClause{
+						Filter: parser.Comparison{
+							LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+							RHS:      parser.NewIntegerValue(1),
+							Operator: ">",
+						},
+					},
+				},
+			},
+			Variables: []parser.Variable{
+				{Name: "@var1"},
+				{Name: "@var2"},
+			},
+		},
+		Error: "[L:- C:-] select query into variables returns more than one record",
+	},
+	{
+		Name: "SelectInto Field Length Error",
+		Expr: parser.SelectInto{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+					WhereClause: parser.WhereClause{
+						Filter: parser.Comparison{
+							LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+							RHS:      parser.NewIntegerValue(2),
+							Operator: "=",
+						},
+					},
+				},
+			},
+			Variables: []parser.Variable{
+				{Name: "@var1"},
+				{Name: "@var2"},
+			},
+		},
+		Error: "[L:- C:-] select query into variables should return exactly 2 fields",
+	},
+	{
+		Name: "SelectInto Undeclared Variable Error",
+		Expr: parser.SelectInto{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+					WhereClause: parser.WhereClause{
+						Filter: parser.Comparison{
+							LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+							RHS:      parser.NewIntegerValue(2),
+							Operator: "=",
+						},
+					},
+				},
+			},
+			Variables: []parser.Variable{
+				{Name: "@var1"},
+				{Name: "@notexist"},
+			},
+		},
+		Error: "[L:- C:-] variable @notexist is undeclared",
+	},
+}
+
+func TestSelectInto(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	defer func() { tf.StrictSelectInto = false }()
+
+	for _, v := range selectIntoTests {
+		tf.StrictSelectInto = v.Strict
+		ViewCache.Clean()
+
+		filter := NewFilter(
+			[]VariableMap{
+				{
+					"@var1": value.NewInteger(0),
+					"@var2": value.NewInteger(0),
+				},
+			},
+			[]ViewMap{{}},
+			[]CursorMap{{}},
+			[]UserDefinedFunctionMap{{}},
+		)
+
+		err := SelectInto(v.Expr, filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(filter.Variables[0], v.ResultVars) {
+			t.Errorf("%s: global vars = %q, want %q", v.Name, filter.Variables[0], v.ResultVars)
+		}
+	}
+}
+
 var declareViewTests = []struct {
 	Name    string
 	ViewMap ViewMap
@@ -393,6 +696,85 @@ func TestDeclareView(t *testing.T) {
 	}
 }
 
+var declareSchemaTests = []struct {
+	Name   string
+	Expr   parser.SchemaDeclaration
+	Result SchemaMap
+	Error  string
+}{
+	{
+		Name: "Declare Schema",
+		Expr: parser.SchemaDeclaration{
+			Table: parser.Identifier{Literal: "table1"},
+			Fields: []parser.SchemaField{
+				{Column: parser.Identifier{Literal: "column1"}, Type: parser.Identifier{Literal: "integer"}},
+				{Column: parser.Identifier{Literal: "column2"}, Type: parser.Identifier{Literal: "string"}},
+			},
+		},
+		Result: SchemaMap{
+			strings.ToUpper(GetTestFilePath("table1.csv")): {
+				Fields: []string{"column1", "column2"},
+				Types:  []string{"INTEGER", "STRING"},
+			},
+		},
+	},
+	{
+		Name: "Declare Schema File Not Exist Error",
+		Expr: parser.SchemaDeclaration{
+			Table: parser.Identifier{Literal: "notexist"},
+			Fields: []parser.SchemaField{
+				{Column: parser.Identifier{Literal: "column1"}, Type: parser.Identifier{Literal: "integer"}},
+			},
+		},
+		Error: "[L:- C:-] file notexist does not exist",
+	},
+	{
+		Name: "Declare Schema Field Duplicate Error",
+		Expr: parser.SchemaDeclaration{
+			Table: parser.Identifier{Literal: "table1"},
+			Fields: []parser.SchemaField{
+				{Column: parser.Identifier{Literal: "column1"}, Type: parser.Identifier{Literal: "integer"}},
+				{Column: parser.Identifier{Literal: "COLUMN1"}, Type: parser.Identifier{Literal: "string"}},
+			},
+		},
+		Error: "[L:- C:-] field name COLUMN1 is a duplicate",
+	},
+	{
+		Name: "Declare Schema Invalid Type Error",
+		Expr: parser.SchemaDeclaration{
+			Table: parser.Identifier{Literal: "table1"},
+			Fields: []parser.SchemaField{
+				{Column: parser.Identifier{Literal: "column1"}, Type: parser.Identifier{Literal: "int"}},
+			},
+		},
+		Error: "[L:- C:-] type int is not supported in schemas",
+	},
+}
+
+func TestDeclareSchema(t *testing.T) {
+	for _, v := range declareSchemaTests {
+		Schemas.Clear()
+
+		err := DeclareSchema(v.Expr)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(Schemas, v.Result) {
+			t.Errorf("%s: schemas = %v, want %v", v.Name, Schemas, v.Result)
+		}
+	}
+	Schemas.Clear()
+}
+
 var selectTests = []struct {
 	Name   string
 	Query  parser.SelectQuery
@@ -453,6 +835,7 @@ var selectTests = []struct {
 				NoHeader:  false,
 				Encoding:  cmd.UTF8,
 				LineBreak: cmd.LF,
+				Columns:   []string{"COLUMN1"},
 			},
 			Header: []HeaderField{
 				{
@@ -480,44 +863,133 @@ var selectTests = []struct {
 		},
 	},
 	{
-		Name: "Select Replace Columns",
+		Name: "Select Field Positions",
 		Query: parser.SelectQuery{
 			SelectEntity: parser.SelectEntity{
 				SelectClause: parser.SelectClause{
 					Fields: []parser.QueryExpression{
-						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
 						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}},
 					},
 				},
 				FromClause: parser.FromClause{
 					Tables: []parser.QueryExpression{
-						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						parser.Table{Object: parser.Identifier{Literal: "group_table"}},
+					},
+				},
+				GroupByClause: parser.GroupByClause{
+					Items: []parser.QueryExpression{
+						parser.NewIntegerValueFromString("1"),
 					},
 				},
 			},
-			LimitClause: parser.LimitClause{
-				Value: parser.NewIntegerValueFromString("1"),
+			OrderByClause: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.NewIntegerValueFromString("1"), Direction: parser.Token{Token: parser.DESC, Literal: "desc"}},
+				},
 			},
 		},
 		Result: &View{
 			FileInfo: &FileInfo{
-				Path:      GetTestFilePath("table1.csv"),
+				Path:      GetTestFilePath("group_table.csv"),
 				Delimiter: ',',
 				NoHeader:  false,
 				Encoding:  cmd.UTF8,
 				LineBreak: cmd.LF,
+				Columns:   []string{"COLUMN1"},
 			},
-			Header: NewHeader("table1", []string{"column2", "column1"}),
-			RecordSet: []Record{
-				NewRecord([]value.Primary{
-					value.NewString("str1"),
-					value.NewString("1"),
-				}),
-			},
-		},
-	},
-	{
-		Name: "Union",
+			Header: []HeaderField{
+				{
+					View:        "group_table",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+				},
+				{
+					Column:      "count(*)",
+					Number:      2,
+					IsFromTable: true,
+				},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewInteger(2),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Select Field Position Out Of Range Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "group_table"}},
+					},
+				},
+				GroupByClause: parser.GroupByClause{
+					Items: []parser.QueryExpression{
+						parser.NewIntegerValueFromString("2"),
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] field position 2 is out of range",
+	},
+	{
+		Name: "Select Replace Columns",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+			LimitClause: parser.LimitClause{
+				Value: parser.NewIntegerValueFromString("1"),
+			},
+		},
+		Result: &View{
+			FileInfo: &FileInfo{
+				Path:      GetTestFilePath("table1.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  cmd.UTF8,
+				LineBreak: cmd.LF,
+				Columns:   []string{"COLUMN1", "COLUMN2"},
+			},
+			Header: NewHeader("table1", []string{"column2", "column1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("str1"),
+					value.NewString("1"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Union",
 		Query: parser.SelectQuery{
 			SelectEntity: parser.SelectSet{
 				LHS: parser.SelectEntity{
@@ -720,6 +1192,86 @@ var selectTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Select Correlated Scalar Subquery with Aggregate Function",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						parser.Field{
+							Object: parser.Subquery{
+								Query: parser.SelectQuery{
+									SelectEntity: parser.SelectEntity{
+										SelectClause: parser.SelectClause{
+											Fields: []parser.QueryExpression{
+												parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}},
+											},
+										},
+										FromClause: parser.FromClause{
+											Tables: []parser.QueryExpression{
+												parser.Table{Object: parser.Identifier{Literal: "table2"}},
+											},
+										},
+										WhereClause: parser.WhereClause{
+											Filter: parser.Comparison{
+												LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+												RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+												Operator: "=",
+											},
+										},
+									},
+								},
+							},
+							Alias: parser.Identifier{Literal: "cnt"},
+						},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			FileInfo: &FileInfo{
+				Path:      GetTestFilePath("table1.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  cmd.UTF8,
+				LineBreak: cmd.LF,
+				Columns:   []string{"COLUMN1", "COLUMN3"},
+			},
+			Header: []HeaderField{
+				{
+					View:        "table1",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+				},
+				{
+					Column:      "cnt",
+					Number:      2,
+					IsFromTable: true,
+				},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewInteger(0),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewInteger(1),
+				}),
+			},
+		},
+	},
 	{
 		Name: "Union Field Length Error",
 		Query: parser.SelectQuery{
@@ -1122,77 +1674,341 @@ func TestSelect(t *testing.T) {
 	}
 }
 
-var insertTests = []struct {
-	Name         string
-	Query        parser.InsertQuery
-	Result       *View
-	ViewCache    ViewMap
-	TempViewList TemporaryViewScopes
-	Error        string
+var selectMaxResultRowsTests = []struct {
+	Name          string
+	MaxResultRows int
+	Query         parser.SelectQuery
+	RecordLen     int
+	Error         string
 }{
 	{
-		Name: "Insert Query",
-		Query: parser.InsertQuery{
-			WithClause: parser.WithClause{
-				With: "with",
-				InlineTables: []parser.QueryExpression{
-					parser.InlineTable{
-						Name: parser.Identifier{Literal: "it"},
-						Fields: []parser.QueryExpression{
-							parser.Identifier{Literal: "c1"},
-						},
-						As: "as",
-						Query: parser.SelectQuery{
-							SelectEntity: parser.SelectEntity{
-								SelectClause: parser.SelectClause{
-									Select: "select",
-									Fields: []parser.QueryExpression{
-										parser.Field{Object: parser.NewIntegerValueFromString("2")},
-									},
-								},
-							},
-						},
+		Name:          "Select Max Result Rows",
+		MaxResultRows: 3,
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{parser.Field{Object: parser.AllColumns{}}},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
 					},
 				},
 			},
-			Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
-			Fields: []parser.QueryExpression{
-				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+		},
+		RecordLen: 3,
+	},
+	{
+		Name:          "Select Max Result Rows Exceeded",
+		MaxResultRows: 2,
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 1}),
+					Fields: []parser.QueryExpression{parser.Field{Object: parser.AllColumns{}}},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
 			},
-			ValuesList: []parser.QueryExpression{
-				parser.RowValue{
-					Value: parser.ValueList{
-						Values: []parser.QueryExpression{
-							parser.NewIntegerValueFromString("4"),
-						},
+		},
+		Error: "[L:1 C:1] number of records exceeded the maximum number of result rows 2",
+	},
+	{
+		Name:          "Select Max Result Rows Exceeded in Cross Join",
+		MaxResultRows: 8,
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{parser.Field{Object: parser.AllColumns{}}},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						parser.Table{Object: parser.Identifier{Literal: "table2"}},
 					},
 				},
-				parser.RowValue{
-					Value: parser.ValueList{
-						Values: []parser.QueryExpression{
-							parser.Subquery{
-								Query: parser.SelectQuery{
-									SelectEntity: parser.SelectEntity{
-										SelectClause: parser.SelectClause{
-											Select: "select",
-											Fields: []parser.QueryExpression{
-												parser.Field{Object: parser.FieldReference{View: parser.Identifier{Literal: "it"}, Column: parser.Identifier{Literal: "c1"}}},
-											},
-										},
-										FromClause: parser.FromClause{
-											Tables: []parser.QueryExpression{
-												parser.Table{Object: parser.Identifier{Literal: "it"}},
-											},
-										},
-									},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+						Operator: "=",
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] number of records exceeded the maximum number of result rows 8",
+	},
+	{
+		Name:          "Select Max Result Rows Exceeded in Inner Join",
+		MaxResultRows: 1,
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{parser.Field{Object: parser.AllColumns{}}},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Join{
+							Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}},
+							JoinTable: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+							JoinType:  parser.Token{Token: parser.INNER, Literal: "inner"},
+							Condition: parser.JoinCondition{
+								On: parser.Comparison{
+									LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+									RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+									Operator: "=",
 								},
 							},
-						},
+						}},
 					},
 				},
 			},
 		},
-		Result: &View{
+		Error: "[L:- C:-] number of records exceeded the maximum number of result rows 1",
+	},
+	{
+		Name:          "Select Max Result Rows Exceeded in Union",
+		MaxResultRows: 5,
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectSet{
+				LHS: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{parser.Field{Object: parser.AllColumns{}}},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+				},
+				Operator: parser.Token{Token: parser.UNION, Literal: "union"},
+				All:      parser.Token{Token: parser.ALL, Literal: "all"},
+				RHS: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{parser.Field{Object: parser.AllColumns{}}},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table2"}},
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] number of records exceeded the maximum number of result rows 5",
+	},
+}
+
+func TestSelect_MaxResultRows(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	filter := NewEmptyFilter()
+
+	for _, v := range selectMaxResultRowsTests {
+		ViewCache.Clean()
+		tf.MaxResultRows = v.MaxResultRows
+		result, err := Select(v.Query, filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result.RecordLen() != v.RecordLen {
+			t.Errorf("%s: record length = %d, want %d", v.Name, result.RecordLen(), v.RecordLen)
+		}
+	}
+	tf.MaxResultRows = 0
+}
+
+var selectStrictUnionTypesTests = []struct {
+	Name      string
+	Query     parser.SelectQuery
+	RecordLen int
+	Error     string
+}{
+	{
+		Name: "Select Strict Union Types",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectSet{
+				LHS: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+				},
+				Operator: parser.Token{Token: parser.UNION, Literal: "union"},
+				RHS: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}},
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table2"}},
+						},
+					},
+				},
+			},
+		},
+		RecordLen: 6,
+	},
+	{
+		Name: "Select Strict Union Types Incompatible Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectSet{
+				LHS: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+				},
+				Operator: parser.Token{Token: parser.UNION, Literal: "union"},
+				RHS: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}}},
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table2"}},
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] field column1 of result sets to be combined has incompatible types INTEGER and STRING",
+	},
+}
+
+func TestSelect_StrictUnionTypes(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.StrictUnionTypes = true
+
+	filter := NewEmptyFilter()
+
+	for _, v := range selectStrictUnionTypesTests {
+		ViewCache.Clean()
+		result, err := Select(v.Query, filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result.RecordLen() != v.RecordLen {
+			t.Errorf("%s: record length = %d, want %d", v.Name, result.RecordLen(), v.RecordLen)
+		}
+	}
+	tf.StrictUnionTypes = false
+}
+
+var insertTests = []struct {
+	Name         string
+	Query        parser.InsertQuery
+	Result       *View
+	ViewCache    ViewMap
+	TempViewList TemporaryViewScopes
+	Error        string
+}{
+	{
+		Name: "Insert Query",
+		Query: parser.InsertQuery{
+			WithClause: parser.WithClause{
+				With: "with",
+				InlineTables: []parser.QueryExpression{
+					parser.InlineTable{
+						Name: parser.Identifier{Literal: "it"},
+						Fields: []parser.QueryExpression{
+							parser.Identifier{Literal: "c1"},
+						},
+						As: "as",
+						Query: parser.SelectQuery{
+							SelectEntity: parser.SelectEntity{
+								SelectClause: parser.SelectClause{
+									Select: "select",
+									Fields: []parser.QueryExpression{
+										parser.Field{Object: parser.NewIntegerValueFromString("2")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Fields: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+			ValuesList: []parser.QueryExpression{
+				parser.RowValue{
+					Value: parser.ValueList{
+						Values: []parser.QueryExpression{
+							parser.NewIntegerValueFromString("4"),
+						},
+					},
+				},
+				parser.RowValue{
+					Value: parser.ValueList{
+						Values: []parser.QueryExpression{
+							parser.Subquery{
+								Query: parser.SelectQuery{
+									SelectEntity: parser.SelectEntity{
+										SelectClause: parser.SelectClause{
+											Select: "select",
+											Fields: []parser.QueryExpression{
+												parser.Field{Object: parser.FieldReference{View: parser.Identifier{Literal: "it"}, Column: parser.Identifier{Literal: "c1"}}},
+											},
+										},
+										FromClause: parser.FromClause{
+											Tables: []parser.QueryExpression{
+												parser.Table{Object: parser.Identifier{Literal: "it"}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: &View{
 			FileInfo: &FileInfo{
 				Path:      GetTestFilePath("table1.csv"),
 				Delimiter: ',',
@@ -1601,6 +2417,8 @@ func TestInsert(t *testing.T) {
 				file.Close(v2.FileInfo.File)
 				v2.FileInfo.File = nil
 			}
+			v2.FileInfo.ModTime = time.Time{}
+			v2.FileInfo.Size = 0
 		}
 
 		if !reflect.DeepEqual(result, v.Result) {
@@ -1870,59 +2688,186 @@ var updateTests = []struct {
 		},
 	},
 	{
-		Name: "Update Query File Does Not Exist Error",
+		Name: "Update Query Multiple Tables to Update",
 		Query: parser.UpdateQuery{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Identifier{Literal: "notexist"}},
+				parser.Table{Object: parser.Identifier{Literal: "t2"}},
+				parser.Table{Object: parser.Identifier{Literal: "t1"}},
 			},
 			SetList: []parser.UpdateSet{
 				{
 					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
-					Value: parser.NewStringValue("update"),
+					Value: parser.NewStringValue("update1"),
+				},
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+					Value: parser.NewStringValue("update2"),
 				},
 			},
-			WhereClause: parser.WhereClause{
-				Filter: parser.Comparison{
-					LHS:      parser.Identifier{Literal: "column1"},
-					RHS:      parser.NewIntegerValueFromString("2"),
-					Operator: "=",
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Join{
+						Table: parser.Table{
+							Object: parser.Identifier{Literal: "table1"},
+							Alias:  parser.Identifier{Literal: "t1"},
+						},
+						JoinTable: parser.Table{
+							Object: parser.Identifier{Literal: "table2"},
+							Alias:  parser.Identifier{Literal: "t2"},
+						},
+						Condition: parser.JoinCondition{
+							On: parser.Comparison{
+								LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+								RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+								Operator: "=",
+							},
+						},
+					}},
 				},
 			},
 		},
-		Error: "[L:- C:-] file notexist does not exist",
-	},
-	{
-		Name: "Update Query Filter Error",
-		Query: parser.UpdateQuery{
-			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Identifier{Literal: "table1"}},
+		Result: []*View{
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("table2.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  cmd.UTF8,
+					LineBreak: cmd.LF,
+				},
+				Header: NewHeader("table2", []string{"column3", "column4"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("update2"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewString("update2"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("4"),
+						value.NewString("str44"),
+					}),
+				},
+				ForUpdate:       true,
+				OperatedRecords: 2,
+			},
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("table1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  cmd.UTF8,
+					LineBreak: cmd.LF,
+				},
+				Header: NewHeader("table1", []string{"column1", "column2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("str1"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("update1"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewString("update1"),
+					}),
+				},
+				ForUpdate:       true,
+				OperatedRecords: 2,
+			},
+		},
+	},
+	{
+		Name: "Update Query Same File with Multiple Aliases",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "t1"}},
+				parser.Table{Object: parser.Identifier{Literal: "t2"}},
 			},
 			SetList: []parser.UpdateSet{
 				{
-					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
-					Value: parser.NewStringValue("update"),
+					Field: parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.NewStringValue("update1"),
+				},
+				{
+					Field: parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "column1"}},
+					Value: parser.NewStringValue("9"),
 				},
 			},
-			WhereClause: parser.WhereClause{
-				Filter: parser.Comparison{
-					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
-					RHS:      parser.NewIntegerValueFromString("2"),
-					Operator: "=",
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Join{
+						Table: parser.Table{
+							Object: parser.Identifier{Literal: "table1"},
+							Alias:  parser.Identifier{Literal: "t1"},
+						},
+						JoinTable: parser.Table{
+							Object: parser.Identifier{Literal: "table1"},
+							Alias:  parser.Identifier{Literal: "t2"},
+						},
+						Condition: parser.JoinCondition{
+							On: parser.Comparison{
+								LHS: parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "column1"}},
+								RHS: parser.Arithmetic{
+									LHS:      parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "column1"}},
+									RHS:      parser.NewIntegerValue(1),
+									Operator: '+',
+								},
+								Operator: "=",
+							},
+						},
+					}},
 				},
 			},
 		},
-		Error: "[L:- C:-] field notexist does not exist",
+		Result: []*View{
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("table1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  cmd.UTF8,
+					LineBreak: cmd.LF,
+				},
+				Header: NewHeader("table1", []string{"column1", "column2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("9"),
+						value.NewString("str1"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("9"),
+						value.NewString("update1"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewString("update1"),
+					}),
+				},
+				ForUpdate:       true,
+				OperatedRecords: 3,
+			},
+		},
 	},
 	{
-		Name: "Update Query File Is Not Loaded Error",
+		Name: "Update Query Same File with Multiple Aliases Record Is Ambiguous Error",
 		Query: parser.UpdateQuery{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Identifier{Literal: "notexist"}},
+				parser.Table{Object: parser.Identifier{Literal: "t1"}},
+				parser.Table{Object: parser.Identifier{Literal: "t2"}},
 			},
 			SetList: []parser.UpdateSet{
 				{
-					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
-					Value: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+					Field: parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.NewStringValue("update1"),
+				},
+				{
+					Field: parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.NewStringValue("update2"),
 				},
 			},
 			FromClause: parser.FromClause{
@@ -1933,13 +2878,60 @@ var updateTests = []struct {
 							Alias:  parser.Identifier{Literal: "t1"},
 						},
 						JoinTable: parser.Table{
-							Object: parser.Identifier{Literal: "table2"},
+							Object: parser.Identifier{Literal: "table1"},
 							Alias:  parser.Identifier{Literal: "t2"},
 						},
+						Condition: parser.JoinCondition{
+							On: parser.Comparison{
+								LHS: parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "column1"}},
+								RHS: parser.Arithmetic{
+									LHS:      parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "column1"}},
+									RHS:      parser.NewIntegerValue(1),
+									Operator: '+',
+								},
+								Operator: "=",
+							},
+						},
+					}},
+				},
+			},
+		},
+		Error: "[L:- C:-] value 'update2' to set in the field t2.column2 is ambiguous",
+	},
+	{
+		Name: "Update Query with Values Table",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "t1"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.FieldReference{View: parser.Identifier{Literal: "v"}, Column: parser.Identifier{Literal: "name"}},
+				},
+			},
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Join{
+						Table: parser.Table{
+							Object: parser.Identifier{Literal: "table1"},
+							Alias:  parser.Identifier{Literal: "t1"},
+						},
+						JoinTable: parser.Table{
+							Object: parser.ValuesTable{
+								Values: "values",
+								RowValues: []parser.QueryExpression{
+									parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewIntegerValue(1), parser.NewStringValue("a")}}},
+									parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewIntegerValue(3), parser.NewStringValue("c")}}},
+								},
+							},
+							Alias:  parser.Identifier{Literal: "v"},
+							Fields: []parser.QueryExpression{parser.Identifier{Literal: "id"}, parser.Identifier{Literal: "name"}},
+						},
 						Condition: parser.JoinCondition{
 							On: parser.Comparison{
 								LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
-								RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+								RHS:      parser.FieldReference{View: parser.Identifier{Literal: "v"}, Column: parser.Identifier{Literal: "id"}},
 								Operator: "=",
 							},
 						},
@@ -1947,18 +2939,45 @@ var updateTests = []struct {
 				},
 			},
 		},
-		Error: "[L:- C:-] table notexist is not loaded",
+		Result: []*View{
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("table1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  cmd.UTF8,
+					LineBreak: cmd.LF,
+				},
+				Header: NewHeader("table1", []string{"column1", "column2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("a"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str2"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewString("c"),
+					}),
+				},
+				ForUpdate:       true,
+				OperatedRecords: 2,
+			},
+		},
 	},
 	{
-		Name: "Update Query Update Table Is Not Specified Error",
+		Name: "Update Query with Values Table Record Is Ambiguous Error",
 		Query: parser.UpdateQuery{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Identifier{Literal: "t2"}},
+				parser.Table{Object: parser.Identifier{Literal: "t1"}},
 			},
 			SetList: []parser.UpdateSet{
 				{
-					Field: parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "column2"}},
-					Value: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.FieldReference{View: parser.Identifier{Literal: "v"}, Column: parser.Identifier{Literal: "name"}},
 				},
 			},
 			FromClause: parser.FromClause{
@@ -1969,13 +2988,20 @@ var updateTests = []struct {
 							Alias:  parser.Identifier{Literal: "t1"},
 						},
 						JoinTable: parser.Table{
-							Object: parser.Identifier{Literal: "table2"},
-							Alias:  parser.Identifier{Literal: "t2"},
+							Object: parser.ValuesTable{
+								Values: "values",
+								RowValues: []parser.QueryExpression{
+									parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewIntegerValue(1), parser.NewStringValue("a")}}},
+									parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewIntegerValue(1), parser.NewStringValue("b")}}},
+								},
+							},
+							Alias:  parser.Identifier{Literal: "v"},
+							Fields: []parser.QueryExpression{parser.Identifier{Literal: "id"}, parser.Identifier{Literal: "name"}},
 						},
 						Condition: parser.JoinCondition{
 							On: parser.Comparison{
 								LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
-								RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+								RHS:      parser.FieldReference{View: parser.Identifier{Literal: "v"}, Column: parser.Identifier{Literal: "id"}},
 								Operator: "=",
 							},
 						},
@@ -1983,32 +3009,32 @@ var updateTests = []struct {
 				},
 			},
 		},
-		Error: "[L:- C:-] field t1.column2 does not exist in the tables to update",
+		Error: "[L:- C:-] value v.name to set in the field column2 is ambiguous",
 	},
 	{
-		Name: "Update Query Update Field Error",
+		Name: "Update Query File Does Not Exist Error",
 		Query: parser.UpdateQuery{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Identifier{Literal: "table1"}},
+				parser.Table{Object: parser.Identifier{Literal: "notexist"}},
 			},
 			SetList: []parser.UpdateSet{
 				{
-					Field: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
 					Value: parser.NewStringValue("update"),
 				},
 			},
 			WhereClause: parser.WhereClause{
 				Filter: parser.Comparison{
-					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					LHS:      parser.Identifier{Literal: "column1"},
 					RHS:      parser.NewIntegerValueFromString("2"),
 					Operator: "=",
 				},
 			},
 		},
-		Error: "[L:- C:-] field notexist does not exist",
+		Error: "[L:- C:-] file notexist does not exist",
 	},
 	{
-		Name: "Update Query Update Value Error",
+		Name: "Update Query Filter Error",
 		Query: parser.UpdateQuery{
 			Tables: []parser.QueryExpression{
 				parser.Table{Object: parser.Identifier{Literal: "table1"}},
@@ -2016,12 +3042,12 @@ var updateTests = []struct {
 			SetList: []parser.UpdateSet{
 				{
 					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
-					Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+					Value: parser.NewStringValue("update"),
 				},
 			},
 			WhereClause: parser.WhereClause{
 				Filter: parser.Comparison{
-					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
 					RHS:      parser.NewIntegerValueFromString("2"),
 					Operator: "=",
 				},
@@ -2030,10 +3056,10 @@ var updateTests = []struct {
 		Error: "[L:- C:-] field notexist does not exist",
 	},
 	{
-		Name: "Update Query Record Is Ambiguous Error",
+		Name: "Update Query File Is Not Loaded Error",
 		Query: parser.UpdateQuery{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Identifier{Literal: "t1"}},
+				parser.Table{Object: parser.Identifier{Literal: "notexist"}},
 			},
 			SetList: []parser.UpdateSet{
 				{
@@ -2052,43 +3078,184 @@ var updateTests = []struct {
 							Object: parser.Identifier{Literal: "table2"},
 							Alias:  parser.Identifier{Literal: "t2"},
 						},
-						JoinType: parser.Token{Token: parser.CROSS, Literal: "cross"},
+						Condition: parser.JoinCondition{
+							On: parser.Comparison{
+								LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+								RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+								Operator: "=",
+							},
+						},
 					}},
 				},
 			},
 		},
-		Error: "[L:- C:-] value column4 to set in the field column2 is ambiguous",
+		Error: "[L:- C:-] table notexist is not loaded",
 	},
-}
-
-func TestUpdate(t *testing.T) {
-	tf := cmd.GetFlags()
-	tf.Repository = TestDir
-	tf.Quiet = false
-
-	filter := NewEmptyFilter()
-	filter.TempViews = TemporaryViewScopes{
-		ViewMap{
-			"TMPVIEW": &View{
-				Header: NewHeader("tmpview", []string{"column1", "column2"}),
-				RecordSet: []Record{
-					NewRecord([]value.Primary{
-						value.NewString("1"),
-						value.NewString("str1"),
-					}),
-					NewRecord([]value.Primary{
-						value.NewString("2"),
-						value.NewString("str2"),
-					}),
-				},
-				FileInfo: &FileInfo{
-					Path:        "tmpview",
-					Delimiter:   ',',
-					IsTemporary: true,
-				},
-			},
-		},
-	}
+	{
+		Name: "Update Query Update Table Is Not Specified Error",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "t2"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+				},
+			},
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Join{
+						Table: parser.Table{
+							Object: parser.Identifier{Literal: "table1"},
+							Alias:  parser.Identifier{Literal: "t1"},
+						},
+						JoinTable: parser.Table{
+							Object: parser.Identifier{Literal: "table2"},
+							Alias:  parser.Identifier{Literal: "t2"},
+						},
+						Condition: parser.JoinCondition{
+							On: parser.Comparison{
+								LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+								RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+								Operator: "=",
+							},
+						},
+					}},
+				},
+			},
+		},
+		Error: "[L:- C:-] field t1.column2 does not exist in the tables to update",
+	},
+	{
+		Name: "Update Query Update Field Error",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+					Value: parser.NewStringValue("update"),
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValueFromString("2"),
+					Operator: "=",
+				},
+			},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Update Query Computed Column Error",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+					Value: parser.NewStringValue("update"),
+				},
+			},
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{
+						Object: parser.Identifier{Literal: "table1"},
+						Options: []parser.TableOption{
+							{Name: parser.Identifier{Literal: "add"}, Value: parser.ComputedColumn{Column: parser.Identifier{Literal: "column"}, Name: parser.Identifier{Literal: "column3"}, As: "as", Value: parser.NewIntegerValue(1)}},
+						},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] field column3 is a computed column and cannot be updated",
+	},
+	{
+		Name: "Update Query Update Value Error",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValueFromString("2"),
+					Operator: "=",
+				},
+			},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Update Query Record Is Ambiguous Error",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "t1"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+				},
+			},
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Join{
+						Table: parser.Table{
+							Object: parser.Identifier{Literal: "table1"},
+							Alias:  parser.Identifier{Literal: "t1"},
+						},
+						JoinTable: parser.Table{
+							Object: parser.Identifier{Literal: "table2"},
+							Alias:  parser.Identifier{Literal: "t2"},
+						},
+						JoinType: parser.Token{Token: parser.CROSS, Literal: "cross"},
+					}},
+				},
+			},
+		},
+		Error: "[L:- C:-] value column4 to set in the field column2 is ambiguous",
+	},
+}
+
+func TestUpdate(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.Quiet = false
+
+	filter := NewEmptyFilter()
+	filter.TempViews = TemporaryViewScopes{
+		ViewMap{
+			"TMPVIEW": &View{
+				Header: NewHeader("tmpview", []string{"column1", "column2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("str1"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str2"),
+					}),
+				},
+				FileInfo: &FileInfo{
+					Path:        "tmpview",
+					Delimiter:   ',',
+					IsTemporary: true,
+				},
+			},
+		},
+	}
 
 	for _, v := range updateTests {
 		ReleaseResources()
@@ -2114,6 +3281,8 @@ func TestUpdate(t *testing.T) {
 				file.Close(v2.FileInfo.File)
 				v2.FileInfo.File = nil
 			}
+			v2.FileInfo.ModTime = time.Time{}
+			v2.FileInfo.Size = 0
 		}
 
 		if !reflect.DeepEqual(result, v.Result) {
@@ -2504,6 +3673,8 @@ func TestDelete(t *testing.T) {
 				file.Close(v2.FileInfo.File)
 				v2.FileInfo.File = nil
 			}
+			v2.FileInfo.ModTime = time.Time{}
+			v2.FileInfo.Size = 0
 		}
 
 		if !reflect.DeepEqual(result, v.Result) {
@@ -2712,7 +3883,7 @@ func TestCreateTable(t *testing.T) {
 
 	for _, v := range createTableTests {
 		ReleaseResources()
-		result, err := CreateTable(v.Query, NewEmptyFilter())
+		result, _, err := CreateTable(v.Query, NewEmptyFilter())
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -2738,77 +3909,398 @@ func TestCreateTable(t *testing.T) {
 	ReleaseResources()
 }
 
-var addColumnsTests = []struct {
-	Name         string
-	Query        parser.AddColumns
-	Result       *View
-	ViewCache    ViewMap
-	TempViewList TemporaryViewScopes
-	Error        string
+func TestCreateTable_IfNotExists(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	defer ReleaseResources()
+
+	ReleaseResources()
+	view, created, err := CreateTable(parser.CreateTable{
+		Table:       parser.Identifier{Literal: "table1.csv"},
+		Fields:      []parser.QueryExpression{parser.Identifier{Literal: "column1"}},
+		IfNotExists: true,
+	}, NewEmptyFilter())
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if created {
+		t.Errorf("table1.csv is created, want to be found")
+	}
+	if view.FileInfo.Path != GetTestFilePath("table1.csv") || view.RecordLen() != 3 {
+		t.Errorf("view = %v, want the view of the existing table1.csv", view)
+	}
+
+	ReleaseResources()
+	if _, created, err = CreateTable(parser.CreateTable{
+		Table:       parser.Identifier{Literal: "create_table_1.csv"},
+		Fields:      []parser.QueryExpression{parser.Identifier{Literal: "column1"}},
+		IfNotExists: true,
+	}, NewEmptyFilter()); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !created {
+		t.Errorf("create_table_1.csv is found, want to be created")
+	}
+
+	view, created, err = CreateTable(parser.CreateTable{
+		Table:       parser.Identifier{Literal: "create_table_1.csv"},
+		Fields:      []parser.QueryExpression{parser.Identifier{Literal: "column2"}},
+		IfNotExists: true,
+	}, NewEmptyFilter())
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if created {
+		t.Errorf("create_table_1.csv created in the transaction is created again, want to be found")
+	}
+	if !reflect.DeepEqual(view.Header, NewHeader("create_table_1", []string{"column1"})) {
+		t.Errorf("header = %v, want the header of the table created in the transaction", view.Header)
+	}
+}
+
+var dropTableTests = []struct {
+	Name   string
+	Query  parser.DropTable
+	Path   string
+	Result []Result
+	Error  string
 }{
 	{
-		Name: "Add Columns",
-		Query: parser.AddColumns{
-			Table: parser.Identifier{Literal: "table1.csv"},
-			Columns: []parser.ColumnDefault{
-				{
-					Column: parser.Identifier{Literal: "column3"},
-				},
-				{
-					Column: parser.Identifier{Literal: "column4"},
-				},
-			},
+		Name: "Drop Table",
+		Query: parser.DropTable{
+			Table: parser.Identifier{Literal: "table1"},
 		},
-		Result: &View{
-			FileInfo: &FileInfo{
-				Path:      GetTestFilePath("table1.csv"),
-				Delimiter: ',',
-				NoHeader:  false,
-				Encoding:  cmd.UTF8,
-				LineBreak: cmd.LF,
-			},
-			Header: NewHeader("table1", []string{"column1", "column2", "column3", "column4"}),
-			RecordSet: []Record{
-				NewRecord([]value.Primary{
-					value.NewString("1"),
-					value.NewString("str1"),
-					value.NewNull(),
-					value.NewNull(),
-				}),
-				NewRecord([]value.Primary{
-					value.NewString("2"),
-					value.NewString("str2"),
-					value.NewNull(),
-					value.NewNull(),
-				}),
-				NewRecord([]value.Primary{
-					value.NewString("3"),
-					value.NewString("str3"),
-					value.NewNull(),
-					value.NewNull(),
-				}),
+		Path: GetTestFilePath("table1.csv"),
+	},
+	{
+		Name: "Drop Table Not Exist Error",
+		Query: parser.DropTable{
+			Table: parser.Identifier{Literal: "notexist"},
+		},
+		Error: "[L:- C:-] file notexist does not exist",
+	},
+	{
+		Name: "Drop Table Dropped Table Error",
+		Query: parser.DropTable{
+			Table: parser.Identifier{Literal: "table1"},
+		},
+		Result: []Result{
+			{
+				Type:     DROP_TABLE,
+				FileInfo: &FileInfo{Path: GetTestFilePath("table1.csv")},
 			},
-			ForUpdate:      true,
-			OperatedFields: 2,
 		},
-		ViewCache: ViewMap{
-			strings.ToUpper(GetTestFilePath("table1.csv")): &View{
-				FileInfo: &FileInfo{
-					Path:      GetTestFilePath("table1.csv"),
-					Delimiter: ',',
-					NoHeader:  false,
-					Encoding:  cmd.UTF8,
-					LineBreak: cmd.LF,
-				},
-				Header: NewHeader("table1", []string{"column1", "column2", "column3", "column4"}),
-				RecordSet: []Record{
-					NewRecord([]value.Primary{
-						value.NewString("1"),
-						value.NewString("str1"),
-						value.NewNull(),
-						value.NewNull(),
-					}),
-					NewRecord([]value.Primary{
+		Error: "[L:- C:-] file table1 does not exist",
+	},
+	{
+		Name: "Drop Table Temporary Table Error",
+		Query: parser.DropTable{
+			Table: parser.Identifier{Literal: "tmpview"},
+		},
+		Error: "[L:- C:-] temporary table tmpview cannot be dropped",
+	},
+}
+
+func TestDropTable(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	defer func() {
+		ReleaseResources()
+		Results = []Result{}
+	}()
+
+	filter := NewEmptyFilter()
+	filter.TempViews[0].Set(&View{
+		Header:    NewHeader("tmpview", []string{"column1"}),
+		RecordSet: RecordSet{},
+		FileInfo:  &FileInfo{Path: "tmpview", IsTemporary: true},
+	})
+
+	for _, v := range dropTableTests {
+		ReleaseResources()
+		Results = v.Result
+
+		result, err := DropTable(v.Query, filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result.FileInfo.Path != v.Path {
+			t.Errorf("%s: path = %q, want %q", v.Name, result.FileInfo.Path, v.Path)
+		}
+		if result.FileInfo.File == nil {
+			t.Errorf("%s: file is not locked", v.Name)
+		}
+	}
+}
+
+var renameTableTests = []struct {
+	Name    string
+	Query   parser.RenameTable
+	Path    string
+	OldPath string
+	Result  []Result
+	Error   string
+}{
+	{
+		Name: "Rename Table",
+		Query: parser.RenameTable{
+			Table:   parser.Identifier{Literal: "table1"},
+			NewName: parser.Identifier{Literal: "renamed.csv"},
+		},
+		Path:    GetTestFilePath("renamed.csv"),
+		OldPath: GetTestFilePath("table1.csv"),
+	},
+	{
+		Name: "Rename Table File Already Exist Error",
+		Query: parser.RenameTable{
+			Table:   parser.Identifier{Literal: "table1"},
+			NewName: parser.Identifier{Literal: "table2.csv"},
+		},
+		Error: "[L:- C:-] file table2.csv already exists",
+	},
+	{
+		Name: "Rename Table File Already Exist Error With Quoted Identifier",
+		Query: parser.RenameTable{
+			Table:   parser.Identifier{Literal: "table1"},
+			NewName: parser.Identifier{Literal: "table2.csv", Quoted: true},
+		},
+		Error: "[L:- C:-] file table2.csv already exists",
+	},
+	{
+		Name: "Rename Table Overwrite",
+		Query: parser.RenameTable{
+			Table:     parser.Identifier{Literal: "table1"},
+			NewName:   parser.Identifier{Literal: "table2.csv"},
+			Overwrite: true,
+		},
+		Path:    GetTestFilePath("table2.csv"),
+		OldPath: GetTestFilePath("table1.csv"),
+	},
+	{
+		Name: "Rename Table to Dropped File",
+		Query: parser.RenameTable{
+			Table:   parser.Identifier{Literal: "table1"},
+			NewName: parser.Identifier{Literal: "table2.csv"},
+		},
+		Result: []Result{
+			{
+				Type:     DROP_TABLE,
+				FileInfo: &FileInfo{Path: GetTestFilePath("table2.csv")},
+			},
+		},
+		Path:    GetTestFilePath("table2.csv"),
+		OldPath: GetTestFilePath("table1.csv"),
+	},
+	{
+		Name: "Rename Table Same Path Error",
+		Query: parser.RenameTable{
+			Table:     parser.Identifier{Literal: "table1"},
+			NewName:   parser.Identifier{Literal: "table1.csv"},
+			Overwrite: true,
+		},
+		Error: "[L:- C:-] file table1.csv already exists",
+	},
+	{
+		Name: "Rename Table Renamed Table Error",
+		Query: parser.RenameTable{
+			Table:   parser.Identifier{Literal: "table1"},
+			NewName: parser.Identifier{Literal: "renamed.csv"},
+		},
+		Result: []Result{
+			{
+				Type:     RENAME_TABLE,
+				FileInfo: &FileInfo{Path: GetTestFilePath("table1b.csv")},
+				OldPath:  GetTestFilePath("table1.csv"),
+			},
+		},
+		Error: "[L:- C:-] file table1 does not exist",
+	},
+	{
+		Name: "Rename Table Temporary Table Error",
+		Query: parser.RenameTable{
+			Table:   parser.Identifier{Literal: "tmpview"},
+			NewName: parser.Identifier{Literal: "renamed.csv"},
+		},
+		Error: "[L:- C:-] temporary table tmpview cannot be renamed",
+	},
+}
+
+func TestRenameTable(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	defer func() {
+		ReleaseResources()
+		Results = []Result{}
+	}()
+
+	filter := NewEmptyFilter()
+	filter.TempViews[0].Set(&View{
+		Header:    NewHeader("tmpview", []string{"column1"}),
+		RecordSet: RecordSet{},
+		FileInfo:  &FileInfo{Path: "tmpview", IsTemporary: true},
+	})
+
+	for _, v := range renameTableTests {
+		ReleaseResources()
+		Results = v.Result
+
+		result, oldPath, err := RenameTable(v.Query, filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result.FileInfo.Path != v.Path {
+			t.Errorf("%s: path = %q, want %q", v.Name, result.FileInfo.Path, v.Path)
+		}
+		if oldPath != v.OldPath {
+			t.Errorf("%s: old path = %q, want %q", v.Name, oldPath, v.OldPath)
+		}
+		if ViewCache.Exists(v.OldPath) {
+			t.Errorf("%s: view of the original path remains in the cache", v.Name)
+		}
+		if view, err := ViewCache.Get(parser.Identifier{Literal: v.Path}); err != nil {
+			t.Errorf("%s: view is not moved in the cache", v.Name)
+		} else if view.Header[0].View != parser.FormatTableName(v.Path) {
+			t.Errorf("%s: view name = %q, want %q", v.Name, view.Header[0].View, parser.FormatTableName(v.Path))
+		}
+	}
+}
+
+func TestRenameTable_UseNewName(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	defer func() {
+		ReleaseResources()
+		Results = []Result{}
+	}()
+
+	ReleaseResources()
+	filter := NewEmptyFilter()
+
+	renamed, oldPath, err := RenameTable(parser.RenameTable{
+		Table:   parser.Identifier{Literal: "table1"},
+		NewName: parser.Identifier{Literal: "renamed.csv"},
+	}, filter)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	Results = []Result{
+		{
+			Type:     RENAME_TABLE,
+			FileInfo: renamed.FileInfo,
+			OldPath:  oldPath,
+		},
+	}
+
+	view := NewView()
+	view.ForUpdate = true
+	if err := view.LoadFromTableIdentifier(parser.Identifier{Literal: "renamed"}, filter.CreateNode()); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if view.FileInfo.Path != GetTestFilePath("renamed.csv") {
+		t.Errorf("path = %q, want %q", view.FileInfo.Path, GetTestFilePath("renamed.csv"))
+	}
+	if view.RecordLen() != 3 {
+		t.Errorf("record length = %d, want %d", view.RecordLen(), 3)
+	}
+
+	err = NewView().LoadFromTableIdentifier(parser.Identifier{Literal: "table1"}, filter.CreateNode())
+	expectErr := "[L:- C:-] file table1 does not exist"
+	if err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error %q, want error %q", err.Error(), expectErr)
+	}
+}
+
+var addColumnsTests = []struct {
+	Name         string
+	Query        parser.AddColumns
+	Result       *View
+	ViewCache    ViewMap
+	TempViewList TemporaryViewScopes
+	Error        string
+}{
+	{
+		Name: "Add Columns",
+		Query: parser.AddColumns{
+			Table: parser.Identifier{Literal: "table1.csv"},
+			Columns: []parser.ColumnDefault{
+				{
+					Column: parser.Identifier{Literal: "column3"},
+				},
+				{
+					Column: parser.Identifier{Literal: "column4"},
+				},
+			},
+		},
+		Result: &View{
+			FileInfo: &FileInfo{
+				Path:      GetTestFilePath("table1.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  cmd.UTF8,
+				LineBreak: cmd.LF,
+			},
+			Header: NewHeader("table1", []string{"column1", "column2", "column3", "column4"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewNull(),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewNull(),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+					value.NewNull(),
+					value.NewNull(),
+				}),
+			},
+			ForUpdate:      true,
+			OperatedFields: 2,
+		},
+		ViewCache: ViewMap{
+			strings.ToUpper(GetTestFilePath("table1.csv")): &View{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("table1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  cmd.UTF8,
+					LineBreak: cmd.LF,
+				},
+				Header: NewHeader("table1", []string{"column1", "column2", "column3", "column4"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("str1"),
+						value.NewNull(),
+						value.NewNull(),
+					}),
+					NewRecord([]value.Primary{
 						value.NewString("2"),
 						value.NewString("str2"),
 						value.NewNull(),
@@ -3166,6 +4658,8 @@ func TestAddColumns(t *testing.T) {
 				file.Close(v2.FileInfo.File)
 				v2.FileInfo.File = nil
 			}
+			v2.FileInfo.ModTime = time.Time{}
+			v2.FileInfo.Size = 0
 		}
 
 		if !reflect.DeepEqual(result, v.Result) {
@@ -3375,6 +4869,8 @@ func TestDropColumns(t *testing.T) {
 				file.Close(v2.FileInfo.File)
 				v2.FileInfo.File = nil
 			}
+			v2.FileInfo.ModTime = time.Time{}
+			v2.FileInfo.Size = 0
 		}
 
 		if !reflect.DeepEqual(result, v.Result) {
@@ -3599,6 +5095,8 @@ func TestRenameColumn(t *testing.T) {
 				file.Close(v2.FileInfo.File)
 				v2.FileInfo.File = nil
 			}
+			v2.FileInfo.ModTime = time.Time{}
+			v2.FileInfo.Size = 0
 		}
 
 		if !reflect.DeepEqual(result, v.Result) {
@@ -3619,79 +5117,768 @@ func TestRenameColumn(t *testing.T) {
 	ReleaseResources()
 }
 
-func TestCommit(t *testing.T) {
-	cmd.SetQuiet(false)
-
-	fp, _ := file.OpenToUpdate(GetTestFilePath("updated_file_1.csv"))
-
-	ViewCache = ViewMap{
-		strings.ToUpper(GetTestFilePath("created_file.csv")): &View{
-			Header:    NewHeader("created_file", []string{"column1", "column2"}),
-			RecordSet: RecordSet{},
+var deduplicateTests = []struct {
+	Name         string
+	Query        parser.Deduplicate
+	Result       *View
+	TempViewList TemporaryViewScopes
+	Error        string
+}{
+	{
+		Name: "Deduplicate",
+		Query: parser.Deduplicate{
+			Table: parser.Identifier{Literal: "tmpview"},
+		},
+		Result: &View{
 			FileInfo: &FileInfo{
-				Path: GetTestFilePath("created_file.csv"),
+				Path:        "tmpview",
+				Delimiter:   ',',
+				IsTemporary: true,
 			},
-		},
-		strings.ToUpper(GetTestFilePath("updated_file_1.csv")): &View{
-			Header: NewHeader("table1", []string{"column1", "column2"}),
+			Header: NewHeader("tmpview", []string{"column1", "column2"}),
 			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
 				NewRecord([]value.Primary{
 					value.NewString("1"),
 					value.NewString("str1"),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("update1"),
-					value.NewString("update2"),
+					value.NewString("1"),
+					value.NewNull(),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("3"),
-					value.NewString("str3"),
+					value.NewString("01"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("STR1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
 				}),
 			},
-			FileInfo: &FileInfo{
-				Path: GetTestFilePath("updated_file_1.csv"),
-				File: fp,
-			},
-		},
-	}
-
-	Results = []Result{
-		{
-			Type: CREATE_TABLE,
-			FileInfo: &FileInfo{
-				Path: GetTestFilePath("created_file.csv"),
-			},
-		},
-		{
-			Type: UPDATE,
-			FileInfo: &FileInfo{
-				Path: GetTestFilePath("updated_file_1.csv"),
-				File: fp,
-			},
-			OperatedCount: 1,
+			ForUpdate:       true,
+			OperatedRecords: 3,
 		},
-		{
-			Type: UPDATE,
-			FileInfo: &FileInfo{
-				Path: GetTestFilePath("updated_file_2.csv"),
+		TempViewList: TemporaryViewScopes{
+			ViewMap{
+				"TMPVIEW": &View{
+					Header: NewHeader("tmpview", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						NewRecord([]value.Primary{
+							value.NewString("2"),
+							value.NewString("str2"),
+						}),
+						NewRecord([]value.Primary{
+							value.NewString("1"),
+							value.NewString("str1"),
+						}),
+						NewRecord([]value.Primary{
+							value.NewString("1"),
+							value.NewNull(),
+						}),
+						NewRecord([]value.Primary{
+							value.NewString("01"),
+							value.NewString("str1"),
+						}),
+						NewRecord([]value.Primary{
+							value.NewString("1"),
+							value.NewString("STR1"),
+						}),
+						NewRecord([]value.Primary{
+							value.NewInteger(1),
+							value.NewString("str1"),
+						}),
+					},
+					FileInfo: &FileInfo{
+						Path:        "tmpview",
+						Delimiter:   ',',
+						IsTemporary: true,
+					},
+					ForUpdate:       true,
+					OperatedRecords: 3,
+				},
+			},
+		},
+	},
+	{
+		Name: "Deduplicate Load Error",
+		Query: parser.Deduplicate{
+			Table: parser.Identifier{Literal: "notexist"},
+		},
+		Error: "[L:- C:-] file notexist does not exist",
+	},
+}
+
+func TestDeduplicate(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.Quiet = false
+
+	filter := NewEmptyFilter()
+	filter.TempViews = TemporaryViewScopes{
+		ViewMap{
+			"TMPVIEW": &View{
+				Header: NewHeader("tmpview", []string{"column1", "column2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str2"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("str1"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str2"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewNull(),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewNull(),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str2"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("01"),
+						value.NewString("str1"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("STR1"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewInteger(1),
+						value.NewString("str1"),
+					}),
+				},
+				FileInfo: &FileInfo{
+					Path:        "tmpview",
+					Delimiter:   ',',
+					IsTemporary: true,
+				},
+			},
+		},
+	}
+
+	for _, v := range deduplicateTests {
+		ReleaseResources()
+		result, err := Deduplicate(v.Query, filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+
+		if v.TempViewList != nil {
+			if !reflect.DeepEqual(filter.TempViews, v.TempViewList) {
+				t.Errorf("%s: temporary views list = %v, want %v", v.Name, filter.TempViews, v.TempViewList)
+			}
+		}
+	}
+	ReleaseResources()
+}
+
+var diffTests = []struct {
+	Name     string
+	Query    parser.Diff
+	Header   Header
+	Result   RecordSet
+	Warnings []string
+	Error    string
+}{
+	{
+		Name: "Diff",
+		Query: parser.Diff{
+			Table:   parser.Identifier{Literal: "tmpview1"},
+			Against: parser.Identifier{Literal: "tmpview2"},
+			Keys:    []parser.QueryExpression{parser.Identifier{Literal: "id"}},
+		},
+		Header: []HeaderField{
+			{Column: "status"},
+			{View: "tmpview1", Column: "id"},
+			{View: "tmpview1", Column: "tmpview1.column1"},
+			{View: "tmpview2", Column: "tmpview2.column1"},
+		},
+		Result: RecordSet{
+			NewRecord([]value.Primary{
+				value.NewString("changed"),
+				value.NewString("2"),
+				value.NewString("str2"),
+				value.NewString("str22"),
+			}),
+			NewRecord([]value.Primary{
+				value.NewString("removed"),
+				value.NewString("3"),
+				value.NewNull(),
+				value.NewNull(),
+			}),
+			NewRecord([]value.Primary{
+				value.NewString("added"),
+				value.NewInteger(4),
+				value.NewNull(),
+				value.NewString("str4"),
+			}),
+		},
+		Warnings: []string{
+			"Warning: [L:- C:-] column column2 in table tmpview1 is not compared because it does not exist in table tmpview2",
+		},
+	},
+	{
+		Name: "Diff Letter Case and Number Format",
+		Query: parser.Diff{
+			Table:   parser.Identifier{Literal: "tmpview3"},
+			Against: parser.Identifier{Literal: "tmpview4"},
+			Keys:    []parser.QueryExpression{parser.Identifier{Literal: "id"}},
+		},
+		Header: []HeaderField{
+			{Column: "status"},
+			{View: "tmpview3", Column: "id"},
+			{View: "tmpview3", Column: "tmpview3.column1"},
+			{View: "tmpview4", Column: "tmpview4.column1"},
+			{View: "tmpview3", Column: "tmpview3.column2"},
+			{View: "tmpview4", Column: "tmpview4.column2"},
+		},
+		Result: RecordSet{
+			NewRecord([]value.Primary{
+				value.NewString("changed"),
+				value.NewString("1"),
+				value.NewString("str1"),
+				value.NewString("STR1"),
+				value.NewString("1"),
+				value.NewString("1"),
+			}),
+			NewRecord([]value.Primary{
+				value.NewString("changed"),
+				value.NewString("2"),
+				value.NewString("str2"),
+				value.NewString("str2"),
+				value.NewString("1"),
+				value.NewString("01"),
+			}),
+		},
+	},
+	{
+		Name: "Diff Key Not Exist Error",
+		Query: parser.Diff{
+			Table:   parser.Identifier{Literal: "tmpview1"},
+			Against: parser.Identifier{Literal: "tmpview2"},
+			Keys:    []parser.QueryExpression{parser.Identifier{Literal: "notexist"}},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Diff Duplicate Key Error",
+		Query: parser.Diff{
+			Table:   parser.Identifier{Literal: "tmpview1"},
+			Against: parser.Identifier{Literal: "tmpview1"},
+			Keys:    []parser.QueryExpression{parser.Identifier{Literal: "column2"}},
+		},
+		Error: "[L:- C:-] DIFF: key values in table tmpview1 are duplicated",
+	},
+	{
+		Name: "Diff Load Error",
+		Query: parser.Diff{
+			Table:   parser.Identifier{Literal: "notexist"},
+			Against: parser.Identifier{Literal: "tmpview2"},
+			Keys:    []parser.QueryExpression{parser.Identifier{Literal: "id"}},
+		},
+		Error: "[L:- C:-] file notexist does not exist",
+	},
+}
+
+func TestDiff(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+
+	filter := NewEmptyFilter()
+	filter.TempViews = TemporaryViewScopes{
+		ViewMap{
+			"TMPVIEW1": &View{
+				Header: NewHeader("tmpview1", []string{"id", "column1", "column2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("str1"),
+						value.NewString("a"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str2"),
+						value.NewString("a"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewNull(),
+						value.NewString("b"),
+					}),
+				},
+				FileInfo: &FileInfo{
+					Path:        "tmpview1",
+					Delimiter:   ',',
+					IsTemporary: true,
+				},
+			},
+			"TMPVIEW2": &View{
+				Header: NewHeader("tmpview2", []string{"column1", "id"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("str4"),
+						value.NewInteger(4),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("str22"),
+						value.NewInteger(2),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("str1"),
+						value.NewInteger(1),
+					}),
+				},
+				FileInfo: &FileInfo{
+					Path:        "tmpview2",
+					Delimiter:   ',',
+					IsTemporary: true,
+				},
+			},
+			"TMPVIEW3": &View{
+				Header: NewHeader("tmpview3", []string{"id", "column1", "column2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("str1"),
+						value.NewString("1"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str2"),
+						value.NewString("1"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewString("str3"),
+						value.NewString("1"),
+					}),
+				},
+				FileInfo: &FileInfo{
+					Path:        "tmpview3",
+					Delimiter:   ',',
+					IsTemporary: true,
+				},
+			},
+			"TMPVIEW4": &View{
+				Header: NewHeader("tmpview4", []string{"id", "column1", "column2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("STR1"),
+						value.NewString("1"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str2"),
+						value.NewString("01"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewString("str3"),
+						value.NewString("1"),
+					}),
+				},
+				FileInfo: &FileInfo{
+					Path:        "tmpview4",
+					Delimiter:   ',',
+					IsTemporary: true,
+				},
+			},
+		},
+	}
+
+	for _, v := range diffTests {
+		ReleaseResources()
+		Warnings.Clear()
+		result, err := Diff(v.Query, filter)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if !reflect.DeepEqual(result.Header, v.Header) {
+			t.Errorf("%s: header = %v, want %v", v.Name, result.Header, v.Header)
+		}
+		if !reflect.DeepEqual(result.RecordSet, v.Result) {
+			t.Errorf("%s: records = %s, want %s", v.Name, result.RecordSet, v.Result)
+		}
+		warnings := make([]string, 0, Warnings.Len())
+		for _, w := range Warnings.List() {
+			warnings = append(warnings, w.String())
+		}
+		if 0 < len(warnings) || 0 < len(v.Warnings) {
+			if !reflect.DeepEqual(warnings, v.Warnings) {
+				t.Errorf("%s: warnings = %q, want %q", v.Name, warnings, v.Warnings)
+			}
+		}
+	}
+	Warnings.Clear()
+	ReleaseResources()
+}
+
+func TestCommit(t *testing.T) {
+	cmd.SetQuiet(false)
+
+	fp, _ := file.OpenToUpdate(GetTestFilePath("updated_file_1.csv"))
+
+	ViewCache = ViewMap{
+		strings.ToUpper(GetTestFilePath("created_file.csv")): &View{
+			Header:    NewHeader("created_file", []string{"column1", "column2"}),
+			RecordSet: RecordSet{},
+			FileInfo: &FileInfo{
+				Path: GetTestFilePath("created_file.csv"),
+			},
+		},
+		strings.ToUpper(GetTestFilePath("updated_file_1.csv")): &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("update1"),
+					value.NewString("update2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path: GetTestFilePath("updated_file_1.csv"),
+				File: fp,
+			},
+		},
+	}
+
+	Results = []Result{
+		{
+			Type: CREATE_TABLE,
+			FileInfo: &FileInfo{
+				Path: GetTestFilePath("created_file.csv"),
+			},
+		},
+		{
+			Type: UPDATE,
+			FileInfo: &FileInfo{
+				Path: GetTestFilePath("updated_file_1.csv"),
+				File: fp,
+			},
+			OperatedCount: 1,
+		},
+		{
+			Type: UPDATE,
+			FileInfo: &FileInfo{
+				Path: GetTestFilePath("updated_file_2.csv"),
+			},
+		},
+	}
+	expect := fmt.Sprintf("Commit: file %q is created.\nCommit: file %q is updated.\n", GetTestFilePath("created_file.csv"), GetTestFilePath("updated_file_1.csv"))
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	Commit(parser.TransactionControl{Token: parser.COMMIT}, NewEmptyFilter())
+
+	w.Close()
+	os.Stdout = oldStdout
+	log, _ := ioutil.ReadAll(r)
+
+	if string(log) != expect {
+		t.Errorf("Commit: log = %q, want %q", string(log), expect)
+	}
+}
+
+func TestCommit_DropTable(t *testing.T) {
+	cmd.SetQuiet(false)
+
+	fpath := GetTestFilePath("dropped_file.csv")
+	if err := ioutil.WriteFile(fpath, []byte("column1,column2\n1,str1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(fpath)
+
+	fp, _ := file.OpenToUpdate(fpath)
+	fileInfo := &FileInfo{
+		Path: fpath,
+		File: fp,
+	}
+	fileInfo.SetFileState(fp)
+
+	ViewCache = ViewMap{
+		strings.ToUpper(fpath): &View{
+			Header:    NewHeader("dropped_file", []string{"column1", "column2"}),
+			RecordSet: RecordSet{},
+			FileInfo:  fileInfo,
+		},
+	}
+	Results = []Result{
+		{
+			Type:     DROP_TABLE,
+			FileInfo: fileInfo,
+		},
+		{
+			Type: CREATE_TABLE,
+			FileInfo: &FileInfo{
+				Path: GetTestFilePath("created_and_dropped_file.csv"),
+			},
+		},
+		{
+			Type: DROP_TABLE,
+			FileInfo: &FileInfo{
+				Path: GetTestFilePath("created_and_dropped_file.csv"),
 			},
 		},
 	}
-	expect := fmt.Sprintf("Commit: file %q is created.\nCommit: file %q is updated.\n", GetTestFilePath("created_file.csv"), GetTestFilePath("updated_file_1.csv"))
+	expect := fmt.Sprintf("Commit: file %q is deleted.\n", fpath)
 
 	oldStdout := os.Stdout
 	r, w, _ := os.Pipe()
 	os.Stdout = w
 
-	Commit(parser.TransactionControl{Token: parser.COMMIT}, NewEmptyFilter())
+	err := Commit(parser.TransactionControl{Token: parser.COMMIT}, NewEmptyFilter())
 
 	w.Close()
 	os.Stdout = oldStdout
 	log, _ := ioutil.ReadAll(r)
 
+	if err != nil {
+		t.Fatalf("Commit: unexpected error %q", err)
+	}
 	if string(log) != expect {
 		t.Errorf("Commit: log = %q, want %q", string(log), expect)
 	}
+	if _, err := os.Stat(fpath); err == nil {
+		t.Errorf("Commit: file %q is not deleted", fpath)
+	}
+	if _, err := os.Stat(GetTestFilePath("created_and_dropped_file.csv")); err == nil {
+		t.Errorf("Commit: file %q is created", GetTestFilePath("created_and_dropped_file.csv"))
+	}
+}
+
+func TestCommit_RenameTable(t *testing.T) {
+	cmd.SetQuiet(true)
+	defer cmd.SetQuiet(false)
+
+	path1 := GetTestFilePath("renamed_file_1.csv")
+	path2 := GetTestFilePath("renamed_file_2.csv")
+	fileInfos := make([]*FileInfo, 2)
+	for i, fpath := range []string{path1, path2} {
+		if err := ioutil.WriteFile(fpath, []byte(fmt.Sprintf("column1\n%d\n", i+1)), 0644); err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(fpath)
+
+		fp, _ := file.OpenToUpdate(fpath)
+		fileInfos[i] = &FileInfo{
+			Path: fpath,
+			File: fp,
+		}
+		fileInfos[i].SetFileState(fp)
+	}
+
+	temp := *fileInfos[0]
+	temp.Path = GetTestFilePath("renamed_temp.csv")
+	swapped1 := *fileInfos[1]
+	swapped1.Path = path1
+	swapped2 := temp
+	swapped2.Path = path2
+
+	ViewCache = ViewMap{
+		strings.ToUpper(path1): &View{
+			Header:    NewHeader("renamed_file_1", []string{"column1"}),
+			RecordSet: RecordSet{},
+			FileInfo:  &swapped1,
+		},
+		strings.ToUpper(path2): &View{
+			Header:    NewHeader("renamed_file_2", []string{"column1"}),
+			RecordSet: RecordSet{},
+			FileInfo:  &swapped2,
+		},
+	}
+	Results = []Result{
+		{
+			Type:     RENAME_TABLE,
+			FileInfo: &temp,
+			OldPath:  path1,
+		},
+		{
+			Type:     RENAME_TABLE,
+			FileInfo: &swapped1,
+			OldPath:  path2,
+		},
+		{
+			Type:     RENAME_TABLE,
+			FileInfo: &swapped2,
+			OldPath:  temp.Path,
+		},
+	}
+
+	if err := Commit(parser.TransactionControl{Token: parser.COMMIT}, NewEmptyFilter()); err != nil {
+		t.Fatalf("Commit: unexpected error %q", err)
+	}
+
+	for i, fpath := range []string{path2, path1} {
+		expect := fmt.Sprintf("column1\n%d\n", i+1)
+		if b, _ := ioutil.ReadFile(fpath); string(b) != expect {
+			t.Errorf("Commit: content of file %q = %q, want %q", fpath, string(b), expect)
+		}
+	}
+	if _, err := os.Stat(temp.Path); err == nil {
+		t.Errorf("Commit: file %q is created", temp.Path)
+	}
+}
+
+func TestCommit_LineBreak(t *testing.T) {
+	cmd.SetQuiet(true)
+	defer func() {
+		cmd.SetQuiet(false)
+		initFlag()
+	}()
+
+	flags := cmd.GetFlags()
+	flags.LineBreak = cmd.LF
+
+	fpath := GetTestFilePath("line_break_file.csv")
+	defer os.Remove(fpath)
+
+	for _, preserve := range []bool{true, false} {
+		flags.PreserveLineBreak = preserve
+
+		if err := ioutil.WriteFile(fpath, []byte("column1,column2\r\n1,str1\r\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		fp, _ := file.OpenToUpdate(fpath)
+		fileInfo := &FileInfo{
+			Path:      fpath,
+			File:      fp,
+			Delimiter: ',',
+			Encoding:  cmd.UTF8,
+			LineBreak: cmd.CRLF,
+		}
+		fileInfo.SetFileState(fp)
+
+		ViewCache = ViewMap{
+			strings.ToUpper(fpath): &View{
+				Header: NewHeader("line_break_file", []string{"column1", "column2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("update1"),
+					}),
+				},
+				FileInfo: fileInfo,
+			},
+		}
+		Results = []Result{
+			{
+				Type:          UPDATE,
+				FileInfo:      fileInfo,
+				OperatedCount: 1,
+			},
+		}
+
+		if err := Commit(parser.TransactionControl{Token: parser.COMMIT}, NewEmptyFilter()); err != nil {
+			t.Fatalf("Commit: unexpected error %q", err)
+		}
+
+		expect := "\"column1\",\"column2\"\r\n\"1\",\"update1\""
+		if !preserve {
+			expect = "\"column1\",\"column2\"\n\"1\",\"update1\""
+		}
+		if b, _ := ioutil.ReadFile(fpath); string(b) != expect {
+			t.Errorf("Commit with preserve-linebreak %t: content = %q, want %q", preserve, string(b), expect)
+		}
+	}
+}
+
+func TestCommit_FileModified(t *testing.T) {
+	fpath := GetTestFilePath("modified_file.csv")
+	if err := ioutil.WriteFile(fpath, []byte("column1,column2\n1,str1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(fpath)
+
+	fp, _ := file.OpenToUpdate(fpath)
+	fileInfo := &FileInfo{
+		Path: fpath,
+		File: fp,
+	}
+	fileInfo.SetFileState(fp)
+
+	ViewCache = ViewMap{
+		strings.ToUpper(fpath): &View{
+			Header: NewHeader("modified_file", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("update1"),
+				}),
+			},
+			FileInfo: fileInfo,
+		},
+	}
+	Results = []Result{
+		{
+			Type:          UPDATE,
+			FileInfo:      fileInfo,
+			OperatedCount: 1,
+		},
+	}
+
+	if err := ioutil.WriteFile(fpath, []byte("column1,column2\n1,str1\n2,str2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := fmt.Sprintf("[L:- C:-] file %s has been modified by another process since it was loaded", fpath)
+	err := Commit(parser.TransactionControl{Token: parser.COMMIT}, NewEmptyFilter())
+	if err == nil {
+		t.Errorf("Commit: no error, want error %q", expect)
+	} else if err.Error() != expect {
+		t.Errorf("Commit: error %q, want error %q", err.Error(), expect)
+	}
+
+	ReleaseResources()
 }
 
 func TestRollback(t *testing.T) {
@@ -3717,6 +5904,26 @@ func TestRollback(t *testing.T) {
 				Path: "updated_file_2.csv",
 			},
 		},
+		{
+			Type: DROP_TABLE,
+			FileInfo: &FileInfo{
+				Path: "created_file.csv",
+			},
+		},
+		{
+			Type: RENAME_TABLE,
+			FileInfo: &FileInfo{
+				Path: "renamed_file.csv",
+			},
+			OldPath: "updated_file_1.csv",
+		},
+		{
+			Type: UPDATE,
+			FileInfo: &FileInfo{
+				Path: "renamed_file.csv",
+			},
+			OperatedCount: 1,
+		},
 	}
 	expect := "Rollback: file \"created_file.csv\" is deleted.\nRollback: file \"updated_file_1.csv\" is restored.\n"
 
@@ -3734,3 +5941,354 @@ func TestRollback(t *testing.T) {
 		t.Errorf("Rollback: log = %q, want %q", string(log), expect)
 	}
 }
+
+func TestEncodeResultSummary(t *testing.T) {
+	results := []Result{
+		{
+			Type:          INSERT,
+			FileInfo:      &FileInfo{Path: "table1.csv"},
+			OperatedCount: 2,
+			Statement:     1,
+		},
+		{
+			Type:          UPDATE,
+			FileInfo:      &FileInfo{Path: "table1.csv"},
+			OperatedCount: 1,
+			Statement:     2,
+		},
+		{
+			Type:      CREATE_TABLE,
+			FileInfo:  &FileInfo{Path: "newtable.csv"},
+			Statement: 3,
+		},
+	}
+	expect := "{\"statement\":1,\"file\":\"table1.csv\",\"affected\":2,\"type\":\"INSERT\"}\n" +
+		"{\"statement\":2,\"file\":\"table1.csv\",\"affected\":1,\"type\":\"UPDATE\"}\n" +
+		"{\"statement\":3,\"file\":\"newtable.csv\",\"affected\":0,\"type\":\"CREATE TABLE\"}"
+
+	result := EncodeResultSummary(results)
+	if result != expect {
+		t.Errorf("result = %q, want %q", result, expect)
+	}
+}
+
+func TestRollbackToSavepoint(t *testing.T) {
+	cmd.SetQuiet(true)
+	defer func() {
+		cmd.SetQuiet(false)
+		ViewCache.Clean()
+		Results = []Result{}
+		Savepoints = []Savepoint{}
+	}()
+
+	newView := func(path string, s string) *View {
+		return &View{
+			Header: NewHeader("table1", []string{"column1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString(s)}),
+			},
+			FileInfo: &FileInfo{Path: path},
+		}
+	}
+
+	filter := NewEmptyFilter()
+	filter.TempViews[0].Set(newView("tmp", "tmp1"))
+	ViewCache.Set(newView("table1.csv", "str1"))
+	Results = []Result{{Type: UPDATE, FileInfo: &FileInfo{Path: "table1.csv"}, OperatedCount: 1}}
+
+	SetSavepoint(parser.Identifier{Literal: "sp1"}, filter)
+
+	ViewCache.Replace(newView("table1.csv", "str2"))
+	ViewCache.Set(newView("table2.csv", "str3"))
+	filter.TempViews.Replace(newView("tmp", "tmp2"))
+	Results = append(Results, Result{Type: UPDATE, FileInfo: &FileInfo{Path: "table1.csv"}, OperatedCount: 1})
+
+	SetSavepoint(parser.Identifier{Literal: "sp2"}, filter)
+
+	err := RollbackToSavepoint(parser.Identifier{Literal: "notexist"}, filter)
+	expectErr := "[L:- C:-] savepoint notexist does not exist"
+	if err == nil {
+		t.Fatalf("RollbackToSavepoint: no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Fatalf("RollbackToSavepoint: error %q, want error %q", err.Error(), expectErr)
+	}
+
+	if err = RollbackToSavepoint(parser.Identifier{Literal: "SP1"}, filter); err != nil {
+		t.Fatalf("RollbackToSavepoint: unexpected error %q", err)
+	}
+
+	if len(Results) != 1 {
+		t.Errorf("RollbackToSavepoint: result length = %d, want %d", len(Results), 1)
+	}
+	if len(Savepoints) != 1 {
+		t.Errorf("RollbackToSavepoint: savepoint length = %d, want %d", len(Savepoints), 1)
+	}
+	if ViewCache.Exists("table2.csv") {
+		t.Errorf("RollbackToSavepoint: view %q exists, want disposed", "table2.csv")
+	}
+	view, _ := ViewCache.Get(parser.Identifier{Literal: "table1.csv"})
+	if !reflect.DeepEqual(view.RecordSet, newView("table1.csv", "str1").RecordSet) {
+		t.Errorf("RollbackToSavepoint: records = %s, want %s", view.RecordSet, newView("table1.csv", "str1").RecordSet)
+	}
+	view, _ = filter.TempViews.Get(parser.Identifier{Literal: "tmp"})
+	if !reflect.DeepEqual(view.RecordSet, newView("tmp", "tmp1").RecordSet) {
+		t.Errorf("RollbackToSavepoint: temporary view records = %s, want %s", view.RecordSet, newView("tmp", "tmp1").RecordSet)
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	initFlag()
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	tf.ReadOnly = true
+	defer func() {
+		tf.ReadOnly = false
+	}()
+
+	filter := NewEmptyFilter()
+
+	_, err := Insert(parser.InsertQuery{
+		Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
+		ValuesList: []parser.QueryExpression{
+			parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewIntegerValueFromString("4")}}},
+		},
+	}, filter)
+	expectErr := "[L:- C:-] INSERT cannot be executed in read-only mode"
+	if err == nil {
+		t.Errorf("Insert: no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("Insert: error %q, want error %q", err.Error(), expectErr)
+	}
+
+	_, _, err = CreateTable(parser.CreateTable{
+		Table:  parser.Identifier{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 14}), Literal: "read_only_table.csv"},
+		Fields: []parser.QueryExpression{parser.Identifier{Literal: "column1"}},
+	}, filter)
+	expectErr = "[L:1 C:14] CREATE TABLE cannot be executed in read-only mode"
+	if err == nil {
+		t.Errorf("CreateTable: no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("CreateTable: error %q, want error %q", err.Error(), expectErr)
+	}
+	if _, err := os.Stat(GetTestFilePath("read_only_table.csv")); err == nil {
+		t.Errorf("CreateTable: file %q is created in read-only mode", "read_only_table.csv")
+	}
+
+	_, err = Deduplicate(parser.Deduplicate{
+		Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
+	}, filter)
+	expectErr = "[L:- C:-] DEDUPLICATE cannot be executed in read-only mode"
+	if err == nil {
+		t.Errorf("Deduplicate: no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("Deduplicate: error %q, want error %q", err.Error(), expectErr)
+	}
+
+	_, err = Export(parser.Export{
+		Table:    parser.Identifier{Literal: "table1"},
+		FilePath: parser.NewStringValue("read_only_export.csv"),
+	}, filter)
+	expectErr = "[L:- C:-] EXPORT cannot be executed in read-only mode"
+	if err == nil {
+		t.Errorf("Export: no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("Export: error %q, want error %q", err.Error(), expectErr)
+	}
+	if _, err := os.Stat(GetTestFilePath("read_only_export.csv")); err == nil {
+		t.Errorf("Export: file %q is created in read-only mode", "read_only_export.csv")
+	}
+
+	filter.TempViews = TemporaryViewScopes{
+		ViewMap{
+			"TMPVIEW": &View{
+				Header: NewHeader("tmpview", []string{"column1"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{value.NewInteger(1)}),
+				},
+				FileInfo: &FileInfo{
+					Path:        "tmpview",
+					Delimiter:   ',',
+					IsTemporary: true,
+				},
+			},
+		},
+	}
+
+	if _, err = Insert(parser.InsertQuery{
+		Table: parser.Table{Object: parser.Identifier{Literal: "tmpview"}},
+		ValuesList: []parser.QueryExpression{
+			parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewIntegerValueFromString("2")}}},
+		},
+	}, filter); err != nil {
+		t.Errorf("Insert: unexpected error %q for a temporary view", err)
+	}
+	if _, err = Update(parser.UpdateQuery{
+		Tables: []parser.QueryExpression{parser.Table{Object: parser.Identifier{Literal: "tmpview"}}},
+		SetList: []parser.UpdateSet{
+			{
+				Field: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				Value: parser.NewIntegerValueFromString("3"),
+			},
+		},
+		WhereClause: parser.WhereClause{
+			Filter: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.NewIntegerValueFromString("1"),
+				Operator: "=",
+			},
+		},
+	}, filter); err != nil {
+		t.Errorf("Update: unexpected error %q for a temporary view", err)
+	}
+	if _, err = Delete(parser.DeleteQuery{
+		FromClause: parser.FromClause{
+			Tables: []parser.QueryExpression{parser.Table{Object: parser.Identifier{Literal: "tmpview"}}},
+		},
+		WhereClause: parser.WhereClause{
+			Filter: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.NewIntegerValueFromString("2"),
+				Operator: "=",
+			},
+		},
+	}, filter); err != nil {
+		t.Errorf("Delete: unexpected error %q for a temporary view", err)
+	}
+	if _, err = AddColumns(parser.AddColumns{
+		Table: parser.Identifier{Literal: "tmpview"},
+		Columns: []parser.ColumnDefault{
+			{Column: parser.Identifier{Literal: "column2"}},
+		},
+	}, filter); err != nil {
+		t.Errorf("AddColumns: unexpected error %q for a temporary view", err)
+	}
+	tmpview, _ := filter.TempViews.Get(parser.Identifier{Literal: "tmpview"})
+	expectRecords := RecordSet{
+		NewRecord([]value.Primary{value.NewInteger(3), value.NewNull()}),
+	}
+	if !reflect.DeepEqual(tmpview.RecordSet, expectRecords) {
+		t.Errorf("temporary view records = %s, want %s", tmpview.RecordSet, expectRecords)
+	}
+
+	_, err = Update(parser.UpdateQuery{
+		Tables: []parser.QueryExpression{parser.Table{Object: parser.Identifier{Literal: "tmpview"}}},
+		SetList: []parser.UpdateSet{
+			{
+				Field: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				Value: parser.NewIntegerValueFromString("4"),
+			},
+		},
+		FromClause: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Join{
+					Table:     parser.Table{Object: parser.Identifier{Literal: "tmpview"}},
+					JoinTable: parser.Table{Object: parser.Identifier{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 40}), Literal: "table1"}},
+					JoinType:  parser.Token{Token: parser.CROSS},
+				}},
+			},
+		},
+	}, filter)
+	expectErr = "[L:1 C:40] UPDATE cannot be executed in read-only mode"
+	if err == nil {
+		t.Errorf("Update: no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("Update: error %q, want error %q", err.Error(), expectErr)
+	}
+
+	_, err = Select(parser.SelectQuery{
+		BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 22}),
+		SelectEntity: parser.SelectEntity{
+			SelectClause: parser.SelectClause{
+				Fields: []parser.QueryExpression{parser.Field{Object: parser.AllColumns{}}},
+			},
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{parser.Table{Object: parser.Identifier{Literal: "table1"}}},
+			},
+		},
+		ForUpdate:    true,
+		ForUpdateLit: "for update",
+	}, filter)
+	expectErr = "[L:1 C:22] SELECT FOR UPDATE cannot be executed in read-only mode"
+	if err == nil {
+		t.Errorf("Select: no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("Select: error %q, want error %q", err.Error(), expectErr)
+	}
+}
+
+func TestSelectForUpdate(t *testing.T) {
+	tf := cmd.GetFlags()
+	tf.Repository = TestDir
+	defer ReleaseResources()
+
+	ViewCache.Clean()
+	filter := NewEmptyFilter()
+
+	query := parser.SelectQuery{
+		SelectEntity: parser.SelectEntity{
+			SelectClause: parser.SelectClause{
+				Fields: []parser.QueryExpression{parser.Field{Object: parser.AllColumns{}}},
+			},
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{parser.Table{Object: parser.Identifier{Literal: "table1"}}},
+			},
+		},
+	}
+
+	if _, err := Select(query, filter); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	view, _ := ViewCache.Get(parser.Identifier{Literal: GetTestFilePath("table1.csv")})
+	if view.ForUpdate || view.FileInfo.File != nil {
+		t.Errorf("file %q is loaded for update by select query without FOR UPDATE", "table1.csv")
+	}
+
+	query.ForUpdate = true
+	query.ForUpdateLit = "for update"
+	if _, err := Select(query, filter); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	view, _ = ViewCache.Get(parser.Identifier{Literal: GetTestFilePath("table1.csv")})
+	if !view.ForUpdate || view.FileInfo.File == nil {
+		t.Errorf("file %q is not loaded for update by select query with FOR UPDATE", "table1.csv")
+	}
+}
+
+func TestBackupFile(t *testing.T) {
+	cmd.SetQuiet(true)
+	defer func() {
+		cmd.SetQuiet(false)
+		BackupFiles = map[string]bool{}
+		committedBackupFiles = map[string]bool{}
+	}()
+
+	fp, _ := os.Open(GetTestFilePath("table1.csv"))
+	defer fp.Close()
+
+	committed := GetTestFilePath("backup_committed.csv.bak")
+	rolledBack := GetTestFilePath("backup_rolled_back.csv.bak")
+
+	if err := BackupFile(fp, committed); err != nil {
+		t.Fatalf("BackupFile: unexpected error %q", err)
+	}
+	Commit(nil, NewEmptyFilter())
+	os.Remove(committed)
+
+	if err := BackupFile(fp, committed); err != nil {
+		t.Fatalf("BackupFile: unexpected error %q for the file backed up in the committed transaction", err)
+	}
+	if _, err := os.Stat(committed); err != nil {
+		t.Errorf("BackupFile: backup file %q is not created in the new transaction", committed)
+	}
+	if err := BackupFile(fp, rolledBack); err != nil {
+		t.Fatalf("BackupFile: unexpected error %q", err)
+	}
+	Rollback(NewEmptyFilter())
+
+	if _, err := os.Stat(committed); err != nil {
+		t.Errorf("BackupFile: backup file %q is removed, want to be retained", committed)
+	}
+	if _, err := os.Stat(rolledBack); err == nil {
+		t.Errorf("BackupFile: backup file %q is retained, want to be removed", rolledBack)
+	}
+}
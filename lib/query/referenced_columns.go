@@ -0,0 +1,264 @@
+package query
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+// referencedColumns returns the upper-cased names of the columns referenced in the query.
+// If the query can refer to columns that are not specified by their names, such as with
+// wildcards or column numbers, then returns nil, which means all columns.
+func referencedColumns(query parser.SelectQuery) []string {
+	c := &columnCollector{
+		names: make(map[string]bool),
+	}
+	if !c.collect(query) {
+		return nil
+	}
+
+	list := make([]string, 0, len(c.names))
+	for name := range c.names {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return list
+}
+
+type columnCollector struct {
+	names map[string]bool
+	views map[string]bool
+	depth int
+}
+
+func (c *columnCollector) add(name string) {
+	c.names[strings.ToUpper(name)] = true
+}
+
+func (c *columnCollector) collect(expr parser.QueryExpression) bool {
+	if expr == nil {
+		return true
+	}
+
+	switch e := expr.(type) {
+	case parser.PrimitiveType, parser.Identifier, parser.Variable, parser.CursorStatus, parser.CursorAttrebute,
+		parser.Dual, parser.Stdin, parser.LimitWith, parser.WindowingClause:
+		return true
+	case parser.FieldReference:
+		c.add(e.Column.Literal)
+		if c.views != nil && 0 < len(e.View.Literal) {
+			c.views[e.View.Literal] = true
+		}
+		return true
+	case parser.AllColumns:
+		// Wildcards in subqueries are expanded with the tables of the subqueries.
+		return 0 < c.depth && c.collectList(e.Replace)
+	case parser.Subquery:
+		c.depth++
+		ok := c.collect(e.Query)
+		c.depth--
+		return ok
+	case parser.SelectQuery:
+		return c.collect(e.WithClause) && c.collect(e.SelectEntity) && c.collect(e.OrderByClause) &&
+			c.collect(e.LimitClause) && c.collect(e.OffsetClause)
+	case parser.SelectSet:
+		return c.collect(e.LHS) && c.collect(e.RHS)
+	case parser.SelectEntity:
+		return c.collect(e.SelectClause) && c.collect(e.FromClause) && c.collect(e.WhereClause) &&
+			c.collect(e.GroupByClause) && c.collect(e.HavingClause)
+	case parser.WithClause:
+		return c.collectList(e.InlineTables)
+	case parser.InlineTable:
+		c.depth++
+		ok := c.collect(e.Query)
+		c.depth--
+		return ok
+	case parser.SelectClause:
+		return c.collectList(e.Fields)
+	case parser.FromClause:
+		return c.collectList(e.Tables)
+	case parser.WhereClause:
+		return c.collect(e.Filter)
+	case parser.GroupByClause:
+		return c.collectList(e.Items)
+	case parser.HavingClause:
+		return c.collect(e.Filter)
+	case parser.OrderByClause:
+		return c.collectList(e.Items)
+	case parser.LimitClause:
+		return c.collect(e.Value) && c.collect(e.With)
+	case parser.OffsetClause:
+		return c.collect(e.Value)
+	case parser.Field:
+		return c.collect(e.Object)
+	case parser.OrderItem:
+		return c.collect(e.Value)
+	case parser.Table:
+		for _, o := range e.Options {
+			if !c.collect(o.Value) {
+				return false
+			}
+		}
+		return c.collect(e.Object)
+	case parser.ComputedColumn:
+		return c.collect(e.Value)
+	case parser.Join:
+		if !e.Natural.IsEmpty() {
+			return false
+		}
+		return c.collect(e.Table) && c.collect(e.JoinTable) && c.collect(e.Partition) && c.collect(e.Condition)
+	case parser.JoinCondition:
+		for _, v := range e.Using {
+			c.add(v.(parser.Identifier).Literal)
+		}
+		return c.collect(e.On)
+	case parser.ValuesTable:
+		return c.collectList(e.RowValues)
+	case parser.UnnestTable:
+		return c.collect(e.Value)
+	case parser.Parentheses:
+		return c.collect(e.Expr)
+	case parser.RowValue:
+		return c.collect(e.Value)
+	case parser.ValueList:
+		return c.collectList(e.Values)
+	case parser.RowValueList:
+		return c.collectList(e.RowValues)
+	case parser.Comparison:
+		return c.collect(e.LHS) && c.collect(e.RHS)
+	case parser.Is:
+		return c.collect(e.LHS) && c.collect(e.RHS)
+	case parser.Between:
+		return c.collect(e.LHS) && c.collect(e.Low) && c.collect(e.High)
+	case parser.In:
+		return c.collect(e.LHS) && c.collect(e.Values)
+	case parser.All:
+		return c.collect(e.LHS) && c.collect(e.Values)
+	case parser.Any:
+		return c.collect(e.LHS) && c.collect(e.Values)
+	case parser.Like:
+		return c.collect(e.LHS) && c.collect(e.Pattern) && c.collect(e.Escape)
+	case parser.SimilarTo:
+		return c.collect(e.LHS) && c.collect(e.Pattern) && c.collect(e.Escape)
+	case parser.RegExpMatch:
+		return c.collect(e.LHS) && c.collect(e.Pattern)
+	case parser.Exists:
+		return c.collect(e.Query)
+	case parser.Arithmetic:
+		return c.collect(e.LHS) && c.collect(e.RHS)
+	case parser.UnaryArithmetic:
+		return c.collect(e.Operand)
+	case parser.Logic:
+		return c.collect(e.LHS) && c.collect(e.RHS)
+	case parser.UnaryLogic:
+		return c.collect(e.Operand)
+	case parser.Concat:
+		return c.collectList(e.Items)
+	case parser.AtTimeZone:
+		return c.collect(e.Value) && c.collect(e.TimeZone)
+	case parser.CaseExpr:
+		return c.collect(e.Value) && c.collectList(e.When) && c.collect(e.Else)
+	case parser.CaseExprWhen:
+		return c.collect(e.Condition) && c.collect(e.Result)
+	case parser.CaseExprElse:
+		return c.collect(e.Result)
+	case parser.Function:
+		return c.collectList(e.Args)
+	case parser.AggregateFunction:
+		return c.collectAggregateArgs(e.Name, e.Distinct, e.Args)
+	case parser.ListAgg:
+		return c.collectList(e.Args) && c.collect(e.OrderBy)
+	case parser.AnalyticFunction:
+		return c.collectAggregateArgs(e.Name, e.Distinct, e.Args) && c.collect(e.AnalyticClause)
+	case parser.AnalyticClause:
+		return c.collect(e.PartitionClause) && c.collect(e.OrderByClause) && c.collect(e.WindowingClause)
+	case parser.PartitionClause:
+		return c.collectList(e.Values)
+	case parser.VariableSubstitution:
+		return c.collect(e.Value)
+	}
+	return false
+}
+
+func (c *columnCollector) collectList(exprs []parser.QueryExpression) bool {
+	for _, v := range exprs {
+		if !c.collect(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *columnCollector) collectAggregateArgs(name string, distinct parser.Token, args []parser.QueryExpression) bool {
+	if strings.EqualFold(name, "COUNT") && distinct.IsEmpty() && len(args) == 1 {
+		if _, ok := args[0].(parser.AllColumns); ok {
+			return true
+		}
+	}
+	return c.collectList(args)
+}
+
+// coversColumns reports whether the columns loaded with the names in loaded include the columns in required.
+func coversColumns(loaded []string, required []string) bool {
+	if loaded == nil {
+		return true
+	}
+	if required == nil {
+		return false
+	}
+	for _, name := range required {
+		if !InStrSlice(name, loaded) {
+			return false
+		}
+	}
+	return true
+}
+
+func mergeColumns(columns1 []string, columns2 []string) []string {
+	if columns1 == nil || columns2 == nil {
+		return nil
+	}
+	list := make([]string, len(columns1), len(columns1)+len(columns2))
+	copy(list, columns1)
+	for _, name := range columns2 {
+		if !InStrSlice(name, list) {
+			list = append(list, name)
+		}
+	}
+	sort.Strings(list)
+	return list
+}
+
+// prunedFieldIndices returns the indices of the fields whose names are in columns.
+// If columns is nil, then returns nil, which means all fields.
+// The first field is kept if no field is referenced so that records are not empty.
+func prunedFieldIndices(names []string, columns []string) []int {
+	if columns == nil {
+		return nil
+	}
+	indices := make([]int, 0, len(columns))
+	for i, name := range names {
+		if InStrSlice(strings.ToUpper(name), columns) {
+			indices = append(indices, i)
+		}
+	}
+	if len(indices) < 1 && 0 < len(names) {
+		indices = append(indices, 0)
+	}
+	return indices
+}
+
+// projectFieldNames returns the names at the indices. If indices is nil, then returns names.
+func projectFieldNames(names []string, indices []int) []string {
+	if indices == nil {
+		return names
+	}
+	list := make([]string, 0, len(indices))
+	for _, idx := range indices {
+		if idx < len(names) {
+			list = append(list, names[idx])
+		}
+	}
+	return list
+}
@@ -0,0 +1,186 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var referencedColumnsTests = []struct {
+	Name   string
+	Query  parser.SelectQuery
+	Result []string
+}{
+	{
+		Name: "Referenced Columns",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{View: parser.Identifier{Literal: "t"}, Column: parser.Identifier{Literal: "column1"}}},
+						parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Exists{
+						Query: parser.Subquery{
+							Query: parser.SelectQuery{
+								SelectEntity: parser.SelectEntity{
+									SelectClause: parser.SelectClause{
+										Fields: []parser.QueryExpression{
+											parser.Field{Object: parser.AllColumns{}},
+										},
+									},
+									FromClause: parser.FromClause{
+										Tables: []parser.QueryExpression{
+											parser.Table{Object: parser.Identifier{Literal: "table2"}},
+										},
+									},
+									WhereClause: parser.WhereClause{
+										Filter: parser.Comparison{
+											LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+											RHS:      parser.FieldReference{View: parser.Identifier{Literal: "t"}, Column: parser.Identifier{Literal: "Column2"}},
+											Operator: "=",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				GroupByClause: parser.GroupByClause{
+					Items: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+			OrderByClause: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}}},
+				},
+			},
+		},
+		Result: []string{"COLUMN1", "COLUMN2", "COLUMN3", "COLUMN4"},
+	},
+	{
+		Name: "Referenced Columns Join Using",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.NewNullValue()},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{
+							Object: parser.Join{
+								Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}},
+								JoinTable: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+								Condition: parser.JoinCondition{
+									Using: []parser.QueryExpression{parser.Identifier{Literal: "column1"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: []string{"COLUMN1"},
+	},
+	{
+		Name: "Referenced Columns No Column",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.NewIntegerValue(1)},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Result: []string{},
+	},
+	{
+		Name: "Referenced Columns All Columns",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Result: nil,
+	},
+	{
+		Name: "Referenced Columns Column Number",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.ColumnNumber{View: parser.Identifier{Literal: "table1"}, Number: value.NewInteger(1)}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Result: nil,
+	},
+	{
+		Name: "Referenced Columns Natural Join",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{
+							Object: parser.Join{
+								Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}},
+								JoinTable: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+								Natural:   parser.Token{Token: parser.NATURAL, Literal: "natural"},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: nil,
+	},
+}
+
+func TestReferencedColumns(t *testing.T) {
+	for _, v := range referencedColumnsTests {
+		result := referencedColumns(v.Query)
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %#v, want %#v", v.Name, result, v.Result)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var schemaTypeConverters = map[string]func(value.Primary) value.Primary{
+	"STRING":   value.ToString,
+	"INTEGER":  value.ToInteger,
+	"FLOAT":    value.ToFloat,
+	"BOOLEAN":  value.ToBoolean,
+	"DATETIME": value.ToDatetime,
+}
+
+type Schema struct {
+	Fields []string
+	Types  []string
+}
+
+// Apply converts the declared fields to the declared types.
+// Conversion errors up to the number specified by the max-errors option are tolerated,
+// and their messages are returned with the values set to nulls.
+func (s *Schema) Apply(header []string, records RecordSet) ([]string, error) {
+	indices := make([]int, len(s.Fields))
+	for i, field := range s.Fields {
+		indices[i] = -1
+		for j, h := range header {
+			if strings.EqualFold(h, field) {
+				indices[i] = j
+				break
+			}
+		}
+		if indices[i] < 0 {
+			return nil, fmt.Errorf("field %s declared in the schema does not exist", field)
+		}
+	}
+
+	flags := cmd.GetFlags()
+	conversionErrors := make([]string, 0)
+
+	for i := range records {
+		for j, idx := range indices {
+			p := records[i][idx].Value()
+			if value.IsNull(p) {
+				continue
+			}
+
+			converted := schemaTypeConverters[s.Types[j]](p)
+			if value.IsNull(converted) && !flags.SchemaNullOnError && !isEmptyString(p) {
+				conversionErrors = append(conversionErrors, fmt.Sprintf("value %s of field %s in record %d cannot be converted to %s", p, s.Fields[j], i+1, s.Types[j]))
+				if flags.MaxErrors < len(conversionErrors) {
+					if flags.MaxErrors < 1 {
+						return nil, errors.New(conversionErrors[0])
+					}
+					return nil, fmt.Errorf("number of conversion errors exceeded %d: %s", flags.MaxErrors, strings.Join(conversionErrors, ", "))
+				}
+			}
+			records[i][idx] = NewCell(converted)
+		}
+	}
+	return conversionErrors, nil
+}
+
+func isEmptyString(p value.Primary) bool {
+	s, ok := p.(value.String)
+	return ok && len(s.Raw()) < 1
+}
+
+type SchemaMap map[string]*Schema
+
+func (m SchemaMap) Exists(fpath string) bool {
+	_, ok := m[strings.ToUpper(fpath)]
+	return ok
+}
+
+func (m SchemaMap) Get(fpath string) (*Schema, bool) {
+	schema, ok := m[strings.ToUpper(fpath)]
+	return schema, ok
+}
+
+func (m SchemaMap) Set(fpath string, schema *Schema) {
+	m[strings.ToUpper(fpath)] = schema
+}
+
+func (m SchemaMap) Clear() {
+	for k := range m {
+		delete(m, k)
+	}
+}
@@ -0,0 +1,150 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var schemaApplyTests = []struct {
+	Name        string
+	Schema      *Schema
+	Header      []string
+	Records     RecordSet
+	NullOnError bool
+	MaxErrors   int
+	Result      RecordSet
+	Errors      []string
+	Error       string
+}{
+	{
+		Name: "Schema Apply",
+		Schema: &Schema{
+			Fields: []string{"ID", "ts", "flag", "amount"},
+			Types:  []string{"INTEGER", "DATETIME", "BOOLEAN", "FLOAT"},
+		},
+		Header: []string{"id", "name", "ts", "flag", "amount"},
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("10"), value.NewString("2012-02-03 09:18:15"), value.NewString("true"), value.NewString("1")}),
+			NewRecord([]value.Primary{value.NewString(""), value.NewString("20"), value.NewNull(), value.NewString("0"), value.NewString("2.5")}),
+		},
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewString("10"), value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())), value.NewBoolean(true), value.NewFloat(1)}),
+			NewRecord([]value.Primary{value.NewNull(), value.NewString("20"), value.NewNull(), value.NewBoolean(false), value.NewFloat(2.5)}),
+		},
+		Errors: []string{},
+	},
+	{
+		Name: "Schema Apply Conversion Error",
+		Schema: &Schema{
+			Fields: []string{"id"},
+			Types:  []string{"INTEGER"},
+		},
+		Header: []string{"id"},
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1")}),
+			NewRecord([]value.Primary{value.NewString("a")}),
+		},
+		Error: "value 'a' of field id in record 2 cannot be converted to INTEGER",
+	},
+	{
+		Name: "Schema Apply Tolerate Conversion Errors",
+		Schema: &Schema{
+			Fields: []string{"id", "ts"},
+			Types:  []string{"INTEGER", "DATETIME"},
+		},
+		Header: []string{"id", "ts"},
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("x")}),
+			NewRecord([]value.Primary{value.NewString("a"), value.NewString("2012-02-03")}),
+		},
+		MaxErrors: 2,
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewNull()}),
+			NewRecord([]value.Primary{value.NewNull(), value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, GetTestLocation()))}),
+		},
+		Errors: []string{
+			"value 'x' of field ts in record 1 cannot be converted to DATETIME",
+			"value 'a' of field id in record 2 cannot be converted to INTEGER",
+		},
+	},
+	{
+		Name: "Schema Apply Conversion Errors Exceeded",
+		Schema: &Schema{
+			Fields: []string{"id"},
+			Types:  []string{"INTEGER"},
+		},
+		Header: []string{"id"},
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewString("a")}),
+			NewRecord([]value.Primary{value.NewString("b")}),
+			NewRecord([]value.Primary{value.NewString("c")}),
+		},
+		MaxErrors: 1,
+		Error:     "number of conversion errors exceeded 1: value 'a' of field id in record 1 cannot be converted to INTEGER, value 'b' of field id in record 2 cannot be converted to INTEGER",
+	},
+	{
+		Name: "Schema Apply Null On Error",
+		Schema: &Schema{
+			Fields: []string{"id"},
+			Types:  []string{"INTEGER"},
+		},
+		Header: []string{"id"},
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1")}),
+			NewRecord([]value.Primary{value.NewString("a")}),
+		},
+		NullOnError: true,
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(1)}),
+			NewRecord([]value.Primary{value.NewNull()}),
+		},
+		Errors: []string{},
+	},
+	{
+		Name: "Schema Apply Field Not Exist Error",
+		Schema: &Schema{
+			Fields: []string{"notexist"},
+			Types:  []string{"INTEGER"},
+		},
+		Header: []string{"id"},
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1")}),
+		},
+		Error: "field notexist declared in the schema does not exist",
+	},
+}
+
+func TestSchema_Apply(t *testing.T) {
+	flags := cmd.GetFlags()
+
+	for _, v := range schemaApplyTests {
+		flags.SchemaNullOnError = v.NullOnError
+		flags.MaxErrors = v.MaxErrors
+
+		conversionErrors, err := v.Schema.Apply(v.Header, v.Records)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(v.Records, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, v.Records, v.Result)
+		}
+		if !reflect.DeepEqual(conversionErrors, v.Errors) {
+			t.Errorf("%s: errors = %q, want %q", v.Name, conversionErrors, v.Errors)
+		}
+	}
+	flags.SchemaNullOnError = false
+	flags.MaxErrors = 0
+}
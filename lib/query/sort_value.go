@@ -18,6 +18,7 @@ const (
 	SORT_VALUE_DATETIME
 	SORT_VALUE_BOOLEAN
 	SORT_VALUE_STRING
+	SORT_VALUE_ARRAY
 )
 
 type SortValues []*SortValue
@@ -81,6 +82,8 @@ func (values SortValues) Serialize() string {
 			list[i] = serializeBoolean(val.Boolean)
 		case SORT_VALUE_STRING:
 			list[i] = serializeString(val.String)
+		case SORT_VALUE_ARRAY:
+			list[i] = val.String
 		}
 	}
 
@@ -102,6 +105,9 @@ func NewSortValue(val value.Primary) *SortValue {
 
 	if value.IsNull(val) {
 		sortValue.Type = SORT_VALUE_NULL
+	} else if a, ok := val.(value.Array); ok {
+		sortValue.Type = SORT_VALUE_ARRAY
+		sortValue.String = serializeArray(a)
 	} else if i := value.ToInteger(val); !value.IsNull(i) {
 		s := value.ToString(val)
 		sortValue.Type = SORT_VALUE_INTEGER
@@ -199,6 +205,14 @@ func (v *SortValue) Less(compareValue *SortValue) ternary.Value {
 			}
 			return ternary.ConvertFromBool(v.String < compareValue.String)
 		}
+	case SORT_VALUE_ARRAY:
+		switch compareValue.Type {
+		case SORT_VALUE_ARRAY:
+			if v.String == compareValue.String {
+				return ternary.UNKNOWN
+			}
+			return ternary.ConvertFromBool(v.String < compareValue.String)
+		}
 	}
 
 	return ternary.UNKNOWN
@@ -233,6 +247,11 @@ func (v *SortValue) EquivalentTo(compareValue *SortValue) bool {
 		case SORT_VALUE_STRING:
 			return v.String == compareValue.String
 		}
+	case SORT_VALUE_ARRAY:
+		switch compareValue.Type {
+		case SORT_VALUE_ARRAY:
+			return v.String == compareValue.String
+		}
 	case SORT_VALUE_NULL:
 		return compareValue.Type == SORT_VALUE_NULL
 	}
@@ -20,7 +20,7 @@ func TestSortValues_Serialize(t *testing.T) {
 		NewSortValue(value.NewBoolean(false)),
 		NewSortValue(value.NewString("str")),
 	}
-	expect := "[N]:[I]1[B]true:[F]1.234:[I]1328289495:[F]1328289495.123:[D]1328289495123456789:[I]0[B]false:[S]STR"
+	expect := "[N]:[I]1[B]true:[F]1.234:[I]1328289495:[F]1328289495.123:[D]1328289495123456789:[I]0[B]false:[S]3:STR"
 
 	result := values.Serialize()
 	if result != expect {
@@ -207,6 +207,18 @@ var sortValueEquivalentToTests = []struct {
 		CompareValue: NewSortValue(value.NewNull()),
 		Result:       false,
 	},
+	{
+		Name:         "SortValue EquivalentTo Array",
+		SortValue:    NewSortValue(value.NewArray([]value.Primary{value.NewInteger(1), value.NewString("a")})),
+		CompareValue: NewSortValue(value.NewArray([]value.Primary{value.NewBoolean(true), value.NewString("A")})),
+		Result:       true,
+	},
+	{
+		Name:         "SortValue EquivalentTo Array and String",
+		SortValue:    NewSortValue(value.NewArray([]value.Primary{value.NewString("a")})),
+		CompareValue: NewSortValue(value.NewString("[A]1([S]1:A)")),
+		Result:       false,
+	},
 }
 
 func TestSortValue_EquivalentTo(t *testing.T) {
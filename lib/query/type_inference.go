@@ -0,0 +1,198 @@
+package query
+
+import (
+	"strconv"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+type inferredType int
+
+const (
+	inferredNull inferredType = iota
+	inferredInteger
+	inferredFloat
+	inferredBoolean
+	inferredDatetime
+	inferredString
+)
+
+var inferredTypeLiterals = map[inferredType]string{
+	inferredNull:     "NULL",
+	inferredInteger:  "INTEGER",
+	inferredFloat:    "FLOAT",
+	inferredBoolean:  "BOOLEAN",
+	inferredDatetime: "DATETIME",
+	inferredString:   "STRING",
+}
+
+func (t inferredType) String() string {
+	return inferredTypeLiterals[t]
+}
+
+func inferTypes(records RecordSet, policy cmd.InferTypes) {
+	switch policy {
+	case cmd.INFER_PER_CELL:
+		for i := range records {
+			for j := range records[i] {
+				records[i][j] = NewCell(inferValueType(records[i][j].Value()))
+			}
+		}
+	case cmd.INFER_PER_COLUMN:
+		if len(records) < 1 {
+			return
+		}
+		for j := range records[0] {
+			inferColumnType(records, j)
+		}
+	}
+}
+
+func inferColumnType(records RecordSet, idx int) {
+	values := make([]value.Primary, len(records))
+	columnType := inferredNull
+
+	for i := range records {
+		values[i] = inferValueType(records[i][idx].Value())
+
+		t := typeOfInferredValue(values[i])
+		switch {
+		case t == inferredNull || t == columnType:
+		case columnType == inferredNull:
+			columnType = t
+		case (t == inferredInteger && columnType == inferredFloat) || (t == inferredFloat && columnType == inferredInteger):
+			columnType = inferredFloat
+		default:
+			return
+		}
+		if columnType == inferredString {
+			return
+		}
+	}
+
+	for i := range records {
+		if columnType == inferredFloat {
+			if _, ok := values[i].(value.Integer); ok {
+				values[i] = value.ToFloat(values[i])
+			}
+		}
+		records[i][idx] = NewCell(values[i])
+	}
+}
+
+// inferredTypeOfColumn returns the type that all values in the column can be interpreted as.
+// Integers and floats are interpreted as floats, and columns with any other combination of types are interpreted as strings.
+func inferredTypeOfColumn(records RecordSet, idx int) inferredType {
+	columnType := inferredNull
+
+	for i := range records {
+		t := typeOfInferredValue(inferValueType(records[i][idx].Value()))
+		switch {
+		case t == inferredNull || t == columnType:
+		case columnType == inferredNull:
+			columnType = t
+		case (t == inferredInteger && columnType == inferredFloat) || (t == inferredFloat && columnType == inferredInteger):
+			columnType = inferredFloat
+		default:
+			return inferredString
+		}
+	}
+	return columnType
+}
+
+func isCompatibleInferredType(t1 inferredType, t2 inferredType) bool {
+	if t1 == inferredNull || t2 == inferredNull || t1 == t2 {
+		return true
+	}
+	return (t1 == inferredInteger || t1 == inferredFloat) && (t2 == inferredInteger || t2 == inferredFloat)
+}
+
+func typeOfInferredValue(p value.Primary) inferredType {
+	switch p.(type) {
+	case value.Null:
+		return inferredNull
+	case value.Integer:
+		return inferredInteger
+	case value.Float:
+		return inferredFloat
+	case value.Boolean:
+		return inferredBoolean
+	case value.Datetime:
+		return inferredDatetime
+	}
+	return inferredString
+}
+
+func inferValueType(p value.Primary) value.Primary {
+	str, ok := p.(value.String)
+	if !ok || len(str.Raw()) < 1 {
+		return p
+	}
+	s := str.Raw()
+
+	if isNumericLiteral(s) {
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return value.NewInteger(i)
+		}
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return value.NewFloat(f)
+		}
+		return p
+	}
+	if b, err := strconv.ParseBool(s); err == nil {
+		return value.NewBoolean(b)
+	}
+	if b, ok := cmd.ParseBooleanLiteral(s); ok {
+		return value.NewBoolean(b)
+	}
+	if t, err := value.StrToTime(s); err == nil {
+		return value.NewDatetime(t)
+	}
+	return p
+}
+
+// isNumericLiteral reports whether s is a decimal number without redundant leading zeros,
+// so that strings such as zip codes are not converted to numbers.
+func isNumericLiteral(s string) bool {
+	i := 0
+	if s[i] == '-' || s[i] == '+' {
+		i++
+	}
+
+	start := i
+	for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+		i++
+	}
+	digits := i - start
+	if 1 < digits && s[start] == '0' {
+		return false
+	}
+
+	if i < len(s) && s[i] == '.' {
+		i++
+		start = i
+		for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+			i++
+		}
+		digits += i - start
+	}
+	if digits < 1 {
+		return false
+	}
+
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		if i < len(s) && (s[i] == '-' || s[i] == '+') {
+			i++
+		}
+		start = i
+		for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+			i++
+		}
+		if i == start {
+			return false
+		}
+	}
+	return i == len(s)
+}
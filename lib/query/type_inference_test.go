@@ -0,0 +1,118 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var inferTypesTests = []struct {
+	Name    string
+	Policy  cmd.InferTypes
+	Records RecordSet
+	Result  RecordSet
+}{
+	{
+		Name:   "Infer Types Off",
+		Policy: cmd.INFER_OFF,
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("true")}),
+		},
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("true")}),
+		},
+	},
+	{
+		Name:   "Infer Types Per Cell",
+		Policy: cmd.INFER_PER_CELL,
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("-1.5"), value.NewString("true"), value.NewString("2012-02-03 09:18:15"), value.NewString("str")}),
+			NewRecord([]value.Primary{value.NewString("00123"), value.NewString("1e3"), value.NewString(""), value.NewNull(), value.NewString("1.")}),
+		},
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewFloat(-1.5), value.NewBoolean(true), value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())), value.NewString("str")}),
+			NewRecord([]value.Primary{value.NewString("00123"), value.NewFloat(1000), value.NewString(""), value.NewNull(), value.NewFloat(1)}),
+		},
+	},
+	{
+		Name:   "Infer Types Per Column",
+		Policy: cmd.INFER_PER_COLUMN,
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("1"), value.NewString("1"), value.NewString("false")}),
+			NewRecord([]value.Primary{value.NewString("2"), value.NewString("2.5"), value.NewString("a"), value.NewNull()}),
+			NewRecord([]value.Primary{value.NewNull(), value.NewString("3"), value.NewString("3"), value.NewString("T")}),
+		},
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewFloat(1), value.NewString("1"), value.NewBoolean(false)}),
+			NewRecord([]value.Primary{value.NewInteger(2), value.NewFloat(2.5), value.NewString("a"), value.NewNull()}),
+			NewRecord([]value.Primary{value.NewNull(), value.NewFloat(3), value.NewString("3"), value.NewBoolean(true)}),
+		},
+	},
+	{
+		Name:    "Infer Types Per Column Empty Records",
+		Policy:  cmd.INFER_PER_COLUMN,
+		Records: RecordSet{},
+		Result:  RecordSet{},
+	},
+}
+
+func TestInferTypes(t *testing.T) {
+	for _, v := range inferTypesTests {
+		inferTypes(v.Records, v.Policy)
+		if !reflect.DeepEqual(v.Records, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, v.Records, v.Result)
+		}
+	}
+}
+
+var inferredTypeOfColumnTests = []struct {
+	Name    string
+	Records RecordSet
+	Index   int
+	Result  inferredType
+}{
+	{
+		Name: "Inferred Type Of Column Integer",
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1")}),
+			NewRecord([]value.Primary{value.NewNull()}),
+			NewRecord([]value.Primary{value.NewInteger(3)}),
+		},
+		Result: inferredInteger,
+	},
+	{
+		Name: "Inferred Type Of Column Float",
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1")}),
+			NewRecord([]value.Primary{value.NewString("2.5")}),
+		},
+		Result: inferredFloat,
+	},
+	{
+		Name: "Inferred Type Of Column Mixed",
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1")}),
+			NewRecord([]value.Primary{value.NewBoolean(true)}),
+		},
+		Result: inferredString,
+	},
+	{
+		Name: "Inferred Type Of Column Null",
+		Records: RecordSet{
+			NewRecord([]value.Primary{value.NewNull()}),
+		},
+		Result: inferredNull,
+	},
+}
+
+func TestInferredTypeOfColumn(t *testing.T) {
+	for _, v := range inferredTypeOfColumnTests {
+		result := inferredTypeOfColumn(v.Records, v.Index)
+		if result != v.Result {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
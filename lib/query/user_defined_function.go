@@ -2,7 +2,9 @@ package query
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
@@ -106,11 +108,12 @@ func (m UserDefinedFunctionMap) Declare(expr parser.FunctionDeclaration) error {
 	uname := strings.ToUpper(expr.Name.Literal)
 
 	m[uname] = &UserDefinedFunction{
-		Name:         expr.Name,
-		Statements:   expr.Statements,
-		Parameters:   parameters,
-		Defaults:     defaults,
-		RequiredArgs: required,
+		Name:            expr.Name,
+		Statements:      expr.Statements,
+		Parameters:      parameters,
+		Defaults:        defaults,
+		RequiredArgs:    required,
+		IsDeterministic: expr.IsDeterministic() && !containsVolatileCall(expr.Statements),
 	}
 	return nil
 }
@@ -171,13 +174,7 @@ func (m UserDefinedFunctionMap) parseParameters(parameters []parser.VariableAssi
 func (m UserDefinedFunctionMap) CheckDuplicate(name parser.Identifier) error {
 	uname := strings.ToUpper(name.Literal)
 
-	if _, ok := Functions[uname]; ok || uname == "NOW" {
-		return NewBuiltInFunctionDeclaredError(name)
-	}
-	if _, ok := AggregateFunctions[uname]; ok {
-		return NewBuiltInFunctionDeclaredError(name)
-	}
-	if _, ok := AnalyticFunctions[uname]; ok {
+	if isReservedFunctionName(uname) {
 		return NewBuiltInFunctionDeclaredError(name)
 	}
 	if _, ok := m[uname]; ok {
@@ -210,17 +207,21 @@ type UserDefinedFunction struct {
 	Defaults     map[string]parser.QueryExpression
 	RequiredArgs int
 
+	IsDeterministic bool
+
 	IsAggregate bool
 	Cursor      parser.Identifier // For Aggregate Functions
 }
 
 func (fn *UserDefinedFunction) Execute(args []value.Primary, filter *Filter) (value.Primary, error) {
 	childScope := filter.CreateChildScope()
+	childScope.inheritStatementContext(filter)
 	return fn.execute(args, childScope)
 }
 
 func (fn *UserDefinedFunction) ExecuteAggregate(values []value.Primary, args []value.Primary, filter *Filter) (value.Primary, error) {
 	childScope := filter.CreateChildScope()
+	childScope.inheritStatementContext(filter)
 	childScope.Cursors.AddPseudoCursor(fn.Cursor, values)
 	return fn.execute(args, childScope)
 }
@@ -278,3 +279,272 @@ func (fn *UserDefinedFunction) execute(args []value.Primary, filter *Filter) (va
 
 	return ret, nil
 }
+
+// FunctionResultCache holds the results of deterministic user defined functions in a statement.
+type FunctionResultCache struct {
+	results map[*UserDefinedFunction]map[string]value.Primary
+	mtx     sync.Mutex
+}
+
+func NewFunctionResultCache() *FunctionResultCache {
+	return &FunctionResultCache{
+		results: make(map[*UserDefinedFunction]map[string]value.Primary),
+	}
+}
+
+// Execute returns the cached result if the function has been called with the same arguments.
+// Results are not cached when the execution fails.
+func (c *FunctionResultCache) Execute(fn *UserDefinedFunction, args []value.Primary, filter *Filter) (value.Primary, error) {
+	key := serializeFunctionArguments(args)
+
+	c.mtx.Lock()
+	p, ok := c.results[fn][key]
+	c.mtx.Unlock()
+	if ok {
+		return p, nil
+	}
+
+	p, err := fn.Execute(args, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mtx.Lock()
+	if _, ok := c.results[fn]; !ok {
+		c.results[fn] = make(map[string]value.Primary)
+	}
+	c.results[fn][key] = p
+	c.mtx.Unlock()
+	return p, nil
+}
+
+func serializeFunctionArguments(args []value.Primary) string {
+	list := make([]string, len(args))
+	for i, arg := range args {
+		s := arg.String()
+		list[i] = fmt.Sprintf("%T", arg) + strconv.Itoa(len(s)) + ":" + s
+	}
+	return strings.Join(list, ",")
+}
+
+// containsVolatileCall reports whether the statements can call functions that return different
+// results for the same arguments. The results of functions declared as deterministic are not cached
+// if their statements contain such calls.
+// Calls to user defined functions are regarded as volatile because they cannot be resolved until they
+// are executed, and so are the statements and expressions that are not inspected.
+func containsVolatileCall(statements []parser.Statement) bool {
+	for _, stmt := range statements {
+		if isVolatileStatement(stmt) {
+			return true
+		}
+	}
+	return false
+}
+
+func isVolatileStatement(stmt parser.Statement) bool {
+	switch s := stmt.(type) {
+	case parser.FlowControl, parser.Exit, parser.OpenCursor, parser.CloseCursor, parser.DisposeCursor,
+		parser.DisposeVariable, parser.DisposeView:
+		return false
+	case parser.VariableDeclaration:
+		for _, v := range s.Assignments {
+			if isVolatileExpression(v.Value) {
+				return true
+			}
+		}
+		return false
+	case parser.VariableSubstitution:
+		return isVolatileExpression(s.Value)
+	case parser.Return:
+		return isVolatileExpression(s.Value)
+	case parser.Print:
+		return isVolatileExpression(s.Value)
+	case parser.Printf:
+		return isVolatileExpression(s.Format) || areVolatileExpressions(s.Values)
+	case parser.SelectQuery:
+		return isVolatileExpression(s)
+	case parser.SelectInto:
+		return isVolatileExpression(s.Query)
+	case parser.CursorDeclaration:
+		return isVolatileExpression(s.Query)
+	case parser.FetchCursor:
+		return isVolatileExpression(s.Position.Number)
+	case parser.ViewDeclaration:
+		return areVolatileExpressions(s.Fields) || isVolatileExpression(s.Query)
+	case parser.If:
+		if isVolatileExpression(s.Condition) || containsVolatileCall(s.Statements) {
+			return true
+		}
+		for _, v := range s.ElseIf {
+			if isVolatileExpression(v.Condition) || containsVolatileCall(v.Statements) {
+				return true
+			}
+		}
+		return containsVolatileCall(s.Else.Statements)
+	case parser.Case:
+		if isVolatileExpression(s.Value) {
+			return true
+		}
+		for _, v := range s.When {
+			if isVolatileExpression(v.Condition) || containsVolatileCall(v.Statements) {
+				return true
+			}
+		}
+		return containsVolatileCall(s.Else.Statements)
+	case parser.While:
+		return isVolatileExpression(s.Condition) || containsVolatileCall(s.Statements)
+	case parser.WhileInCursor:
+		return containsVolatileCall(s.Statements)
+	case parser.Loop:
+		return containsVolatileCall(s.Statements)
+	}
+	return true
+}
+
+func isVolatileExpression(expr parser.QueryExpression) bool {
+	if expr == nil {
+		return false
+	}
+
+	switch e := expr.(type) {
+	case parser.PrimitiveType, parser.Identifier, parser.FieldReference, parser.ColumnNumber, parser.Variable,
+		parser.CursorStatus, parser.CursorAttrebute, parser.AllColumns, parser.Dual, parser.Stdin,
+		parser.LimitWith, parser.WindowingClause:
+		return false
+	case parser.Function:
+		name := strings.ToUpper(e.Name)
+		if _, ok := Functions[name]; !ok || name == "CALL" || isVolatileFunction(name) {
+			return true
+		}
+		return areVolatileExpressions(e.Args)
+	case parser.AggregateFunction:
+		if _, ok := AggregateFunctions[strings.ToUpper(e.Name)]; !ok {
+			return true
+		}
+		return areVolatileExpressions(e.Args)
+	case parser.ListAgg:
+		return areVolatileExpressions(e.Args) || isVolatileExpression(e.OrderBy)
+	case parser.AnalyticFunction:
+		if _, ok := AnalyticFunctions[strings.ToUpper(e.Name)]; !ok {
+			if _, ok := AggregateFunctions[strings.ToUpper(e.Name)]; !ok {
+				return true
+			}
+		}
+		return areVolatileExpressions(e.Args) || isVolatileExpression(e.AnalyticClause)
+	case parser.AnalyticClause:
+		return isVolatileExpression(e.PartitionClause) || isVolatileExpression(e.OrderByClause) ||
+			isVolatileExpression(e.WindowingClause)
+	case parser.PartitionClause:
+		return areVolatileExpressions(e.Values)
+	case parser.Subquery:
+		return isVolatileExpression(e.Query)
+	case parser.SelectQuery:
+		return isVolatileExpression(e.WithClause) || isVolatileExpression(e.SelectEntity) ||
+			isVolatileExpression(e.OrderByClause) || isVolatileExpression(e.LimitClause) ||
+			isVolatileExpression(e.OffsetClause)
+	case parser.SelectSet:
+		return isVolatileExpression(e.LHS) || isVolatileExpression(e.RHS)
+	case parser.SelectEntity:
+		return isVolatileExpression(e.SelectClause) || isVolatileExpression(e.FromClause) ||
+			isVolatileExpression(e.WhereClause) || isVolatileExpression(e.GroupByClause) ||
+			isVolatileExpression(e.HavingClause)
+	case parser.WithClause:
+		return areVolatileExpressions(e.InlineTables)
+	case parser.InlineTable:
+		return isVolatileExpression(e.Query)
+	case parser.SelectClause:
+		return areVolatileExpressions(e.Fields)
+	case parser.FromClause:
+		return areVolatileExpressions(e.Tables)
+	case parser.WhereClause:
+		return isVolatileExpression(e.Filter)
+	case parser.GroupByClause:
+		return areVolatileExpressions(e.Items)
+	case parser.HavingClause:
+		return isVolatileExpression(e.Filter)
+	case parser.OrderByClause:
+		return areVolatileExpressions(e.Items)
+	case parser.LimitClause:
+		return isVolatileExpression(e.Value) || isVolatileExpression(e.With)
+	case parser.OffsetClause:
+		return isVolatileExpression(e.Value)
+	case parser.Field:
+		return isVolatileExpression(e.Object)
+	case parser.OrderItem:
+		return isVolatileExpression(e.Value)
+	case parser.Table:
+		for _, o := range e.Options {
+			if isVolatileExpression(o.Value) {
+				return true
+			}
+		}
+		return isVolatileExpression(e.Object)
+	case parser.Join:
+		return isVolatileExpression(e.Table) || isVolatileExpression(e.JoinTable) ||
+			isVolatileExpression(e.Partition) || isVolatileExpression(e.Condition)
+	case parser.JoinCondition:
+		return isVolatileExpression(e.On)
+	case parser.ValuesTable:
+		return areVolatileExpressions(e.RowValues)
+	case parser.UnnestTable:
+		return isVolatileExpression(e.Value)
+	case parser.Parentheses:
+		return isVolatileExpression(e.Expr)
+	case parser.RowValue:
+		return isVolatileExpression(e.Value)
+	case parser.ValueList:
+		return areVolatileExpressions(e.Values)
+	case parser.RowValueList:
+		return areVolatileExpressions(e.RowValues)
+	case parser.Comparison:
+		return isVolatileExpression(e.LHS) || isVolatileExpression(e.RHS)
+	case parser.Is:
+		return isVolatileExpression(e.LHS) || isVolatileExpression(e.RHS)
+	case parser.Between:
+		return isVolatileExpression(e.LHS) || isVolatileExpression(e.Low) || isVolatileExpression(e.High)
+	case parser.In:
+		return isVolatileExpression(e.LHS) || isVolatileExpression(e.Values)
+	case parser.All:
+		return isVolatileExpression(e.LHS) || isVolatileExpression(e.Values)
+	case parser.Any:
+		return isVolatileExpression(e.LHS) || isVolatileExpression(e.Values)
+	case parser.Like:
+		return isVolatileExpression(e.LHS) || isVolatileExpression(e.Pattern) || isVolatileExpression(e.Escape)
+	case parser.SimilarTo:
+		return isVolatileExpression(e.LHS) || isVolatileExpression(e.Pattern) || isVolatileExpression(e.Escape)
+	case parser.RegExpMatch:
+		return isVolatileExpression(e.LHS) || isVolatileExpression(e.Pattern)
+	case parser.Exists:
+		return isVolatileExpression(e.Query)
+	case parser.Arithmetic:
+		return isVolatileExpression(e.LHS) || isVolatileExpression(e.RHS)
+	case parser.UnaryArithmetic:
+		return isVolatileExpression(e.Operand)
+	case parser.Logic:
+		return isVolatileExpression(e.LHS) || isVolatileExpression(e.RHS)
+	case parser.UnaryLogic:
+		return isVolatileExpression(e.Operand)
+	case parser.Concat:
+		return areVolatileExpressions(e.Items)
+	case parser.AtTimeZone:
+		return isVolatileExpression(e.Value) || isVolatileExpression(e.TimeZone)
+	case parser.CaseExpr:
+		return isVolatileExpression(e.Value) || areVolatileExpressions(e.When) || isVolatileExpression(e.Else)
+	case parser.CaseExprWhen:
+		return isVolatileExpression(e.Condition) || isVolatileExpression(e.Result)
+	case parser.CaseExprElse:
+		return isVolatileExpression(e.Result)
+	case parser.VariableSubstitution:
+		return isVolatileExpression(e.Value)
+	}
+	return true
+}
+
+func areVolatileExpressions(exprs []parser.QueryExpression) bool {
+	for _, v := range exprs {
+		if isVolatileExpression(v) {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package query
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
@@ -465,6 +466,40 @@ var userDefinedFunctionMapDeclareTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "UserDefinedFunctionMap Declare Deterministic Function",
+		Expr: parser.FunctionDeclaration{
+			Name:          parser.Identifier{Literal: "detfunc"},
+			Deterministic: parser.Token{Token: parser.DETERMINISTIC, Literal: "deterministic"},
+			Statements: []parser.Statement{
+				parser.Return{Value: parser.NewIntegerValue(1)},
+			},
+		},
+		Result: UserDefinedFunctionMap{
+			"USERFUNC": &UserDefinedFunction{
+				Name: parser.Identifier{Literal: "userfunc"},
+				Parameters: []parser.Variable{
+					{Name: "@arg1"},
+					{Name: "@arg2"},
+				},
+				Defaults:     map[string]parser.QueryExpression{},
+				RequiredArgs: 2,
+				Statements: []parser.Statement{
+					parser.Print{Value: parser.Variable{Name: "@var1"}},
+				},
+			},
+			"DETFUNC": &UserDefinedFunction{
+				Name:         parser.Identifier{Literal: "detfunc"},
+				Parameters:   []parser.Variable{},
+				Defaults:     map[string]parser.QueryExpression{},
+				RequiredArgs: 0,
+				Statements: []parser.Statement{
+					parser.Return{Value: parser.NewIntegerValue(1)},
+				},
+				IsDeterministic: true,
+			},
+		},
+	},
 	{
 		Name: "UserDefinedFunctionMap Declare Redeclaration Error",
 		Expr: parser.FunctionDeclaration{
@@ -501,6 +536,50 @@ var userDefinedFunctionMapDeclareTests = []struct {
 		},
 		Error: "[L:- C:-] parameter @arg1 is a duplicate",
 	},
+	{
+		Name: "UserDefinedFunctionMap Declare Deterministic Function Calling Volatile Function",
+		Expr: parser.FunctionDeclaration{
+			Name:          parser.Identifier{Literal: "volfunc"},
+			Deterministic: parser.Token{Token: parser.DETERMINISTIC, Literal: "deterministic"},
+			Statements: []parser.Statement{
+				parser.Return{Value: parser.Function{Name: "rand"}},
+			},
+		},
+		Result: UserDefinedFunctionMap{
+			"USERFUNC": &UserDefinedFunction{
+				Name: parser.Identifier{Literal: "userfunc"},
+				Parameters: []parser.Variable{
+					{Name: "@arg1"},
+					{Name: "@arg2"},
+				},
+				Defaults:     map[string]parser.QueryExpression{},
+				RequiredArgs: 2,
+				Statements: []parser.Statement{
+					parser.Print{Value: parser.Variable{Name: "@var1"}},
+				},
+			},
+			"DETFUNC": &UserDefinedFunction{
+				Name:         parser.Identifier{Literal: "detfunc"},
+				Parameters:   []parser.Variable{},
+				Defaults:     map[string]parser.QueryExpression{},
+				RequiredArgs: 0,
+				Statements: []parser.Statement{
+					parser.Return{Value: parser.NewIntegerValue(1)},
+				},
+				IsDeterministic: true,
+			},
+			"VOLFUNC": &UserDefinedFunction{
+				Name:         parser.Identifier{Literal: "volfunc"},
+				Parameters:   []parser.Variable{},
+				Defaults:     map[string]parser.QueryExpression{},
+				RequiredArgs: 0,
+				Statements: []parser.Statement{
+					parser.Return{Value: parser.Function{Name: "rand"}},
+				},
+				IsDeterministic: false,
+			},
+		},
+	},
 }
 
 func TestUserDefinedFunctionMap_Declare(t *testing.T) {
@@ -802,6 +881,63 @@ var userDefinedFunctionExecuteTests = []struct {
 		},
 		Result: value.NewInteger(6),
 	},
+	{
+		Name: "UserDefinedFunction Execute Return in Case Statement",
+		Func: &UserDefinedFunction{
+			Name: parser.Identifier{Literal: "userfunc"},
+			Parameters: []parser.Variable{
+				{Name: "@arg1"},
+			},
+			Statements: []parser.Statement{
+				parser.Case{
+					Value: parser.Variable{Name: "@arg1"},
+					When: []parser.CaseWhen{
+						{
+							Condition: parser.NewIntegerValue(1),
+							Statements: []parser.Statement{
+								parser.Return{Value: parser.NewStringValue("one")},
+							},
+						},
+					},
+					Else: parser.CaseElse{
+						Statements: []parser.Statement{
+							parser.Return{Value: parser.NewStringValue("other")},
+						},
+					},
+				},
+				parser.Return{Value: parser.NewStringValue("not reached")},
+			},
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+		},
+		Result: value.NewString("one"),
+	},
+	{
+		Name: "UserDefinedFunction Execute Return in Loop",
+		Func: &UserDefinedFunction{
+			Name: parser.Identifier{Literal: "userfunc"},
+			Parameters: []parser.Variable{
+				{Name: "@arg1"},
+			},
+			Statements: []parser.Statement{
+				parser.Loop{
+					Statements: []parser.Statement{
+						parser.If{
+							Condition: parser.NewTernaryValueFromString("true"),
+							Statements: []parser.Statement{
+								parser.Return{Value: parser.Variable{Name: "@arg1"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		Args: []value.Primary{
+			value.NewInteger(2),
+		},
+		Result: value.NewInteger(2),
+	},
 	{
 		Name: "UserDefinedFunction Execute No Return Statement",
 		Func: &UserDefinedFunction{
@@ -1342,3 +1478,160 @@ func TestUserDefinedFunction_CheckArgsLen(t *testing.T) {
 		}
 	}
 }
+
+func TestFunctionResultCache_Execute(t *testing.T) {
+	vars := VariableMap{
+		"@count": value.NewInteger(0),
+	}
+	filter := NewFilter(
+		[]VariableMap{vars},
+		[]ViewMap{{}},
+		[]CursorMap{{}},
+		[]UserDefinedFunctionMap{{}},
+	)
+
+	fn := &UserDefinedFunction{
+		Name: parser.Identifier{Literal: "userfunc"},
+		Parameters: []parser.Variable{
+			{Name: "@arg1"},
+		},
+		RequiredArgs: 1,
+		Statements: []parser.Statement{
+			parser.VariableSubstitution{
+				Variable: parser.Variable{Name: "@count"},
+				Value: parser.Arithmetic{
+					LHS:      parser.Variable{Name: "@count"},
+					RHS:      parser.NewIntegerValue(1),
+					Operator: '+',
+				},
+			},
+			parser.Return{Value: parser.Variable{Name: "@count"}},
+		},
+		IsDeterministic: true,
+	}
+
+	cache := NewFunctionResultCache()
+	calls := []struct {
+		Args   []value.Primary
+		Result value.Primary
+	}{
+		{Args: []value.Primary{value.NewInteger(1)}, Result: value.NewInteger(1)},
+		{Args: []value.Primary{value.NewInteger(1)}, Result: value.NewInteger(1)},
+		{Args: []value.Primary{value.NewString("1")}, Result: value.NewInteger(2)},
+		{Args: []value.Primary{value.NewInteger(1)}, Result: value.NewInteger(1)},
+	}
+
+	for i, v := range calls {
+		result, err := cache.Execute(fn, v.Args, filter)
+		if err != nil {
+			t.Errorf("call %d: unexpected error %q", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("call %d: result = %s, want %s", i, result, v.Result)
+		}
+	}
+
+	if count := vars["@count"]; !reflect.DeepEqual(count, value.NewInteger(2)) {
+		t.Errorf("function is executed %s times, want %d times", count, 2)
+	}
+}
+
+var containsVolatileCallTests = []struct {
+	Name       string
+	Statements []parser.Statement
+	Result     bool
+}{
+	{
+		Name: "Deterministic Statements",
+		Statements: []parser.Statement{
+			parser.If{
+				Condition: parser.Comparison{
+					LHS:      parser.Variable{Name: "@arg1"},
+					RHS:      parser.NewIntegerValue(1),
+					Operator: "=",
+				},
+				Statements: []parser.Statement{
+					parser.Return{Value: parser.Function{Name: "upper", Args: []parser.QueryExpression{parser.Variable{Name: "@arg1"}}}},
+				},
+			},
+			parser.Return{Value: parser.NewNullValue()},
+		},
+		Result: false,
+	},
+	{
+		Name: "Volatile Function in Nested Statements",
+		Statements: []parser.Statement{
+			parser.While{
+				Condition: parser.NewTernaryValueFromString("true"),
+				Statements: []parser.Statement{
+					parser.If{
+						Condition: parser.NewTernaryValueFromString("true"),
+						Else: parser.Else{
+							Statements: []parser.Statement{
+								parser.Return{Value: parser.Function{Name: "now"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: true,
+	},
+	{
+		Name: "Volatile Function in Subquery",
+		Statements: []parser.Statement{
+			parser.Return{
+				Value: parser.Subquery{
+					Query: parser.SelectQuery{
+						SelectEntity: parser.SelectEntity{
+							SelectClause: parser.SelectClause{
+								Fields: []parser.QueryExpression{
+									parser.Field{Object: parser.Function{Name: "random_uuid"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: true,
+	},
+	{
+		Name: "User Defined Function Call",
+		Statements: []parser.Statement{
+			parser.Return{Value: parser.Function{Name: "userfunc"}},
+		},
+		Result: true,
+	},
+}
+
+func TestContainsVolatileCall(t *testing.T) {
+	for _, v := range containsVolatileCallTests {
+		result := containsVolatileCall(v.Statements)
+		if result != v.Result {
+			t.Errorf("%s: result = %t, want %t", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestUserDefinedFunction_ExecuteWithStatementTime(t *testing.T) {
+	now := time.Date(2013, 2, 3, 0, 0, 0, 0, GetTestLocation())
+	filter := NewEmptyFilter()
+	filter.Now = now
+
+	fn := &UserDefinedFunction{
+		Name: parser.Identifier{Literal: "userfunc"},
+		Statements: []parser.Statement{
+			parser.Return{Value: parser.Function{Name: "now"}},
+		},
+	}
+
+	result, err := fn.Execute([]value.Primary{}, filter)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(result, value.NewDatetime(now)) {
+		t.Errorf("result = %s, want %s", result, value.NewDatetime(now))
+	}
+}
@@ -93,6 +93,8 @@ func SerializeComparisonKeys(values []value.Primary) string {
 func SerializeKey(val value.Primary) string {
 	if value.IsNull(val) {
 		return serializeNull()
+	} else if a, ok := val.(value.Array); ok {
+		return serializeArray(a)
 	} else if in := value.ToInteger(val); !value.IsNull(in) {
 		return serializeInteger(in.(value.Integer).Raw())
 	} else if f := value.ToFloat(val); !value.IsNull(f) {
@@ -157,7 +159,12 @@ func serializeBoolean(b bool) string {
 }
 
 func serializeString(s string) string {
-	return "[S]" + strings.ToUpper(strings.TrimSpace(s))
+	s = strings.ToUpper(strings.TrimSpace(s))
+	return "[S]" + strconv.Itoa(len(s)) + ":" + s
+}
+
+func serializeArray(a value.Array) string {
+	return "[A]" + strconv.Itoa(a.Len()) + "(" + SerializeComparisonKeys(a.Raw()) + ")"
 }
 
 func FormatString(format string, args []value.Primary) (string, error) {
@@ -330,6 +337,8 @@ func FormatString(format string, args []value.Primary) (string, error) {
 						s = args[placeholderOrder].(value.Ternary).Ternary().String()
 					case value.Datetime:
 						s = args[placeholderOrder].(value.Datetime).Format(time.RFC3339Nano)
+					case value.Array:
+						s = args[placeholderOrder].(value.Array).String()
 					case value.Null:
 						s = "NULL"
 					}
@@ -375,6 +384,222 @@ func FormatString(format string, args []value.Primary) (string, error) {
 	return buf.String(), nil
 }
 
+func FormatNumberWithPattern(p value.Primary, format string) (string, error) {
+	fillMode := false
+	if 2 <= len(format) && strings.EqualFold(format[:2], "FM") {
+		fillMode = true
+		format = format[2:]
+	}
+
+	intPattern := format
+	fracPattern := ""
+	hasPoint := false
+	if i := strings.IndexByte(format, '.'); -1 < i {
+		intPattern, fracPattern, hasPoint = format[:i], format[i+1:], true
+	}
+	for _, r := range intPattern {
+		if r != '9' && r != '0' && r != ',' {
+			return "", NewInvalidFormatTokenError(string(r))
+		}
+	}
+	for _, r := range fracPattern {
+		if r != '9' && r != '0' {
+			return "", NewInvalidFormatTokenError(string(r))
+		}
+	}
+
+	overflow := strings.Repeat("#", len(format)+1)
+
+	var negative bool
+	var digits string
+	switch p.(type) {
+	case value.Integer:
+		i := p.(value.Integer).Raw()
+		negative = i < 0
+		digits = strings.TrimPrefix(strconv.FormatInt(i, 10), "-")
+		if 0 < len(fracPattern) {
+			digits = digits + "." + strings.Repeat("0", len(fracPattern))
+		}
+	case value.Float:
+		f := p.(value.Float).Raw()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return overflow, nil
+		}
+		negative = f < 0
+		digits = strconv.FormatFloat(math.Abs(f), 'f', len(fracPattern), 64)
+	}
+	if len(strings.Trim(digits, "0.")) < 1 {
+		negative = false
+	}
+
+	intDigits := digits
+	fracDigits := ""
+	if i := strings.IndexByte(digits, '.'); -1 < i {
+		intDigits, fracDigits = digits[:i], digits[i+1:]
+	}
+	intDigits = strings.TrimLeft(intDigits, "0")
+
+	if strings.Count(intPattern, "9")+strings.Count(intPattern, "0") < len(intDigits) {
+		return overflow, nil
+	}
+
+	zeroFrom := strings.IndexByte(intPattern, '0')
+	if zeroFrom < 0 {
+		zeroFrom = len(intPattern)
+	}
+	lastDigitPos := strings.LastIndexAny(intPattern, "90")
+	if len(intDigits) < 1 && len(fracPattern) < 1 && -1 < lastDigitPos && lastDigitPos < zeroFrom {
+		zeroFrom = lastDigitPos
+	}
+
+	intBuf := make([]byte, len(intPattern))
+	di := len(intDigits) - 1
+	for i := len(intPattern) - 1; 0 <= i; i-- {
+		switch {
+		case intPattern[i] == ',':
+			intBuf[i] = ','
+		case 0 <= di:
+			intBuf[i] = intDigits[di]
+			di--
+		case zeroFrom <= i:
+			intBuf[i] = '0'
+		default:
+			intBuf[i] = ' '
+		}
+	}
+	for i := 0; i < len(intBuf) && (intBuf[i] == ' ' || intBuf[i] == ','); i++ {
+		intBuf[i] = ' '
+	}
+
+	sign := " "
+	if negative {
+		sign = "-"
+	}
+	intStr := string(intBuf)
+	lead := len(intStr) - len(strings.TrimLeft(intStr, " "))
+	result := intStr[:lead] + sign + intStr[lead:]
+
+	if hasPoint {
+		if fillMode {
+			end := len(fracDigits)
+			for 0 < end && fracPattern[end-1] == '9' && fracDigits[end-1] == '0' {
+				end--
+			}
+			fracDigits = fracDigits[:end]
+		}
+		result = result + "." + fracDigits
+	}
+
+	if fillMode {
+		result = strings.TrimLeft(result, " ")
+	}
+	return result, nil
+}
+
+var datetimePatternTokens = []string{
+	"YYYY", "YY", "MONTH", "MON", "MM", "DDD", "DD", "DAY", "DY",
+	"HH24", "HH12", "HH", "MI", "SS", "FF", "AM", "PM",
+}
+
+func FormatDatetimeWithPattern(t time.Time, format string) (string, error) {
+	var buf bytes.Buffer
+
+	runes := []rune(format)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		if r == '"' {
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if len(runes) <= end {
+				return "", NewInvalidFormatTokenError(string(runes[i:]))
+			}
+			buf.WriteString(string(runes[i+1 : end]))
+			i = end + 1
+			continue
+		}
+
+		if strings.ContainsRune(" -/,.;:", r) {
+			buf.WriteRune(r)
+			i++
+			continue
+		}
+
+		token := ""
+		for _, t := range datetimePatternTokens {
+			if len(t) <= len(runes)-i && strings.EqualFold(string(runes[i:i+len(t)]), t) {
+				token = t
+				break
+			}
+		}
+		if len(token) < 1 {
+			return "", NewInvalidFormatTokenError(string(r))
+		}
+		pattern := string(runes[i : i+len(token)])
+		i += len(token)
+
+		switch token {
+		case "YYYY":
+			buf.WriteString(fmt.Sprintf("%04d", t.Year()))
+		case "YY":
+			buf.WriteString(fmt.Sprintf("%02d", t.Year()%100))
+		case "MONTH":
+			buf.WriteString(matchPatternCase(pattern, t.Month().String()))
+		case "MON":
+			buf.WriteString(matchPatternCase(pattern, t.Month().String()[:3]))
+		case "MM":
+			buf.WriteString(fmt.Sprintf("%02d", int(t.Month())))
+		case "DDD":
+			buf.WriteString(fmt.Sprintf("%03d", t.YearDay()))
+		case "DD":
+			buf.WriteString(fmt.Sprintf("%02d", t.Day()))
+		case "DAY":
+			buf.WriteString(matchPatternCase(pattern, t.Weekday().String()))
+		case "DY":
+			buf.WriteString(matchPatternCase(pattern, t.Weekday().String()[:3]))
+		case "HH24":
+			buf.WriteString(fmt.Sprintf("%02d", t.Hour()))
+		case "HH12", "HH":
+			h := t.Hour() % 12
+			if h == 0 {
+				h = 12
+			}
+			buf.WriteString(fmt.Sprintf("%02d", h))
+		case "MI":
+			buf.WriteString(fmt.Sprintf("%02d", t.Minute()))
+		case "SS":
+			buf.WriteString(fmt.Sprintf("%02d", t.Second()))
+		case "FF":
+			precision := 6
+			if i < len(runes) && '1' <= runes[i] && runes[i] <= '9' {
+				precision = int(runes[i] - '0')
+				i++
+			}
+			buf.WriteString(fmt.Sprintf("%09d", t.Nanosecond())[:precision])
+		case "AM", "PM":
+			meridiem := "AM"
+			if 12 <= t.Hour() {
+				meridiem = "PM"
+			}
+			buf.WriteString(matchPatternCase(pattern, meridiem))
+		}
+	}
+
+	return buf.String(), nil
+}
+
+func matchPatternCase(pattern string, s string) string {
+	switch pattern {
+	case strings.ToUpper(pattern):
+		return strings.ToUpper(s)
+	case strings.ToLower(pattern):
+		return strings.ToLower(s)
+	}
+	return s
+}
+
 func RecordRange(cpuIndex int, totalLen int, numberOfCPU int) (int, int) {
 	calcLen := totalLen / numberOfCPU
 
@@ -24,7 +24,7 @@ func TestSerializeComparisonKeys(t *testing.T) {
 		value.NewTernary(ternary.UNKNOWN),
 		value.NewNull(),
 	}
-	expect := "[S]STR:[I]1[B]true:[I]0[B]false:[I]3:[F]1.234:[I]1328289495:[F]1328289495.123:[D]1328289495123456789:[I]1[B]true:[I]0[B]false:[N]:[N]"
+	expect := "[S]3:STR:[I]1[B]true:[I]0[B]false:[I]3:[F]1.234:[I]1328289495:[F]1328289495.123:[D]1328289495123456789:[I]1[B]true:[I]0[B]false:[N]:[N]"
 
 	result := SerializeComparisonKeys(values)
 	if result != expect {
@@ -32,6 +32,48 @@ func TestSerializeComparisonKeys(t *testing.T) {
 	}
 }
 
+var serializeComparisonKeysCollisionTests = []struct {
+	Name    string
+	Values1 []value.Primary
+	Values2 []value.Primary
+}{
+	{
+		Name:    "Strings Containing Separator",
+		Values1: []value.Primary{value.NewString("a:[S]b")},
+		Values2: []value.Primary{value.NewString("a"), value.NewString("b")},
+	},
+	{
+		Name:    "Strings Containing Separator In Different Positions",
+		Values1: []value.Primary{value.NewString("a:[S]1:b"), value.NewString("c")},
+		Values2: []value.Primary{value.NewString("a"), value.NewString("b:[S]1:c")},
+	},
+	{
+		Name:    "String Containing Serialized Integer",
+		Values1: []value.Primary{value.NewString("a:[I]1[B]true")},
+		Values2: []value.Primary{value.NewString("a"), value.NewInteger(1)},
+	},
+	{
+		Name:    "String Containing Serialized Null",
+		Values1: []value.Primary{value.NewString("a:[N]"), value.NewNull()},
+		Values2: []value.Primary{value.NewString("a"), value.NewString(":[N]")},
+	},
+	{
+		Name:    "Arrays With Different Boundaries",
+		Values1: []value.Primary{value.NewArray([]value.Primary{value.NewString("a"), value.NewString("b")}), value.NewArray([]value.Primary{})},
+		Values2: []value.Primary{value.NewArray([]value.Primary{value.NewString("a")}), value.NewArray([]value.Primary{value.NewString("b")})},
+	},
+}
+
+func TestSerializeComparisonKeys_Collision(t *testing.T) {
+	for _, v := range serializeComparisonKeysCollisionTests {
+		key1 := SerializeComparisonKeys(v.Values1)
+		key2 := SerializeComparisonKeys(v.Values2)
+		if key1 == key2 {
+			t.Errorf("%s: keys collide with %q", v.Name, key1)
+		}
+	}
+}
+
 var formatStringTests = []struct {
 	Name   string
 	Format string
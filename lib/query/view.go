@@ -1,6 +1,10 @@
 package query
 
 import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"math"
 	"os"
 	"sort"
@@ -13,6 +17,7 @@ import (
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+	"github.com/mithrandie/csvq/lib/xlsx"
 
 	"github.com/mithrandie/ternary"
 )
@@ -34,6 +39,9 @@ type View struct {
 	sortDirections             []int
 	sortNullPositions          []int
 
+	partitionLists     map[string]PartitionList
+	partitionListOrder string
+
 	offset int
 
 	OperatedRecords int
@@ -73,9 +81,15 @@ func (view *View) Load(clause parser.FromClause, filter *Filter) error {
 	view.RecordSet = views[0].RecordSet
 	view.FileInfo = views[0].FileInfo
 
+	enterPhase(PROFILE_JOINING)
 	for i := 1; i < len(views); i++ {
+		if err := checkResultRows(clause.Tables[i].(parser.Table).Object, view.RecordLen()*views[i].RecordLen()); err != nil {
+			leavePhase(PROFILE_JOINING)
+			return err
+		}
 		CrossJoin(view, views[i])
 	}
+	leavePhase(PROFILE_JOINING)
 
 	view.Filter = filter
 	return nil
@@ -97,6 +111,10 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 	}
 
 	table := tableExpr.(parser.Table)
+	if _, ok := table.Object.(parser.Join); !ok {
+		enterPhase(PROFILE_LOADING)
+		defer leavePhase(PROFILE_LOADING)
+	}
 
 	var view *View
 	var err error
@@ -105,17 +123,30 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 	case parser.Dual:
 		view = loadDualView()
 	case parser.Stdin:
-		delimiter := cmd.GetFlags().Delimiter
-		if delimiter == cmd.UNDEF {
-			delimiter = ','
+		options, err := NewTableOptions(table.Options)
+		if err != nil {
+			return nil, err
+		}
+		if 0 < len(options.Sheet) || 0 < options.LimitRead {
+			return nil, NewTableOptionNotApplicableError(table)
+		}
+
+		if options.Delimiter == cmd.UNDEF {
+			options.Delimiter = ','
 		}
 		fileInfo := &FileInfo{
 			Path:        table.Object.String(),
-			Delimiter:   delimiter,
+			Delimiter:   options.Delimiter,
+			NoHeader:    options.NoHeader,
+			Encoding:    options.Encoding,
 			IsTemporary: true,
 		}
 
-		if !filter.TempViews[len(filter.TempViews)-1].Exists(fileInfo.Path) {
+		if filter.TempViews[len(filter.TempViews)-1].Exists(fileInfo.Path) {
+			if !filter.TempViews[len(filter.TempViews)-1].HasSameOptions(fileInfo.Path, options) {
+				return nil, NewTableOptionsConflictError(table, fileInfo.Path)
+			}
+		} else {
 			if !cmd.IsReadableFromPipeOrRedirection() {
 				return nil, NewStdinEmptyError(table.Object.(parser.Stdin))
 			}
@@ -123,7 +154,7 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			fp := os.Stdin
 			defer fp.Close()
 
-			loadView, err := loadViewFromFile(fp, fileInfo)
+			loadView, err := loadViewFromFile(fp, fileInfo, table.Object, nil)
 			if err != nil {
 				return nil, NewCsvParsingError(table.Object, fileInfo.Path, err.Error())
 			}
@@ -147,11 +178,17 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 	case parser.Identifier:
 		tableIdentifier := table.Object.(parser.Identifier)
 		if filter.RecursiveTable != nil && strings.EqualFold(tableIdentifier.Literal, filter.RecursiveTable.Name.Literal) && filter.RecursiveTmpView != nil {
+			if 0 < len(table.Options) {
+				return nil, NewTableOptionNotApplicableError(table)
+			}
 			view = filter.RecursiveTmpView
 			if !strings.EqualFold(filter.RecursiveTable.Name.Literal, table.Name().Literal) {
 				view.Header.Update(table.Name().Literal, nil)
 			}
 		} else if it, err := filter.InlineTables.Get(tableIdentifier); err == nil {
+			if 0 < len(table.Options) {
+				return nil, NewTableOptionNotApplicableError(table)
+			}
 			if err = filter.Aliases.Add(table.Name(), ""); err != nil {
 				return nil, err
 			}
@@ -162,8 +199,13 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 		} else {
 			var fileInfo *FileInfo
 			var commonTableName string
+			var filtered *View
+			var isGlob bool
 
 			if filter.TempViews.Exists(tableIdentifier.Literal) {
+				if 0 < len(table.Options) {
+					return nil, NewTableOptionNotApplicableError(table)
+				}
 				fileInfo = &FileInfo{
 					Path: tableIdentifier.Literal,
 				}
@@ -179,56 +221,166 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			} else {
 				flags := cmd.GetFlags()
 
-				fileInfo, err = NewFileInfoForCreate(tableIdentifier, flags.Repository, flags.Delimiter)
+				options, err := NewTableOptions(table.Options)
+				if err != nil {
+					return nil, err
+				}
+
+				delimiter := options.Delimiter
+				fileInfo, err = NewFileInfoForCreate(tableIdentifier, options.Repository, delimiter)
 				if err != nil {
 					return nil, err
 				}
+				options.Delimiter = fileInfo.Delimiter
+
+				if isXlsxFile(fileInfo.Path) {
+					if forUpdate {
+						return nil, NewFileNotUpdatableError(tableIdentifier, fileInfo.Path)
+					}
+				} else if 0 < len(options.Sheet) {
+					return nil, NewTableOptionNotApplicableError(table)
+				}
+
+				if forUpdate && 0 < options.LimitRead {
+					return nil, NewTableOptionNotApplicableError(table)
+				}
 
-				if !ViewCache.Exists(fileInfo.Path) {
-					fileInfo, err = NewFileInfo(tableIdentifier, flags.Repository, flags.Delimiter)
+				columns := filter.referencedColumns
+				if forUpdate {
+					columns = nil
+				}
+
+				if !ViewCache.Exists(fileInfo.Path) || !ViewCache.HasSameOptions(fileInfo.Path, options) || !ViewCache.HasColumns(fileInfo.Path, columns) {
+					createInfo := fileInfo
+					fileInfo, err = NewFileInfo(tableIdentifier, options.Repository, delimiter)
 					if err != nil {
-						return nil, err
+						if isGlobPattern(createInfo.Path) {
+							if forUpdate {
+								return nil, NewFileNotUpdatableError(tableIdentifier, createInfo.Path)
+							}
+							fileInfo = createInfo
+							isGlob = true
+						} else {
+							fpath, ok := cachedFilePath(createInfo.Path)
+							if !ok {
+								return nil, err
+							}
+							fileInfo = &FileInfo{
+								Path:      fpath,
+								Delimiter: delimiter,
+							}
+							if delimiter == cmd.UNDEF {
+								fileInfo.Delimiter = ViewCache[strings.ToUpper(fpath)].FileInfo.Delimiter
+							}
+						}
+					}
+					options.Delimiter = fileInfo.Delimiter
+					if !forUpdate && options.LimitRead < 1 && !(ViewCache.Exists(fileInfo.Path) && ViewCache[strings.ToUpper(fileInfo.Path)].ForUpdate) {
+						// Files loaded to be updated in the transaction are read in full.
+						options.LimitRead = flags.Head
+					}
+					fileInfo.NoHeader = options.NoHeader
+					fileInfo.Encoding = options.Encoding
+					fileInfo.Sheet = options.Sheet
+					fileInfo.LimitRead = options.LimitRead
+					if isRemovedFile(fileInfo.Path) {
+						return nil, NewFileNotExistError(tableIdentifier)
 					}
 
 					ufpath := strings.ToUpper(fileInfo.Path)
-					if !ViewCache.Exists(fileInfo.Path) || (forUpdate && !ViewCache[ufpath].ForUpdate) {
-						ViewCache.Dispose(fileInfo.Path)
+					reload := ViewCache.Exists(fileInfo.Path) && !ViewCache.HasSameOptions(fileInfo.Path, options)
+					if reload && ViewCache[ufpath].ForUpdate {
+						return nil, NewTableOptionsConflictError(table, fileInfo.Path)
+					}
+					if !reload && ViewCache.Exists(fileInfo.Path) && !ViewCache.HasColumns(fileInfo.Path, columns) {
+						// Columns loaded by other queries are kept so that the cache can be reused by them.
+						columns = mergeColumns(ViewCache[ufpath].FileInfo.Columns, columns)
+						reload = true
+					}
+					if isXlsxFile(fileInfo.Path) || Schemas.Exists(fileInfo.Path) || flags.TrimTrailingDelimiter {
+						columns = nil
+					}
+					fileInfo.Columns = columns
 
-						var fp *os.File
+					var condition *loadCondition
+					if filter.loadCondition != nil && !forUpdate && !useInternalId && !isGlob && !ViewCache.Exists(fileInfo.Path) && filter.loadCondition.isApplicable(fileInfo, options) {
+						condition = filter.loadCondition
+					}
+
+					if !ViewCache.Exists(fileInfo.Path) || reload || (forUpdate && !ViewCache[ufpath].ForUpdate) {
 						if forUpdate {
-							fp, err = file.OpenToUpdate(fileInfo.Path)
-							if err != nil {
-								if _, ok := err.(*file.TimeoutError); ok {
-									return nil, NewFileLockTimeoutError(tableIdentifier, fileInfo.Path)
-								}
-								return nil, NewReadFileError(tableIdentifier, err.Error())
+							if err = materializeCursorStreams(fileInfo.Path); err != nil {
+								return nil, err
 							}
-							fileInfo.File = fp
+						}
+						ViewCache.Dispose(fileInfo.Path)
+
+						var loadView *View
+						if isGlob {
+							loadView, err = loadViewFromGlob(fileInfo, tableIdentifier)
 						} else {
-							fp, err = file.OpenToRead(fileInfo.Path)
-							if err != nil {
-								if _, ok := err.(*file.TimeoutError); ok {
-									return nil, NewFileLockTimeoutError(tableIdentifier, fileInfo.Path)
+							var fp *os.File
+							if forUpdate {
+								fp, err = file.OpenToUpdate(fileInfo.Path)
+								if err != nil {
+									if _, ok := err.(*file.TimeoutError); ok {
+										return nil, NewFileLockTimeoutError(tableIdentifier, fileInfo.Path)
+									}
+									return nil, NewReadFileError(tableIdentifier, err.Error())
+								}
+								if err = fileInfo.SetFileState(fp); err != nil {
+									file.Close(fp)
+									return nil, NewReadFileError(tableIdentifier, err.Error())
 								}
-								return nil, NewReadFileError(tableIdentifier, err.Error())
+								if flags.Backup {
+									if err = BackupFile(fp, fileInfo.Path+flags.BackupSuffix); err != nil {
+										file.Close(fp)
+										return nil, NewWriteFileError(tableIdentifier, err.Error())
+									}
+								}
+								fileInfo.File = fp
+							} else {
+								fp, err = file.OpenToRead(fileInfo.Path)
+								if err != nil {
+									if _, ok := err.(*file.TimeoutError); ok {
+										return nil, NewFileLockTimeoutError(tableIdentifier, fileInfo.Path)
+									}
+									return nil, NewReadFileError(tableIdentifier, err.Error())
+								}
+								defer file.Close(fp)
+							}
+							loadView, err = loadViewFromFile(fp, fileInfo, tableIdentifier, condition)
+							if err != nil && forUpdate {
+								file.Close(fp)
 							}
-							defer file.Close(fp)
 						}
-						loadView, err := loadViewFromFile(fp, fileInfo)
 						if err != nil {
-							if forUpdate {
-								file.Close(fp)
+							if _, ok := err.(AppError); ok {
+								return nil, err
+							}
+							if isXlsxFile(fileInfo.Path) {
+								return nil, NewXlsxParsingError(tableIdentifier, fileInfo.Path, err.Error())
 							}
 							return nil, NewCsvParsingError(tableIdentifier, fileInfo.Path, err.Error())
 						}
 						loadView.ForUpdate = forUpdate
-						ViewCache.Set(loadView)
+						if condition != nil {
+							// Views that do not have all the records of the file are not cached.
+							filtered = loadView
+						} else {
+							ViewCache.Set(loadView)
+						}
 					}
 				}
+				if isRemovedFile(fileInfo.Path) {
+					return nil, NewFileNotExistError(tableIdentifier)
+				}
 				commonTableName = parser.FormatTableName(fileInfo.Path)
 
 				pathIdent := parser.Identifier{Literal: fileInfo.Path}
-				if useInternalId {
+				if filtered != nil {
+					view = filtered
+				} else if useInternalId {
 					view, _ = ViewCache.GetWithInternalId(pathIdent)
 				} else {
 					view, _ = ViewCache.Get(pathIdent)
@@ -254,6 +406,16 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			return nil, err
 		}
 
+		enterPhase(PROFILE_JOINING)
+		defer leavePhase(PROFILE_JOINING)
+
+		if !join.AsOf.IsEmpty() {
+			if err = AsOfJoin(view, view2, join, filter); err != nil {
+				return nil, err
+			}
+			break
+		}
+
 		condition, includeFields, excludeFields, err := ParseJoinCondition(join, view, view2)
 		if err != nil {
 			return nil, err
@@ -268,6 +430,12 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 			}
 		}
 
+		if joinType == parser.CROSS || (joinType == parser.INNER && condition == nil) {
+			if err = checkResultRows(join.JoinTable.(parser.Table).Object, view.RecordLen()*view2.RecordLen()); err != nil {
+				return nil, err
+			}
+		}
+
 		switch joinType {
 		case parser.CROSS:
 			CrossJoin(view, view2)
@@ -333,39 +501,224 @@ func loadView(tableExpr parser.QueryExpression, filter *Filter, useInternalId bo
 	case parser.Subquery:
 		subquery := table.Object.(parser.Subquery)
 		view, err = Select(subquery.Query, filter)
-		if table.Alias != nil {
-			if err = filter.Aliases.Add(table.Alias.(parser.Identifier), ""); err != nil {
-				return nil, err
-			}
+		if err != nil {
+			return nil, err
 		}
-		if err == nil {
-			view.Header.Update(table.Name().Literal, nil)
+		err = updateVirtualTableHeader(view, table, filter)
+	case parser.ValuesTable:
+		view, err = loadViewFromValuesTable(table.Object.(parser.ValuesTable), filter)
+		if err != nil {
+			return nil, err
+		}
+		err = updateVirtualTableHeader(view, table, filter)
+	case parser.UnnestTable:
+		view, err = loadViewFromUnnestTable(table.Object.(parser.UnnestTable), filter)
+		if err != nil {
+			return nil, err
 		}
+		err = updateVirtualTableHeader(view, table, filter)
+	}
+
+	if err == nil && 0 < len(table.Options) {
+		err = addComputedColumns(view, table, filter)
 	}
 
 	return view, err
 }
 
-func loadViewFromFile(fp *os.File, fileInfo *FileInfo) (*View, error) {
+// addComputedColumns appends the columns declared with the ADD COLUMN table option to the loaded view.
+// The values are not written back to the file.
+func addComputedColumns(view *View, table parser.Table, filter *Filter) error {
+	options, err := NewTableOptions(table.Options)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range options.ComputedColumns {
+		if InStrSliceWithCaseInsensitive(c.Name.Literal, view.Header.TableColumnNames()) {
+			return NewDuplicateFieldNameError(c.Name)
+		}
+
+		values := make([]value.Primary, view.RecordLen())
+		filterForLoop := NewFilterForSequentialEvaluation(view, filter)
+		for i := range view.RecordSet {
+			filterForLoop.Records[0].RecordIndex = i
+			if values[i], err = filterForLoop.Evaluate(c.Value); err != nil {
+				return err
+			}
+		}
+
+		number := 0
+		for _, f := range view.Header {
+			if number < f.Number {
+				number = f.Number
+			}
+		}
+		view.Header = append(view.Header, HeaderField{
+			View:        table.Name().Literal,
+			Column:      c.Name.Literal,
+			Number:      number + 1,
+			IsFromTable: true,
+			IsComputed:  true,
+		})
+
+		for i := range view.RecordSet {
+			record := make(Record, len(view.RecordSet[i])+1)
+			copy(record, view.RecordSet[i])
+			record[len(record)-1] = NewCell(values[i])
+			view.RecordSet[i] = record
+		}
+	}
+	return nil
+}
+
+func loadViewFromValuesTable(valuesTable parser.ValuesTable, filter *Filter) (*View, error) {
+	records := make(RecordSet, len(valuesTable.RowValues))
+	fieldLen := 0
+	for i, v := range valuesTable.RowValues {
+		rv := v.(parser.RowValue)
+		values, err := filter.evalRowValue(rv)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			fieldLen = len(values)
+		} else if len(values) != fieldLen {
+			return nil, NewValuesTableRowValueLengthError(rv, fieldLen)
+		}
+		records[i] = NewRecord(values)
+	}
+
+	fields := make([]string, fieldLen)
+	for i := range fields {
+		fields[i] = "c" + strconv.Itoa(i+1)
+	}
+
+	view := NewView()
+	view.Header = NewHeader("", fields)
+	view.RecordSet = records
+	return view, nil
+}
+
+// loadViewFromUnnestTable expands an array into records that have one column.
+// A null value is expanded into no records.
+func loadViewFromUnnestTable(unnestTable parser.UnnestTable, filter *Filter) (*View, error) {
+	p, err := filter.Evaluate(unnestTable.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []value.Primary
+	if !value.IsNull(p) {
+		a, ok := p.(value.Array)
+		if !ok {
+			return nil, NewUnnestNotArrayError(unnestTable)
+		}
+		values = a.Raw()
+	}
+
+	fields := []string{"value"}
+	if unnestTable.WithOrdinality() {
+		fields = append(fields, "ordinality")
+	}
+
+	records := make(RecordSet, len(values))
+	for i, v := range values {
+		if unnestTable.WithOrdinality() {
+			records[i] = NewRecord([]value.Primary{v, value.NewInteger(int64(i + 1))})
+		} else {
+			records[i] = NewRecord([]value.Primary{v})
+		}
+	}
+
+	view := NewView()
+	view.Header = NewHeader("", fields)
+	view.RecordSet = records
+	return view, nil
+}
+
+func updateVirtualTableHeader(view *View, table parser.Table, filter *Filter) error {
+	if table.Alias != nil {
+		if err := filter.Aliases.Add(table.Alias.(parser.Identifier), ""); err != nil {
+			return err
+		}
+	}
+	if err := view.Header.Update(table.Name().Literal, table.Fields); err != nil {
+		if _, ok := err.(*FieldLengthNotMatchError); ok {
+			return NewTableAliasFieldLengthError(table, view.FieldLen())
+		}
+		return err
+	}
+	return nil
+}
+
+func newCsvReader(r io.Reader, delimiter rune, enc cmd.Encoding) (*csv.Reader, cmd.Encoding, error) {
 	flags := cmd.GetFlags()
 
-	r := cmd.GetReader(fp, flags.Encoding)
+	if enc == cmd.AUTO {
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, enc, err
+		}
+		enc = cmd.DetectEncoding(b)
+		r = bytes.NewReader(b)
+	}
 
-	reader := csv.NewReader(r)
-	reader.Delimiter = fileInfo.Delimiter
+	reader := csv.NewReader(cmd.GetReader(r, enc))
+	reader.Delimiter = delimiter
 	reader.WithoutNull = flags.WithoutNull
+	reader.EncodingFallback = flags.EncodingFallback
+	reader.SkipLines = flags.SkipLines
+	reader.CommentPrefix = flags.CommentPrefix
+	reader.BackslashEscape = delimiter == '\t' && flags.TsvStyle == cmd.ESCAPE
+	return reader, enc, nil
+}
+
+func loadViewFromFile(fp *os.File, fileInfo *FileInfo, expr parser.QueryExpression, condition *loadCondition) (*View, error) {
+	if isXlsxFile(fileInfo.Path) {
+		return loadViewFromXlsx(fp, fileInfo)
+	}
+
+	flags := cmd.GetFlags()
+
+	reader, enc, err := newCsvReader(fp, fileInfo.Delimiter, fileInfo.Encoding)
+	if err != nil {
+		return nil, err
+	}
 
-	var err error
 	var header []string
-	if !flags.NoHeader {
+	if !fileInfo.NoHeader {
 		header, err = reader.ReadHeader()
 		if err != nil && err != csv.EOF {
 			return nil, err
 		}
+		if flags.PreserveQuoting {
+			fileInfo.UnquotedHeader = make(map[string]bool, len(header))
+			for i, quoted := range reader.FieldsQuoted() {
+				if !quoted && i < len(header) {
+					fileInfo.UnquotedHeader[header[i]] = true
+				}
+			}
+		}
+	}
+
+	var fieldIndices []int
+	var fieldNames []string
+	if header != nil {
+		fieldIndices = prunedFieldIndices(header, fileInfo.Columns)
+		fieldNames = projectFieldNames(header, fieldIndices)
+		if condition != nil && !condition.prepare(fieldNames) {
+			condition = nil
+		}
+	}
+
+	type csvRecord struct {
+		fields []csv.Field
+		quoted []bool
 	}
 
 	records := RecordSet{}
-	rowch := make(chan []csv.Field, 1000)
+	rowch := make(chan csvRecord, 1000)
 	fieldch := make(chan []value.Primary, 1000)
 
 	wg := sync.WaitGroup{}
@@ -382,6 +735,8 @@ func loadViewFromFile(fp *os.File, fileInfo *FileInfo) (*View, error) {
 		wg.Done()
 	}()
 
+	var conditionErr error
+
 	wg.Add(1)
 	go func() {
 		for {
@@ -389,9 +744,55 @@ func loadViewFromFile(fp *os.File, fileInfo *FileInfo) (*View, error) {
 			if !ok {
 				break
 			}
-			fields := make([]value.Primary, len(row))
-			for i, v := range row {
-				fields[i] = v.ToPrimary()
+			if conditionErr != nil {
+				continue
+			}
+			if fieldNames == nil {
+				names := make([]string, len(row.fields))
+				for i := range names {
+					names[i] = "c" + strconv.Itoa(i+1)
+				}
+				fieldIndices = prunedFieldIndices(names, fileInfo.Columns)
+				fieldNames = projectFieldNames(names, fieldIndices)
+				if condition != nil && !condition.prepare(fieldNames) {
+					condition = nil
+				}
+			}
+			if fieldIndices != nil {
+				projected := csvRecord{fields: make([]csv.Field, 0, len(fieldIndices))}
+				if row.quoted != nil {
+					projected.quoted = make([]bool, 0, len(fieldIndices))
+				}
+				for _, idx := range fieldIndices {
+					if idx < len(row.fields) {
+						projected.fields = append(projected.fields, row.fields[idx])
+						if row.quoted != nil {
+							projected.quoted = append(projected.quoted, row.quoted[idx])
+						}
+					}
+				}
+				row = projected
+			}
+			fields := make([]value.Primary, len(row.fields))
+			for i, v := range row.fields {
+				if row.quoted != nil && v != nil && !row.quoted[i] {
+					fields[i] = value.NewUnquotedString(string(v))
+				} else {
+					fields[i] = v.ToPrimary()
+				}
+			}
+			if condition != nil {
+				if flags.InferTypes == cmd.INFER_PER_CELL {
+					for i := range fields {
+						fields[i] = inferValueType(fields[i])
+					}
+				}
+				if ok, e := condition.match(NewRecord(fields)); e != nil {
+					conditionErr = e
+					continue
+				} else if !ok {
+					continue
+				}
 			}
 			fieldch <- fields
 		}
@@ -401,7 +802,7 @@ func loadViewFromFile(fp *os.File, fileInfo *FileInfo) (*View, error) {
 
 	wg.Add(1)
 	go func() {
-		for {
+		for n := 0; fileInfo.LimitRead < 1 || n < fileInfo.LimitRead; n++ {
 			record, e := reader.Read()
 			if e == csv.EOF {
 				break
@@ -410,7 +811,11 @@ func loadViewFromFile(fp *os.File, fileInfo *FileInfo) (*View, error) {
 				err = e
 				break
 			}
-			rowch <- record
+			row := csvRecord{fields: record}
+			if flags.PreserveQuoting {
+				row.quoted = reader.FieldsQuoted()
+			}
+			rowch <- row
 		}
 		close(rowch)
 		wg.Done()
@@ -421,16 +826,56 @@ func loadViewFromFile(fp *os.File, fileInfo *FileInfo) (*View, error) {
 	if err != nil {
 		return nil, err
 	}
+	if conditionErr != nil {
+		return nil, conditionErr
+	}
+	if condition != nil {
+		condition.applied = true
+	}
+	// Files to be updated are opened before being loaded, and kept open until the transaction ends.
+	forUpdate := fileInfo.File != nil
+	if forUpdate && 0 < reader.SkippedLines {
+		// Skipped lines cannot be written back, so the file opened to be updated is left as it is.
+		return nil, NewSkippedLinesNotUpdatableError(expr, fileInfo.Path)
+	}
+
+	fieldLen := reader.FieldsPerRecord
+	if flags.TrimTrailingDelimiter && reader.TrailingDelimiter {
+		fieldLen--
+		if header != nil {
+			header = header[:fieldLen]
+		}
+		for i := range records {
+			records[i] = records[i][:fieldLen]
+		}
+	}
 
 	if header == nil {
-		header = make([]string, reader.FieldsPerRecord)
-		for i := 0; i < reader.FieldsPerRecord; i++ {
+		header = make([]string, fieldLen)
+		for i := 0; i < fieldLen; i++ {
 			header[i] = "c" + strconv.Itoa(i+1)
 		}
 	}
+	header = projectFieldNames(header, fieldIndices)
+
+	if schema, ok := Schemas.Get(fileInfo.Path); ok {
+		conversionErrors, err := schema.Apply(header, records)
+		if err != nil {
+			return nil, err
+		}
+		for _, message := range conversionErrors {
+			Warnings.Add(expr, fmt.Sprintf(WARNING_CONVERSION_ERROR, fileInfo.Path, message))
+		}
+	} else if !forUpdate {
+		// Types are not inferred for files to be updated so that fields not updated are written back as they are.
+		inferTypes(records, flags.InferTypes)
+	}
+
+	if 0 < reader.InvalidSequences {
+		Warnings.Add(expr, fmt.Sprintf(WARNING_INVALID_BYTE_SEQUENCES, reader.InvalidSequences, fileInfo.Path))
+	}
 
-	fileInfo.NoHeader = flags.NoHeader
-	fileInfo.Encoding = flags.Encoding
+	fileInfo.Encoding = enc
 	fileInfo.LineBreak = reader.LineBreak
 	if fileInfo.LineBreak == "" {
 		fileInfo.LineBreak = flags.LineBreak
@@ -443,6 +888,163 @@ func loadViewFromFile(fp *os.File, fileInfo *FileInfo) (*View, error) {
 	return view, nil
 }
 
+// Files matching the pattern are read concurrently, and their records are concatenated in the order of the file paths.
+func loadViewFromGlob(fileInfo *FileInfo, tableIdentifier parser.Identifier) (*View, error) {
+	paths, err := globFilePaths(fileInfo.Path)
+	if err != nil {
+		return nil, NewReadFileError(tableIdentifier, err.Error())
+	}
+	if len(paths) < 1 {
+		return nil, NewFileNotExistError(tableIdentifier)
+	}
+
+	views := make([]*View, len(paths))
+
+	gm := NewGoroutineManager(len(paths), 2)
+	for i := 0; i < gm.CPU; i++ {
+		gm.Add()
+		go func(thIdx int) {
+			start, end := gm.RecordRange(thIdx)
+
+			for j := start; j < end; j++ {
+				if gm.HasError() {
+					break
+				}
+				v, e := loadViewFromMatchedFile(paths[j], fileInfo, tableIdentifier)
+				if e != nil {
+					gm.SetError(e)
+					break
+				}
+				views[j] = v
+			}
+
+			gm.Done()
+		}(i)
+	}
+	gm.Wait()
+
+	if gm.HasError() {
+		return nil, gm.Error()
+	}
+
+	fields := views[0].Header.TableColumnNames()
+	recordLen := 0
+	for i, v := range views {
+		if i != 0 && !equalColumnNames(v.Header.TableColumnNames(), fields) {
+			return nil, NewGlobHeaderNotMatchError(tableIdentifier, paths[i], paths[0])
+		}
+		recordLen += v.RecordLen()
+	}
+
+	records := make(RecordSet, 0, recordLen)
+	for _, v := range views {
+		records = append(records, v.RecordSet...)
+	}
+	if 0 < fileInfo.LimitRead && fileInfo.LimitRead < len(records) {
+		records = records[:fileInfo.LimitRead]
+	}
+
+	fileInfo.Encoding = views[0].FileInfo.Encoding
+	fileInfo.LineBreak = views[0].FileInfo.LineBreak
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), fields)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+func equalColumnNames(names1 []string, names2 []string) bool {
+	if len(names1) != len(names2) {
+		return false
+	}
+	for i := range names1 {
+		if !strings.EqualFold(names1[i], names2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func loadViewFromMatchedFile(fpath string, fileInfo *FileInfo, tableIdentifier parser.Identifier) (*View, error) {
+	fp, err := file.OpenToRead(fpath)
+	if err != nil {
+		if _, ok := err.(*file.TimeoutError); ok {
+			return nil, NewFileLockTimeoutError(tableIdentifier, fpath)
+		}
+		return nil, NewReadFileError(tableIdentifier, err.Error())
+	}
+	defer file.Close(fp)
+
+	matchedInfo := &FileInfo{
+		Path:      fpath,
+		Delimiter: fileInfo.Delimiter,
+		NoHeader:  fileInfo.NoHeader,
+		Encoding:  fileInfo.Encoding,
+		Sheet:     fileInfo.Sheet,
+		LimitRead: fileInfo.LimitRead,
+		Columns:   fileInfo.Columns,
+	}
+	view, err := loadViewFromFile(fp, matchedInfo, tableIdentifier, nil)
+	if err != nil {
+		if _, ok := err.(AppError); ok {
+			return nil, err
+		}
+		if isXlsxFile(fpath) {
+			return nil, NewXlsxParsingError(tableIdentifier, fpath, err.Error())
+		}
+		return nil, NewCsvParsingError(tableIdentifier, fpath, err.Error())
+	}
+	return view, nil
+}
+
+func loadViewFromXlsx(fp *os.File, fileInfo *FileInfo) (*View, error) {
+	info, err := fp.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := xlsx.ReadSheet(fp, info.Size(), fileInfo.Sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldLen := 0
+	if 0 < len(rows) {
+		fieldLen = len(rows[0])
+	}
+
+	header := make([]string, fieldLen)
+	for i := 0; i < fieldLen; i++ {
+		header[i] = "c" + strconv.Itoa(i+1)
+	}
+	if !fileInfo.NoHeader && 0 < len(rows) {
+		for i, p := range rows[0] {
+			if s := value.ToString(p); !value.IsNull(s) && 0 < len(s.(value.String).Raw()) {
+				header[i] = s.(value.String).Raw()
+			}
+		}
+		rows = rows[1:]
+	}
+	if 0 < fileInfo.LimitRead && fileInfo.LimitRead < len(rows) {
+		rows = rows[:fileInfo.LimitRead]
+	}
+
+	records := make(RecordSet, len(rows))
+	for i, row := range rows {
+		records[i] = NewRecord(row)
+	}
+
+	fileInfo.Encoding = cmd.UTF8
+	fileInfo.LineBreak = cmd.GetFlags().LineBreak
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
 func loadDualView() *View {
 	view := View{
 		Header:    NewDualHeader(),
@@ -475,6 +1077,9 @@ func NewViewFromGroupedRecord(filterRecord FilterRecord) *View {
 }
 
 func (view *View) Where(clause parser.WhereClause) error {
+	enterPhase(PROFILE_FILTERING)
+	defer leavePhase(PROFILE_FILTERING)
+
 	return view.filter(clause.Filter)
 }
 
@@ -527,6 +1132,9 @@ func (view *View) filter(condition parser.QueryExpression) error {
 }
 
 func (view *View) GroupBy(clause parser.GroupByClause) error {
+	enterPhase(PROFILE_GROUPING)
+	defer leavePhase(PROFILE_GROUPING)
+
 	return view.group(clause.Items)
 }
 
@@ -612,8 +1220,10 @@ func (view *View) group(items []parser.QueryExpression) error {
 	return nil
 }
 
+// groupAll makes no group if there is no record, except in scalar subqueries,
+// in which aggregate functions return their results for an empty set.
 func (view *View) groupAll() error {
-	if 0 < view.RecordLen() {
+	if 0 < view.RecordLen() || view.aggregatesEmptySet() {
 		records := make(RecordSet, 1)
 		record := make(Record, view.FieldLen())
 		for i := 0; i < view.FieldLen(); i++ {
@@ -631,7 +1241,34 @@ func (view *View) groupAll() error {
 	return nil
 }
 
+func (view *View) aggregatesEmptySet() bool {
+	return view.Filter != nil && view.Filter.aggregatesEmptySet
+}
+
 func (view *View) Having(clause parser.HavingClause) error {
+	enterPhase(PROFILE_FILTERING)
+	defer leavePhase(PROFILE_FILTERING)
+
+	if !view.isGrouped && view.RecordLen() < 1 && view.aggregatesEmptySet() {
+		// Aggregate functions in the condition are detected with a record of nulls,
+		// and then the condition is applied to the group of no records.
+		values := make([]value.Primary, view.FieldLen())
+		for i := range values {
+			values[i] = value.NewNull()
+		}
+		probe := &View{
+			Header:    view.Header,
+			RecordSet: RecordSet{NewRecord(values)},
+			Filter:    view.Filter,
+		}
+		if err := probe.filter(clause.Filter); err != nil {
+			if _, ok := err.(*NotGroupingRecordsError); !ok {
+				return err
+			}
+			view.group(nil)
+		}
+	}
+
 	err := view.filter(clause.Filter)
 	if err != nil {
 		if _, ok := err.(*NotGroupingRecordsError); ok {
@@ -647,48 +1284,118 @@ func (view *View) Having(clause parser.HavingClause) error {
 	return nil
 }
 
-func (view *View) Select(clause parser.SelectClause) error {
-	var parseAllColumns = func(view *View, fields []parser.QueryExpression) []parser.QueryExpression {
-		insertIdx := -1
-
-		for i, field := range fields {
-			if _, ok := field.(parser.Field).Object.(parser.AllColumns); ok {
-				insertIdx = i
-				break
+func (view *View) expandAllColumns(allColumns parser.AllColumns) ([]parser.QueryExpression, error) {
+	columns := view.Header.TableColumns()
+	if 0 < len(allColumns.View.Literal) {
+		list := make([]parser.QueryExpression, 0, len(columns))
+		for _, c := range columns {
+			if strings.EqualFold(c.(parser.FieldReference).View.Literal, allColumns.View.Literal) {
+				list = append(list, c)
 			}
 		}
+		if len(list) < 1 {
+			return nil, NewTableNotLoadedError(allColumns.View)
+		}
+		columns = list
+	}
 
-		if insertIdx < 0 {
-			return fields
+	if allColumns.Except != nil {
+		exceptIndices, err := view.FieldIndices(allColumns.Except)
+		if err != nil {
+			return nil, err
 		}
 
-		columns := view.Header.TableColumns()
-		insertLen := len(columns)
-		insert := make([]parser.QueryExpression, insertLen)
-		for i, c := range columns {
-			insert[i] = parser.Field{
-				Object: c,
+		list := make([]parser.QueryExpression, 0, len(columns))
+		for _, c := range columns {
+			idx, _ := view.FieldIndex(c)
+			if !InIntSlice(idx, exceptIndices) {
+				list = append(list, c)
 			}
 		}
+		columns = list
+	}
+	replaceIndices := make([]int, len(allColumns.Replace))
+	for i, r := range allColumns.Replace {
+		alias := r.(parser.Field).Alias.(parser.Identifier)
+		idx, err := view.FieldIndex(parser.FieldReference{BaseExpr: alias.BaseExpr, Column: alias})
+		if err != nil {
+			return nil, err
+		}
+		replaceIndices[i] = idx
+	}
 
-		list := make([]parser.QueryExpression, len(fields)-1+insertLen)
-		for i, field := range fields {
-			switch {
-			case i == insertIdx:
-				continue
-			case i < insertIdx:
-				list[i] = field
-			default:
-				list[i+insertLen-1] = field
+	insert := make([]parser.QueryExpression, len(columns))
+	for i, c := range columns {
+		insert[i] = parser.Field{
+			Object: c,
+		}
+		if 0 < len(replaceIndices) {
+			idx, _ := view.FieldIndex(c)
+			for j, ridx := range replaceIndices {
+				if idx == ridx {
+					insert[i] = allColumns.Replace[j]
+					break
+				}
 			}
 		}
-		for i, field := range insert {
-			list[i+insertIdx] = field
+	}
+	return insert, nil
+}
+
+func (view *View) parseAllColumns(fields []parser.QueryExpression) ([]parser.QueryExpression, error) {
+	list := make([]parser.QueryExpression, 0, len(fields))
+	for _, field := range fields {
+		allColumns, ok := field.(parser.Field).Object.(parser.AllColumns)
+		if !ok {
+			list = append(list, field)
+			continue
 		}
 
-		return list
+		insert, err := view.expandAllColumns(allColumns)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, insert...)
 	}
+	return list, nil
+}
 
+// Replaces positions of fields in items with the corresponding fields in the select clause.
+func (view *View) replaceFieldPositions(items []parser.QueryExpression, clause parser.SelectClause) ([]parser.QueryExpression, error) {
+	var fields []parser.QueryExpression
+	var err error
+
+	list := make([]parser.QueryExpression, len(items))
+	for i, item := range items {
+		pos, ok := fieldPosition(item)
+		if !ok {
+			list[i] = item
+			continue
+		}
+
+		if fields == nil {
+			if fields, err = view.parseAllColumns(clause.Fields); err != nil {
+				return nil, err
+			}
+		}
+		if pos < 1 || len(fields) < pos {
+			return nil, NewFieldPositionOutOfRangeError(item)
+		}
+		list[i] = fields[pos-1].(parser.Field).Object
+	}
+	return list, nil
+}
+
+func fieldPosition(expr parser.QueryExpression) (int, bool) {
+	if pt, ok := expr.(parser.PrimitiveType); ok {
+		if i, ok := pt.Value.(value.Integer); ok {
+			return int(i.Raw()), true
+		}
+	}
+	return 0, false
+}
+
+func (view *View) Select(clause parser.SelectClause) error {
 	var evalFields = func(view *View, fields []parser.QueryExpression) error {
 		fieldsObjects := make([]parser.QueryExpression, len(fields))
 		for i, f := range fields {
@@ -716,10 +1423,13 @@ func (view *View) Select(clause parser.SelectClause) error {
 		return nil
 	}
 
-	fields := parseAllColumns(view, clause.Fields)
+	fields, err := view.parseAllColumns(clause.Fields)
+	if err != nil {
+		return err
+	}
 
 	origFieldLen := view.FieldLen()
-	err := evalFields(view, fields)
+	err = evalFields(view, fields)
 	if err != nil {
 		if _, ok := err.(*NotGroupingRecordsError); ok {
 			view.Header = view.Header[:origFieldLen]
@@ -740,19 +1450,14 @@ func (view *View) Select(clause parser.SelectClause) error {
 	}
 
 	if clause.IsDistinct() {
-		view.GenerateComparisonKeys()
-		records := make(RecordSet, 0, view.RecordLen())
-		values := make(map[string]bool)
-		for i, v := range view.RecordSet {
-			if !values[view.comparisonKeysInEachRecord[i]] {
-				values[view.comparisonKeysInEachRecord[i]] = true
-
-				record := make(Record, len(view.selectFields))
-				for j, idx := range view.selectFields {
-					record[j] = v[idx]
-				}
-				records = append(records, record)
+		indices := view.uniqueRecordIndices()
+		records := make(RecordSet, len(indices))
+		for i, recordIdx := range indices {
+			record := make(Record, len(view.selectFields))
+			for j, idx := range view.selectFields {
+				record[j] = view.RecordSet[recordIdx][idx]
 			}
+			records[i] = record
 		}
 
 		hfields := NewEmptyHeader(len(view.selectFields))
@@ -763,13 +1468,30 @@ func (view *View) Select(clause parser.SelectClause) error {
 
 		view.Header = hfields
 		view.RecordSet = records
-		view.comparisonKeysInEachRecord = nil
 		view.sortValuesInEachCell = nil
+		view.partitionLists = nil
 	}
 
 	return nil
 }
 
+// Returns indices of records appearing first for each comparison key, in the order of the records.
+func (view *View) uniqueRecordIndices() []int {
+	view.GenerateComparisonKeys()
+
+	indices := make([]int, 0, view.RecordLen())
+	keys := make(map[string]bool, view.RecordLen())
+	for i, key := range view.comparisonKeysInEachRecord {
+		if !keys[key] {
+			keys[key] = true
+			indices = append(indices, i)
+		}
+	}
+
+	view.comparisonKeysInEachRecord = nil
+	return indices
+}
+
 func (view *View) GenerateComparisonKeys() {
 	view.comparisonKeysInEachRecord = make([]string, view.RecordLen())
 
@@ -811,9 +1533,14 @@ func (view *View) SelectAllColumns() error {
 }
 
 func (view *View) OrderBy(clause parser.OrderByClause) error {
-	orderValues := make([]parser.QueryExpression, len(clause.Items))
-	for i, item := range clause.Items {
-		orderValues[i] = item.(parser.OrderItem).Value
+	enterPhase(PROFILE_SORTING)
+	defer leavePhase(PROFILE_SORTING)
+
+	orderValues := make([]parser.QueryExpression, 0, len(clause.Items))
+	for _, item := range clause.Items {
+		if _, ok := fieldPosition(item.(parser.OrderItem).Value); !ok {
+			orderValues = append(orderValues, item.(parser.OrderItem).Value)
+		}
 	}
 	if err := view.ExtendRecordCapacity(orderValues); err != nil {
 		return err
@@ -822,6 +1549,15 @@ func (view *View) OrderBy(clause parser.OrderByClause) error {
 	sortIndices := make([]int, len(clause.Items))
 	for i, v := range clause.Items {
 		oi := v.(parser.OrderItem)
+		if pos, ok := fieldPosition(oi.Value); ok {
+			idx, err := view.selectFieldIndex(oi.Value, pos)
+			if err != nil {
+				return err
+			}
+			sortIndices[i] = idx
+			continue
+		}
+
 		idx, err := view.evalColumn(oi.Value, "")
 		if err != nil {
 			return err
@@ -883,7 +1619,7 @@ func (view *View) OrderBy(clause parser.OrderByClause) error {
 	}
 	gm.Wait()
 
-	sort.Sort(view)
+	sort.Stable(view)
 	return nil
 }
 
@@ -894,6 +1630,9 @@ func (view *View) additionalColumns(expr parser.QueryExpression) ([]string, erro
 	case parser.FieldReference, parser.ColumnNumber:
 		return nil, nil
 	case parser.Function:
+		if _, ok := ExternalAggregateFunctions[strings.ToUpper(expr.(parser.Function).Name)]; ok && !view.isGrouped {
+			return nil, NewNotGroupingRecordsError(expr, expr.(parser.Function).Name)
+		}
 		if udfn, err := view.Filter.Functions.Get(expr, expr.(parser.Function).Name); err == nil {
 			if udfn.IsAggregate && !view.isGrouped {
 				return nil, NewNotGroupingRecordsError(expr, expr.(parser.Function).Name)
@@ -1061,11 +1800,21 @@ func (view *View) evalColumn(obj parser.QueryExpression, alias string) (idx int,
 
 func (view *View) evalAnalyticFunction(expr parser.AnalyticFunction) error {
 	name := strings.ToUpper(expr.Name)
-	if _, ok := AggregateFunctions[name]; !ok {
+	_, isExternal := ExternalAggregateFunctions[name]
+	_, isArg := ArgAggregateFunctions[name]
+	_, isFraction := FractionAggregateFunctions[name]
+	if _, ok := AggregateFunctions[name]; !ok && !isExternal && !isArg && !isFraction {
 		if _, ok := AnalyticFunctions[name]; !ok {
-			if udfn, err := view.Filter.Functions.Get(expr, expr.Name); err != nil || !udfn.IsAggregate {
+			udfn, err := view.Filter.Functions.Get(expr, expr.Name)
+			if err != nil {
+				if _, ok := Functions[name]; ok || isNowFunction(name) {
+					return NewScalarFunctionWithOverError(expr, expr.Name)
+				}
 				return NewFunctionNotExistError(expr, expr.Name)
 			}
+			if !udfn.IsAggregate {
+				return NewScalarFunctionWithOverError(expr, expr.Name)
+			}
 		}
 	}
 
@@ -1088,10 +1837,18 @@ func (view *View) evalAnalyticFunction(expr parser.AnalyticFunction) error {
 	}
 
 	if expr.AnalyticClause.OrderByClause != nil {
-		err := view.OrderBy(expr.AnalyticClause.OrderByClause.(parser.OrderByClause))
+		orderByClause := expr.AnalyticClause.OrderByClause.(parser.OrderByClause)
+		if order := orderByClause.String(); view.partitionLists == nil || order != view.partitionListOrder {
+			view.partitionLists = make(map[string]PartitionList)
+			view.partitionListOrder = order
+		}
+
+		err := view.OrderBy(orderByClause)
 		if err != nil {
 			return err
 		}
+	} else if view.partitionLists == nil {
+		view.partitionLists = make(map[string]PartitionList)
 	}
 
 	err := Analyze(view, expr, partitionIndices)
@@ -1255,9 +2012,23 @@ func (view *View) insert(fields []parser.QueryExpression, valuesList [][]value.P
 	return nil
 }
 
+func (view *View) selectFieldIndex(expr parser.QueryExpression, pos int) (int, error) {
+	if view.selectFields == nil {
+		if pos < 1 || view.FieldLen() < pos {
+			return -1, NewFieldPositionOutOfRangeError(expr)
+		}
+		return pos - 1, nil
+	}
+
+	if pos < 1 || len(view.selectFields) < pos {
+		return -1, NewFieldPositionOutOfRangeError(expr)
+	}
+	return view.selectFields[pos-1], nil
+}
+
 func (view *View) Fix() {
 	resize := false
-	if len(view.selectFields) < view.FieldLen() {
+	if len(view.selectFields) != view.FieldLen() || view.isGrouped {
 		resize = true
 	} else {
 		for i := 0; i < view.FieldLen(); i++ {
@@ -1321,6 +2092,8 @@ func (view *View) Fix() {
 	view.sortValuesInEachRecord = nil
 	view.sortDirections = nil
 	view.sortNullPositions = nil
+	view.partitionLists = nil
+	view.partitionListOrder = ""
 	view.offset = 0
 }
 
@@ -1404,7 +2177,7 @@ func (view *View) Intersect(calcView *View, all bool) {
 	view.comparisonKeysInEachRecord = nil
 }
 
-func (view *View) ListValuesForAggregateFunctions(expr parser.QueryExpression, arg parser.QueryExpression, distinct bool, filter *Filter) ([]value.Primary, error) {
+func (view *View) ListValuesForAggregateFunctions(expr parser.QueryExpression, args []parser.QueryExpression, distinct bool, filter *Filter) ([]value.Primary, error) {
 	list := make([]value.Primary, view.RecordLen())
 
 	gm := NewGoroutineManager(view.RecordLen(), 150)
@@ -1421,7 +2194,7 @@ func (view *View) ListValuesForAggregateFunctions(expr parser.QueryExpression, a
 				}
 
 				filter.Records[0].RecordIndex = i
-				p, e := filter.Evaluate(arg)
+				p, e := evalAggregateListValue(filter, args)
 				if e != nil {
 					if _, ok := e.(*NotGroupingRecordsError); ok {
 						gm.SetError(NewNestedAggregateFunctionsError(expr))
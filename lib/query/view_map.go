@@ -49,6 +49,15 @@ func (list TemporaryViewScopes) GetWithInternalId(name parser.Identifier) (*View
 	return nil, NewTableNotLoadedError(name)
 }
 
+func (list TemporaryViewScopes) HasSameOptions(name string, options TableOptions) bool {
+	for _, m := range list {
+		if m.Exists(name) {
+			return m.HasSameOptions(name, options)
+		}
+	}
+	return false
+}
+
 func (list TemporaryViewScopes) Set(view *View) {
 	list[0].Set(view)
 }
@@ -167,6 +176,26 @@ func (m ViewMap) GetWithInternalId(fpath parser.Identifier) (*View, error) {
 	return nil, NewTableNotLoadedError(fpath)
 }
 
+func (m ViewMap) HasSameOptions(fpath string, options TableOptions) bool {
+	if view, ok := m[strings.ToUpper(fpath)]; ok {
+		return view.FileInfo.NoHeader == options.NoHeader &&
+			view.FileInfo.Delimiter == options.Delimiter &&
+			(view.FileInfo.Encoding == options.Encoding || options.Encoding == cmd.AUTO) &&
+			view.FileInfo.Sheet == options.Sheet &&
+			view.FileInfo.LimitRead == options.LimitRead
+	}
+	return false
+}
+
+// HasColumns reports whether the view of the file is loaded with all of the columns.
+// Nil columns means all columns in the file.
+func (m ViewMap) HasColumns(fpath string, columns []string) bool {
+	if view, ok := m[strings.ToUpper(fpath)]; ok {
+		return coversColumns(view.FileInfo.Columns, columns)
+	}
+	return false
+}
+
 func (m ViewMap) Set(view *View) {
 	if view.FileInfo != nil {
 		m[strings.ToUpper(view.FileInfo.Path)] = view
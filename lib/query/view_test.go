@@ -15,15 +15,26 @@ import (
 )
 
 var viewLoadTests = []struct {
-	Name          string
-	Encoding      cmd.Encoding
-	NoHeader      bool
-	From          parser.FromClause
-	UseInternalId bool
-	Stdin         string
-	Filter        *Filter
-	Result        *View
-	Error         string
+	Name               string
+	Encoding           cmd.Encoding
+	NoEncodingFallback bool
+	NoHeader           bool
+	TrimTrailing       bool
+	PreserveQuoting    bool
+	SkipLines          int
+	Head               int
+	CommentPrefix      string
+	InferTypes         cmd.InferTypes
+	From               parser.FromClause
+	UseInternalId      bool
+	ForUpdate          bool
+	Columns            []string
+	Condition          parser.QueryExpression
+	Stdin              string
+	Filter             *Filter
+	Result             *View
+	Warning            string
+	Error              string
 }{
 	{
 		Name: "Dual View",
@@ -190,6 +201,258 @@ var viewLoadTests = []struct {
 			},
 		},
 	},
+	{
+		Name:               "Load From Stdin Invalid Byte Sequence Error",
+		NoEncodingFallback: true,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{Stdin: "stdin"}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin: "column1,column2\n1,\"str\xff\"",
+		Error: "[L:- C:-] csv parse error in file stdin: line 2, column 7: invalid byte sequence",
+	},
+	{
+		Name: "Load From Stdin With Encoding Fallback",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{Stdin: "stdin"}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin: "column1,column2\n1,\"str\xff\"",
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str\ufffd"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "stdin",
+				Delimiter: ',',
+				Encoding:  cmd.UTF8,
+			},
+			Filter: &Filter{
+				Variables: []VariableMap{{}},
+				TempViews: []ViewMap{
+					{
+						"STDIN": nil,
+					},
+				},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"T": "STDIN",
+					},
+				},
+			},
+		},
+		Warning: "Warning: [L:- C:-] 1 invalid byte sequences in file stdin are replaced with U+FFFD",
+	},
+	{
+		Name:     "Load From Stdin With Encoding Auto Detection",
+		Encoding: cmd.AUTO,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{Stdin: "stdin"}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin: "column1,column2\n1,\"caf\xe9\"",
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("café"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "stdin",
+				Delimiter: ',',
+				Encoding:  cmd.LATIN1,
+			},
+			Filter: &Filter{
+				Variables: []VariableMap{{}},
+				TempViews: []ViewMap{
+					{
+						"STDIN": nil,
+					},
+				},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"T": "STDIN",
+					},
+				},
+			},
+		},
+	},
+	{
+		Name:     "Load From Stdin With UTF-16 Encoding",
+		Encoding: cmd.UTF16LE,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{Stdin: "stdin"}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin: "\xff\xfec\x00o\x00l\x00u\x00m\x00n\x001\x00,\x00c\x00o\x00l\x00u\x00m\x00n\x002\x00\n\x001\x00,\x00\"\x00c\x00a\x00f\x00\xe9\x00\"\x00",
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("café"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "stdin",
+				Delimiter: ',',
+				Encoding:  cmd.UTF16LE,
+			},
+			Filter: &Filter{
+				Variables: []VariableMap{{}},
+				TempViews: []ViewMap{
+					{
+						"STDIN": nil,
+					},
+				},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"T": "STDIN",
+					},
+				},
+			},
+		},
+	},
+	{
+		Name:            "Load From Stdin With Preserving Quoting",
+		PreserveQuoting: true,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{Stdin: "stdin"}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin: "column1,\"column2\"\n1,\"str1\"\n,\"\"\n",
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewUnquotedString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewString(""),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:           "stdin",
+				Delimiter:      ',',
+				UnquotedHeader: map[string]bool{"column1": true},
+			},
+			Filter: &Filter{
+				Variables: []VariableMap{{}},
+				TempViews: []ViewMap{
+					{
+						"STDIN": nil,
+					},
+				},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"T": "STDIN",
+					},
+				},
+			},
+		},
+	},
+	{
+		Name:         "Load From Stdin With Trimming Trailing Delimiter",
+		TrimTrailing: true,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{Stdin: "stdin"}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin: "column1,column2,\n1,\"str1\",\n",
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "stdin",
+				Delimiter: ',',
+			},
+			Filter: &Filter{
+				Variables: []VariableMap{{}},
+				TempViews: []ViewMap{
+					{
+						"STDIN": nil,
+					},
+				},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"T": "STDIN",
+					},
+				},
+			},
+		},
+	},
+	{
+		Name:          "Load From Stdin With Skipping Lines and Comments",
+		SkipLines:     1,
+		CommentPrefix: "#",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{Stdin: "stdin"}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin: "preamble\n#comment\ncolumn1,column2\n1,\"str1\"\n#2,\"str2\"\n3,\"str3\"\n",
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "stdin",
+				Delimiter: ',',
+			},
+			Filter: &Filter{
+				Variables: []VariableMap{{}},
+				TempViews: []ViewMap{
+					{
+						"STDIN": nil,
+					},
+				},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"T": "STDIN",
+					},
+				},
+			},
+		},
+	},
 	{
 		Name: "Load From Stdin With Internal Id",
 		From: parser.FromClause{
@@ -520,60 +783,1102 @@ var viewLoadTests = []struct {
 		},
 	},
 	{
-		Name: "Load Multiple File",
+		Name: "Load Tsv File Without Extension",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
-					Object: parser.Identifier{Literal: "table1"},
-				},
-				parser.Table{
-					Object: parser.Identifier{Literal: "table2"},
+					Object: parser.Identifier{Literal: "table3"},
 				},
 			},
 		},
 		Result: &View{
-			Header: []HeaderField{
-				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
-				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
-				{View: "table2", Column: "column3", Number: 1, IsFromTable: true},
-				{View: "table2", Column: "column4", Number: 2, IsFromTable: true},
-			},
+			Header: NewHeader("table3", []string{"column5", "column6"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
 					value.NewString("1"),
 					value.NewString("str1"),
-					value.NewString("2"),
-					value.NewString("str22"),
-				}),
-				NewRecord([]value.Primary{
-					value.NewString("1"),
-					value.NewString("str1"),
-					value.NewString("3"),
-					value.NewString("str33"),
-				}),
-				NewRecord([]value.Primary{
-					value.NewString("1"),
-					value.NewString("str1"),
-					value.NewString("4"),
-					value.NewString("str44"),
-				}),
-				NewRecord([]value.Primary{
-					value.NewString("2"),
-					value.NewString("str2"),
-					value.NewString("2"),
-					value.NewString("str22"),
 				}),
 				NewRecord([]value.Primary{
 					value.NewString("2"),
 					value.NewString("str2"),
-					value.NewString("3"),
-					value.NewString("str33"),
 				}),
-				NewRecord([]value.Primary{
-					value.NewString("2"),
-					value.NewString("str2"),
-					value.NewString("4"),
-					value.NewString("str44"),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table3.tsv",
+				Delimiter: '\t',
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE3": strings.ToUpper(GetTestFilePath("table3.tsv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load File With No Header Option",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_noheader"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "no"}, Value: parser.Identifier{Literal: "header"}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table_noheader", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE_NOHEADER": strings.ToUpper(GetTestFilePath("table_noheader.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name:     "Load File With Header Option",
+		NoHeader: true,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_noheader"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "header"}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table_noheader", []string{"1", "str1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE_NOHEADER": strings.ToUpper(GetTestFilePath("table_noheader.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load File Invalid Table Option Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_noheader"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "notexist"}},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] table option notexist is invalid",
+	},
+	{
+		Name: "Load File With Delimiter Option",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "delimiter"}, Value: parser.NewStringValue("\\t")},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1,column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1,str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2,str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3,str3"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load File With Encoding Option",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_sjis"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "encoding"}, Value: parser.Identifier{Literal: "sjis"}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table_sjis", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("日本語"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE_SJIS": strings.ToUpper(GetTestFilePath("table_sjis.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load File Invalid Delimiter Option Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "delimiter"}, Value: parser.NewStringValue(",,")},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] table option delimiter ',,' is invalid",
+	},
+	{
+		Name: "Load File Invalid Encoding Option Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "encoding"}, Value: parser.Identifier{Literal: "notexist"}},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] table option encoding notexist is invalid",
+	},
+	{
+		Name: "Load Xlsx File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_book.xlsx"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "sheet"}, Value: parser.NewStringValue("items")},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table_book", []string{"id", "name", "amount", "created"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("apple"),
+					value.NewFloat(1.5),
+					value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("banana"),
+					value.NewNull(),
+					value.NewDatetime(time.Date(2012, 2, 3, 12, 0, 0, 0, GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString("cherry"),
+					value.NewInteger(-20),
+					value.NewNull(),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE_BOOK": strings.ToUpper(GetTestFilePath("table_book.xlsx")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load Xlsx File With No Header Option",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_book.xlsx"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "sheet"}, Value: parser.NewStringValue("notes")},
+						{Name: parser.Identifier{Literal: "no"}, Value: parser.Identifier{Literal: "header"}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table_book", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("note"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("first"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE_BOOK": strings.ToUpper(GetTestFilePath("table_book.xlsx")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load Xlsx File Sheet Not Exist Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_book.xlsx"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "sheet"}, Value: parser.NewStringValue("notexist")},
+					},
+				},
+			},
+		},
+		Error: fmt.Sprintf("[L:- C:-] xlsx parse error in file %s: sheet notexist does not exist", GetTestFilePath("table_book.xlsx")),
+	},
+	{
+		Name: "Load Csv File With Sheet Option Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "sheet"}, Value: parser.NewStringValue("items")},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] table options cannot be specified for table1",
+	},
+	{
+		Name: "Load File With Repository Option",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: filepath.Join(filepath.Base(TestDir), "table1")},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "repository"}, Value: parser.NewStringValue(filepath.Dir(TestDir))},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table1.csv",
+				Delimiter: ',',
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load Files Matching Pattern",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "glob_*.csv"},
+					Alias:  parser.Identifier{Literal: "g"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("g", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "glob_*.csv",
+				Delimiter: ',',
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"G": strings.ToUpper(GetTestFilePath("glob_*.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load Files Matching Pattern Header Not Match Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1*.csv"},
+				},
+			},
+		},
+		Error: fmt.Sprintf("[L:- C:-] header of file %s does not match the header of file %s", GetTestFilePath("table1b.csv"), GetTestFilePath("table1.csv")),
+	},
+	{
+		Name: "Load Files Matching Pattern Not Exist Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "notexist_*.csv"},
+				},
+			},
+		},
+		Error: "[L:- C:-] file notexist_*.csv does not exist",
+	},
+	{
+		Name:      "Load Files Matching Pattern For Update Error",
+		ForUpdate: true,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "glob_*.csv"},
+				},
+			},
+		},
+		Error: fmt.Sprintf("[L:- C:-] file %s cannot be updated", GetTestFilePath("glob_*.csv")),
+	},
+	{
+		Name:      "Load File With Skipping Lines For Update Error",
+		SkipLines: 1,
+		ForUpdate: true,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1.csv"},
+				},
+			},
+		},
+		Error: fmt.Sprintf("[L:- C:-] file %s cannot be updated because some lines are skipped by the skip-lines or comment option", GetTestFilePath("table1.csv")),
+	},
+	{
+		Name: "Load File With Computed Columns",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "add"}, Value: parser.ComputedColumn{Column: parser.Identifier{Literal: "column"}, Name: parser.Identifier{Literal: "total"}, As: "as", Value: parser.Arithmetic{LHS: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}, RHS: parser.NewIntegerValue(2), Operator: '*'}}},
+						{Name: parser.Identifier{Literal: "add"}, Value: parser.ComputedColumn{Column: parser.Identifier{Literal: "column"}, Name: parser.Identifier{Literal: "total2"}, As: "as", Value: parser.Arithmetic{LHS: parser.FieldReference{Column: parser.Identifier{Literal: "total"}}, RHS: parser.NewIntegerValue(1), Operator: '+'}}},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "t", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "t", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "t", Column: "total", Number: 3, IsFromTable: true, IsComputed: true},
+				{View: "t", Column: "total2", Number: 4, IsFromTable: true, IsComputed: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewInteger(2),
+					value.NewInteger(3),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewInteger(4),
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+					value.NewInteger(6),
+					value.NewInteger(7),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table1.csv",
+				Delimiter: ',',
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"T": strings.ToUpper(GetTestFilePath("table1.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load File With Computed Column Duplicate Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "add"}, Value: parser.ComputedColumn{Column: parser.Identifier{Literal: "column"}, Name: parser.Identifier{Literal: "column1"}, As: "as", Value: parser.NewIntegerValue(1)}},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] field name column1 is a duplicate",
+	},
+	{
+		Name: "Load File With Computed Column Evaluation Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "add"}, Value: parser.ComputedColumn{Column: parser.Identifier{Literal: "column"}, Name: parser.Identifier{Literal: "total"}, As: "as", Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}}},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Load File With Invalid Add Option Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "add"}, Value: parser.ComputedColumn{Column: parser.Identifier{Literal: "field"}, Name: parser.Identifier{Literal: "total"}, As: "as", Value: parser.NewIntegerValue(1)}},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] table option add field total as 1 is invalid",
+	},
+	{
+		Name: "Load File With Invalid Repository Option Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "repository"}, Value: parser.NewStringValue("")},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] table option repository '' is invalid",
+	},
+	{
+		Name: "Load File With Limit Read Option",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "limit_read"}, Value: parser.NewIntegerValue(2)},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load File With Head Flag",
+		Head: 1,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load File Invalid Limit Read Option Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "limit_read"}, Value: parser.NewIntegerValue(0)},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] table option limit_read 0 is invalid",
+	},
+	{
+		Name:      "Load File With Limit Read Option For Update Error",
+		ForUpdate: true,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+					Options: []parser.TableOption{
+						{Name: parser.Identifier{Literal: "limit_read"}, Value: parser.NewIntegerValue(2)},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] table options cannot be specified for table1",
+	},
+	{
+		Name:    "Load File With Referenced Columns",
+		Columns: []string{"COLUMN2"},
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("str3"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name:     "Load File With Referenced Columns Without Header",
+		NoHeader: true,
+		Columns:  []string{"C2"},
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("column2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("str3"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name:    "Load File With No Referenced Columns",
+		Columns: []string{},
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name:      "Load File With Referenced Columns For Update",
+		ForUpdate: true,
+		Columns:   []string{"COLUMN2"},
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+				},
+			},
+		},
+		Result: &View{
+			ForUpdate: true,
+			Header:    NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name:       "Load File With Type Inference",
+		InferTypes: cmd.INFER_PER_COLUMN,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString("str3"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name:       "Load File With Type Inference For Update",
+		InferTypes: cmd.INFER_PER_COLUMN,
+		ForUpdate:  true,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+				},
+			},
+		},
+		Result: &View{
+			ForUpdate: true,
+			Header:    NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load File With Condition",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.NewStringValue("2"),
+			Operator: "=",
+		},
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name:     "Load File With Condition Without Header",
+		NoHeader: true,
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "c2"}},
+			RHS:      parser.NewStringValue("str3"),
+			Operator: "=",
+		},
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load File With Condition Referring Other Table",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			RHS:      parser.NewStringValue("2"),
+			Operator: "=",
+		},
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+					},
+				},
+			},
+		},
+	},
+	{
+		Name:      "Load Xlsx File For Update Error",
+		ForUpdate: true,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_book.xlsx"},
+				},
+			},
+		},
+		Error: fmt.Sprintf("[L:- C:-] file %s cannot be updated", GetTestFilePath("table_book.xlsx")),
+	},
+	{
+		Name: "Load Multiple File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+				},
+				parser.Table{
+					Object: parser.Identifier{Literal: "table2"},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: "column3", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column4", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewString("2"),
+					value.NewString("str22"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewString("3"),
+					value.NewString("str33"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewString("4"),
+					value.NewString("str44"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewString("2"),
+					value.NewString("str22"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewString("3"),
+					value.NewString("str33"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewString("4"),
+					value.NewString("str44"),
 				}),
 				NewRecord([]value.Primary{
 					value.NewString("3"),
@@ -1061,11 +2366,290 @@ var viewLoadTests = []struct {
 							},
 						},
 					},
-					Alias: parser.Identifier{Literal: "t"},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "[L:- C:-] table name t is a duplicate",
+	},
+	{
+		Name: "Load Subquery Query Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Subquery{
+						Query: parser.SelectQuery{
+							SelectEntity: parser.SelectEntity{
+								SelectClause: parser.SelectClause{
+									Select: "select",
+									Fields: []parser.QueryExpression{
+										parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+									},
+								},
+								FromClause: parser.FromClause{
+									Tables: []parser.QueryExpression{
+										parser.Table{Object: parser.Identifier{Literal: "table1"}},
+									},
+								},
+							},
+						},
+					},
+					Alias: parser.Identifier{Literal: "alias"},
+				},
+			},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Load Values Table",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.ValuesTable{
+						Values: "values",
+						RowValues: []parser.QueryExpression{
+							parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewIntegerValue(1), parser.NewStringValue("a")}}},
+							parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewIntegerValue(2), parser.NewStringValue("b")}}},
+						},
+					},
+					Alias:  parser.Identifier{Literal: "v"},
+					Fields: []parser.QueryExpression{parser.Identifier{Literal: "id"}, parser.Identifier{Literal: "name"}},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("v", []string{"id", "name"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("a"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("b"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"V": "",
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load Values Table Without Field Names",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.ValuesTable{
+						Values: "values",
+						RowValues: []parser.QueryExpression{
+							parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewIntegerValue(1), parser.NewStringValue("a")}}},
+						},
+					},
+					Alias: parser.Identifier{Literal: "v"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("v", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("a"),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"V": "",
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load Values Table Row Value Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.ValuesTable{
+						Values: "values",
+						RowValues: []parser.QueryExpression{
+							parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewIntegerValue(1), parser.NewStringValue("a")}}},
+							parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewIntegerValue(2)}}},
+						},
+					},
+					Alias: parser.Identifier{Literal: "v"},
+				},
+			},
+		},
+		Error: "[L:- C:-] row value should contain exactly 2 values",
+	},
+	{
+		Name: "Load Values Table Field Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.ValuesTable{
+						Values: "values",
+						RowValues: []parser.QueryExpression{
+							parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewIntegerValue(1), parser.NewStringValue("a")}}},
+						},
+					},
+					Alias:  parser.Identifier{Literal: "v"},
+					Fields: []parser.QueryExpression{parser.Identifier{Literal: "id"}},
+				},
+			},
+		},
+		Error: "[L:- C:-] 2 field names should be given for table v",
+	},
+	{
+		Name: "Load Unnest Table",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.UnnestTable{
+						Unnest: "unnest",
+						Value:  parser.Variable{Name: "@arr"},
+					},
+					Alias:  parser.Identifier{Literal: "u"},
+					Fields: []parser.QueryExpression{parser.Identifier{Literal: "elem"}},
+				},
+			},
+		},
+		Filter: NewFilter(
+			[]VariableMap{{
+				"@arr": value.NewArray([]value.Primary{value.NewString("a"), value.NewNull()}),
+			}},
+			[]ViewMap{{}},
+			[]CursorMap{{}},
+			[]UserDefinedFunctionMap{{}},
+		),
+		Result: &View{
+			Header: NewHeader("u", []string{"elem"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"U": "",
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load Unnest Table With Ordinality",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.UnnestTable{
+						Unnest:     "unnest",
+						Value:      parser.Variable{Name: "@arr"},
+						With:       "with",
+						Ordinality: "ordinality",
+					},
+					Alias: parser.Identifier{Literal: "u"},
+				},
+			},
+		},
+		Filter: NewFilter(
+			[]VariableMap{{
+				"@arr": value.NewArray([]value.Primary{value.NewString("a"), value.NewString("b")}),
+			}},
+			[]ViewMap{{}},
+			[]CursorMap{{}},
+			[]UserDefinedFunctionMap{{}},
+		),
+		Result: &View{
+			Header: NewHeader("u", []string{"value", "ordinality"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(2),
+				}),
+			},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"U": "",
+					},
+				},
+			},
+		},
+	},
+	{
+		Name: "Load Unnest Table Null",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.UnnestTable{
+						Unnest: "unnest",
+						Value:  parser.NewNullValue(),
+					},
+					Alias: parser.Identifier{Literal: "u"},
+				},
+			},
+		},
+		Result: &View{
+			Header:    NewHeader("u", []string{"value"}),
+			RecordSet: []Record{},
+			Filter: &Filter{
+				Variables:    []VariableMap{{}},
+				TempViews:    []ViewMap{{}},
+				Cursors:      []CursorMap{{}},
+				InlineTables: InlineTableNodes{{}},
+				Aliases: AliasNodes{
+					{
+						"U": "",
+					},
 				},
 			},
 		},
-		Error: "[L:- C:-] table name t is a duplicate",
+	},
+	{
+		Name: "Load Unnest Table Not Array Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.UnnestTable{
+						Unnest: "unnest",
+						Value:  parser.NewStringValue("abc"),
+					},
+					Alias: parser.Identifier{Literal: "u"},
+				},
+			},
+		},
+		Error: "[L:- C:-] 'abc' is not an array for unnest",
 	},
 	{
 		Name: "Load CSV Parse Error",
@@ -1168,6 +2752,14 @@ func TestView_Load(t *testing.T) {
 		} else {
 			tf.Encoding = cmd.UTF8
 		}
+		tf.EncodingFallback = !v.NoEncodingFallback
+		tf.TrimTrailingDelimiter = v.TrimTrailing
+		tf.PreserveQuoting = v.PreserveQuoting
+		tf.SkipLines = v.SkipLines
+		tf.Head = v.Head
+		tf.CommentPrefix = v.CommentPrefix
+		tf.InferTypes = v.InferTypes
+		Warnings.Clear()
 
 		var oldStdin *os.File
 		if 0 < len(v.Stdin) {
@@ -1183,8 +2775,14 @@ func TestView_Load(t *testing.T) {
 			v.Filter = NewEmptyFilter()
 		}
 		view.UseInternalId = v.UseInternalId
+		view.ForUpdate = v.ForUpdate
 
-		err := view.Load(v.From, v.Filter.CreateNode())
+		filter := v.Filter.CreateNode()
+		filter.referencedColumns = v.Columns
+		if v.Condition != nil {
+			filter.loadCondition = newLoadCondition(parser.SelectEntity{FromClause: v.From, WhereClause: parser.WhereClause{Filter: v.Condition}}, filter)
+		}
+		err := view.Load(v.From, filter)
 
 		if 0 < len(v.Stdin) {
 			os.Stdin = oldStdin
@@ -1210,6 +2808,14 @@ func TestView_Load(t *testing.T) {
 			if view.FileInfo.Delimiter != v.Result.FileInfo.Delimiter {
 				t.Errorf("%s: delimiter = %q, want %q", v.Name, view.FileInfo.Delimiter, v.Result.FileInfo.Delimiter)
 			}
+			if 0 < len(v.Result.FileInfo.Encoding) && view.FileInfo.Encoding != v.Result.FileInfo.Encoding {
+				t.Errorf("%s: encoding = %s, want %s", v.Name, view.FileInfo.Encoding, v.Result.FileInfo.Encoding)
+			}
+		}
+		if 0 < len(v.Warning) {
+			if Warnings.Len() != 1 || Warnings.List()[0].String() != v.Warning {
+				t.Errorf("%s: warnings = %v, want %q", v.Name, Warnings.List(), v.Warning)
+			}
 		}
 		view.FileInfo = nil
 		v.Result.FileInfo = nil
@@ -1238,6 +2844,7 @@ func TestView_Load(t *testing.T) {
 			t.Errorf("%s: result = %v, want %v", v.Name, view, v.Result)
 		}
 	}
+	tf.InferTypes = cmd.INFER_OFF
 }
 
 func TestNewViewFromGroupedRecord(t *testing.T) {
@@ -1941,7 +3548,282 @@ var viewSelectTests = []struct {
 	Error  string
 }{
 	{
-		Name: "Select",
+		Name: "Select",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: INTERNAL_ID_COLUMN},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: INTERNAL_ID_COLUMN},
+				{View: "table2", Column: "column3", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column4", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewInteger(1),
+					value.NewString("2"),
+					value.NewString("str22"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewInteger(2),
+					value.NewString("3"),
+					value.NewString("str33"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewInteger(3),
+					value.NewString("1"),
+					value.NewString("str44"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewInteger(1),
+					value.NewString("2"),
+					value.NewString("str22"),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		Select: parser.SelectClause{
+			Fields: []parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}, Alias: parser.Identifier{Literal: "c2"}},
+				parser.Field{Object: parser.AllColumns{}},
+				parser.Field{Object: parser.NewIntegerValueFromString("1"), Alias: parser.Identifier{Literal: "a"}},
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}, Alias: parser.Identifier{Literal: "c2a"}},
+				parser.Field{Object: parser.ColumnNumber{View: parser.Identifier{Literal: "table2"}, Number: value.NewInteger(1)}, Alias: parser.Identifier{Literal: "t21"}},
+				parser.Field{Object: parser.ColumnNumber{View: parser.Identifier{Literal: "table2"}, Number: value.NewInteger(1)}, Alias: parser.Identifier{Literal: "t21a"}},
+				parser.Field{Object: parser.PrimitiveType{
+					Literal: "2012-01-01",
+					Value:   value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation())),
+				}},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: INTERNAL_ID_COLUMN},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Aliases: []string{"c2", "c2a"}, Number: 2, IsFromTable: true},
+				{View: "table2", Column: INTERNAL_ID_COLUMN},
+				{View: "table2", Column: "column3", Aliases: []string{"t21", "t21a"}, Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column4", Number: 2, IsFromTable: true},
+				{Column: "1", Aliases: []string{"a"}},
+				{Column: "2012-01-01T00:00:00Z"},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewInteger(1),
+					value.NewString("2"),
+					value.NewString("str22"),
+					value.NewInteger(1),
+					value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewInteger(2),
+					value.NewString("3"),
+					value.NewString("str33"),
+					value.NewInteger(1),
+					value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewInteger(3),
+					value.NewString("1"),
+					value.NewString("str44"),
+					value.NewInteger(1),
+					value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("2"),
+					value.NewString("str2"),
+					value.NewInteger(1),
+					value.NewString("2"),
+					value.NewString("str22"),
+					value.NewInteger(1),
+					value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation())),
+				}),
+			},
+			Filter:       NewEmptyFilter(),
+			selectFields: []int{2, 1, 2, 4, 5, 6, 2, 4, 4, 7},
+		},
+	},
+	{
+		Name: "Select All Columns Except",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: INTERNAL_ID_COLUMN},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: INTERNAL_ID_COLUMN},
+				{View: "table2", Column: "column3", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column4", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewInteger(1),
+					value.NewString("2"),
+					value.NewString("str22"),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		Select: parser.SelectClause{
+			Fields: []parser.QueryExpression{
+				parser.Field{Object: parser.AllColumns{
+					Except: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+					},
+				}},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: INTERNAL_ID_COLUMN},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: INTERNAL_ID_COLUMN},
+				{View: "table2", Column: "column3", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column4", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewInteger(1),
+					value.NewString("2"),
+					value.NewString("str22"),
+				}),
+			},
+			Filter:       NewEmptyFilter(),
+			selectFields: []int{1, 5},
+		},
+	},
+	{
+		Name: "Select All Columns Except Field Not Exist Error",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: INTERNAL_ID_COLUMN},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		Select: parser.SelectClause{
+			Fields: []parser.QueryExpression{
+				parser.Field{Object: parser.AllColumns{
+					Except: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+					},
+				}},
+			},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Select All Columns Replace",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: INTERNAL_ID_COLUMN},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		Select: parser.SelectClause{
+			Fields: []parser.QueryExpression{
+				parser.Field{Object: parser.AllColumns{
+					Replace: []parser.QueryExpression{
+						parser.Field{Object: parser.NewIntegerValueFromString("1"), Alias: parser.Identifier{Literal: "column1"}},
+					},
+				}},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: INTERNAL_ID_COLUMN},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{Column: "1", Aliases: []string{"column1"}},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewInteger(1),
+				}),
+			},
+			Filter:       NewEmptyFilter(),
+			selectFields: []int{3, 2},
+		},
+	},
+	{
+		Name: "Select All Columns Replace Field Not Exist Error",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: INTERNAL_ID_COLUMN},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		Select: parser.SelectClause{
+			Fields: []parser.QueryExpression{
+				parser.Field{Object: parser.AllColumns{
+					Replace: []parser.QueryExpression{
+						parser.Field{Object: parser.NewIntegerValueFromString("1"), Alias: parser.Identifier{Literal: "notexist"}},
+					},
+				}},
+			},
+		},
+		Error: "[L:- C:-] field notexist does not exist",
+	},
+	{
+		Name: "Select Qualified All Columns",
 		View: &View{
 			Header: []HeaderField{
 				{View: "table1", Column: INTERNAL_ID_COLUMN},
@@ -1960,44 +3842,20 @@ var viewSelectTests = []struct {
 					value.NewString("2"),
 					value.NewString("str22"),
 				}),
-				NewRecord([]value.Primary{
-					value.NewInteger(1),
-					value.NewString("1"),
-					value.NewString("str1"),
-					value.NewInteger(2),
-					value.NewString("3"),
-					value.NewString("str33"),
-				}),
-				NewRecord([]value.Primary{
-					value.NewInteger(1),
-					value.NewString("1"),
-					value.NewString("str1"),
-					value.NewInteger(3),
-					value.NewString("1"),
-					value.NewString("str44"),
-				}),
-				NewRecord([]value.Primary{
-					value.NewInteger(2),
-					value.NewString("2"),
-					value.NewString("str2"),
-					value.NewInteger(1),
-					value.NewString("2"),
-					value.NewString("str22"),
-				}),
 			},
 			Filter: NewEmptyFilter(),
 		},
 		Select: parser.SelectClause{
 			Fields: []parser.QueryExpression{
-				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}, Alias: parser.Identifier{Literal: "c2"}},
-				parser.Field{Object: parser.AllColumns{}},
-				parser.Field{Object: parser.NewIntegerValueFromString("1"), Alias: parser.Identifier{Literal: "a"}},
-				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}, Alias: parser.Identifier{Literal: "c2a"}},
-				parser.Field{Object: parser.ColumnNumber{View: parser.Identifier{Literal: "table2"}, Number: value.NewInteger(1)}, Alias: parser.Identifier{Literal: "t21"}},
-				parser.Field{Object: parser.ColumnNumber{View: parser.Identifier{Literal: "table2"}, Number: value.NewInteger(1)}, Alias: parser.Identifier{Literal: "t21a"}},
-				parser.Field{Object: parser.PrimitiveType{
-					Literal: "2012-01-01",
-					Value:   value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation())),
+				parser.Field{Object: parser.AllColumns{
+					View: parser.Identifier{Literal: "table2"},
+				}},
+				parser.Field{Object: parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}}},
+				parser.Field{Object: parser.AllColumns{
+					View: parser.Identifier{Literal: "TABLE1"},
+					Except: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
 				}},
 			},
 		},
@@ -2005,12 +3863,10 @@ var viewSelectTests = []struct {
 			Header: []HeaderField{
 				{View: "table1", Column: INTERNAL_ID_COLUMN},
 				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
-				{View: "table1", Column: "column2", Aliases: []string{"c2", "c2a"}, Number: 2, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
 				{View: "table2", Column: INTERNAL_ID_COLUMN},
-				{View: "table2", Column: "column3", Aliases: []string{"t21", "t21a"}, Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column3", Number: 1, IsFromTable: true},
 				{View: "table2", Column: "column4", Number: 2, IsFromTable: true},
-				{Column: "1", Aliases: []string{"a"}},
-				{Column: "2012-01-01T00:00:00Z"},
 			},
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
@@ -2020,43 +3876,43 @@ var viewSelectTests = []struct {
 					value.NewInteger(1),
 					value.NewString("2"),
 					value.NewString("str22"),
-					value.NewInteger(1),
-					value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation())),
-				}),
-				NewRecord([]value.Primary{
-					value.NewInteger(1),
-					value.NewString("1"),
-					value.NewString("str1"),
-					value.NewInteger(2),
-					value.NewString("3"),
-					value.NewString("str33"),
-					value.NewInteger(1),
-					value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation())),
 				}),
+			},
+			Filter:       NewEmptyFilter(),
+			selectFields: []int{4, 5, 1, 2},
+		},
+	},
+	{
+		Name: "Select Qualified All Columns Table Not Loaded Error",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: INTERNAL_ID_COLUMN},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: INTERNAL_ID_COLUMN},
+				{View: "table2", Column: "column3", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column4", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
 				NewRecord([]value.Primary{
 					value.NewInteger(1),
 					value.NewString("1"),
 					value.NewString("str1"),
-					value.NewInteger(3),
-					value.NewString("1"),
-					value.NewString("str44"),
-					value.NewInteger(1),
-					value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation())),
-				}),
-				NewRecord([]value.Primary{
-					value.NewInteger(2),
-					value.NewString("2"),
-					value.NewString("str2"),
 					value.NewInteger(1),
 					value.NewString("2"),
 					value.NewString("str22"),
-					value.NewInteger(1),
-					value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation())),
 				}),
 			},
-			Filter:       NewEmptyFilter(),
-			selectFields: []int{2, 1, 2, 4, 5, 6, 2, 4, 4, 7},
+			Filter: NewEmptyFilter(),
+		},
+		Select: parser.SelectClause{
+			Fields: []parser.QueryExpression{
+				parser.Field{Object: parser.AllColumns{
+					View: parser.Identifier{Literal: "notexist"},
+				}},
+			},
 		},
+		Error: "[L:- C:-] table notexist is not loaded",
 	},
 	{
 		Name: "Select Distinct",
@@ -2325,13 +4181,179 @@ var viewSelectTests = []struct {
 								OrderBy: "order by",
 								Items: []parser.QueryExpression{
 									parser.OrderItem{
-										Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+										Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+									},
+								},
+							},
+						},
+					},
+					Alias: parser.Identifier{Literal: "rownum"},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{Column: "row_number() over (partition by column1 order by column2)", Aliases: []string{"rownum"}},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(3),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(4),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(5),
+					value.NewInteger(3),
+				}),
+			},
+			Filter:       NewEmptyFilter(),
+			selectFields: []int{0, 1, 2},
+		},
+	},
+	{
+		Name: "Select Analytic Functions Sharing Partitions",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(3),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(4),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		Select: parser.SelectClause{
+			Fields: []parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				parser.Field{
+					Object: parser.AnalyticFunction{
+						Name: "lead",
+						Args: []parser.QueryExpression{
+							parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						},
+						Over: "over",
+						AnalyticClause: parser.AnalyticClause{
+							PartitionClause: parser.PartitionClause{
+								PartitionBy: "partition by",
+								Values: []parser.QueryExpression{
+									parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+								},
+							},
+							OrderByClause: parser.OrderByClause{
+								OrderBy: "order by",
+								Items: []parser.QueryExpression{
+									parser.OrderItem{
+										Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+									},
+								},
+							},
+						},
+					},
+				},
+				parser.Field{
+					Object: parser.AnalyticFunction{
+						Name: "row_number",
+						Over: "over",
+						AnalyticClause: parser.AnalyticClause{
+							PartitionClause: parser.PartitionClause{
+								PartitionBy: "partition by",
+								Values: []parser.QueryExpression{
+									parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+								},
+							},
+							OrderByClause: parser.OrderByClause{
+								OrderBy: "order by",
+								Items: []parser.QueryExpression{
+									parser.OrderItem{
+										Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+									},
+								},
+							},
+						},
+					},
+				},
+				parser.Field{
+					Object: parser.AnalyticFunction{
+						Name: "last_value",
+						Args: []parser.QueryExpression{
+							parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						},
+						Over: "over",
+						AnalyticClause: parser.AnalyticClause{
+							PartitionClause: parser.PartitionClause{
+								PartitionBy: "partition by",
+								Values: []parser.QueryExpression{
+									parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+								},
+							},
+							OrderByClause: parser.OrderByClause{
+								OrderBy: "order by",
+								Items: []parser.QueryExpression{
+									parser.OrderItem{
+										Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+									},
+								},
+							},
+						},
+					},
+				},
+				parser.Field{
+					Object: parser.AnalyticFunction{
+						Name: "row_number",
+						Over: "over",
+						AnalyticClause: parser.AnalyticClause{
+							PartitionClause: parser.PartitionClause{
+								PartitionBy: "partition by",
+								Values: []parser.QueryExpression{
+									parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+								},
+							},
+							OrderByClause: parser.OrderByClause{
+								OrderBy: "order by",
+								Items: []parser.QueryExpression{
+									parser.OrderItem{
+										Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+										Direction: parser.Token{Token: parser.DESC, Literal: "desc"},
 									},
 								},
 							},
 						},
 					},
-					Alias: parser.Identifier{Literal: "rownum"},
 				},
 			},
 		},
@@ -2339,37 +4361,55 @@ var viewSelectTests = []struct {
 			Header: []HeaderField{
 				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
 				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
-				{Column: "row_number() over (partition by column1 order by column2)", Aliases: []string{"rownum"}},
+				{Column: "lead(column2) over (partition by column1 order by column2)"},
+				{Column: "row_number() over (partition by column1 order by column2)"},
+				{Column: "last_value(column2) over (partition by column1 order by column2)"},
+				{Column: "row_number() over (partition by column1 order by column2 desc)"},
 			},
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewString("a"),
-					value.NewInteger(1),
+					value.NewString("b"),
+					value.NewInteger(5),
+					value.NewNull(),
+					value.NewInteger(3),
+					value.NewInteger(5),
 					value.NewInteger(1),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("a"),
+					value.NewString("b"),
+					value.NewInteger(4),
+					value.NewInteger(5),
 					value.NewInteger(2),
+					value.NewInteger(5),
 					value.NewInteger(2),
 				}),
 				NewRecord([]value.Primary{
 					value.NewString("b"),
 					value.NewInteger(3),
+					value.NewInteger(4),
 					value.NewInteger(1),
+					value.NewInteger(5),
+					value.NewInteger(3),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("b"),
-					value.NewInteger(4),
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewNull(),
+					value.NewInteger(2),
 					value.NewInteger(2),
+					value.NewInteger(1),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("b"),
-					value.NewInteger(5),
-					value.NewInteger(3),
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+					value.NewInteger(1),
+					value.NewInteger(2),
+					value.NewInteger(2),
 				}),
 			},
 			Filter:       NewEmptyFilter(),
-			selectFields: []int{0, 1, 2},
+			selectFields: []int{0, 1, 2, 3, 4, 5},
 		},
 	},
 	{
@@ -2431,6 +4471,34 @@ var viewSelectTests = []struct {
 		},
 		Error: "[L:- C:-] function notexist does not exist",
 	},
+	{
+		Name: "Select Scalar Function With Over Clause Error",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+			},
+			Filter: NewEmptyFilter(),
+		},
+		Select: parser.SelectClause{
+			Fields: []parser.QueryExpression{
+				parser.Field{
+					Object: parser.AnalyticFunction{
+						Name: "upper",
+						Args: []parser.QueryExpression{
+							parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						},
+						Over:           "over",
+						AnalyticClause: parser.AnalyticClause{},
+					},
+				},
+			},
+		},
+		Error: "[L:- C:-] function upper is not an analytic function or an aggregate function and cannot be used with an OVER clause",
+	},
 	{
 		Name: "Select Analytic Function Partition Error",
 		View: &View{
@@ -2772,7 +4840,7 @@ var viewOrderByTests = []struct {
 					Value: parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
 				},
 				parser.OrderItem{
-					Value: parser.NewIntegerValueFromString("1"),
+					Value: parser.NewStringValue("1"),
 				},
 			},
 		},
@@ -2789,31 +4857,31 @@ var viewOrderByTests = []struct {
 					value.NewNull(),
 					value.NewString("2"),
 					value.NewString("4"),
-					value.NewInteger(1),
+					value.NewString("1"),
 				}),
 				NewRecordWithId(2, []value.Primary{
 					value.NewString("1"),
 					value.NewString("4"),
 					value.NewString("3"),
-					value.NewInteger(1),
+					value.NewString("1"),
 				}),
 				NewRecordWithId(3, []value.Primary{
 					value.NewString("1"),
 					value.NewString("4"),
 					value.NewString("3"),
-					value.NewInteger(1),
+					value.NewString("1"),
 				}),
 				NewRecordWithId(4, []value.Primary{
 					value.NewString("1"),
 					value.NewString("3"),
 					value.NewNull(),
-					value.NewInteger(1),
+					value.NewString("1"),
 				}),
 				NewRecordWithId(1, []value.Primary{
 					value.NewString("1"),
 					value.NewString("3"),
 					value.NewString("2"),
-					value.NewInteger(1),
+					value.NewString("1"),
 				}),
 			},
 			Filter: NewEmptyFilter(),
@@ -3015,6 +5083,86 @@ var viewOrderByTests = []struct {
 		},
 		Error: "[L:- C:-] function sum cannot aggregate not grouping records",
 	},
+	{
+		Name: "Order By Field Position",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: INTERNAL_ID_COLUMN},
+				{View: "table1", Column: "column1", IsFromTable: true},
+				{View: "table1", Column: "column2", IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewString("b"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("2"),
+					value.NewString("a"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewString("3"),
+					value.NewString("c"),
+				}),
+			},
+			Filter:       NewEmptyFilter(),
+			selectFields: []int{2, 1},
+		},
+		OrderBy: parser.OrderByClause{
+			Items: []parser.QueryExpression{
+				parser.OrderItem{
+					Value: parser.NewIntegerValueFromString("1"),
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: INTERNAL_ID_COLUMN},
+				{View: "table1", Column: "column1", IsFromTable: true},
+				{View: "table1", Column: "column2", IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("2"),
+					value.NewString("a"),
+				}),
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewString("b"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewString("3"),
+					value.NewString("c"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Order By Field Position Out Of Range Error",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: INTERNAL_ID_COLUMN},
+				{View: "table1", Column: "column1", IsFromTable: true},
+				{View: "table1", Column: "column2", IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewString("b"),
+				}),
+			},
+			Filter:       NewEmptyFilter(),
+			selectFields: []int{2, 1},
+		},
+		OrderBy: parser.OrderByClause{
+			Items: []parser.QueryExpression{
+				parser.OrderItem{
+					Value: parser.NewIntegerValueFromString("3"),
+				},
+			},
+		},
+		Error: "[L:- C:-] field position 3 is out of range",
+	},
 }
 
 func TestView_OrderBy(t *testing.T) {
@@ -3041,6 +5189,44 @@ func TestView_OrderBy(t *testing.T) {
 	}
 }
 
+func TestView_OrderBy_Stable(t *testing.T) {
+	recordLen := 100
+
+	view := &View{
+		Header:    NewHeaderWithId("table1", []string{"column1"}),
+		RecordSet: make([]Record, recordLen),
+		Filter:    NewEmptyFilter(),
+	}
+	for i := 0; i < recordLen; i++ {
+		view.RecordSet[i] = NewRecordWithId(i, []value.Primary{value.NewInteger(int64(i % 3))})
+	}
+
+	err := view.OrderBy(parser.OrderByClause{
+		Items: []parser.QueryExpression{
+			parser.OrderItem{
+				Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	for i := 1; i < recordLen; i++ {
+		prevKey := view.RecordSet[i-1][1].Value().(value.Integer).Raw()
+		key := view.RecordSet[i][1].Value().(value.Integer).Raw()
+		if prevKey != key {
+			continue
+		}
+
+		prevId := view.RecordSet[i-1][0].Value().(value.Integer).Raw()
+		id := view.RecordSet[i][0].Value().(value.Integer).Raw()
+		if id < prevId {
+			t.Errorf("record %d is placed after record %d in equal order keys", prevId, id)
+		}
+	}
+}
+
 var viewExtendRecordCapacity = []struct {
 	Name   string
 	View   *View
@@ -4294,41 +6480,79 @@ func TestView_InsertFromQuery(t *testing.T) {
 	}
 }
 
-func TestView_Fix(t *testing.T) {
-	view := &View{
-		Header: []HeaderField{
-			{View: "table1", Column: INTERNAL_ID_COLUMN},
-			{View: "table1", Column: "column1", IsFromTable: true},
-			{View: "table1", Column: "column2", IsFromTable: true},
+var viewFixTests = []struct {
+	Name   string
+	View   *View
+	Result *View
+}{
+	{
+		Name: "Fix",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: INTERNAL_ID_COLUMN},
+				{View: "table1", Column: "column1", IsFromTable: true},
+				{View: "table1", Column: "column2", IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			selectFields: []int{2},
 		},
-		RecordSet: []Record{
-			NewRecordWithId(1, []value.Primary{
-				value.NewString("1"),
-				value.NewString("str1"),
-			}),
-			NewRecordWithId(2, []value.Primary{
-				value.NewString("1"),
-				value.NewString("str1"),
-			}),
+		Result: &View{
+			Header: NewHeader("table1", []string{"column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("str1"),
+				}),
+			},
+			selectFields: []int(nil),
 		},
-		selectFields: []int{2},
-	}
-	expect := &View{
-		Header: NewHeader("table1", []string{"column2"}),
-		RecordSet: []Record{
-			NewRecord([]value.Primary{
-				value.NewString("str1"),
-			}),
-			NewRecord([]value.Primary{
-				value.NewString("str1"),
-			}),
+	},
+	{
+		Name: "Fix Repeated Column",
+		View: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: "column1", IsFromTable: true},
+				{View: "table1", Column: "column2", IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			selectFields: []int{0, 1, 0},
 		},
-		selectFields: []int(nil),
-	}
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2", "column1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewString("1"),
+				}),
+			},
+			selectFields: []int(nil),
+		},
+	},
+}
 
-	view.Fix()
-	if !reflect.DeepEqual(view, expect) {
-		t.Errorf("fix: view = %v, want %v", view, expect)
+func TestView_Fix(t *testing.T) {
+	for _, v := range viewFixTests {
+		v.View.Fix()
+		if !reflect.DeepEqual(v.View, v.Result) {
+			t.Errorf("%s: view = %v, want %v", v.Name, v.View, v.Result)
+		}
 	}
 }
 
@@ -0,0 +1,112 @@
+package query
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+const (
+	WARNING_MESSAGE_TEMPLATE                     = "Warning: [L:%d C:%d] %s"
+	WARNING_MESSAGE_WITH_FILEPATH_TEMPLATE       = "Warning: %s [L:%d C:%d] %s"
+	WARNING_MESSAGE_WITH_EMPTY_POSITION_TEMPLATE = "Warning: [L:- C:-] %s"
+
+	WARNING_CAST_ROUNDED = "value %s is rounded to %s by function %s"
+	WARNING_CAST_FAILED  = "value %s cannot be converted by function %s"
+
+	WARNING_INVALID_BYTE_SEQUENCES = "%d invalid byte sequences in file %s are replaced with U+FFFD"
+	WARNING_CONVERSION_ERROR       = "conversion error in file %s: %s"
+	WARNING_DIFF_COLUMN_IGNORED    = "column %s in table %s is not compared because it does not exist in table %s"
+
+	WARNING_OMITTED = "%d more warnings are omitted"
+)
+
+// Maximum number of warnings kept in a list.
+const MAX_WARNINGS = 100
+
+type Warning struct {
+	SourceFile string
+	Line       int
+	Char       int
+	Message    string
+}
+
+func NewWarning(expr parser.Expression, message string) Warning {
+	w := Warning{
+		Message: message,
+	}
+	if expr.HasParseInfo() {
+		w.SourceFile = expr.SourceFile()
+		w.Line = expr.Line()
+		w.Char = expr.Char()
+	}
+	return w
+}
+
+func (w Warning) String() string {
+	if w.Line < 1 {
+		return fmt.Sprintf(WARNING_MESSAGE_WITH_EMPTY_POSITION_TEMPLATE, w.Message)
+	}
+	if 0 < len(w.SourceFile) {
+		return fmt.Sprintf(WARNING_MESSAGE_WITH_FILEPATH_TEMPLATE, w.SourceFile, w.Line, w.Char, w.Message)
+	}
+	return fmt.Sprintf(WARNING_MESSAGE_TEMPLATE, w.Line, w.Char, w.Message)
+}
+
+type WarningList struct {
+	list    []Warning
+	exists  map[Warning]bool
+	dropped int
+	mtx     *sync.Mutex
+}
+
+func NewWarningList() *WarningList {
+	return &WarningList{
+		list:   []Warning{},
+		exists: map[Warning]bool{},
+		mtx:    &sync.Mutex{},
+	}
+}
+
+// Warnings that are the same as those already added, or that exceed MAX_WARNINGS, are dropped and only counted.
+func (l *WarningList) Add(expr parser.Expression, message string) {
+	w := NewWarning(expr, message)
+
+	l.mtx.Lock()
+	if l.exists[w] || MAX_WARNINGS <= len(l.list) {
+		l.dropped++
+	} else {
+		l.list = append(l.list, w)
+		l.exists[w] = true
+	}
+	l.mtx.Unlock()
+}
+
+func (l *WarningList) List() []Warning {
+	l.mtx.Lock()
+	list := make([]Warning, len(l.list))
+	copy(list, l.list)
+	l.mtx.Unlock()
+	return list
+}
+
+func (l *WarningList) Len() int {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return len(l.list)
+}
+
+func (l *WarningList) Dropped() int {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.dropped
+}
+
+func (l *WarningList) Clear() {
+	l.mtx.Lock()
+	l.list = l.list[:0]
+	l.exists = map[Warning]bool{}
+	l.dropped = 0
+	l.mtx.Unlock()
+}
@@ -0,0 +1,127 @@
+package query
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func TestWarning_String(t *testing.T) {
+	w := NewWarning(parser.NewNullValue(), "message")
+	expect := "Warning: [L:- C:-] message"
+	if w.String() != expect {
+		t.Errorf("string = %q, want %q", w.String(), expect)
+	}
+
+	w = NewWarning(parser.Function{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 8}), Name: "integer"}, "message")
+	expect = "Warning: [L:1 C:8] message"
+	if w.String() != expect {
+		t.Errorf("string = %q, want %q", w.String(), expect)
+	}
+
+	w = NewWarning(parser.Function{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 8, SourceFile: "source.sql"}), Name: "integer"}, "message")
+	expect = "Warning: source.sql [L:1 C:8] message"
+	if w.String() != expect {
+		t.Errorf("string = %q, want %q", w.String(), expect)
+	}
+}
+
+func TestWarningList_Add(t *testing.T) {
+	list := NewWarningList()
+
+	list.Add(parser.NewNullValue(), "message")
+	list.Add(parser.NewNullValue(), "message")
+	if list.Len() != 1 {
+		t.Errorf("length = %d, want %d", list.Len(), 1)
+	}
+	if list.Dropped() != 1 {
+		t.Errorf("dropped = %d, want %d", list.Dropped(), 1)
+	}
+
+	for i := 0; i < MAX_WARNINGS+10; i++ {
+		list.Add(parser.NewNullValue(), "message"+strconv.Itoa(i))
+	}
+	if list.Len() != MAX_WARNINGS {
+		t.Errorf("length = %d, want %d", list.Len(), MAX_WARNINGS)
+	}
+	if list.Dropped() != 12 {
+		t.Errorf("dropped = %d, want %d", list.Dropped(), 12)
+	}
+
+	list.Clear()
+	list.Add(parser.NewNullValue(), "message")
+	if list.Len() != 1 || list.Dropped() != 0 {
+		t.Errorf("length = %d, dropped = %d, want %d, %d", list.Len(), list.Dropped(), 1, 0)
+	}
+}
+
+var castWarningTests = []struct {
+	Name     string
+	Function parser.Function
+	Args     []value.Primary
+	Warnings []string
+}{
+	{
+		Name:     "Integer Without Warning",
+		Function: parser.Function{Name: "integer"},
+		Args:     []value.Primary{value.NewFloat(2)},
+		Warnings: []string{},
+	},
+	{
+		Name:     "Integer Rounded",
+		Function: parser.Function{Name: "integer"},
+		Args:     []value.Primary{value.NewFloat(1.5)},
+		Warnings: []string{"Warning: [L:- C:-] value 1.5 is rounded to 2 by function integer"},
+	},
+	{
+		Name:     "Integer Conversion Failed",
+		Function: parser.Function{Name: "integer"},
+		Args:     []value.Primary{value.NewString("abc")},
+		Warnings: []string{"Warning: [L:- C:-] value 'abc' cannot be converted by function integer"},
+	},
+	{
+		Name:     "Integer From Null",
+		Function: parser.Function{Name: "integer"},
+		Args:     []value.Primary{value.NewNull()},
+		Warnings: []string{},
+	},
+	{
+		Name:     "Float Conversion Failed",
+		Function: parser.Function{Name: "float"},
+		Args:     []value.Primary{value.NewString("abc")},
+		Warnings: []string{"Warning: [L:- C:-] value 'abc' cannot be converted by function float"},
+	},
+	{
+		Name:     "Datetime Conversion Failed",
+		Function: parser.Function{Name: "datetime"},
+		Args:     []value.Primary{value.NewString("abc")},
+		Warnings: []string{"Warning: [L:- C:-] value 'abc' cannot be converted by function datetime"},
+	},
+}
+
+func TestCastWarnings(t *testing.T) {
+	for _, v := range castWarningTests {
+		Warnings.Clear()
+
+		fn := Functions[strings.ToUpper(v.Function.Name)]
+		_, err := fn(v.Function, v.Args)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		list := Warnings.List()
+		result := make([]string, len(list))
+		for i, w := range list {
+			result[i] = w.String()
+		}
+		if !reflect.DeepEqual(result, v.Warnings) {
+			t.Errorf("%s: warnings = %q, want %q", v.Name, result, v.Warnings)
+		}
+	}
+	Warnings.Clear()
+}
@@ -36,6 +36,16 @@ func CompareCombinedly(p1 Primary, p2 Primary) ComparisonResult {
 		return INCOMMENSURABLE
 	}
 
+	if a1, ok := p1.(Array); ok {
+		if a2, ok := p2.(Array); ok {
+			return compareArrays(a1, a2)
+		}
+		return INCOMMENSURABLE
+	}
+	if _, ok := p2.(Array); ok {
+		return INCOMMENSURABLE
+	}
+
 	if i1 := ToInteger(p1); !IsNull(i1) {
 		if i2 := ToInteger(p2); !IsNull(i2) {
 			v1 := i1.(Integer).Raw()
@@ -108,6 +118,25 @@ func CompareCombinedly(p1 Primary, p2 Primary) ComparisonResult {
 	return INCOMMENSURABLE
 }
 
+// Arrays are equal if all the elements are equal in order. Arrays do not have the order of magnitude.
+func compareArrays(a1 Array, a2 Array) ComparisonResult {
+	if a1.Len() != a2.Len() {
+		return NOT_EQUAL
+	}
+
+	result := EQUAL
+	for i, v := range a1.Raw() {
+		switch CompareCombinedly(v, a2.Raw()[i]) {
+		case EQUAL, BOOL_EQUAL:
+		case INCOMMENSURABLE:
+			result = INCOMMENSURABLE
+		default:
+			return NOT_EQUAL
+		}
+	}
+	return result
+}
+
 func Equal(p1 Primary, p2 Primary) ternary.Value {
 	if r := CompareCombinedly(p1, p2); r != INCOMMENSURABLE {
 		return ternary.ConvertFromBool(r == EQUAL || r == BOOL_EQUAL)
@@ -97,6 +97,31 @@ var compareCombinedlyTests = []struct {
 		RHS:    NewTernaryFromString("true"),
 		Result: INCOMMENSURABLE,
 	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		RHS:    NewArray([]Primary{NewString("1"), NewString("A")}),
+		Result: EQUAL,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		RHS:    NewArray([]Primary{NewInteger(1), NewString("b")}),
+		Result: NOT_EQUAL,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1)}),
+		RHS:    NewArray([]Primary{NewInteger(1), NewInteger(2)}),
+		Result: NOT_EQUAL,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewNull()}),
+		RHS:    NewArray([]Primary{NewInteger(1), NewNull()}),
+		Result: INCOMMENSURABLE,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1)}),
+		RHS:    NewInteger(1),
+		Result: INCOMMENSURABLE,
+	},
 }
 
 func TestCompareCombinedly(t *testing.T) {
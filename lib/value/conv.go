@@ -26,7 +26,31 @@ func (m DatetimeFormatMap) Get(s string) string {
 
 var DatetimeFormats = DatetimeFormatMap{}
 
+type StrftimeFormatMap map[string]string
+
+func (m StrftimeFormatMap) Get(s string) string {
+	if f, ok := m[s]; ok {
+		return f
+	}
+	f := ConvertStrftimeFormat(s)
+	m[s] = f
+	return f
+}
+
+var StrftimeFormats = StrftimeFormatMap{}
+
+// StrToTime parses a string as a datetime value.
+// Strings without time zone information are parsed in the default timezone,
+// and strings with time zone information are converted to the default timezone.
 func StrToTime(s string) (time.Time, error) {
+	t, err := strToTime(s)
+	if err != nil {
+		return t, err
+	}
+	return t.In(cmd.GetLocation()), nil
+}
+
+func strToTime(s string) (time.Time, error) {
 	s = strings.TrimSpace(s)
 
 	flags := cmd.GetFlags()
@@ -48,7 +72,7 @@ func StrToTime(s string) (time.Time, error) {
 					return t, nil
 				}
 			} else if s[10] == 'T' {
-				if s[len(s)-3] == '+' || s[len(s)-6] == '-' || s[len(s)-1] == 'Z' {
+				if s[len(s)-6] == '+' || s[len(s)-6] == '-' || s[len(s)-1] == 'Z' {
 					if t, e := time.Parse(time.RFC3339Nano, s); e == nil {
 						return t, nil
 					}
@@ -195,6 +219,75 @@ func ConvertDatetimeFormat(format string) string {
 	return buf.String()
 }
 
+func ConvertStrftimeFormat(format string) string {
+	runes := []rune(format)
+	var buf bytes.Buffer
+
+	escaped := false
+	for _, r := range runes {
+		if !escaped {
+			switch r {
+			case '%':
+				escaped = true
+			default:
+				buf.WriteRune(r)
+			}
+			continue
+		}
+
+		switch r {
+		case 'a':
+			buf.WriteString("Mon")
+		case 'A':
+			buf.WriteString("Monday")
+		case 'b':
+			buf.WriteString("Jan")
+		case 'B':
+			buf.WriteString("January")
+		case 'd':
+			buf.WriteString("02")
+		case 'e':
+			buf.WriteString("_2")
+		case 'f':
+			if 0 < buf.Len() && buf.Bytes()[buf.Len()-1] == '.' {
+				buf.Truncate(buf.Len() - 1)
+			}
+			buf.WriteString(".000000")
+		case 'F':
+			buf.WriteString("2006-01-02")
+		case 'H':
+			buf.WriteString("15")
+		case 'I':
+			buf.WriteString("03")
+		case 'j':
+			buf.WriteString("002")
+		case 'm':
+			buf.WriteString("01")
+		case 'M':
+			buf.WriteString("04")
+		case 'p':
+			buf.WriteString("PM")
+		case 'S':
+			buf.WriteString("05")
+		case 'T':
+			buf.WriteString("15:04:05")
+		case 'y':
+			buf.WriteString("06")
+		case 'Y':
+			buf.WriteString("2006")
+		case 'z':
+			buf.WriteString("-0700")
+		case 'Z':
+			buf.WriteString("MST")
+		default:
+			buf.WriteRune(r)
+		}
+		escaped = false
+	}
+
+	return buf.String()
+}
+
 func Float64ToTime(f float64) time.Time {
 	s := Float64ToStr(f)
 	ns := strings.Split(s, ".")
@@ -143,6 +143,11 @@ func TestStrToTime(t *testing.T) {
 		t.Errorf("unexpected error %q for %q", err, s)
 	}
 
+	s = "2006-01-02T15:04:05+09:00"
+	if _, err := StrToTime(s); err != nil {
+		t.Errorf("unexpected error %q for %q", err, s)
+	}
+
 	s = "2006-01-02T15:04:05"
 	if _, err := StrToTime(s); err != nil {
 		t.Errorf("unexpected error %q for %q", err, s)
@@ -152,6 +157,14 @@ func TestStrToTime(t *testing.T) {
 	if _, err := StrToTime(s); err == nil {
 		t.Errorf("no errors, want error for %q", s)
 	}
+
+	s = "2006-01-02T15:04:05-08:00"
+	expect := time.Date(2006, 1, 2, 23, 4, 5, 0, time.UTC)
+	if dt, err := StrToTime(s); err != nil {
+		t.Errorf("unexpected error %q for %q", err, s)
+	} else if !dt.Equal(expect) || dt.Location() != cmd.GetLocation() {
+		t.Errorf("result = %s, want %s in %s for %q", dt, expect, cmd.GetLocation(), s)
+	}
 }
 
 var convertDatetimeFormatTests = []struct {
@@ -250,6 +263,45 @@ func TestConvertDatetimeFormat(t *testing.T) {
 	}
 }
 
+var convertStrftimeFormatTests = []struct {
+	Format string
+	Result string
+}{
+	{
+		Format: "%Y-%m-%d %H:%M:%S",
+		Result: "2006-01-02 15:04:05",
+	},
+	{
+		Format: "%a %A %b %B %e %j",
+		Result: "Mon Monday Jan January _2 002",
+	},
+	{
+		Format: "%I:%M %p %y",
+		Result: "03:04 PM 06",
+	},
+	{
+		Format: "%F %T.%f",
+		Result: "2006-01-02 15:04:05.000000",
+	},
+	{
+		Format: "%z %Z",
+		Result: "-0700 MST",
+	},
+	{
+		Format: "%% %x %",
+		Result: "% x ",
+	},
+}
+
+func TestConvertStrftimeFormat(t *testing.T) {
+	for _, v := range convertStrftimeFormatTests {
+		converted := ConvertStrftimeFormat(v.Format)
+		if converted != v.Result {
+			t.Errorf("result = %q, want %q for %q", converted, v.Result, v.Format)
+		}
+	}
+}
+
 func TestParseFloat64(t *testing.T) {
 	var p Primary
 	var f float64
@@ -5,6 +5,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
+
 	"github.com/mithrandie/ternary"
 )
 
@@ -21,7 +23,8 @@ type Primary interface {
 }
 
 type String struct {
-	literal string
+	literal  string
+	unquoted bool
 }
 
 func (s String) String() string {
@@ -34,15 +37,30 @@ func NewString(s string) String {
 	}
 }
 
+// NewUnquotedString returns a string that was read from a field without quotes.
+func NewUnquotedString(s string) String {
+	return String{
+		literal:  s,
+		unquoted: true,
+	}
+}
+
 func (s String) Raw() string {
 	return s.literal
 }
 
+func (s String) Unquoted() bool {
+	return s.unquoted
+}
+
 func (s String) Ternary() ternary.Value {
 	lit := strings.TrimSpace(s.Raw())
 	if b, err := strconv.ParseBool(lit); err == nil {
 		return ternary.ConvertFromBool(b)
 	}
+	if b, ok := cmd.ParseBooleanLiteral(lit); ok {
+		return ternary.ConvertFromBool(b)
+	}
 	return ternary.UNKNOWN
 }
 
@@ -198,6 +216,37 @@ func (dt Datetime) Format(s string) string {
 	return dt.value.Format(s)
 }
 
+// Array is a list of values created in a query. Arrays are not read from files.
+type Array struct {
+	values []Primary
+}
+
+func NewArray(values []Primary) Array {
+	return Array{
+		values: values,
+	}
+}
+
+func (a Array) String() string {
+	list := make([]string, len(a.values))
+	for i, v := range a.values {
+		list[i] = v.String()
+	}
+	return "[" + strings.Join(list, ", ") + "]"
+}
+
+func (a Array) Raw() []Primary {
+	return a.values
+}
+
+func (a Array) Len() int {
+	return len(a.values)
+}
+
+func (a Array) Ternary() ternary.Value {
+	return ternary.UNKNOWN
+}
+
 type Null struct{}
 
 func NewNull() Null {
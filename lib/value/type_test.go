@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
+
 	"github.com/mithrandie/ternary"
 )
 
@@ -55,6 +57,19 @@ func TestString_Ternary(t *testing.T) {
 	if p.Ternary() != ternary.UNKNOWN {
 		t.Errorf("ternary = %s, want %s for %#v", p.Ternary(), ternary.UNKNOWN, p)
 	}
+
+	cmd.SetBooleanLiterals("yes:no")
+	s = "Yes"
+	p = NewString(s)
+	if p.Ternary() != ternary.TRUE {
+		t.Errorf("ternary = %s, want %s for %#v", p.Ternary(), ternary.TRUE, p)
+	}
+	s = "no"
+	p = NewString(s)
+	if p.Ternary() != ternary.FALSE {
+		t.Errorf("ternary = %s, want %s for %#v", p.Ternary(), ternary.FALSE, p)
+	}
+	cmd.SetBooleanLiterals("")
 }
 
 func TestInteger_String(t *testing.T) {
@@ -160,7 +175,7 @@ func TestTernary_Ternary(t *testing.T) {
 
 func TestDatetime_String(t *testing.T) {
 	s := "2012-01-01T12:34:56Z"
-	p := NewDatetimeFromString(s)
+	p := NewDatetime(time.Date(2012, 1, 1, 12, 34, 56, 0, time.UTC))
 
 	expect := "'" + s + "'"
 	if p.String() != expect {
@@ -195,12 +210,27 @@ func TestDatetime_Ternary(t *testing.T) {
 func TestDatetime_Format(t *testing.T) {
 	dtstring := "2012-08-01T04:03:05.123-08:00"
 	dt := NewDatetimeFromString(dtstring)
-	expect := "2012-08-01T04:03:05-08:00"
+	expect := time.Date(2012, 8, 1, 12, 3, 5, 0, time.UTC).In(cmd.GetLocation()).Format(time.RFC3339)
 	if dt.Format(time.RFC3339) != expect {
 		t.Errorf("result = %q, want %q for %q ", dt.Format(time.RFC3339), expect, dtstring)
 	}
 }
 
+func TestArray_String(t *testing.T) {
+	p := NewArray([]Primary{NewInteger(1), NewString("a"), NewNull(), NewArray([]Primary{})})
+	expect := "[1, 'a', NULL, []]"
+	if p.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", p.String(), expect, p)
+	}
+}
+
+func TestArray_Ternary(t *testing.T) {
+	p := NewArray([]Primary{NewBoolean(true)})
+	if p.Ternary() != ternary.UNKNOWN {
+		t.Errorf("ternary = %s, want %s for %#v", p.Ternary(), ternary.UNKNOWN, p)
+	}
+}
+
 func TestNull_String(t *testing.T) {
 	p := NewNull()
 	if p.String() != "NULL" {
@@ -0,0 +1,399 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+const (
+	workbookPath      = "xl/workbook.xml"
+	workbookRelsPath  = "xl/_rels/workbook.xml.rels"
+	sharedStringsPath = "xl/sharedStrings.xml"
+	stylesPath        = "xl/styles.xml"
+)
+
+type workbook struct {
+	Properties struct {
+		Date1904 bool `xml:"date1904,attr"`
+	} `xml:"workbookPr"`
+	Sheets []sheet `xml:"sheets>sheet"`
+}
+
+type sheet struct {
+	Name  string     `xml:"name,attr"`
+	Attrs []xml.Attr `xml:",any,attr"`
+}
+
+func (s sheet) relationshipId() string {
+	for _, attr := range s.Attrs {
+		if attr.Name.Local == "id" {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+type relationships struct {
+	Relationships []relationship `xml:"Relationship"`
+}
+
+type relationship struct {
+	Id     string `xml:"Id,attr"`
+	Target string `xml:"Target,attr"`
+}
+
+type richText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (rt richText) String() string {
+	if len(rt.Runs) < 1 {
+		return rt.Text
+	}
+	var buf bytes.Buffer
+	buf.WriteString(rt.Text)
+	for _, r := range rt.Runs {
+		buf.WriteString(r.Text)
+	}
+	return buf.String()
+}
+
+type sharedStrings struct {
+	Items []richText `xml:"si"`
+}
+
+type styleSheet struct {
+	NumFmts []struct {
+		Id         int    `xml:"numFmtId,attr"`
+		FormatCode string `xml:"formatCode,attr"`
+	} `xml:"numFmts>numFmt"`
+	CellXfs []struct {
+		NumFmtId int `xml:"numFmtId,attr"`
+	} `xml:"cellXfs>xf"`
+}
+
+type worksheet struct {
+	Rows []row `xml:"sheetData>row"`
+}
+
+type row struct {
+	R     int    `xml:"r,attr"`
+	Cells []cell `xml:"c"`
+}
+
+type cell struct {
+	R      string    `xml:"r,attr"`
+	T      string    `xml:"t,attr"`
+	S      int       `xml:"s,attr"`
+	V      *string   `xml:"v"`
+	Inline *richText `xml:"is"`
+}
+
+type book struct {
+	files      map[string]*zip.File
+	strings    []string
+	dateStyles map[int]bool
+	date1904   bool
+}
+
+// ReadSheet reads the cells of the sheet in an xlsx file.
+// If the sheet name is empty, the first sheet is read.
+// Rows are padded with nulls to the length of the longest row.
+func ReadSheet(r io.ReaderAt, size int64, sheetName string) ([][]value.Primary, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, errors.New("not an xlsx file")
+	}
+
+	b := &book{
+		files:      make(map[string]*zip.File, len(zr.File)),
+		dateStyles: make(map[int]bool),
+	}
+	for _, f := range zr.File {
+		b.files[f.Name] = f
+	}
+
+	sheetPath, err := b.sheetPath(sheetName)
+	if err != nil {
+		return nil, err
+	}
+	if err = b.loadSharedStrings(); err != nil {
+		return nil, err
+	}
+	if err = b.loadStyles(); err != nil {
+		return nil, err
+	}
+
+	var ws worksheet
+	if err = b.decode(sheetPath, &ws); err != nil {
+		return nil, err
+	}
+	return b.readRows(ws)
+}
+
+func (b *book) decode(name string, v interface{}) error {
+	f, ok := b.files[name]
+	if !ok {
+		return fmt.Errorf("%s does not exist", name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	if err = xml.NewDecoder(rc).Decode(v); err != nil {
+		return fmt.Errorf("%s: %s", name, err.Error())
+	}
+	return nil
+}
+
+func (b *book) sheetPath(sheetName string) (string, error) {
+	var wb workbook
+	if err := b.decode(workbookPath, &wb); err != nil {
+		return "", err
+	}
+	b.date1904 = wb.Properties.Date1904
+
+	var rels relationships
+	if err := b.decode(workbookRelsPath, &rels); err != nil {
+		return "", err
+	}
+
+	var target *sheet
+	for i := range wb.Sheets {
+		if len(sheetName) < 1 || wb.Sheets[i].Name == sheetName {
+			target = &wb.Sheets[i]
+			break
+		}
+	}
+	if target == nil {
+		if len(sheetName) < 1 {
+			return "", errors.New("workbook has no sheets")
+		}
+		return "", fmt.Errorf("sheet %s does not exist", sheetName)
+	}
+
+	id := target.relationshipId()
+	for _, rel := range rels.Relationships {
+		if rel.Id == id {
+			if strings.HasPrefix(rel.Target, "/") {
+				return strings.TrimPrefix(rel.Target, "/"), nil
+			}
+			return path.Join(path.Dir(workbookPath), rel.Target), nil
+		}
+	}
+	return "", fmt.Errorf("sheet %s does not exist", target.Name)
+}
+
+func (b *book) loadSharedStrings() error {
+	if _, ok := b.files[sharedStringsPath]; !ok {
+		return nil
+	}
+
+	var sst sharedStrings
+	if err := b.decode(sharedStringsPath, &sst); err != nil {
+		return err
+	}
+	b.strings = make([]string, len(sst.Items))
+	for i, item := range sst.Items {
+		b.strings[i] = item.String()
+	}
+	return nil
+}
+
+func (b *book) loadStyles() error {
+	if _, ok := b.files[stylesPath]; !ok {
+		return nil
+	}
+
+	var styles styleSheet
+	if err := b.decode(stylesPath, &styles); err != nil {
+		return err
+	}
+
+	customDateFormats := make(map[int]bool, len(styles.NumFmts))
+	for _, f := range styles.NumFmts {
+		customDateFormats[f.Id] = isDateFormatCode(f.FormatCode)
+	}
+	for i, xf := range styles.CellXfs {
+		if isDate, ok := customDateFormats[xf.NumFmtId]; ok {
+			b.dateStyles[i] = isDate
+		} else {
+			b.dateStyles[i] = isBuiltInDateFormat(xf.NumFmtId)
+		}
+	}
+	return nil
+}
+
+func (b *book) readRows(ws worksheet) ([][]value.Primary, error) {
+	records := make([][]value.Primary, 0, len(ws.Rows))
+	fieldLen := 0
+
+	for _, r := range ws.Rows {
+		rowIdx := len(records)
+		if 0 < r.R {
+			rowIdx = r.R - 1
+		}
+		for len(records) <= rowIdx {
+			records = append(records, nil)
+		}
+
+		record := records[rowIdx]
+		for _, c := range r.Cells {
+			colIdx := len(record)
+			if 0 < len(c.R) {
+				idx, err := columnIndex(c.R)
+				if err != nil {
+					return nil, err
+				}
+				colIdx = idx
+			}
+			for len(record) <= colIdx {
+				record = append(record, value.NewNull())
+			}
+
+			p, err := b.cellValue(c)
+			if err != nil {
+				return nil, fmt.Errorf("cell %s: %s", c.R, err.Error())
+			}
+			record[colIdx] = p
+		}
+		records[rowIdx] = record
+
+		if fieldLen < len(record) {
+			fieldLen = len(record)
+		}
+	}
+
+	for i := range records {
+		for len(records[i]) < fieldLen {
+			records[i] = append(records[i], value.NewNull())
+		}
+	}
+	return records, nil
+}
+
+func (b *book) cellValue(c cell) (value.Primary, error) {
+	if c.T == "inlineStr" {
+		if c.Inline == nil {
+			return value.NewNull(), nil
+		}
+		return value.NewString(c.Inline.String()), nil
+	}
+
+	if c.V == nil {
+		return value.NewNull(), nil
+	}
+	v := *c.V
+
+	switch c.T {
+	case "s":
+		i, err := strconv.Atoi(v)
+		if err != nil || i < 0 || len(b.strings) <= i {
+			return nil, errors.New("invalid shared string index")
+		}
+		return value.NewString(b.strings[i]), nil
+	case "str", "e":
+		return value.NewString(v), nil
+	case "b":
+		return value.NewTernary(ternary.ConvertFromBool(v == "1")), nil
+	case "d":
+		t, err := time.ParseInLocation("2006-01-02T15:04:05.999999999", v, cmd.GetLocation())
+		if err != nil {
+			return value.NewString(v), nil
+		}
+		return value.NewDatetime(t), nil
+	}
+
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return value.NewString(v), nil
+	}
+	if b.dateStyles[c.S] {
+		return value.NewDatetime(b.serialToTime(f)), nil
+	}
+	return value.ParseFloat64(f), nil
+}
+
+func (b *book) serialToTime(f float64) time.Time {
+	ms := int64(math.Round(f * 86400000))
+	days := int(ms / 86400000)
+	nsec := int(ms%86400000) * int(time.Millisecond)
+
+	if b.date1904 {
+		return time.Date(1904, 1, 1+days, 0, 0, 0, nsec, cmd.GetLocation())
+	}
+	return time.Date(1899, 12, 30+days, 0, 0, 0, nsec, cmd.GetLocation())
+}
+
+func columnIndex(ref string) (int, error) {
+	idx := 0
+	n := 0
+	for _, r := range ref {
+		if 'A' <= r && r <= 'Z' {
+			idx = idx*26 + int(r-'A'+1)
+			n++
+			continue
+		}
+		break
+	}
+	if n < 1 {
+		return 0, fmt.Errorf("invalid cell reference %s", ref)
+	}
+	return idx - 1, nil
+}
+
+func isBuiltInDateFormat(id int) bool {
+	return (14 <= id && id <= 22) || (45 <= id && id <= 47)
+}
+
+func isDateFormatCode(code string) bool {
+	quoted := false
+	bracket := false
+	escaped := false
+
+	for _, r := range code {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted:
+			if r == '"' {
+				quoted = false
+			}
+		case bracket:
+			if r == ']' {
+				bracket = false
+			}
+		case r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = true
+		case r == '[':
+			bracket = true
+		default:
+			switch r {
+			case 'y', 'Y', 'm', 'M', 'd', 'D', 'h', 'H', 's', 'S':
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+package xlsx
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var readSheetTests = []struct {
+	Name   string
+	Sheet  string
+	Output [][]value.Primary
+	Error  string
+}{
+	{
+		Name:  "First Sheet",
+		Sheet: "",
+		Output: [][]value.Primary{
+			{value.NewString("id"), value.NewString("name"), value.NewString("amount"), value.NewString("created")},
+			{value.NewInteger(1), value.NewString("apple"), value.NewFloat(1.5), value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, cmd.GetLocation()))},
+			{value.NewInteger(2), value.NewString("banana"), value.NewNull(), value.NewDatetime(time.Date(2012, 2, 3, 12, 0, 0, 0, cmd.GetLocation()))},
+			{value.NewInteger(3), value.NewString("cherry"), value.NewInteger(-20), value.NewNull()},
+		},
+	},
+	{
+		Name:  "Specified Sheet",
+		Sheet: "notes",
+		Output: [][]value.Primary{
+			{value.NewString("note")},
+			{value.NewString("first")},
+		},
+	},
+	{
+		Name:  "Sheet Not Exist Error",
+		Sheet: "notexist",
+		Error: "sheet notexist does not exist",
+	},
+}
+
+func TestReadSheet(t *testing.T) {
+	fp, err := os.Open(filepath.Join("..", "..", "testdata", "csv", "table_book.xlsx"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fp.Close()
+	info, _ := fp.Stat()
+
+	for _, v := range readSheetTests {
+		output, err := ReadSheet(fp, info.Size(), v.Sheet)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(output, v.Output) {
+			t.Errorf("%s: output = %s, want %s", v.Name, output, v.Output)
+		}
+	}
+
+	input := []byte("id,name")
+	_, err = ReadSheet(bytes.NewReader(input), int64(len(input)), "")
+	if err == nil || err.Error() != "not an xlsx file" {
+		t.Errorf("error = %v, want error %q for a csv input", err, "not an xlsx file")
+	}
+}
+
+var isDateFormatCodeTests = []struct {
+	Code   string
+	Result bool
+}{
+	{Code: "yyyy-mm-dd", Result: true},
+	{Code: "h:mm AM/PM", Result: true},
+	{Code: "General", Result: false},
+	{Code: "#,##0.00", Result: false},
+	{Code: "[Red]0.00", Result: false},
+	{Code: "0.00\"days\"", Result: false},
+	{Code: "0\\d", Result: false},
+}
+
+func TestIsDateFormatCode(t *testing.T) {
+	for _, v := range isDateFormatCodeTests {
+		if result := isDateFormatCode(v.Code); result != v.Result {
+			t.Errorf("result = %t, want %t for %q", result, v.Result, v.Code)
+		}
+	}
+}
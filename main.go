@@ -39,7 +39,11 @@ func main() {
 		cli.StringFlag{
 			Name:  "encoding, e",
 			Value: "UTF8",
-			Usage: "file encoding. one of: UTF8|SJIS",
+			Usage: "file encoding. one of: AUTO|UTF8|UTF16LE|UTF16BE|SJIS|LATIN1",
+		},
+		cli.BoolTFlag{
+			Name:  "encoding-fallback",
+			Usage: "replace invalid byte sequences in loading files with U+FFFD. set false to fail instead",
 		},
 		cli.StringFlag{
 			Name:  "line-break, l",
@@ -76,10 +80,82 @@ func main() {
 			Name:  "without-null, a",
 			Usage: "parse empty fields as empty strings",
 		},
+		cli.BoolFlag{
+			Name:  "trim-trailing-delimiter",
+			Usage: "remove an empty last field if all records end with a delimiter",
+		},
+		cli.IntFlag{
+			Name:  "skip-lines",
+			Usage: "number of lines to be skipped at the beginning of files",
+		},
+		cli.IntFlag{
+			Name:  "head",
+			Usage: "number of records to be read from the beginning of files. 0 means unlimited",
+		},
+		cli.StringFlag{
+			Name:  "comment",
+			Usage: "skip lines beginning with the prefix in files",
+		},
+		cli.StringFlag{
+			Name:  "tsv-style",
+			Value: "QUOTE",
+			Usage: "how to write tab characters and line breaks in fields of tab-delimited data. one of: QUOTE|ESCAPE",
+		},
+		cli.BoolFlag{
+			Name:  "preserve-quoting",
+			Usage: "write unchanged fields without quotes if they are not quoted in loaded files",
+		},
+		cli.BoolTFlag{
+			Name:  "preserve-linebreak",
+			Usage: "write updated files with the line breaks detected in loaded files. set false to use the line-break flag",
+		},
+		cli.StringFlag{
+			Name:  "locale",
+			Value: "en",
+			Usage: "locale for names of days and months. one of: en|ja|de|fr|es",
+		},
+		cli.StringFlag{
+			Name:  "boolean-literals",
+			Usage: "additional literals recognized as boolean values. comma-separated pairs of true and false literals (e.g. \"yes:no,on:off\")",
+		},
+		cli.StringFlag{
+			Name:  "infer-types",
+			Value: "OFF",
+			Usage: "how to infer value types of fields in loaded files. one of: OFF|PER-CELL|PER-COLUMN",
+		},
+		cli.BoolFlag{
+			Name:  "schema-null-on-error",
+			Usage: "set nulls to values that cannot be converted to the types declared by schemas instead of raising errors",
+		},
+		cli.IntFlag{
+			Name:  "max-errors",
+			Usage: "number of conversion errors tolerated in loading a file with a schema",
+		},
+		cli.IntFlag{
+			Name:  "max-iterations",
+			Usage: "maximum number of iterations of a WHILE or LOOP statement. 0 means unlimited",
+		},
+		cli.IntFlag{
+			Name:  "approx-precision",
+			Value: cmd.DEFAULT_APPROX_PRECISION,
+			Usage: "precision of the APPROX_COUNT_DISTINCT function. 4 - 18",
+		},
+		cli.IntFlag{
+			Name:  "max-result-rows",
+			Usage: "maximum number of records in a result of a query. 0 means unlimited",
+		},
+		cli.BoolFlag{
+			Name:  "strict-union-types",
+			Usage: "raise an error if corresponding fields of result sets combined by set operators have incompatible types",
+		},
+		cli.BoolFlag{
+			Name:  "strict-select-into",
+			Usage: "raise an error if a select query assigning values into variables returns no record",
+		},
 		cli.StringFlag{
 			Name:  "write-encoding, E",
 			Value: "UTF8",
-			Usage: "file encoding. one of: UTF8|SJIS",
+			Usage: "file encoding. one of: UTF8|UTF16LE|UTF16BE|SJIS|LATIN1",
 		},
 		cli.StringFlag{
 			Name:  "out, o",
@@ -97,6 +173,16 @@ func main() {
 			Name:  "without-header, N",
 			Usage: "when the file format is specified as CSV or TSV, write without the header line",
 		},
+		cli.StringFlag{
+			Name:  "number-notation",
+			Value: "FIXED",
+			Usage: "notation of floating-point numbers in output. one of: FIXED|SCIENTIFIC|AUTO",
+		},
+		cli.StringFlag{
+			Name:  "empty-result",
+			Value: "AUTO",
+			Usage: "how to write results of select queries with no records. one of: AUTO|HEADER|NONE|MESSAGE",
+		},
 		cli.BoolFlag{
 			Name:  "quiet, q",
 			Usage: "suppress operation log output",
@@ -106,10 +192,45 @@ func main() {
 			Value: defaultCPU,
 			Usage: "hint for the number of cpu cores to be used. 1 - number of cpu cores",
 		},
+		cli.Int64Flag{
+			Name:  "seed",
+			Usage: "non-zero seed for random numbers. records are processed sequentially if specified",
+		},
 		cli.BoolFlag{
 			Name:  "stats, x",
 			Usage: "show execution time and memory statistics",
 		},
+		cli.BoolFlag{
+			Name:  "profile",
+			Usage: "show time spent in each phase of statements",
+		},
+		cli.BoolFlag{
+			Name:  "warnings",
+			Usage: "show warnings occurred during execution",
+		},
+		cli.BoolFlag{
+			Name:  "read-only",
+			Usage: "prohibit statements that modify files",
+		},
+		cli.BoolFlag{
+			Name:  "backup",
+			Usage: "create backups of files before they are updated",
+		},
+		cli.StringFlag{
+			Name:  "backup-suffix",
+			Value: ".bak",
+			Usage: "suffix of backup file names",
+		},
+		cli.StringFlag{
+			Name:  "error-format",
+			Value: "TEXT",
+			Usage: "error output format. one of: TEXT|JSON",
+		},
+		cli.StringFlag{
+			Name:  "summary",
+			Value: "TEXT",
+			Usage: "output format of the results of operations on files. one of: TEXT|JSON",
+		},
 	}
 
 	app.Commands = []cli.Command{
@@ -180,9 +301,14 @@ func main() {
 			if apperr, ok := err.(query.AppError); ok {
 				code = apperr.GetCode()
 			} else if ex, ok := err.(*query.Exit); ok {
-				code = ex.GetCode()
+				return cli.NewExitError(ex.Error(), ex.GetCode())
+			}
+
+			message := err.Error()
+			if cmd.GetFlags().ErrorFormat == cmd.JSON {
+				message = query.EncodeErrorToJson(err)
 			}
-			return cli.NewExitError(err.Error(), code)
+			return cli.NewExitError(message, code)
 		}
 
 		return nil
@@ -225,6 +351,7 @@ func setFlags(c *cli.Context) error {
 	if err := cmd.SetEncoding(c.GlobalString("encoding")); err != nil {
 		return err
 	}
+	cmd.SetEncodingFallback(c.GlobalBoolT("encoding-fallback"))
 	if err := cmd.SetLineBreak(c.String("line-break")); err != nil {
 		return err
 	}
@@ -241,6 +368,31 @@ func setFlags(c *cli.Context) error {
 	cmd.SetWaitTimeout(c.GlobalFloat64("wait-timeout"))
 	cmd.SetNoHeader(c.GlobalBool("no-header"))
 	cmd.SetWithoutNull(c.GlobalBool("without-null"))
+	cmd.SetTrimTrailingDelimiter(c.GlobalBool("trim-trailing-delimiter"))
+	cmd.SetSkipLines(c.GlobalInt("skip-lines"))
+	cmd.SetHead(c.GlobalInt("head"))
+	cmd.SetCommentPrefix(c.GlobalString("comment"))
+	if err := cmd.SetTsvStyle(c.GlobalString("tsv-style")); err != nil {
+		return err
+	}
+	cmd.SetPreserveQuoting(c.GlobalBool("preserve-quoting"))
+	cmd.SetPreserveLineBreak(c.GlobalBoolT("preserve-linebreak"))
+	if err := cmd.SetLocale(c.GlobalString("locale")); err != nil {
+		return err
+	}
+	if err := cmd.SetBooleanLiterals(c.GlobalString("boolean-literals")); err != nil {
+		return err
+	}
+	if err := cmd.SetInferTypes(c.GlobalString("infer-types")); err != nil {
+		return err
+	}
+	cmd.SetSchemaNullOnError(c.GlobalBool("schema-null-on-error"))
+	cmd.SetMaxErrors(c.GlobalInt("max-errors"))
+	cmd.SetMaxIterations(c.GlobalInt("max-iterations"))
+	cmd.SetApproxPrecision(c.GlobalInt("approx-precision"))
+	cmd.SetMaxResultRows(c.GlobalInt("max-result-rows"))
+	cmd.SetStrictUnionTypes(c.GlobalBool("strict-union-types"))
+	cmd.SetStrictSelectInto(c.GlobalBool("strict-select-into"))
 
 	if err := cmd.SetWriteEncoding(c.GlobalString("write-encoding")); err != nil {
 		return err
@@ -255,10 +407,30 @@ func setFlags(c *cli.Context) error {
 		return err
 	}
 	cmd.SetWithoutHeader(c.GlobalBool("without-header"))
+	if err := cmd.SetNumberNotation(c.GlobalString("number-notation")); err != nil {
+		return err
+	}
+	if err := cmd.SetEmptyResult(c.GlobalString("empty-result")); err != nil {
+		return err
+	}
 
 	cmd.SetQuiet(c.GlobalBool("quiet"))
 	cmd.SetCPU(c.GlobalInt("cpu"))
+	cmd.SetSeed(c.GlobalInt64("seed"))
 	cmd.SetStats(c.GlobalBool("stats"))
+	cmd.SetProfile(c.GlobalBool("profile"))
+	cmd.SetWarnings(c.GlobalBool("warnings"))
+	cmd.SetReadOnly(c.GlobalBool("read-only"))
+	cmd.SetBackup(c.GlobalBool("backup"))
+	if err := cmd.SetBackupSuffix(c.GlobalString("backup-suffix")); err != nil {
+		return err
+	}
+	if err := cmd.SetErrorFormat(c.GlobalString("error-format")); err != nil {
+		return err
+	}
+	if err := cmd.SetSummary(c.GlobalString("summary")); err != nil {
+		return err
+	}
 
 	return nil
 }